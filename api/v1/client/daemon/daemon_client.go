@@ -78,8 +78,14 @@ type ClientService interface {
 
 	GetNodeIds(params *GetNodeIdsParams, opts ...ClientOption) (*GetNodeIdsOK, error)
 
+	GetStatusCollectorStats(params *GetStatusCollectorStatsParams, opts ...ClientOption) (*GetStatusCollectorStatsOK, error)
+
+	GetStatusWatch(params *GetStatusWatchParams, writer io.Writer, opts ...ClientOption) (*GetStatusWatchOK, error)
+
 	PatchConfig(params *PatchConfigParams, opts ...ClientOption) (*PatchConfigOK, error)
 
+	PutStatusProbes(params *PutStatusProbesParams, opts ...ClientOption) (*PutStatusProbesOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -436,6 +442,92 @@ func (a *Client) GetNodeIds(params *GetNodeIdsParams, opts ...ClientOption) (*Ge
 	panic(msg)
 }
 
+/*
+	GetStatusCollectorStats gets internal statistics of the status collector
+
+	Returns statistics about the probes run by the status collector, such
+
+as the number of probes currently running or failing.
+*/
+func (a *Client) GetStatusCollectorStats(params *GetStatusCollectorStatsParams, opts ...ClientOption) (*GetStatusCollectorStatsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetStatusCollectorStatsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetStatusCollectorStats",
+		Method:             "GET",
+		PathPattern:        "/status/collector/stats",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetStatusCollectorStatsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetStatusCollectorStatsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetStatusCollectorStats: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+	GetStatusWatch streams health of cilium daemon
+
+	Streams the health and status information of the Cilium daemon as
+
+newline-delimited JSON. A new status object is emitted whenever a
+status probe reports an update, and a heartbeat is emitted
+periodically even when nothing changes.
+*/
+func (a *Client) GetStatusWatch(params *GetStatusWatchParams, writer io.Writer, opts ...ClientOption) (*GetStatusWatchOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetStatusWatchParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetStatusWatch",
+		Method:             "GET",
+		PathPattern:        "/status/watch",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetStatusWatchReader{formats: a.formats, writer: writer},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetStatusWatchOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetStatusWatch: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 	PatchConfig modifies daemon configuration
 
@@ -479,6 +571,49 @@ func (a *Client) PatchConfig(params *PatchConfigParams, opts ...ClientOption) (*
 	panic(msg)
 }
 
+/*
+	PutStatusProbes enables or disables a status probe
+
+	Disables or re-enables scheduling of the named status probe.
+
+Disabled probes are no longer run and report as disabled rather
+than going stale.
+*/
+func (a *Client) PutStatusProbes(params *PutStatusProbesParams, opts ...ClientOption) (*PutStatusProbesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPutStatusProbesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PutStatusProbes",
+		Method:             "PUT",
+		PathPattern:        "/status/probes",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PutStatusProbesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PutStatusProbesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for PutStatusProbes: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
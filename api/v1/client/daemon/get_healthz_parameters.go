@@ -72,6 +72,16 @@ type GetHealthzParams struct {
 	*/
 	Brief *bool
 
+	/* Components.
+
+	     Comma-separated list of status components to include in the
+	response, e.g. "encryption,kube-proxy-replacement". Component
+	names match the field names of the status response. If unset,
+	the full status is returned.
+
+	*/
+	Components *string
+
 	/* RequireK8sConnectivity.
 
 	   If set to true, failure of the agent to connect to the Kubernetes control plane will cause the agent's health status to also fail.
@@ -81,6 +91,13 @@ type GetHealthzParams struct {
 	*/
 	RequireK8sConnectivity *bool
 
+	/* Verbose.
+
+	   Verbose will return status sections omitted from the default payload, such as the per-service backend weights.
+
+	*/
+	Verbose *bool
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -156,6 +173,17 @@ func (o *GetHealthzParams) SetBrief(brief *bool) {
 	o.Brief = brief
 }
 
+// WithComponents adds the components to the get healthz params
+func (o *GetHealthzParams) WithComponents(components *string) *GetHealthzParams {
+	o.SetComponents(components)
+	return o
+}
+
+// SetComponents adds the components to the get healthz params
+func (o *GetHealthzParams) SetComponents(components *string) {
+	o.Components = components
+}
+
 // WithRequireK8sConnectivity adds the requireK8sConnectivity to the get healthz params
 func (o *GetHealthzParams) WithRequireK8sConnectivity(requireK8sConnectivity *bool) *GetHealthzParams {
 	o.SetRequireK8sConnectivity(requireK8sConnectivity)
@@ -167,6 +195,17 @@ func (o *GetHealthzParams) SetRequireK8sConnectivity(requireK8sConnectivity *boo
 	o.RequireK8sConnectivity = requireK8sConnectivity
 }
 
+// WithVerbose adds the verbose to the get healthz params
+func (o *GetHealthzParams) WithVerbose(verbose *bool) *GetHealthzParams {
+	o.SetVerbose(verbose)
+	return o
+}
+
+// SetVerbose adds the verbose to the get healthz params
+func (o *GetHealthzParams) SetVerbose(verbose *bool) {
+	o.Verbose = verbose
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *GetHealthzParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -183,6 +222,23 @@ func (o *GetHealthzParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Re
 		}
 	}
 
+	if o.Components != nil {
+
+		// query param components
+		var qrComponents string
+
+		if o.Components != nil {
+			qrComponents = *o.Components
+		}
+		qComponents := qrComponents
+		if qComponents != "" {
+
+			if err := r.SetQueryParam("components", qComponents); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.RequireK8sConnectivity != nil {
 
 		// header param require-k8s-connectivity
@@ -191,6 +247,14 @@ func (o *GetHealthzParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Re
 		}
 	}
 
+	if o.Verbose != nil {
+
+		// header param verbose
+		if err := r.SetHeaderParam("verbose", swag.FormatBool(*o.Verbose)); err != nil {
+			return err
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -33,6 +33,12 @@ func (o *GetHealthzReader) ReadResponse(response runtime.ClientResponse, consume
 			return nil, err
 		}
 		return result, nil
+	case 400:
+		result := NewGetHealthzInvalid()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	default:
 		return nil, runtime.NewAPIError("[GET /healthz] GetHealthz", response, response.Code())
 	}
@@ -107,3 +113,71 @@ func (o *GetHealthzOK) readResponse(response runtime.ClientResponse, consumer ru
 
 	return nil
 }
+
+// NewGetHealthzInvalid creates a GetHealthzInvalid with default headers values
+func NewGetHealthzInvalid() *GetHealthzInvalid {
+	return &GetHealthzInvalid{}
+}
+
+/*
+GetHealthzInvalid describes a response with status code 400, with default header values.
+
+Unknown status component
+*/
+type GetHealthzInvalid struct {
+	Payload models.Error
+}
+
+// IsSuccess returns true when this get healthz invalid response has a 2xx status code
+func (o *GetHealthzInvalid) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get healthz invalid response has a 3xx status code
+func (o *GetHealthzInvalid) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get healthz invalid response has a 4xx status code
+func (o *GetHealthzInvalid) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get healthz invalid response has a 5xx status code
+func (o *GetHealthzInvalid) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get healthz invalid response a status code equal to that given
+func (o *GetHealthzInvalid) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the get healthz invalid response
+func (o *GetHealthzInvalid) Code() int {
+	return 400
+}
+
+func (o *GetHealthzInvalid) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /healthz][%d] getHealthzInvalid %s", 400, payload)
+}
+
+func (o *GetHealthzInvalid) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /healthz][%d] getHealthzInvalid %s", 400, payload)
+}
+
+func (o *GetHealthzInvalid) GetPayload() models.Error {
+	return o.Payload
+}
+
+func (o *GetHealthzInvalid) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -65,6 +65,8 @@ type ClientService interface {
 
 	GetEndpoint(params *GetEndpointParams, opts ...ClientOption) (*GetEndpointOK, error)
 
+	GetEndpointGc(params *GetEndpointGcParams, opts ...ClientOption) (*GetEndpointGcOK, error)
+
 	GetEndpointID(params *GetEndpointIDParams, opts ...ClientOption) (*GetEndpointIDOK, error)
 
 	GetEndpointIDConfig(params *GetEndpointIDConfigParams, opts ...ClientOption) (*GetEndpointIDConfigOK, error)
@@ -217,6 +219,48 @@ func (a *Client) GetEndpoint(params *GetEndpointParams, opts ...ClientOption) (*
 	panic(msg)
 }
 
+/*
+	GetEndpointGc retrieves the history of endpoint garbage collection cycles
+
+	Returns the results of the most recent endpoint garbage collection
+
+cycles, ordered from oldest to newest.
+*/
+func (a *Client) GetEndpointGc(params *GetEndpointGcParams, opts ...ClientOption) (*GetEndpointGcOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetEndpointGcParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetEndpointGc",
+		Method:             "GET",
+		PathPattern:        "/endpoint/gc",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetEndpointGcReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetEndpointGcOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetEndpointGc: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 GetEndpointID gets endpoint by endpoint ID
 
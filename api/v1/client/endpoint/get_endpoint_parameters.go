@@ -17,6 +17,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	"github.com/cilium/cilium/api/v1/models"
 )
@@ -66,6 +67,23 @@ GetEndpointParams contains all the parameters to send to the API endpoint
 */
 type GetEndpointParams struct {
 
+	/* Continue.
+
+	     Opaque continue token returned in the X-Cilium-List-Continue
+	header of a previous response, to retrieve the next page.
+
+	*/
+	Continue *string
+
+	/* Fields.
+
+	     Comma-separated list of endpoint status fields to include in the
+	response, e.g. "identity,networking,state". If unset, all fields
+	are included.
+
+	*/
+	Fields *string
+
 	/* Labels.
 
 	   List of labels
@@ -73,6 +91,16 @@ type GetEndpointParams struct {
 	*/
 	Labels models.Labels
 
+	/* Limit.
+
+	     Maximum number of endpoints to return. If more endpoints are
+	available, the response carries a continue token in the
+	X-Cilium-List-Continue header. If unset, all endpoints are
+	returned in a single response.
+
+	*/
+	Limit *int64
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -126,6 +154,28 @@ func (o *GetEndpointParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithContinue adds the continueVar to the get endpoint params
+func (o *GetEndpointParams) WithContinue(continueVar *string) *GetEndpointParams {
+	o.SetContinue(continueVar)
+	return o
+}
+
+// SetContinue adds the continue to the get endpoint params
+func (o *GetEndpointParams) SetContinue(continueVar *string) {
+	o.Continue = continueVar
+}
+
+// WithFields adds the fields to the get endpoint params
+func (o *GetEndpointParams) WithFields(fields *string) *GetEndpointParams {
+	o.SetFields(fields)
+	return o
+}
+
+// SetFields adds the fields to the get endpoint params
+func (o *GetEndpointParams) SetFields(fields *string) {
+	o.Fields = fields
+}
+
 // WithLabels adds the labels to the get endpoint params
 func (o *GetEndpointParams) WithLabels(labels models.Labels) *GetEndpointParams {
 	o.SetLabels(labels)
@@ -137,6 +187,17 @@ func (o *GetEndpointParams) SetLabels(labels models.Labels) {
 	o.Labels = labels
 }
 
+// WithLimit adds the limit to the get endpoint params
+func (o *GetEndpointParams) WithLimit(limit *int64) *GetEndpointParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the get endpoint params
+func (o *GetEndpointParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *GetEndpointParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -144,12 +205,63 @@ func (o *GetEndpointParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 		return err
 	}
 	var res []error
+
+	if o.Continue != nil {
+
+		// query param continue
+		var qrContinue string
+
+		if o.Continue != nil {
+			qrContinue = *o.Continue
+		}
+		qContinue := qrContinue
+		if qContinue != "" {
+
+			if err := r.SetQueryParam("continue", qContinue); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Fields != nil {
+
+		// query param fields
+		var qrFields string
+
+		if o.Fields != nil {
+			qrFields = *o.Fields
+		}
+		qFields := qrFields
+		if qFields != "" {
+
+			if err := r.SetQueryParam("fields", qFields); err != nil {
+				return err
+			}
+		}
+	}
 	if o.Labels != nil {
 		if err := r.SetBodyParam(o.Labels); err != nil {
 			return err
 		}
 	}
 
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -33,6 +33,12 @@ func (o *GetEndpointReader) ReadResponse(response runtime.ClientResponse, consum
 			return nil, err
 		}
 		return result, nil
+	case 400:
+		result := NewGetEndpointInvalid()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 404:
 		result := NewGetEndpointNotFound()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -61,6 +67,13 @@ GetEndpointOK describes a response with status code 200, with default header val
 Success
 */
 type GetEndpointOK struct {
+
+	/* Token to retrieve the next page of endpoints. Empty when
+	there are no further endpoints.
+
+	*/
+	XCiliumListContinue string
+
 	Payload []*models.Endpoint
 }
 
@@ -110,6 +123,13 @@ func (o *GetEndpointOK) GetPayload() []*models.Endpoint {
 
 func (o *GetEndpointOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header X-Cilium-List-Continue
+	hdrXCiliumListContinue := response.GetHeader("X-Cilium-List-Continue")
+
+	if hdrXCiliumListContinue != "" {
+		o.XCiliumListContinue = hdrXCiliumListContinue
+	}
+
 	// response payload
 	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
 		return err
@@ -118,6 +138,62 @@ func (o *GetEndpointOK) readResponse(response runtime.ClientResponse, consumer r
 	return nil
 }
 
+// NewGetEndpointInvalid creates a GetEndpointInvalid with default headers values
+func NewGetEndpointInvalid() *GetEndpointInvalid {
+	return &GetEndpointInvalid{}
+}
+
+/*
+GetEndpointInvalid describes a response with status code 400, with default header values.
+
+Invalid request (malformed continue token or unknown field name)
+*/
+type GetEndpointInvalid struct {
+}
+
+// IsSuccess returns true when this get endpoint invalid response has a 2xx status code
+func (o *GetEndpointInvalid) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get endpoint invalid response has a 3xx status code
+func (o *GetEndpointInvalid) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get endpoint invalid response has a 4xx status code
+func (o *GetEndpointInvalid) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get endpoint invalid response has a 5xx status code
+func (o *GetEndpointInvalid) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get endpoint invalid response a status code equal to that given
+func (o *GetEndpointInvalid) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the get endpoint invalid response
+func (o *GetEndpointInvalid) Code() int {
+	return 400
+}
+
+func (o *GetEndpointInvalid) Error() string {
+	return fmt.Sprintf("[GET /endpoint][%d] getEndpointInvalid", 400)
+}
+
+func (o *GetEndpointInvalid) String() string {
+	return fmt.Sprintf("[GET /endpoint][%d] getEndpointInvalid", 400)
+}
+
+func (o *GetEndpointInvalid) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
 // NewGetEndpointNotFound creates a GetEndpointNotFound with default headers values
 func NewGetEndpointNotFound() *GetEndpointNotFound {
 	return &GetEndpointNotFound{}
@@ -30,6 +30,10 @@ type PathStatus struct {
 
 	// IP address queried for the connectivity status
 	IP string `json:"ip,omitempty"`
+
+	// Reachability of the node's tunnel port (UDP), only probed when the
+	// tunnel port probe is enabled
+	Tunnel *ConnectivityStatus `json:"tunnel,omitempty"`
 }
 
 // Validate validates this path status
@@ -44,6 +48,10 @@ func (m *PathStatus) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateTunnel(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -88,6 +96,25 @@ func (m *PathStatus) validateIcmp(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *PathStatus) validateTunnel(formats strfmt.Registry) error {
+	if swag.IsZero(m.Tunnel) { // not required
+		return nil
+	}
+
+	if m.Tunnel != nil {
+		if err := m.Tunnel.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("tunnel")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("tunnel")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ContextValidate validate this path status based on the context it is used
 func (m *PathStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -100,6 +127,10 @@ func (m *PathStatus) ContextValidate(ctx context.Context, formats strfmt.Registr
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateTunnel(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -148,6 +179,27 @@ func (m *PathStatus) contextValidateIcmp(ctx context.Context, formats strfmt.Reg
 	return nil
 }
 
+func (m *PathStatus) contextValidateTunnel(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Tunnel != nil {
+
+		if swag.IsZero(m.Tunnel) { // not required
+			return nil
+		}
+
+		if err := m.Tunnel.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("tunnel")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("tunnel")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *PathStatus) MarshalBinary() ([]byte, error) {
 	if m == nil {
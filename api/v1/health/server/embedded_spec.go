@@ -245,6 +245,10 @@ func init() {
         "ip": {
           "description": "IP address queried for the connectivity status",
           "type": "string"
+        },
+        "tunnel": {
+          "description": "Reachability of the node's tunnel port (UDP), only probed when the\ntunnel port probe is enabled",
+          "$ref": "#/definitions/ConnectivityStatus"
         }
       }
     },
@@ -503,6 +507,10 @@ func init() {
         "ip": {
           "description": "IP address queried for the connectivity status",
           "type": "string"
+        },
+        "tunnel": {
+          "description": "Reachability of the node's tunnel port (UDP), only probed when the\ntunnel port probe is enabled",
+          "$ref": "#/definitions/ConnectivityStatus"
         }
       }
     },
@@ -25,8 +25,14 @@ type BPFMap struct {
 	// Contents of cache
 	Cache []*BPFMapEntry `json:"cache"`
 
+	// ID of the BPF map as reported by the kernel
+	ID int64 `json:"id,omitempty"`
+
 	// Path to BPF map
 	Path string `json:"path,omitempty"`
+
+	// True if the agent re-used an existing pinned map instead of creating a new one
+	ReusedPin bool `json:"reused-pin,omitempty"`
 }
 
 // Validate validates this b p f map
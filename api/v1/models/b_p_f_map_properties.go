@@ -17,14 +17,31 @@ import (
 
 // BPFMapProperties BPF map properties
 //
+// +k8s:deepcopy-gen=true
+//
 // swagger:model BPFMapProperties
 type BPFMapProperties struct {
 
+	// ID of the BPF map as reported by the kernel
+	ID int64 `json:"id,omitempty"`
+
 	// Name of the BPF map
 	Name string `json:"name,omitempty"`
 
+	// Path the BPF map is pinned to in the BPF filesystem
+	PinPath string `json:"pin-path,omitempty"`
+
+	// True if the agent re-used an existing pinned map instead of creating a new one
+	ReusedPin bool `json:"reused-pin,omitempty"`
+
 	// Size of the BPF map
 	Size int64 `json:"size,omitempty"`
+
+	// Number of entries in the BPF map when it was last sampled. Null if
+	// the map has not been sampled yet, for example because it has not
+	// been created.
+	//
+	Used *int64 `json:"used,omitempty"`
 }
 
 // Validate validates this b p f map properties
@@ -34,6 +34,9 @@ type BandwidthManager struct {
 
 	// Is bandwidth manager enabled
 	Enabled bool `json:"enabled,omitempty"`
+
+	// Human readable reason why the bandwidth manager is disabled
+	Reason string `json:"reason,omitempty"`
 }
 
 // Validate validates this bandwidth manager
@@ -27,6 +27,9 @@ type ClusterMeshStatus struct {
 	// List of remote clusters
 	Clusters []*RemoteCluster `json:"clusters"`
 
+	// Number of remote clusters contributing backends to global services
+	NumContributingClusters int64 `json:"num-contributing-clusters,omitempty"`
+
 	// Number of global services
 	NumGlobalServices int64 `json:"num-global-services,omitempty"`
 }
@@ -29,6 +29,9 @@ type EndpointHealth struct {
 	// Is this endpoint reachable
 	Connected bool `json:"connected,omitempty"`
 
+	// Proxy redirects required by the endpoint's policy which are not installed
+	MissingProxyRedirects []string `json:"missingProxyRedirects"`
+
 	// overall health
 	OverallHealth EndpointHealthStatus `json:"overallHealth,omitempty"`
 
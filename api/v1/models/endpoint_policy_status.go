@@ -22,6 +22,9 @@ import (
 // swagger:model EndpointPolicyStatus
 type EndpointPolicyStatus struct {
 
+	// Fraction of the endpoint's BPF policy map in use (0.0 to 1.0)
+	PolicyMapPressure float64 `json:"policy-map-pressure,omitempty"`
+
 	// The policy revision currently enforced in the proxy for this endpoint
 	ProxyPolicyRevision int64 `json:"proxy-policy-revision,omitempty"`
 
@@ -10,6 +10,7 @@ package models
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -22,6 +23,9 @@ import (
 // swagger:model EndpointStatus
 type EndpointStatus struct {
 
+	// BPF maps dedicated to this endpoint
+	BpfMaps []*EndpointBPFMap `json:"bpf-maps"`
+
 	// Status of internal controllers attached to this endpoint
 	Controllers ControllerStatuses `json:"controllers,omitempty"`
 
@@ -61,6 +65,10 @@ type EndpointStatus struct {
 func (m *EndpointStatus) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateBpfMaps(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateControllers(formats); err != nil {
 		res = append(res, err)
 	}
@@ -111,6 +119,32 @@ func (m *EndpointStatus) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *EndpointStatus) validateBpfMaps(formats strfmt.Registry) error {
+	if swag.IsZero(m.BpfMaps) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.BpfMaps); i++ {
+		if swag.IsZero(m.BpfMaps[i]) { // not required
+			continue
+		}
+
+		if m.BpfMaps[i] != nil {
+			if err := m.BpfMaps[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("bpf-maps" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("bpf-maps" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *EndpointStatus) validateControllers(formats strfmt.Registry) error {
 	if swag.IsZero(m.Controllers) { // not required
 		return nil
@@ -323,6 +357,10 @@ func (m *EndpointStatus) validateState(formats strfmt.Registry) error {
 func (m *EndpointStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateBpfMaps(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateControllers(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -373,6 +411,31 @@ func (m *EndpointStatus) ContextValidate(ctx context.Context, formats strfmt.Reg
 	return nil
 }
 
+func (m *EndpointStatus) contextValidateBpfMaps(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.BpfMaps); i++ {
+
+		if m.BpfMaps[i] != nil {
+
+			if swag.IsZero(m.BpfMaps[i]) { // not required
+				return nil
+			}
+
+			if err := m.BpfMaps[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("bpf-maps" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("bpf-maps" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *EndpointStatus) contextValidateControllers(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := m.Controllers.ContextValidate(ctx, formats); err != nil {
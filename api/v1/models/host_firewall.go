@@ -31,6 +31,9 @@ type HostFirewall struct {
 	// mode
 	// Enum: ["Disabled","Enabled"]
 	Mode string `json:"mode,omitempty"`
+
+	// Human readable reason why the host firewall is disabled
+	Reason string `json:"reason,omitempty"`
 }
 
 // Validate validates this host firewall
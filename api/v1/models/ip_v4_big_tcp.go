@@ -28,6 +28,9 @@ type IPV4BigTCP struct {
 
 	// Maximum IPv4 GSO size
 	MaxGSO int64 `json:"maxGSO,omitempty"`
+
+	// Human readable reason why IPv4 BIG TCP is disabled
+	Reason string `json:"reason,omitempty"`
 }
 
 // Validate validates this IP v4 big TCP
@@ -28,6 +28,9 @@ type IPV6BigTCP struct {
 
 	// Maximum IPv6 GSO size
 	MaxGSO int64 `json:"maxGSO,omitempty"`
+
+	// Human readable reason why IPv6 BIG TCP is disabled
+	Reason string `json:"reason,omitempty"`
 }
 
 // Validate validates this IP v6 big TCP
@@ -11,6 +11,7 @@ package models
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -25,21 +26,34 @@ import (
 // swagger:model K8sStatus
 type K8sStatus struct {
 
+	// Per-address-family connectivity to the kube-apiserver, populated on dual-stack clusters when the apiserver is reachable over both families
+	Families []*K8sFamilyStatus `json:"families"`
+
 	// k8s api versions
 	K8sAPIVersions []string `json:"k8s-api-versions"`
 
 	// Human readable status/error/warning message
 	Msg string `json:"msg,omitempty"`
 
+	// Recent rate of requests to the kube-apiserver in requests per second
+	RequestRate float64 `json:"request-rate,omitempty"`
+
 	// State the component is in
 	// Enum: ["Ok","Warning","Failure","Disabled"]
 	State string `json:"state,omitempty"`
+
+	// Total count of requests delayed by the client-side rate limiter
+	ThrottleEvents int64 `json:"throttle-events,omitempty"`
 }
 
 // Validate validates this k8s status
 func (m *K8sStatus) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateFamilies(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateState(formats); err != nil {
 		res = append(res, err)
 	}
@@ -50,6 +64,32 @@ func (m *K8sStatus) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *K8sStatus) validateFamilies(formats strfmt.Registry) error {
+	if swag.IsZero(m.Families) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Families); i++ {
+		if swag.IsZero(m.Families[i]) { // not required
+			continue
+		}
+
+		if m.Families[i] != nil {
+			if err := m.Families[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("families" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("families" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 var k8sStatusTypeStatePropEnum []interface{}
 
 func init() {
@@ -98,8 +138,42 @@ func (m *K8sStatus) validateState(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this k8s status based on context it is used
+// ContextValidate validate this k8s status based on the context it is used
 func (m *K8sStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateFamilies(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *K8sStatus) contextValidateFamilies(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Families); i++ {
+
+		if m.Families[i] != nil {
+
+			if swag.IsZero(m.Families[i]) { // not required
+				return nil
+			}
+
+			if err := m.Families[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("families" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("families" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -43,6 +43,9 @@ type KubeProxyReplacement struct {
 	// mode
 	// Enum: ["True","False"]
 	Mode string `json:"mode,omitempty"`
+
+	// Fraction of the global NAT table currently in use
+	NatTableUtilization float64 `json:"natTableUtilization,omitempty"`
 }
 
 // Validate validates this kube proxy replacement
@@ -243,13 +246,18 @@ func (m *KubeProxyReplacement) UnmarshalBinary(b []byte) error {
 // swagger:model KubeProxyReplacementDeviceListItems0
 type KubeProxyReplacementDeviceListItems0 struct {
 
-	//
+	// Primary IP addresses of the device
 	//
 	// +k8s:deepcopy-gen=true
 	IP []string `json:"ip"`
 
 	// name
 	Name string `json:"name,omitempty"`
+
+	// Secondary IP addresses of the device
+	//
+	// +k8s:deepcopy-gen=true
+	SecondaryIP []string `json:"secondaryIP"`
 }
 
 // Validate validates this kube proxy replacement device list items0
@@ -319,6 +327,9 @@ type KubeProxyReplacementFeatures struct {
 
 	// socket l b tracing
 	SocketLBTracing *KubeProxyReplacementFeaturesSocketLBTracing `json:"socketLBTracing,omitempty"`
+
+	// source range check
+	SourceRangeCheck *KubeProxyReplacementFeaturesSourceRangeCheck `json:"sourceRangeCheck,omitempty"`
 }
 
 // Validate validates this kube proxy replacement features
@@ -361,6 +372,10 @@ func (m *KubeProxyReplacementFeatures) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateSourceRangeCheck(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -538,6 +553,25 @@ func (m *KubeProxyReplacementFeatures) validateSocketLBTracing(formats strfmt.Re
 	return nil
 }
 
+func (m *KubeProxyReplacementFeatures) validateSourceRangeCheck(formats strfmt.Registry) error {
+	if swag.IsZero(m.SourceRangeCheck) { // not required
+		return nil
+	}
+
+	if m.SourceRangeCheck != nil {
+		if err := m.SourceRangeCheck.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("features" + "." + "sourceRangeCheck")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("features" + "." + "sourceRangeCheck")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ContextValidate validate this kube proxy replacement features based on the context it is used
 func (m *KubeProxyReplacementFeatures) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -578,6 +612,10 @@ func (m *KubeProxyReplacementFeatures) ContextValidate(ctx context.Context, form
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateSourceRangeCheck(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -773,6 +811,27 @@ func (m *KubeProxyReplacementFeatures) contextValidateSocketLBTracing(ctx contex
 	return nil
 }
 
+func (m *KubeProxyReplacementFeatures) contextValidateSourceRangeCheck(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.SourceRangeCheck != nil {
+
+		if swag.IsZero(m.SourceRangeCheck) { // not required
+			return nil
+		}
+
+		if err := m.SourceRangeCheck.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("features" + "." + "sourceRangeCheck")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("features" + "." + "sourceRangeCheck")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *KubeProxyReplacementFeatures) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -1174,7 +1233,9 @@ func (m *KubeProxyReplacementFeaturesNat46X64Service) UnmarshalBinary(b []byte)
 	return nil
 }
 
-// KubeProxyReplacementFeaturesNodePort kube proxy replacement features node port
+// KubeProxyReplacementFeaturesNodePort
+//
+// +k8s:deepcopy-gen=true
 //
 // swagger:model KubeProxyReplacementFeaturesNodePort
 type KubeProxyReplacementFeaturesNodePort struct {
@@ -1183,6 +1244,15 @@ type KubeProxyReplacementFeaturesNodePort struct {
 	// Enum: ["None","Native","Generic","Best-Effort"]
 	Acceleration string `json:"acceleration,omitempty"`
 
+	//
+	//
+	// +k8s:deepcopy-gen=true
+	AccelerationDevices []*KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0 `json:"accelerationDevices"`
+
+	// Status of XDP acceleration across devices. Warning if any
+	// device fell back from the requested acceleration mode.
+	AccelerationStatus *Status `json:"accelerationStatus,omitempty"`
+
 	// algorithm
 	// Enum: ["Random","Maglev"]
 	Algorithm string `json:"algorithm,omitempty"`
@@ -1216,6 +1286,14 @@ func (m *KubeProxyReplacementFeaturesNodePort) Validate(formats strfmt.Registry)
 		res = append(res, err)
 	}
 
+	if err := m.validateAccelerationDevices(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateAccelerationStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateAlgorithm(formats); err != nil {
 		res = append(res, err)
 	}
@@ -1282,6 +1360,51 @@ func (m *KubeProxyReplacementFeaturesNodePort) validateAcceleration(formats strf
 	return nil
 }
 
+func (m *KubeProxyReplacementFeaturesNodePort) validateAccelerationDevices(formats strfmt.Registry) error {
+	if swag.IsZero(m.AccelerationDevices) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.AccelerationDevices); i++ {
+		if swag.IsZero(m.AccelerationDevices[i]) { // not required
+			continue
+		}
+
+		if m.AccelerationDevices[i] != nil {
+			if err := m.AccelerationDevices[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("features" + "." + "nodePort" + "." + "accelerationDevices" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("features" + "." + "nodePort" + "." + "accelerationDevices" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *KubeProxyReplacementFeaturesNodePort) validateAccelerationStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.AccelerationStatus) { // not required
+		return nil
+	}
+
+	if m.AccelerationStatus != nil {
+		if err := m.AccelerationStatus.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("features" + "." + "nodePort" + "." + "accelerationStatus")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("features" + "." + "nodePort" + "." + "accelerationStatus")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 var kubeProxyReplacementFeaturesNodePortTypeAlgorithmPropEnum []interface{}
 
 func init() {
@@ -1414,8 +1537,67 @@ func (m *KubeProxyReplacementFeaturesNodePort) validateMode(formats strfmt.Regis
 	return nil
 }
 
-// ContextValidate validates this kube proxy replacement features node port based on context it is used
+// ContextValidate validate this kube proxy replacement features node port based on the context it is used
 func (m *KubeProxyReplacementFeaturesNodePort) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateAccelerationDevices(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateAccelerationStatus(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *KubeProxyReplacementFeaturesNodePort) contextValidateAccelerationDevices(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.AccelerationDevices); i++ {
+
+		if m.AccelerationDevices[i] != nil {
+
+			if swag.IsZero(m.AccelerationDevices[i]) { // not required
+				return nil
+			}
+
+			if err := m.AccelerationDevices[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("features" + "." + "nodePort" + "." + "accelerationDevices" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("features" + "." + "nodePort" + "." + "accelerationDevices" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *KubeProxyReplacementFeaturesNodePort) contextValidateAccelerationStatus(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.AccelerationStatus != nil {
+
+		if swag.IsZero(m.AccelerationStatus) { // not required
+			return nil
+		}
+
+		if err := m.AccelerationStatus.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("features" + "." + "nodePort" + "." + "accelerationStatus")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("features" + "." + "nodePort" + "." + "accelerationStatus")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -1437,6 +1619,109 @@ func (m *KubeProxyReplacementFeaturesNodePort) UnmarshalBinary(b []byte) error {
 	return nil
 }
 
+// KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0
+//
+// +k8s:deepcopy-gen=true
+//
+// swagger:model KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0
+type KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0 struct {
+
+	// attached mode
+	// Enum: ["Native","Generic","None"]
+	AttachedMode string `json:"attachedMode,omitempty"`
+
+	// device
+	Device string `json:"device,omitempty"`
+
+	// fallback reason
+	FallbackReason string `json:"fallbackReason,omitempty"`
+
+	// requested mode
+	RequestedMode string `json:"requestedMode,omitempty"`
+}
+
+// Validate validates this kube proxy replacement features node port acceleration devices items0
+func (m *KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAttachedMode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var kubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0TypeAttachedModePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["Native","Generic","None"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		kubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0TypeAttachedModePropEnum = append(kubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0TypeAttachedModePropEnum, v)
+	}
+}
+
+const (
+
+	// KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0AttachedModeNative captures enum value "Native"
+	KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0AttachedModeNative string = "Native"
+
+	// KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0AttachedModeGeneric captures enum value "Generic"
+	KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0AttachedModeGeneric string = "Generic"
+
+	// KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0AttachedModeNone captures enum value "None"
+	KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0AttachedModeNone string = "None"
+)
+
+// prop value enum
+func (m *KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0) validateAttachedModeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, kubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0TypeAttachedModePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0) validateAttachedMode(formats strfmt.Registry) error {
+	if swag.IsZero(m.AttachedMode) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateAttachedModeEnum("attachedMode", "body", m.AttachedMode); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this kube proxy replacement features node port acceleration devices items0 based on context it is used
+func (m *KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0) UnmarshalBinary(b []byte) error {
+	var res KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
 // KubeProxyReplacementFeaturesSessionAffinity kube proxy replacement features session affinity
 //
 // swagger:model KubeProxyReplacementFeaturesSessionAffinity
@@ -1481,6 +1766,23 @@ type KubeProxyReplacementFeaturesSocketLB struct {
 
 	// enabled
 	Enabled bool `json:"enabled,omitempty"`
+
+	// Number of connections rejected because socket-LB is
+	// restricted to the host namespace
+	HostnsOnlyRejectedConnections int64 `json:"hostns-only-rejected-connections,omitempty"`
+
+	// Number of service lookups that found no backend
+	NoBackendConnections int64 `json:"no-backend-connections,omitempty"`
+
+	// Number of TCP connections translated by socket-LB
+	TCPTranslations int64 `json:"tcp-translations,omitempty"`
+
+	// Number of UDP connections translated by socket-LB
+	UDPTranslations int64 `json:"udp-translations,omitempty"`
+
+	// Warning about sustained socket-LB connection failures, if
+	// any
+	Warning string `json:"warning,omitempty"`
 }
 
 // Validate validates this kube proxy replacement features socket l b
@@ -1547,3 +1849,43 @@ func (m *KubeProxyReplacementFeaturesSocketLBTracing) UnmarshalBinary(b []byte)
 	*m = res
 	return nil
 }
+
+// KubeProxyReplacementFeaturesSourceRangeCheck kube proxy replacement features source range check
+//
+// swagger:model KubeProxyReplacementFeaturesSourceRangeCheck
+type KubeProxyReplacementFeaturesSourceRangeCheck struct {
+
+	// enabled
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Number of services with loadBalancerSourceRanges restrictions programmed in the datapath
+	RestrictedServices int64 `json:"restricted-services,omitempty"`
+}
+
+// Validate validates this kube proxy replacement features source range check
+func (m *KubeProxyReplacementFeaturesSourceRangeCheck) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this kube proxy replacement features source range check based on context it is used
+func (m *KubeProxyReplacementFeaturesSourceRangeCheck) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *KubeProxyReplacementFeaturesSourceRangeCheck) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *KubeProxyReplacementFeaturesSourceRangeCheck) UnmarshalBinary(b []byte) error {
+	var res KubeProxyReplacementFeaturesSourceRangeCheck
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
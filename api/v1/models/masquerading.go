@@ -25,6 +25,14 @@ import (
 // swagger:model Masquerading
 type Masquerading struct {
 
+	// Number of IPv4 SNAT port allocation failures observed over the last
+	// probe interval.
+	AllocFailuresIPV4 int64 `json:"alloc-failures-ipv4,omitempty"`
+
+	// Number of IPv6 SNAT port allocation failures observed over the last
+	// probe interval.
+	AllocFailuresIPV6 int64 `json:"alloc-failures-ipv6,omitempty"`
+
 	// enabled
 	Enabled bool `json:"enabled,omitempty"`
 
@@ -38,6 +46,9 @@ type Masquerading struct {
 	// Enum: ["BPF","iptables"]
 	Mode string `json:"mode,omitempty"`
 
+	// Human readable reason why masquerading is disabled
+	Reason string `json:"reason,omitempty"`
+
 	// This field is obsolete, please use snat-exclusion-cidr-v4 or snat-exclusion-cidr-v6.
 	SnatExclusionCidr string `json:"snat-exclusion-cidr,omitempty"`
 
@@ -49,6 +60,13 @@ type Masquerading struct {
 	// an IPv6 address that belongs to this CIDR.
 	// For IPv6 we only do masquerading in iptables mode.
 	SnatExclusionCidrV6 string `json:"snat-exclusion-cidr-v6,omitempty"`
+
+	// Source port range from which BPF masquerading allocates SNAT ports.
+	SnatPortRange string `json:"snat-port-range,omitempty"`
+
+	// Overall health of masquerading. Warning if SNAT port allocation
+	// failures were observed over the last probe interval.
+	Status *Status `json:"status,omitempty"`
 }
 
 // Validate validates this masquerading
@@ -63,6 +81,10 @@ func (m *Masquerading) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -130,6 +152,25 @@ func (m *Masquerading) validateMode(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Masquerading) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	if m.Status != nil {
+		if err := m.Status.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("status")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("status")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ContextValidate validate this masquerading based on the context it is used
 func (m *Masquerading) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -138,6 +179,10 @@ func (m *Masquerading) ContextValidate(ctx context.Context, formats strfmt.Regis
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateStatus(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -165,6 +210,27 @@ func (m *Masquerading) contextValidateEnabledProtocols(ctx context.Context, form
 	return nil
 }
 
+func (m *Masquerading) contextValidateStatus(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Status != nil {
+
+		if swag.IsZero(m.Status) { // not required
+			return nil
+		}
+
+		if err := m.Status.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("status")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("status")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Masquerading) MarshalBinary() ([]byte, error) {
 	if m == nil {
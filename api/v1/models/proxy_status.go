@@ -26,6 +26,9 @@ import (
 // swagger:model ProxyStatus
 type ProxyStatus struct {
 
+	// Status of the Envoy admin endpoint
+	EnvoyAdmin *EnvoyAdminStatus `json:"envoy-admin,omitempty"`
+
 	// Deployment mode of Envoy L7 proxy
 	// Enum: ["embedded","external"]
 	EnvoyDeploymentMode string `json:"envoy-deployment-mode,omitempty"`
@@ -36,6 +39,9 @@ type ProxyStatus struct {
 	// Port range used for proxying
 	PortRange string `json:"port-range,omitempty"`
 
+	// Consistency of configured redirects with endpoint policy
+	RedirectInconsistencies *ProxyRedirectInconsistencies `json:"redirect-inconsistencies,omitempty"`
+
 	// Detailed description of configured redirects
 	Redirects []*ProxyRedirect `json:"redirects"`
 
@@ -50,10 +56,18 @@ type ProxyStatus struct {
 func (m *ProxyStatus) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateEnvoyAdmin(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateEnvoyDeploymentMode(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateRedirectInconsistencies(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateRedirects(formats); err != nil {
 		res = append(res, err)
 	}
@@ -64,6 +78,25 @@ func (m *ProxyStatus) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ProxyStatus) validateEnvoyAdmin(formats strfmt.Registry) error {
+	if swag.IsZero(m.EnvoyAdmin) { // not required
+		return nil
+	}
+
+	if m.EnvoyAdmin != nil {
+		if err := m.EnvoyAdmin.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("envoy-admin")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("envoy-admin")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 var proxyStatusTypeEnvoyDeploymentModePropEnum []interface{}
 
 func init() {
@@ -106,6 +139,25 @@ func (m *ProxyStatus) validateEnvoyDeploymentMode(formats strfmt.Registry) error
 	return nil
 }
 
+func (m *ProxyStatus) validateRedirectInconsistencies(formats strfmt.Registry) error {
+	if swag.IsZero(m.RedirectInconsistencies) { // not required
+		return nil
+	}
+
+	if m.RedirectInconsistencies != nil {
+		if err := m.RedirectInconsistencies.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("redirect-inconsistencies")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("redirect-inconsistencies")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ProxyStatus) validateRedirects(formats strfmt.Registry) error {
 	if swag.IsZero(m.Redirects) { // not required
 		return nil
@@ -136,6 +188,14 @@ func (m *ProxyStatus) validateRedirects(formats strfmt.Registry) error {
 func (m *ProxyStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateEnvoyAdmin(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateRedirectInconsistencies(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateRedirects(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -146,6 +206,48 @@ func (m *ProxyStatus) ContextValidate(ctx context.Context, formats strfmt.Regist
 	return nil
 }
 
+func (m *ProxyStatus) contextValidateEnvoyAdmin(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.EnvoyAdmin != nil {
+
+		if swag.IsZero(m.EnvoyAdmin) { // not required
+			return nil
+		}
+
+		if err := m.EnvoyAdmin.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("envoy-admin")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("envoy-admin")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ProxyStatus) contextValidateRedirectInconsistencies(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.RedirectInconsistencies != nil {
+
+		if swag.IsZero(m.RedirectInconsistencies) { // not required
+			return nil
+		}
+
+		if err := m.RedirectInconsistencies.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("redirect-inconsistencies")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("redirect-inconsistencies")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ProxyStatus) contextValidateRedirects(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.Redirects); i++ {
@@ -23,6 +23,10 @@ import (
 // swagger:model Routing
 type Routing struct {
 
+	// MTU of the direct routing device. Zero if the inter-host routing
+	// mode is tunnel.
+	DirectRoutingDeviceMTU int64 `json:"direct-routing-device-mtu,omitempty"`
+
 	// Datapath routing mode for cross-cluster connectivity
 	// Enum: ["Native","Tunnel"]
 	InterHostRoutingMode string `json:"inter-host-routing-mode,omitempty"`
@@ -26,6 +26,9 @@ type Srv6 struct {
 	// enabled
 	Enabled bool `json:"enabled,omitempty"`
 
+	// Human readable reason why SRv6 is disabled
+	Reason string `json:"reason,omitempty"`
+
 	// srv6 encap mode
 	// Enum: ["SRH","Reduced"]
 	Srv6EncapMode string `json:"srv6EncapMode,omitempty"`
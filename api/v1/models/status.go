@@ -11,6 +11,7 @@ package models
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -20,31 +21,122 @@ import (
 
 // Status Status of an individual component
 //
+// +k8s:deepcopy-gen=true
+//
 // swagger:model Status
 type Status struct {
 
 	// Human readable status/error/warning message
 	Msg string `json:"msg,omitempty"`
 
+	// Machine readable code for the cause of the state. Only set on the
+	// overall Cilium status of a status response.
+	// Enum: ["OK","STALE_PROBES","KVSTORE_UNAVAILABLE","CONTAINER_RUNTIME_NOT_READY","K8S_UNREACHABLE","NAT_TABLE_PRESSURE","MAP_PRESSURE","CNI_WRITE_FAILED","CLUSTERMESH_DEGRADED","FQDN_CACHE_LIMIT","CONTROLLERS_FAILING","MODULES_DEGRADED"]
+	ReasonCode string `json:"reason-code,omitempty"`
+
 	// State the component is in
 	// Enum: ["Ok","Warning","Failure","Disabled"]
 	State string `json:"state,omitempty"`
+
+	// Outcome of the individual checks performed by the probe, for
+	// probes that verify several things
+	SubChecks []*SubCheckStatus `json:"sub-checks"`
 }
 
 // Validate validates this status
 func (m *Status) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateReasonCode(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateState(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateSubChecks(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+var statusTypeReasonCodePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["OK","STALE_PROBES","KVSTORE_UNAVAILABLE","CONTAINER_RUNTIME_NOT_READY","K8S_UNREACHABLE","NAT_TABLE_PRESSURE","MAP_PRESSURE","CNI_WRITE_FAILED","CLUSTERMESH_DEGRADED","FQDN_CACHE_LIMIT","CONTROLLERS_FAILING","MODULES_DEGRADED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		statusTypeReasonCodePropEnum = append(statusTypeReasonCodePropEnum, v)
+	}
+}
+
+const (
+
+	// StatusReasonCodeOK captures enum value "OK"
+	StatusReasonCodeOK string = "OK"
+
+	// StatusReasonCodeSTALEPROBES captures enum value "STALE_PROBES"
+	StatusReasonCodeSTALEPROBES string = "STALE_PROBES"
+
+	// StatusReasonCodeKVSTOREUNAVAILABLE captures enum value "KVSTORE_UNAVAILABLE"
+	StatusReasonCodeKVSTOREUNAVAILABLE string = "KVSTORE_UNAVAILABLE"
+
+	// StatusReasonCodeCONTAINERRUNTIMENOTREADY captures enum value "CONTAINER_RUNTIME_NOT_READY"
+	StatusReasonCodeCONTAINERRUNTIMENOTREADY string = "CONTAINER_RUNTIME_NOT_READY"
+
+	// StatusReasonCodeK8SUNREACHABLE captures enum value "K8S_UNREACHABLE"
+	StatusReasonCodeK8SUNREACHABLE string = "K8S_UNREACHABLE"
+
+	// StatusReasonCodeNATTABLEPRESSURE captures enum value "NAT_TABLE_PRESSURE"
+	StatusReasonCodeNATTABLEPRESSURE string = "NAT_TABLE_PRESSURE"
+
+	// StatusReasonCodeMAPPRESSURE captures enum value "MAP_PRESSURE"
+	StatusReasonCodeMAPPRESSURE string = "MAP_PRESSURE"
+
+	// StatusReasonCodeCNIWRITEFAILED captures enum value "CNI_WRITE_FAILED"
+	StatusReasonCodeCNIWRITEFAILED string = "CNI_WRITE_FAILED"
+
+	// StatusReasonCodeCLUSTERMESHDEGRADED captures enum value "CLUSTERMESH_DEGRADED"
+	StatusReasonCodeCLUSTERMESHDEGRADED string = "CLUSTERMESH_DEGRADED"
+
+	// StatusReasonCodeFQDNCACHELIMIT captures enum value "FQDN_CACHE_LIMIT"
+	StatusReasonCodeFQDNCACHELIMIT string = "FQDN_CACHE_LIMIT"
+
+	// StatusReasonCodeCONTROLLERSFAILING captures enum value "CONTROLLERS_FAILING"
+	StatusReasonCodeCONTROLLERSFAILING string = "CONTROLLERS_FAILING"
+
+	// StatusReasonCodeMODULESDEGRADED captures enum value "MODULES_DEGRADED"
+	StatusReasonCodeMODULESDEGRADED string = "MODULES_DEGRADED"
+)
+
+// prop value enum
+func (m *Status) validateReasonCodeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, statusTypeReasonCodePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Status) validateReasonCode(formats strfmt.Registry) error {
+	if swag.IsZero(m.ReasonCode) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateReasonCodeEnum("reason-code", "body", m.ReasonCode); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 var statusTypeStatePropEnum []interface{}
 
 func init() {
@@ -93,8 +185,68 @@ func (m *Status) validateState(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this status based on context it is used
+func (m *Status) validateSubChecks(formats strfmt.Registry) error {
+	if swag.IsZero(m.SubChecks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.SubChecks); i++ {
+		if swag.IsZero(m.SubChecks[i]) { // not required
+			continue
+		}
+
+		if m.SubChecks[i] != nil {
+			if err := m.SubChecks[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("sub-checks" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("sub-checks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this status based on the context it is used
 func (m *Status) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateSubChecks(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Status) contextValidateSubChecks(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.SubChecks); i++ {
+
+		if m.SubChecks[i] != nil {
+
+			if swag.IsZero(m.SubChecks[i]) { // not required
+				return nil
+			}
+
+			if err := m.SubChecks[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("sub-checks" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("sub-checks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -10,6 +10,7 @@ package models
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -36,6 +37,9 @@ type StatusResponse struct {
 	// Status of BPF maps
 	BpfMaps *BPFMapStatus `json:"bpf-maps,omitempty"`
 
+	// Status of BPF program complexity relative to the verifier limit
+	BpfVerifier *BPFVerifierStatus `json:"bpf-verifier,omitempty"`
+
 	// Status of Cilium daemon
 	Cilium *Status `json:"cilium,omitempty"`
 
@@ -60,6 +64,9 @@ type StatusResponse struct {
 	// Status of the CNI configuration file
 	CniFile *Status `json:"cni-file,omitempty"`
 
+	// Consistency of the datapath configuration across the cluster
+	ConfigConsistency *ConfigConsistency `json:"config-consistency,omitempty"`
+
 	// Status of local container runtime
 	ContainerRuntime *Status `json:"container-runtime,omitempty"`
 
@@ -72,18 +79,30 @@ type StatusResponse struct {
 	// Status of transparent encryption
 	Encryption *EncryptionStatus `json:"encryption,omitempty"`
 
+	// Statistics of the toFQDNs DNS cache and selectors
+	FqdnCache *FQDNCacheStatus `json:"fqdn-cache,omitempty"`
+
 	// Status of the host firewall
 	HostFirewall *HostFirewall `json:"host-firewall,omitempty"`
 
 	// Status of Hubble server
 	Hubble *HubbleStatus `json:"hubble,omitempty"`
 
+	// Distribution of local endpoints across security identities
+	IdentityDistribution *IdentityDistribution `json:"identity-distribution,omitempty"`
+
 	// Status of identity range of the cluster
 	IdentityRange *IdentityRange `json:"identity-range,omitempty"`
 
+	// Status of IP address conflict detection
+	IPConflicts *IPConflicts `json:"ip-conflicts,omitempty"`
+
 	// Status of IP address management
 	Ipam *IPAMStatus `json:"ipam,omitempty"`
 
+	// Integrity of the iptables rules installed by the agent
+	IptablesRules *IptablesRulesStatus `json:"iptables-rules,omitempty"`
+
 	// Status of IPv4 BIG TCP
 	IPV4BigTCP *IPV4BigTCP `json:"ipv4-big-tcp,omitempty"`
 
@@ -99,23 +118,77 @@ type StatusResponse struct {
 	// Status of key/value datastore
 	Kvstore *Status `json:"kvstore,omitempty"`
 
+	// Rolling statistics of key/value store client operations
+	KvstoreOperations *KVStoreOperations `json:"kvstore-operations,omitempty"`
+
+	// Reason the agent shut down before this run, "unknown" if no record of the previous shutdown is available
+	LastShutdownReason string `json:"last-shutdown-reason,omitempty"`
+
+	// Status of load balancer datapath reconciliation
+	LoadBalancer *LoadBalancerStatus `json:"load-balancer,omitempty"`
+
+	// Fill level of the connection tracking and NAT maps
+	MapPressure []*MapPressureEntry `json:"map-pressure"`
+
 	// Status of masquerading
 	Masquerading *Masquerading `json:"masquerading,omitempty"`
 
+	// Health of hive modules
+	Modules *ModulesHealth `json:"modules,omitempty"`
+
+	// Consistency of the kernel neighbor entries for node next-hops against the set of known remote nodes
+	NeighborConsistency *NeighborConsistency `json:"neighbor-consistency,omitempty"`
+
+	// Health of the kernel neighbor entries managed for node next-hops
+	NeighborTable *NeighborTable `json:"neighbor-table,omitempty"`
+
 	// Status of the node monitor
 	NodeMonitor *MonitorStatus `json:"nodeMonitor,omitempty"`
 
+	// Utilization of the per-endpoint BPF policy maps
+	PolicyMapPressure *PolicyMapPressure `json:"policy-map-pressure,omitempty"`
+
+	// Status of the most recent recalculation of the policy of all endpoints
+	PolicyRecalculation *PolicyRecalculation `json:"policy-recalculation,omitempty"`
+
+	// Execution statistics of the status probes
+	Probes []*ProbeStatus `json:"probes"`
+
 	// Status of proxy
 	Proxy *ProxyStatus `json:"proxy,omitempty"`
 
+	// Saturation of the API and datapath rate limiters
+	RateLimiter *RateLimiterStatus `json:"rate-limiter,omitempty"`
+
+	// Outcome of the endpoint restore performed when the agent started
+	Restore *RestoreStatus `json:"restore,omitempty"`
+
 	// Status of routing
 	Routing *Routing `json:"routing,omitempty"`
 
+	// Status of SCTP support
+	Sctp *Sctp `json:"sctp,omitempty"`
+
+	// Per-service backend weights and states, only reported when verbose status was requested
+	ServiceBackends []*ServiceBackendsStatus `json:"service-backends"`
+
 	// Status of SRv6
 	Srv6 *Srv6 `json:"srv6,omitempty"`
 
 	// List of stale information in the status
 	Stale map[string]strfmt.DateTime `json:"stale,omitempty"`
+
+	// Time at which the agent started, formatted as RFC3339
+	StartTime string `json:"start-time,omitempty"`
+
+	// Status of the host sysctl parameters that Cilium depends on
+	Sysctls []*SysctlStatus `json:"sysctls"`
+
+	// Consistency of the BPF tunnel map against the known nodes
+	TunnelConsistency *TunnelConsistencyStatus `json:"tunnel-consistency,omitempty"`
+
+	// Time since the agent started
+	Uptime string `json:"uptime,omitempty"`
 }
 
 // Validate validates this status response
@@ -138,6 +211,10 @@ func (m *StatusResponse) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateBpfVerifier(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateCilium(formats); err != nil {
 		res = append(res, err)
 	}
@@ -162,6 +239,10 @@ func (m *StatusResponse) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateConfigConsistency(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateContainerRuntime(formats); err != nil {
 		res = append(res, err)
 	}
@@ -178,6 +259,10 @@ func (m *StatusResponse) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateFqdnCache(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateHostFirewall(formats); err != nil {
 		res = append(res, err)
 	}
@@ -186,14 +271,26 @@ func (m *StatusResponse) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateIdentityDistribution(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateIdentityRange(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateIPConflicts(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateIpam(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateIptablesRules(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateIPV4BigTCP(formats); err != nil {
 		res = append(res, err)
 	}
@@ -214,22 +311,74 @@ func (m *StatusResponse) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateKvstoreOperations(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLoadBalancer(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMapPressure(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateMasquerading(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateModules(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNeighborConsistency(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNeighborTable(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateNodeMonitor(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validatePolicyMapPressure(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePolicyRecalculation(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateProbes(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateProxy(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateRateLimiter(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRestore(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateRouting(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateSctp(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateServiceBackends(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateSrv6(formats); err != nil {
 		res = append(res, err)
 	}
@@ -238,6 +387,14 @@ func (m *StatusResponse) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateSysctls(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTunnelConsistency(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -318,6 +475,25 @@ func (m *StatusResponse) validateBpfMaps(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *StatusResponse) validateBpfVerifier(formats strfmt.Registry) error {
+	if swag.IsZero(m.BpfVerifier) { // not required
+		return nil
+	}
+
+	if m.BpfVerifier != nil {
+		if err := m.BpfVerifier.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("bpf-verifier")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("bpf-verifier")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *StatusResponse) validateCilium(formats strfmt.Registry) error {
 	if swag.IsZero(m.Cilium) { // not required
 		return nil
@@ -432,6 +608,25 @@ func (m *StatusResponse) validateCniFile(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *StatusResponse) validateConfigConsistency(formats strfmt.Registry) error {
+	if swag.IsZero(m.ConfigConsistency) { // not required
+		return nil
+	}
+
+	if m.ConfigConsistency != nil {
+		if err := m.ConfigConsistency.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("config-consistency")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("config-consistency")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *StatusResponse) validateContainerRuntime(formats strfmt.Registry) error {
 	if swag.IsZero(m.ContainerRuntime) { // not required
 		return nil
@@ -504,6 +699,25 @@ func (m *StatusResponse) validateEncryption(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *StatusResponse) validateFqdnCache(formats strfmt.Registry) error {
+	if swag.IsZero(m.FqdnCache) { // not required
+		return nil
+	}
+
+	if m.FqdnCache != nil {
+		if err := m.FqdnCache.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("fqdn-cache")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("fqdn-cache")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *StatusResponse) validateHostFirewall(formats strfmt.Registry) error {
 	if swag.IsZero(m.HostFirewall) { // not required
 		return nil
@@ -542,6 +756,25 @@ func (m *StatusResponse) validateHubble(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *StatusResponse) validateIdentityDistribution(formats strfmt.Registry) error {
+	if swag.IsZero(m.IdentityDistribution) { // not required
+		return nil
+	}
+
+	if m.IdentityDistribution != nil {
+		if err := m.IdentityDistribution.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("identity-distribution")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("identity-distribution")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *StatusResponse) validateIdentityRange(formats strfmt.Registry) error {
 	if swag.IsZero(m.IdentityRange) { // not required
 		return nil
@@ -561,6 +794,25 @@ func (m *StatusResponse) validateIdentityRange(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *StatusResponse) validateIPConflicts(formats strfmt.Registry) error {
+	if swag.IsZero(m.IPConflicts) { // not required
+		return nil
+	}
+
+	if m.IPConflicts != nil {
+		if err := m.IPConflicts.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("ip-conflicts")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("ip-conflicts")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *StatusResponse) validateIpam(formats strfmt.Registry) error {
 	if swag.IsZero(m.Ipam) { // not required
 		return nil
@@ -580,6 +832,25 @@ func (m *StatusResponse) validateIpam(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *StatusResponse) validateIptablesRules(formats strfmt.Registry) error {
+	if swag.IsZero(m.IptablesRules) { // not required
+		return nil
+	}
+
+	if m.IptablesRules != nil {
+		if err := m.IptablesRules.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("iptables-rules")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("iptables-rules")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *StatusResponse) validateIPV4BigTCP(formats strfmt.Registry) error {
 	if swag.IsZero(m.IPV4BigTCP) { // not required
 		return nil
@@ -675,6 +946,70 @@ func (m *StatusResponse) validateKvstore(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *StatusResponse) validateKvstoreOperations(formats strfmt.Registry) error {
+	if swag.IsZero(m.KvstoreOperations) { // not required
+		return nil
+	}
+
+	if m.KvstoreOperations != nil {
+		if err := m.KvstoreOperations.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("kvstore-operations")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("kvstore-operations")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) validateLoadBalancer(formats strfmt.Registry) error {
+	if swag.IsZero(m.LoadBalancer) { // not required
+		return nil
+	}
+
+	if m.LoadBalancer != nil {
+		if err := m.LoadBalancer.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("load-balancer")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("load-balancer")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) validateMapPressure(formats strfmt.Registry) error {
+	if swag.IsZero(m.MapPressure) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.MapPressure); i++ {
+		if swag.IsZero(m.MapPressure[i]) { // not required
+			continue
+		}
+
+		if m.MapPressure[i] != nil {
+			if err := m.MapPressure[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("map-pressure" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("map-pressure" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *StatusResponse) validateMasquerading(formats strfmt.Registry) error {
 	if swag.IsZero(m.Masquerading) { // not required
 		return nil
@@ -694,17 +1029,17 @@ func (m *StatusResponse) validateMasquerading(formats strfmt.Registry) error {
 	return nil
 }
 
-func (m *StatusResponse) validateNodeMonitor(formats strfmt.Registry) error {
-	if swag.IsZero(m.NodeMonitor) { // not required
+func (m *StatusResponse) validateModules(formats strfmt.Registry) error {
+	if swag.IsZero(m.Modules) { // not required
 		return nil
 	}
 
-	if m.NodeMonitor != nil {
-		if err := m.NodeMonitor.Validate(formats); err != nil {
+	if m.Modules != nil {
+		if err := m.Modules.Validate(formats); err != nil {
 			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("nodeMonitor")
+				return ve.ValidateName("modules")
 			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("nodeMonitor")
+				return ce.ValidateName("modules")
 			}
 			return err
 		}
@@ -713,17 +1048,17 @@ func (m *StatusResponse) validateNodeMonitor(formats strfmt.Registry) error {
 	return nil
 }
 
-func (m *StatusResponse) validateProxy(formats strfmt.Registry) error {
-	if swag.IsZero(m.Proxy) { // not required
+func (m *StatusResponse) validateNeighborConsistency(formats strfmt.Registry) error {
+	if swag.IsZero(m.NeighborConsistency) { // not required
 		return nil
 	}
 
-	if m.Proxy != nil {
-		if err := m.Proxy.Validate(formats); err != nil {
+	if m.NeighborConsistency != nil {
+		if err := m.NeighborConsistency.Validate(formats); err != nil {
 			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("proxy")
+				return ve.ValidateName("neighbor-consistency")
 			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("proxy")
+				return ce.ValidateName("neighbor-consistency")
 			}
 			return err
 		}
@@ -732,17 +1067,17 @@ func (m *StatusResponse) validateProxy(formats strfmt.Registry) error {
 	return nil
 }
 
-func (m *StatusResponse) validateRouting(formats strfmt.Registry) error {
-	if swag.IsZero(m.Routing) { // not required
+func (m *StatusResponse) validateNeighborTable(formats strfmt.Registry) error {
+	if swag.IsZero(m.NeighborTable) { // not required
 		return nil
 	}
 
-	if m.Routing != nil {
-		if err := m.Routing.Validate(formats); err != nil {
+	if m.NeighborTable != nil {
+		if err := m.NeighborTable.Validate(formats); err != nil {
 			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("routing")
+				return ve.ValidateName("neighbor-table")
 			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("routing")
+				return ce.ValidateName("neighbor-table")
 			}
 			return err
 		}
@@ -751,17 +1086,17 @@ func (m *StatusResponse) validateRouting(formats strfmt.Registry) error {
 	return nil
 }
 
-func (m *StatusResponse) validateSrv6(formats strfmt.Registry) error {
-	if swag.IsZero(m.Srv6) { // not required
+func (m *StatusResponse) validateNodeMonitor(formats strfmt.Registry) error {
+	if swag.IsZero(m.NodeMonitor) { // not required
 		return nil
 	}
 
-	if m.Srv6 != nil {
-		if err := m.Srv6.Validate(formats); err != nil {
+	if m.NodeMonitor != nil {
+		if err := m.NodeMonitor.Validate(formats); err != nil {
 			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("srv6")
+				return ve.ValidateName("nodeMonitor")
 			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("srv6")
+				return ce.ValidateName("nodeMonitor")
 			}
 			return err
 		}
@@ -770,31 +1105,280 @@ func (m *StatusResponse) validateSrv6(formats strfmt.Registry) error {
 	return nil
 }
 
-func (m *StatusResponse) validateStale(formats strfmt.Registry) error {
-	if swag.IsZero(m.Stale) { // not required
+func (m *StatusResponse) validatePolicyMapPressure(formats strfmt.Registry) error {
+	if swag.IsZero(m.PolicyMapPressure) { // not required
 		return nil
 	}
 
-	for k := range m.Stale {
-
-		if err := validate.FormatOf("stale"+"."+k, "body", "date-time", m.Stale[k].String(), formats); err != nil {
+	if m.PolicyMapPressure != nil {
+		if err := m.PolicyMapPressure.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("policy-map-pressure")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("policy-map-pressure")
+			}
 			return err
 		}
-
 	}
 
 	return nil
 }
 
-// ContextValidate validate this status response based on the context it is used
-func (m *StatusResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
-	var res []error
+func (m *StatusResponse) validatePolicyRecalculation(formats strfmt.Registry) error {
+	if swag.IsZero(m.PolicyRecalculation) { // not required
+		return nil
+	}
 
-	if err := m.contextValidateAttachMode(ctx, formats); err != nil {
-		res = append(res, err)
+	if m.PolicyRecalculation != nil {
+		if err := m.PolicyRecalculation.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("policy-recalculation")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("policy-recalculation")
+			}
+			return err
+		}
 	}
 
-	if err := m.contextValidateAuthCertificateProvider(ctx, formats); err != nil {
+	return nil
+}
+
+func (m *StatusResponse) validateProbes(formats strfmt.Registry) error {
+	if swag.IsZero(m.Probes) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Probes); i++ {
+		if swag.IsZero(m.Probes[i]) { // not required
+			continue
+		}
+
+		if m.Probes[i] != nil {
+			if err := m.Probes[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("probes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("probes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) validateProxy(formats strfmt.Registry) error {
+	if swag.IsZero(m.Proxy) { // not required
+		return nil
+	}
+
+	if m.Proxy != nil {
+		if err := m.Proxy.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("proxy")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("proxy")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) validateRateLimiter(formats strfmt.Registry) error {
+	if swag.IsZero(m.RateLimiter) { // not required
+		return nil
+	}
+
+	if m.RateLimiter != nil {
+		if err := m.RateLimiter.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("rate-limiter")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("rate-limiter")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) validateRestore(formats strfmt.Registry) error {
+	if swag.IsZero(m.Restore) { // not required
+		return nil
+	}
+
+	if m.Restore != nil {
+		if err := m.Restore.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("restore")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("restore")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) validateRouting(formats strfmt.Registry) error {
+	if swag.IsZero(m.Routing) { // not required
+		return nil
+	}
+
+	if m.Routing != nil {
+		if err := m.Routing.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("routing")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("routing")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) validateSctp(formats strfmt.Registry) error {
+	if swag.IsZero(m.Sctp) { // not required
+		return nil
+	}
+
+	if m.Sctp != nil {
+		if err := m.Sctp.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("sctp")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("sctp")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) validateServiceBackends(formats strfmt.Registry) error {
+	if swag.IsZero(m.ServiceBackends) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ServiceBackends); i++ {
+		if swag.IsZero(m.ServiceBackends[i]) { // not required
+			continue
+		}
+
+		if m.ServiceBackends[i] != nil {
+			if err := m.ServiceBackends[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("service-backends" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("service-backends" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) validateSrv6(formats strfmt.Registry) error {
+	if swag.IsZero(m.Srv6) { // not required
+		return nil
+	}
+
+	if m.Srv6 != nil {
+		if err := m.Srv6.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("srv6")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("srv6")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) validateStale(formats strfmt.Registry) error {
+	if swag.IsZero(m.Stale) { // not required
+		return nil
+	}
+
+	for k := range m.Stale {
+
+		if err := validate.FormatOf("stale"+"."+k, "body", "date-time", m.Stale[k].String(), formats); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) validateSysctls(formats strfmt.Registry) error {
+	if swag.IsZero(m.Sysctls) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Sysctls); i++ {
+		if swag.IsZero(m.Sysctls[i]) { // not required
+			continue
+		}
+
+		if m.Sysctls[i] != nil {
+			if err := m.Sysctls[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("sysctls" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("sysctls" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) validateTunnelConsistency(formats strfmt.Registry) error {
+	if swag.IsZero(m.TunnelConsistency) { // not required
+		return nil
+	}
+
+	if m.TunnelConsistency != nil {
+		if err := m.TunnelConsistency.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("tunnel-consistency")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("tunnel-consistency")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this status response based on the context it is used
+func (m *StatusResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateAttachMode(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateAuthCertificateProvider(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
@@ -806,6 +1390,10 @@ func (m *StatusResponse) ContextValidate(ctx context.Context, formats strfmt.Reg
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateBpfVerifier(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateCilium(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -830,6 +1418,10 @@ func (m *StatusResponse) ContextValidate(ctx context.Context, formats strfmt.Reg
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateConfigConsistency(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateContainerRuntime(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -846,6 +1438,10 @@ func (m *StatusResponse) ContextValidate(ctx context.Context, formats strfmt.Reg
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateFqdnCache(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateHostFirewall(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -854,14 +1450,26 @@ func (m *StatusResponse) ContextValidate(ctx context.Context, formats strfmt.Reg
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateIdentityDistribution(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateIdentityRange(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateIPConflicts(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateIpam(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateIptablesRules(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateIPV4BigTCP(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -882,26 +1490,86 @@ func (m *StatusResponse) ContextValidate(ctx context.Context, formats strfmt.Reg
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateKvstoreOperations(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateLoadBalancer(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateMapPressure(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateMasquerading(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateModules(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateNeighborConsistency(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateNeighborTable(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateNodeMonitor(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidatePolicyMapPressure(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidatePolicyRecalculation(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateProbes(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateProxy(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateRateLimiter(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateRestore(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateRouting(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateSctp(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateServiceBackends(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateSrv6(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateSysctls(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateTunnelConsistency(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -968,19 +1636,429 @@ func (m *StatusResponse) contextValidateBandwidthManager(ctx context.Context, fo
 	return nil
 }
 
-func (m *StatusResponse) contextValidateBpfMaps(ctx context.Context, formats strfmt.Registry) error {
+func (m *StatusResponse) contextValidateBpfMaps(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.BpfMaps != nil {
+
+		if swag.IsZero(m.BpfMaps) { // not required
+			return nil
+		}
+
+		if err := m.BpfMaps.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("bpf-maps")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("bpf-maps")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) contextValidateBpfVerifier(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.BpfVerifier != nil {
+
+		if swag.IsZero(m.BpfVerifier) { // not required
+			return nil
+		}
+
+		if err := m.BpfVerifier.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("bpf-verifier")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("bpf-verifier")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) contextValidateCilium(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Cilium != nil {
+
+		if swag.IsZero(m.Cilium) { // not required
+			return nil
+		}
+
+		if err := m.Cilium.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("cilium")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("cilium")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) contextValidateClockSource(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ClockSource != nil {
+
+		if swag.IsZero(m.ClockSource) { // not required
+			return nil
+		}
+
+		if err := m.ClockSource.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("clock-source")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("clock-source")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) contextValidateCluster(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Cluster != nil {
+
+		if swag.IsZero(m.Cluster) { // not required
+			return nil
+		}
+
+		if err := m.Cluster.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("cluster")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("cluster")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) contextValidateClusterMesh(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ClusterMesh != nil {
+
+		if swag.IsZero(m.ClusterMesh) { // not required
+			return nil
+		}
+
+		if err := m.ClusterMesh.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("cluster-mesh")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("cluster-mesh")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) contextValidateCniChaining(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.CniChaining != nil {
+
+		if swag.IsZero(m.CniChaining) { // not required
+			return nil
+		}
+
+		if err := m.CniChaining.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("cni-chaining")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("cni-chaining")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) contextValidateCniFile(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.CniFile != nil {
+
+		if swag.IsZero(m.CniFile) { // not required
+			return nil
+		}
+
+		if err := m.CniFile.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("cni-file")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("cni-file")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) contextValidateConfigConsistency(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ConfigConsistency != nil {
+
+		if swag.IsZero(m.ConfigConsistency) { // not required
+			return nil
+		}
+
+		if err := m.ConfigConsistency.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("config-consistency")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("config-consistency")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) contextValidateContainerRuntime(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ContainerRuntime != nil {
+
+		if swag.IsZero(m.ContainerRuntime) { // not required
+			return nil
+		}
+
+		if err := m.ContainerRuntime.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("container-runtime")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("container-runtime")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) contextValidateControllers(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.Controllers.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("controllers")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("controllers")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) contextValidateDatapathMode(ctx context.Context, formats strfmt.Registry) error {
+
+	if swag.IsZero(m.DatapathMode) { // not required
+		return nil
+	}
+
+	if err := m.DatapathMode.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("datapath-mode")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("datapath-mode")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) contextValidateEncryption(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Encryption != nil {
+
+		if swag.IsZero(m.Encryption) { // not required
+			return nil
+		}
+
+		if err := m.Encryption.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("encryption")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("encryption")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) contextValidateFqdnCache(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.FqdnCache != nil {
+
+		if swag.IsZero(m.FqdnCache) { // not required
+			return nil
+		}
+
+		if err := m.FqdnCache.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("fqdn-cache")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("fqdn-cache")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) contextValidateHostFirewall(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.HostFirewall != nil {
+
+		if swag.IsZero(m.HostFirewall) { // not required
+			return nil
+		}
+
+		if err := m.HostFirewall.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("host-firewall")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("host-firewall")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) contextValidateHubble(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Hubble != nil {
+
+		if swag.IsZero(m.Hubble) { // not required
+			return nil
+		}
+
+		if err := m.Hubble.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("hubble")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("hubble")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) contextValidateIdentityDistribution(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.IdentityDistribution != nil {
+
+		if swag.IsZero(m.IdentityDistribution) { // not required
+			return nil
+		}
+
+		if err := m.IdentityDistribution.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("identity-distribution")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("identity-distribution")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) contextValidateIdentityRange(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.IdentityRange != nil {
+
+		if swag.IsZero(m.IdentityRange) { // not required
+			return nil
+		}
+
+		if err := m.IdentityRange.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("identity-range")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("identity-range")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) contextValidateIPConflicts(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.IPConflicts != nil {
+
+		if swag.IsZero(m.IPConflicts) { // not required
+			return nil
+		}
+
+		if err := m.IPConflicts.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("ip-conflicts")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("ip-conflicts")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) contextValidateIpam(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Ipam != nil {
+
+		if swag.IsZero(m.Ipam) { // not required
+			return nil
+		}
+
+		if err := m.Ipam.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("ipam")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("ipam")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) contextValidateIptablesRules(ctx context.Context, formats strfmt.Registry) error {
 
-	if m.BpfMaps != nil {
+	if m.IptablesRules != nil {
 
-		if swag.IsZero(m.BpfMaps) { // not required
+		if swag.IsZero(m.IptablesRules) { // not required
 			return nil
 		}
 
-		if err := m.BpfMaps.ContextValidate(ctx, formats); err != nil {
+		if err := m.IptablesRules.ContextValidate(ctx, formats); err != nil {
 			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("bpf-maps")
+				return ve.ValidateName("iptables-rules")
 			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("bpf-maps")
+				return ce.ValidateName("iptables-rules")
 			}
 			return err
 		}
@@ -989,19 +2067,19 @@ func (m *StatusResponse) contextValidateBpfMaps(ctx context.Context, formats str
 	return nil
 }
 
-func (m *StatusResponse) contextValidateCilium(ctx context.Context, formats strfmt.Registry) error {
+func (m *StatusResponse) contextValidateIPV4BigTCP(ctx context.Context, formats strfmt.Registry) error {
 
-	if m.Cilium != nil {
+	if m.IPV4BigTCP != nil {
 
-		if swag.IsZero(m.Cilium) { // not required
+		if swag.IsZero(m.IPV4BigTCP) { // not required
 			return nil
 		}
 
-		if err := m.Cilium.ContextValidate(ctx, formats); err != nil {
+		if err := m.IPV4BigTCP.ContextValidate(ctx, formats); err != nil {
 			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("cilium")
+				return ve.ValidateName("ipv4-big-tcp")
 			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("cilium")
+				return ce.ValidateName("ipv4-big-tcp")
 			}
 			return err
 		}
@@ -1010,19 +2088,19 @@ func (m *StatusResponse) contextValidateCilium(ctx context.Context, formats strf
 	return nil
 }
 
-func (m *StatusResponse) contextValidateClockSource(ctx context.Context, formats strfmt.Registry) error {
+func (m *StatusResponse) contextValidateIPV6BigTCP(ctx context.Context, formats strfmt.Registry) error {
 
-	if m.ClockSource != nil {
+	if m.IPV6BigTCP != nil {
 
-		if swag.IsZero(m.ClockSource) { // not required
+		if swag.IsZero(m.IPV6BigTCP) { // not required
 			return nil
 		}
 
-		if err := m.ClockSource.ContextValidate(ctx, formats); err != nil {
+		if err := m.IPV6BigTCP.ContextValidate(ctx, formats); err != nil {
 			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("clock-source")
+				return ve.ValidateName("ipv6-big-tcp")
 			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("clock-source")
+				return ce.ValidateName("ipv6-big-tcp")
 			}
 			return err
 		}
@@ -1031,19 +2109,19 @@ func (m *StatusResponse) contextValidateClockSource(ctx context.Context, formats
 	return nil
 }
 
-func (m *StatusResponse) contextValidateCluster(ctx context.Context, formats strfmt.Registry) error {
+func (m *StatusResponse) contextValidateKubeProxyReplacement(ctx context.Context, formats strfmt.Registry) error {
 
-	if m.Cluster != nil {
+	if m.KubeProxyReplacement != nil {
 
-		if swag.IsZero(m.Cluster) { // not required
+		if swag.IsZero(m.KubeProxyReplacement) { // not required
 			return nil
 		}
 
-		if err := m.Cluster.ContextValidate(ctx, formats); err != nil {
+		if err := m.KubeProxyReplacement.ContextValidate(ctx, formats); err != nil {
 			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("cluster")
+				return ve.ValidateName("kube-proxy-replacement")
 			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("cluster")
+				return ce.ValidateName("kube-proxy-replacement")
 			}
 			return err
 		}
@@ -1052,19 +2130,19 @@ func (m *StatusResponse) contextValidateCluster(ctx context.Context, formats str
 	return nil
 }
 
-func (m *StatusResponse) contextValidateClusterMesh(ctx context.Context, formats strfmt.Registry) error {
+func (m *StatusResponse) contextValidateKubernetes(ctx context.Context, formats strfmt.Registry) error {
 
-	if m.ClusterMesh != nil {
+	if m.Kubernetes != nil {
 
-		if swag.IsZero(m.ClusterMesh) { // not required
+		if swag.IsZero(m.Kubernetes) { // not required
 			return nil
 		}
 
-		if err := m.ClusterMesh.ContextValidate(ctx, formats); err != nil {
+		if err := m.Kubernetes.ContextValidate(ctx, formats); err != nil {
 			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("cluster-mesh")
+				return ve.ValidateName("kubernetes")
 			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("cluster-mesh")
+				return ce.ValidateName("kubernetes")
 			}
 			return err
 		}
@@ -1073,19 +2151,19 @@ func (m *StatusResponse) contextValidateClusterMesh(ctx context.Context, formats
 	return nil
 }
 
-func (m *StatusResponse) contextValidateCniChaining(ctx context.Context, formats strfmt.Registry) error {
+func (m *StatusResponse) contextValidateKvstore(ctx context.Context, formats strfmt.Registry) error {
 
-	if m.CniChaining != nil {
+	if m.Kvstore != nil {
 
-		if swag.IsZero(m.CniChaining) { // not required
+		if swag.IsZero(m.Kvstore) { // not required
 			return nil
 		}
 
-		if err := m.CniChaining.ContextValidate(ctx, formats); err != nil {
+		if err := m.Kvstore.ContextValidate(ctx, formats); err != nil {
 			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("cni-chaining")
+				return ve.ValidateName("kvstore")
 			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("cni-chaining")
+				return ce.ValidateName("kvstore")
 			}
 			return err
 		}
@@ -1094,19 +2172,19 @@ func (m *StatusResponse) contextValidateCniChaining(ctx context.Context, formats
 	return nil
 }
 
-func (m *StatusResponse) contextValidateCniFile(ctx context.Context, formats strfmt.Registry) error {
+func (m *StatusResponse) contextValidateKvstoreOperations(ctx context.Context, formats strfmt.Registry) error {
 
-	if m.CniFile != nil {
+	if m.KvstoreOperations != nil {
 
-		if swag.IsZero(m.CniFile) { // not required
+		if swag.IsZero(m.KvstoreOperations) { // not required
 			return nil
 		}
 
-		if err := m.CniFile.ContextValidate(ctx, formats); err != nil {
+		if err := m.KvstoreOperations.ContextValidate(ctx, formats); err != nil {
 			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("cni-file")
+				return ve.ValidateName("kvstore-operations")
 			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("cni-file")
+				return ce.ValidateName("kvstore-operations")
 			}
 			return err
 		}
@@ -1115,19 +2193,19 @@ func (m *StatusResponse) contextValidateCniFile(ctx context.Context, formats str
 	return nil
 }
 
-func (m *StatusResponse) contextValidateContainerRuntime(ctx context.Context, formats strfmt.Registry) error {
+func (m *StatusResponse) contextValidateLoadBalancer(ctx context.Context, formats strfmt.Registry) error {
 
-	if m.ContainerRuntime != nil {
+	if m.LoadBalancer != nil {
 
-		if swag.IsZero(m.ContainerRuntime) { // not required
+		if swag.IsZero(m.LoadBalancer) { // not required
 			return nil
 		}
 
-		if err := m.ContainerRuntime.ContextValidate(ctx, formats); err != nil {
+		if err := m.LoadBalancer.ContextValidate(ctx, formats); err != nil {
 			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("container-runtime")
+				return ve.ValidateName("load-balancer")
 			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("container-runtime")
+				return ce.ValidateName("load-balancer")
 			}
 			return err
 		}
@@ -1136,51 +2214,44 @@ func (m *StatusResponse) contextValidateContainerRuntime(ctx context.Context, fo
 	return nil
 }
 
-func (m *StatusResponse) contextValidateControllers(ctx context.Context, formats strfmt.Registry) error {
-
-	if err := m.Controllers.ContextValidate(ctx, formats); err != nil {
-		if ve, ok := err.(*errors.Validation); ok {
-			return ve.ValidateName("controllers")
-		} else if ce, ok := err.(*errors.CompositeError); ok {
-			return ce.ValidateName("controllers")
-		}
-		return err
-	}
+func (m *StatusResponse) contextValidateMapPressure(ctx context.Context, formats strfmt.Registry) error {
 
-	return nil
-}
+	for i := 0; i < len(m.MapPressure); i++ {
 
-func (m *StatusResponse) contextValidateDatapathMode(ctx context.Context, formats strfmt.Registry) error {
+		if m.MapPressure[i] != nil {
 
-	if swag.IsZero(m.DatapathMode) { // not required
-		return nil
-	}
+			if swag.IsZero(m.MapPressure[i]) { // not required
+				return nil
+			}
 
-	if err := m.DatapathMode.ContextValidate(ctx, formats); err != nil {
-		if ve, ok := err.(*errors.Validation); ok {
-			return ve.ValidateName("datapath-mode")
-		} else if ce, ok := err.(*errors.CompositeError); ok {
-			return ce.ValidateName("datapath-mode")
+			if err := m.MapPressure[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("map-pressure" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("map-pressure" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
 		}
-		return err
+
 	}
 
 	return nil
 }
 
-func (m *StatusResponse) contextValidateEncryption(ctx context.Context, formats strfmt.Registry) error {
+func (m *StatusResponse) contextValidateMasquerading(ctx context.Context, formats strfmt.Registry) error {
 
-	if m.Encryption != nil {
+	if m.Masquerading != nil {
 
-		if swag.IsZero(m.Encryption) { // not required
+		if swag.IsZero(m.Masquerading) { // not required
 			return nil
 		}
 
-		if err := m.Encryption.ContextValidate(ctx, formats); err != nil {
+		if err := m.Masquerading.ContextValidate(ctx, formats); err != nil {
 			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("encryption")
+				return ve.ValidateName("masquerading")
 			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("encryption")
+				return ce.ValidateName("masquerading")
 			}
 			return err
 		}
@@ -1189,19 +2260,19 @@ func (m *StatusResponse) contextValidateEncryption(ctx context.Context, formats
 	return nil
 }
 
-func (m *StatusResponse) contextValidateHostFirewall(ctx context.Context, formats strfmt.Registry) error {
+func (m *StatusResponse) contextValidateModules(ctx context.Context, formats strfmt.Registry) error {
 
-	if m.HostFirewall != nil {
+	if m.Modules != nil {
 
-		if swag.IsZero(m.HostFirewall) { // not required
+		if swag.IsZero(m.Modules) { // not required
 			return nil
 		}
 
-		if err := m.HostFirewall.ContextValidate(ctx, formats); err != nil {
+		if err := m.Modules.ContextValidate(ctx, formats); err != nil {
 			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("host-firewall")
+				return ve.ValidateName("modules")
 			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("host-firewall")
+				return ce.ValidateName("modules")
 			}
 			return err
 		}
@@ -1210,19 +2281,19 @@ func (m *StatusResponse) contextValidateHostFirewall(ctx context.Context, format
 	return nil
 }
 
-func (m *StatusResponse) contextValidateHubble(ctx context.Context, formats strfmt.Registry) error {
+func (m *StatusResponse) contextValidateNeighborConsistency(ctx context.Context, formats strfmt.Registry) error {
 
-	if m.Hubble != nil {
+	if m.NeighborConsistency != nil {
 
-		if swag.IsZero(m.Hubble) { // not required
+		if swag.IsZero(m.NeighborConsistency) { // not required
 			return nil
 		}
 
-		if err := m.Hubble.ContextValidate(ctx, formats); err != nil {
+		if err := m.NeighborConsistency.ContextValidate(ctx, formats); err != nil {
 			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("hubble")
+				return ve.ValidateName("neighbor-consistency")
 			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("hubble")
+				return ce.ValidateName("neighbor-consistency")
 			}
 			return err
 		}
@@ -1231,19 +2302,19 @@ func (m *StatusResponse) contextValidateHubble(ctx context.Context, formats strf
 	return nil
 }
 
-func (m *StatusResponse) contextValidateIdentityRange(ctx context.Context, formats strfmt.Registry) error {
+func (m *StatusResponse) contextValidateNeighborTable(ctx context.Context, formats strfmt.Registry) error {
 
-	if m.IdentityRange != nil {
+	if m.NeighborTable != nil {
 
-		if swag.IsZero(m.IdentityRange) { // not required
+		if swag.IsZero(m.NeighborTable) { // not required
 			return nil
 		}
 
-		if err := m.IdentityRange.ContextValidate(ctx, formats); err != nil {
+		if err := m.NeighborTable.ContextValidate(ctx, formats); err != nil {
 			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("identity-range")
+				return ve.ValidateName("neighbor-table")
 			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("identity-range")
+				return ce.ValidateName("neighbor-table")
 			}
 			return err
 		}
@@ -1252,19 +2323,19 @@ func (m *StatusResponse) contextValidateIdentityRange(ctx context.Context, forma
 	return nil
 }
 
-func (m *StatusResponse) contextValidateIpam(ctx context.Context, formats strfmt.Registry) error {
+func (m *StatusResponse) contextValidateNodeMonitor(ctx context.Context, formats strfmt.Registry) error {
 
-	if m.Ipam != nil {
+	if m.NodeMonitor != nil {
 
-		if swag.IsZero(m.Ipam) { // not required
+		if swag.IsZero(m.NodeMonitor) { // not required
 			return nil
 		}
 
-		if err := m.Ipam.ContextValidate(ctx, formats); err != nil {
+		if err := m.NodeMonitor.ContextValidate(ctx, formats); err != nil {
 			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("ipam")
+				return ve.ValidateName("nodeMonitor")
 			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("ipam")
+				return ce.ValidateName("nodeMonitor")
 			}
 			return err
 		}
@@ -1273,19 +2344,19 @@ func (m *StatusResponse) contextValidateIpam(ctx context.Context, formats strfmt
 	return nil
 }
 
-func (m *StatusResponse) contextValidateIPV4BigTCP(ctx context.Context, formats strfmt.Registry) error {
+func (m *StatusResponse) contextValidatePolicyMapPressure(ctx context.Context, formats strfmt.Registry) error {
 
-	if m.IPV4BigTCP != nil {
+	if m.PolicyMapPressure != nil {
 
-		if swag.IsZero(m.IPV4BigTCP) { // not required
+		if swag.IsZero(m.PolicyMapPressure) { // not required
 			return nil
 		}
 
-		if err := m.IPV4BigTCP.ContextValidate(ctx, formats); err != nil {
+		if err := m.PolicyMapPressure.ContextValidate(ctx, formats); err != nil {
 			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("ipv4-big-tcp")
+				return ve.ValidateName("policy-map-pressure")
 			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("ipv4-big-tcp")
+				return ce.ValidateName("policy-map-pressure")
 			}
 			return err
 		}
@@ -1294,19 +2365,19 @@ func (m *StatusResponse) contextValidateIPV4BigTCP(ctx context.Context, formats
 	return nil
 }
 
-func (m *StatusResponse) contextValidateIPV6BigTCP(ctx context.Context, formats strfmt.Registry) error {
+func (m *StatusResponse) contextValidatePolicyRecalculation(ctx context.Context, formats strfmt.Registry) error {
 
-	if m.IPV6BigTCP != nil {
+	if m.PolicyRecalculation != nil {
 
-		if swag.IsZero(m.IPV6BigTCP) { // not required
+		if swag.IsZero(m.PolicyRecalculation) { // not required
 			return nil
 		}
 
-		if err := m.IPV6BigTCP.ContextValidate(ctx, formats); err != nil {
+		if err := m.PolicyRecalculation.ContextValidate(ctx, formats); err != nil {
 			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("ipv6-big-tcp")
+				return ve.ValidateName("policy-recalculation")
 			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("ipv6-big-tcp")
+				return ce.ValidateName("policy-recalculation")
 			}
 			return err
 		}
@@ -1315,40 +2386,44 @@ func (m *StatusResponse) contextValidateIPV6BigTCP(ctx context.Context, formats
 	return nil
 }
 
-func (m *StatusResponse) contextValidateKubeProxyReplacement(ctx context.Context, formats strfmt.Registry) error {
+func (m *StatusResponse) contextValidateProbes(ctx context.Context, formats strfmt.Registry) error {
 
-	if m.KubeProxyReplacement != nil {
+	for i := 0; i < len(m.Probes); i++ {
 
-		if swag.IsZero(m.KubeProxyReplacement) { // not required
-			return nil
-		}
+		if m.Probes[i] != nil {
 
-		if err := m.KubeProxyReplacement.ContextValidate(ctx, formats); err != nil {
-			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("kube-proxy-replacement")
-			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("kube-proxy-replacement")
+			if swag.IsZero(m.Probes[i]) { // not required
+				return nil
+			}
+
+			if err := m.Probes[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("probes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("probes" + "." + strconv.Itoa(i))
+				}
+				return err
 			}
-			return err
 		}
+
 	}
 
 	return nil
 }
 
-func (m *StatusResponse) contextValidateKubernetes(ctx context.Context, formats strfmt.Registry) error {
+func (m *StatusResponse) contextValidateProxy(ctx context.Context, formats strfmt.Registry) error {
 
-	if m.Kubernetes != nil {
+	if m.Proxy != nil {
 
-		if swag.IsZero(m.Kubernetes) { // not required
+		if swag.IsZero(m.Proxy) { // not required
 			return nil
 		}
 
-		if err := m.Kubernetes.ContextValidate(ctx, formats); err != nil {
+		if err := m.Proxy.ContextValidate(ctx, formats); err != nil {
 			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("kubernetes")
+				return ve.ValidateName("proxy")
 			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("kubernetes")
+				return ce.ValidateName("proxy")
 			}
 			return err
 		}
@@ -1357,19 +2432,19 @@ func (m *StatusResponse) contextValidateKubernetes(ctx context.Context, formats
 	return nil
 }
 
-func (m *StatusResponse) contextValidateKvstore(ctx context.Context, formats strfmt.Registry) error {
+func (m *StatusResponse) contextValidateRateLimiter(ctx context.Context, formats strfmt.Registry) error {
 
-	if m.Kvstore != nil {
+	if m.RateLimiter != nil {
 
-		if swag.IsZero(m.Kvstore) { // not required
+		if swag.IsZero(m.RateLimiter) { // not required
 			return nil
 		}
 
-		if err := m.Kvstore.ContextValidate(ctx, formats); err != nil {
+		if err := m.RateLimiter.ContextValidate(ctx, formats); err != nil {
 			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("kvstore")
+				return ve.ValidateName("rate-limiter")
 			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("kvstore")
+				return ce.ValidateName("rate-limiter")
 			}
 			return err
 		}
@@ -1378,19 +2453,19 @@ func (m *StatusResponse) contextValidateKvstore(ctx context.Context, formats str
 	return nil
 }
 
-func (m *StatusResponse) contextValidateMasquerading(ctx context.Context, formats strfmt.Registry) error {
+func (m *StatusResponse) contextValidateRestore(ctx context.Context, formats strfmt.Registry) error {
 
-	if m.Masquerading != nil {
+	if m.Restore != nil {
 
-		if swag.IsZero(m.Masquerading) { // not required
+		if swag.IsZero(m.Restore) { // not required
 			return nil
 		}
 
-		if err := m.Masquerading.ContextValidate(ctx, formats); err != nil {
+		if err := m.Restore.ContextValidate(ctx, formats); err != nil {
 			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("masquerading")
+				return ve.ValidateName("restore")
 			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("masquerading")
+				return ce.ValidateName("restore")
 			}
 			return err
 		}
@@ -1399,19 +2474,19 @@ func (m *StatusResponse) contextValidateMasquerading(ctx context.Context, format
 	return nil
 }
 
-func (m *StatusResponse) contextValidateNodeMonitor(ctx context.Context, formats strfmt.Registry) error {
+func (m *StatusResponse) contextValidateRouting(ctx context.Context, formats strfmt.Registry) error {
 
-	if m.NodeMonitor != nil {
+	if m.Routing != nil {
 
-		if swag.IsZero(m.NodeMonitor) { // not required
+		if swag.IsZero(m.Routing) { // not required
 			return nil
 		}
 
-		if err := m.NodeMonitor.ContextValidate(ctx, formats); err != nil {
+		if err := m.Routing.ContextValidate(ctx, formats); err != nil {
 			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("nodeMonitor")
+				return ve.ValidateName("routing")
 			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("nodeMonitor")
+				return ce.ValidateName("routing")
 			}
 			return err
 		}
@@ -1420,19 +2495,19 @@ func (m *StatusResponse) contextValidateNodeMonitor(ctx context.Context, formats
 	return nil
 }
 
-func (m *StatusResponse) contextValidateProxy(ctx context.Context, formats strfmt.Registry) error {
+func (m *StatusResponse) contextValidateSctp(ctx context.Context, formats strfmt.Registry) error {
 
-	if m.Proxy != nil {
+	if m.Sctp != nil {
 
-		if swag.IsZero(m.Proxy) { // not required
+		if swag.IsZero(m.Sctp) { // not required
 			return nil
 		}
 
-		if err := m.Proxy.ContextValidate(ctx, formats); err != nil {
+		if err := m.Sctp.ContextValidate(ctx, formats); err != nil {
 			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("proxy")
+				return ve.ValidateName("sctp")
 			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("proxy")
+				return ce.ValidateName("sctp")
 			}
 			return err
 		}
@@ -1441,22 +2516,26 @@ func (m *StatusResponse) contextValidateProxy(ctx context.Context, formats strfm
 	return nil
 }
 
-func (m *StatusResponse) contextValidateRouting(ctx context.Context, formats strfmt.Registry) error {
+func (m *StatusResponse) contextValidateServiceBackends(ctx context.Context, formats strfmt.Registry) error {
 
-	if m.Routing != nil {
+	for i := 0; i < len(m.ServiceBackends); i++ {
 
-		if swag.IsZero(m.Routing) { // not required
-			return nil
-		}
+		if m.ServiceBackends[i] != nil {
 
-		if err := m.Routing.ContextValidate(ctx, formats); err != nil {
-			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("routing")
-			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("routing")
+			if swag.IsZero(m.ServiceBackends[i]) { // not required
+				return nil
+			}
+
+			if err := m.ServiceBackends[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("service-backends" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("service-backends" + "." + strconv.Itoa(i))
+				}
+				return err
 			}
-			return err
 		}
+
 	}
 
 	return nil
@@ -1483,6 +2562,52 @@ func (m *StatusResponse) contextValidateSrv6(ctx context.Context, formats strfmt
 	return nil
 }
 
+func (m *StatusResponse) contextValidateSysctls(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Sysctls); i++ {
+
+		if m.Sysctls[i] != nil {
+
+			if swag.IsZero(m.Sysctls[i]) { // not required
+				return nil
+			}
+
+			if err := m.Sysctls[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("sysctls" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("sysctls" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *StatusResponse) contextValidateTunnelConsistency(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.TunnelConsistency != nil {
+
+		if swag.IsZero(m.TunnelConsistency) { // not required
+			return nil
+		}
+
+		if err := m.TunnelConsistency.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("tunnel-consistency")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("tunnel-consistency")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *StatusResponse) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -12,6 +12,27 @@ import (
 	strfmt "github.com/go-openapi/strfmt"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BPFMapProperties) DeepCopyInto(out *BPFMapProperties) {
+	*out = *in
+	if in.Used != nil {
+		in, out := &in.Used, &out.Used
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BPFMapProperties.
+func (in *BPFMapProperties) DeepCopy() *BPFMapProperties {
+	if in == nil {
+		return nil
+	}
+	out := new(BPFMapProperties)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BPFMapStatus) DeepCopyInto(out *BPFMapStatus) {
 	*out = *in
@@ -22,7 +43,7 @@ func (in *BPFMapStatus) DeepCopyInto(out *BPFMapStatus) {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
 				*out = new(BPFMapProperties)
-				**out = **in
+				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
@@ -39,6 +60,22 @@ func (in *BPFMapStatus) DeepCopy() *BPFMapStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BPFVerifierStatus) DeepCopyInto(out *BPFVerifierStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BPFVerifierStatus.
+func (in *BPFVerifierStatus) DeepCopy() *BPFVerifierStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BPFVerifierStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BandwidthManager) DeepCopyInto(out *BandwidthManager) {
 	*out = *in
@@ -125,7 +162,7 @@ func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
 	if in.CiliumHealth != nil {
 		in, out := &in.CiliumHealth, &out.CiliumHealth
 		*out = new(Status)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Nodes != nil {
 		in, out := &in.Nodes, &out.Nodes
@@ -151,6 +188,54 @@ func (in *ClusterStatus) DeepCopy() *ClusterStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigConsistency) DeepCopyInto(out *ConfigConsistency) {
+	*out = *in
+	if in.Mismatches != nil {
+		in, out := &in.Mismatches, &out.Mismatches
+		*out = make([]*ConfigMismatch, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConfigMismatch)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigConsistency.
+func (in *ConfigConsistency) DeepCopy() *ConfigConsistency {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigConsistency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMismatch) DeepCopyInto(out *ConfigMismatch) {
+	*out = *in
+	if in.ExampleNodes != nil {
+		in, out := &in.ExampleNodes, &out.ExampleNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMismatch.
+func (in *ConfigMismatch) DeepCopy() *ConfigMismatch {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMismatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerStatus) DeepCopyInto(out *ControllerStatus) {
 	*out = *in
@@ -240,6 +325,55 @@ func (in *EncryptionStatus) DeepCopy() *EncryptionStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvoyAdminStatus) DeepCopyInto(out *EnvoyAdminStatus) {
+	*out = *in
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(Status)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.XdsConfigVersions != nil {
+		in, out := &in.XdsConfigVersions, &out.XdsConfigVersions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyAdminStatus.
+func (in *EnvoyAdminStatus) DeepCopy() *EnvoyAdminStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvoyAdminStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FQDNCacheStatus) DeepCopyInto(out *FQDNCacheStatus) {
+	*out = *in
+	if in.OverLimitEndpointIds != nil {
+		in, out := &in.OverLimitEndpointIds, &out.OverLimitEndpointIds
+		*out = make([]int64, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FQDNCacheStatus.
+func (in *FQDNCacheStatus) DeepCopy() *FQDNCacheStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FQDNCacheStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostFirewall) DeepCopyInto(out *HostFirewall) {
 	*out = *in
@@ -338,254 +472,611 @@ func (in *IPAMStatus) DeepCopy() *IPAMStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPsecStatus) DeepCopyInto(out *IPsecStatus) {
+func (in *IPConflict) DeepCopyInto(out *IPConflict) {
 	*out = *in
-	if in.DecryptInterfaces != nil {
-		in, out := &in.DecryptInterfaces, &out.DecryptInterfaces
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.XfrmErrors != nil {
-		in, out := &in.XfrmErrors, &out.XfrmErrors
-		*out = make(map[string]int64, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	if in.Claimants != nil {
+		in, out := &in.Claimants, &out.Claimants
+		*out = make([]*IPConflictClaimant, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(IPConflictClaimant)
+				**out = **in
+			}
 		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPsecStatus.
-func (in *IPsecStatus) DeepCopy() *IPsecStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPConflict.
+func (in *IPConflict) DeepCopy() *IPConflict {
 	if in == nil {
 		return nil
 	}
-	out := new(IPsecStatus)
+	out := new(IPConflict)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *K8sStatus) DeepCopyInto(out *K8sStatus) {
+func (in *IPConflictClaimant) DeepCopyInto(out *IPConflictClaimant) {
 	*out = *in
-	if in.K8sAPIVersions != nil {
-		in, out := &in.K8sAPIVersions, &out.K8sAPIVersions
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8sStatus.
-func (in *K8sStatus) DeepCopy() *K8sStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPConflictClaimant.
+func (in *IPConflictClaimant) DeepCopy() *IPConflictClaimant {
 	if in == nil {
 		return nil
 	}
-	out := new(K8sStatus)
+	out := new(IPConflictClaimant)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubeProxyReplacement) DeepCopyInto(out *KubeProxyReplacement) {
+func (in *IPConflicts) DeepCopyInto(out *IPConflicts) {
 	*out = *in
-	if in.DeviceList != nil {
-		in, out := &in.DeviceList, &out.DeviceList
-		*out = make([]*KubeProxyReplacementDeviceListItems0, len(*in))
+	if in.Conflicts != nil {
+		in, out := &in.Conflicts, &out.Conflicts
+		*out = make([]*IPConflict, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(KubeProxyReplacementDeviceListItems0)
+				*out = new(IPConflict)
 				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
-	if in.Devices != nil {
-		in, out := &in.Devices, &out.Devices
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Features != nil {
-		in, out := &in.Features, &out.Features
-		*out = new(KubeProxyReplacementFeatures)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(Status)
 		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeProxyReplacement.
-func (in *KubeProxyReplacement) DeepCopy() *KubeProxyReplacement {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPConflicts.
+func (in *IPConflicts) DeepCopy() *IPConflicts {
 	if in == nil {
 		return nil
 	}
-	out := new(KubeProxyReplacement)
+	out := new(IPConflicts)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubeProxyReplacementDeviceListItems0) DeepCopyInto(out *KubeProxyReplacementDeviceListItems0) {
+func (in *IPsecStatus) DeepCopyInto(out *IPsecStatus) {
 	*out = *in
-	if in.IP != nil {
-		in, out := &in.IP, &out.IP
+	if in.DecryptInterfaces != nil {
+		in, out := &in.DecryptInterfaces, &out.DecryptInterfaces
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.XfrmErrors != nil {
+		in, out := &in.XfrmErrors, &out.XfrmErrors
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeProxyReplacementDeviceListItems0.
-func (in *KubeProxyReplacementDeviceListItems0) DeepCopy() *KubeProxyReplacementDeviceListItems0 {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPsecStatus.
+func (in *IPsecStatus) DeepCopy() *IPsecStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(KubeProxyReplacementDeviceListItems0)
+	out := new(IPsecStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubeProxyReplacementFeatures) DeepCopyInto(out *KubeProxyReplacementFeatures) {
+func (in *IdentityDistribution) DeepCopyInto(out *IdentityDistribution) {
 	*out = *in
-	if in.Annotations != nil {
-		in, out := &in.Annotations, &out.Annotations
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.ExternalIPs != nil {
-		in, out := &in.ExternalIPs, &out.ExternalIPs
-		*out = new(KubeProxyReplacementFeaturesExternalIPs)
-		**out = **in
-	}
-	if in.GracefulTermination != nil {
-		in, out := &in.GracefulTermination, &out.GracefulTermination
-		*out = new(KubeProxyReplacementFeaturesGracefulTermination)
-		**out = **in
-	}
-	if in.HostPort != nil {
-		in, out := &in.HostPort, &out.HostPort
-		*out = new(KubeProxyReplacementFeaturesHostPort)
-		**out = **in
-	}
-	if in.HostReachableServices != nil {
-		in, out := &in.HostReachableServices, &out.HostReachableServices
-		*out = new(KubeProxyReplacementFeaturesHostReachableServices)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Nat46X64 != nil {
-		in, out := &in.Nat46X64, &out.Nat46X64
-		*out = new(KubeProxyReplacementFeaturesNat46X64)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.NodePort != nil {
-		in, out := &in.NodePort, &out.NodePort
-		*out = new(KubeProxyReplacementFeaturesNodePort)
-		**out = **in
-	}
-	if in.SessionAffinity != nil {
-		in, out := &in.SessionAffinity, &out.SessionAffinity
-		*out = new(KubeProxyReplacementFeaturesSessionAffinity)
-		**out = **in
-	}
-	if in.SocketLB != nil {
-		in, out := &in.SocketLB, &out.SocketLB
-		*out = new(KubeProxyReplacementFeaturesSocketLB)
-		**out = **in
-	}
-	if in.SocketLBTracing != nil {
-		in, out := &in.SocketLBTracing, &out.SocketLBTracing
-		*out = new(KubeProxyReplacementFeaturesSocketLBTracing)
-		**out = **in
+	if in.Identities != nil {
+		in, out := &in.Identities, &out.Identities
+		*out = make([]*IdentityEndpointCount, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(IdentityEndpointCount)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeProxyReplacementFeatures.
-func (in *KubeProxyReplacementFeatures) DeepCopy() *KubeProxyReplacementFeatures {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityDistribution.
+func (in *IdentityDistribution) DeepCopy() *IdentityDistribution {
 	if in == nil {
 		return nil
 	}
-	out := new(KubeProxyReplacementFeatures)
+	out := new(IdentityDistribution)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubeProxyReplacementFeaturesHostReachableServices) DeepCopyInto(out *KubeProxyReplacementFeaturesHostReachableServices) {
+func (in *IdentityEndpointCount) DeepCopyInto(out *IdentityEndpointCount) {
 	*out = *in
-	if in.Protocols != nil {
-		in, out := &in.Protocols, &out.Protocols
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeProxyReplacementFeaturesHostReachableServices.
-func (in *KubeProxyReplacementFeaturesHostReachableServices) DeepCopy() *KubeProxyReplacementFeaturesHostReachableServices {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityEndpointCount.
+func (in *IdentityEndpointCount) DeepCopy() *IdentityEndpointCount {
 	if in == nil {
 		return nil
 	}
-	out := new(KubeProxyReplacementFeaturesHostReachableServices)
+	out := new(IdentityEndpointCount)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubeProxyReplacementFeaturesNat46X64) DeepCopyInto(out *KubeProxyReplacementFeaturesNat46X64) {
+func (in *IptablesRulesStatus) DeepCopyInto(out *IptablesRulesStatus) {
 	*out = *in
-	if in.Gateway != nil {
-		in, out := &in.Gateway, &out.Gateway
-		*out = new(KubeProxyReplacementFeaturesNat46X64Gateway)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Service != nil {
-		in, out := &in.Service, &out.Service
-		*out = new(KubeProxyReplacementFeaturesNat46X64Service)
-		**out = **in
+	if in.MissingRules != nil {
+		in, out := &in.MissingRules, &out.MissingRules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeProxyReplacementFeaturesNat46X64.
-func (in *KubeProxyReplacementFeaturesNat46X64) DeepCopy() *KubeProxyReplacementFeaturesNat46X64 {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IptablesRulesStatus.
+func (in *IptablesRulesStatus) DeepCopy() *IptablesRulesStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(KubeProxyReplacementFeaturesNat46X64)
+	out := new(IptablesRulesStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubeProxyReplacementFeaturesNat46X64Gateway) DeepCopyInto(out *KubeProxyReplacementFeaturesNat46X64Gateway) {
+func (in *K8sFamilyStatus) DeepCopyInto(out *K8sFamilyStatus) {
 	*out = *in
-	if in.Prefixes != nil {
-		in, out := &in.Prefixes, &out.Prefixes
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeProxyReplacementFeaturesNat46X64Gateway.
-func (in *KubeProxyReplacementFeaturesNat46X64Gateway) DeepCopy() *KubeProxyReplacementFeaturesNat46X64Gateway {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8sFamilyStatus.
+func (in *K8sFamilyStatus) DeepCopy() *K8sFamilyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(KubeProxyReplacementFeaturesNat46X64Gateway)
+	out := new(K8sFamilyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Masquerading) DeepCopyInto(out *Masquerading) {
+func (in *K8sStatus) DeepCopyInto(out *K8sStatus) {
+	*out = *in
+	if in.Families != nil {
+		in, out := &in.Families, &out.Families
+		*out = make([]*K8sFamilyStatus, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(K8sFamilyStatus)
+				**out = **in
+			}
+		}
+	}
+	if in.K8sAPIVersions != nil {
+		in, out := &in.K8sAPIVersions, &out.K8sAPIVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8sStatus.
+func (in *K8sStatus) DeepCopy() *K8sStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(K8sStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KVStoreOperationStats) DeepCopyInto(out *KVStoreOperationStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KVStoreOperationStats.
+func (in *KVStoreOperationStats) DeepCopy() *KVStoreOperationStats {
+	if in == nil {
+		return nil
+	}
+	out := new(KVStoreOperationStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KVStoreOperations) DeepCopyInto(out *KVStoreOperations) {
+	*out = *in
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]*KVStoreOperationStats, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(KVStoreOperationStats)
+				**out = **in
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KVStoreOperations.
+func (in *KVStoreOperations) DeepCopy() *KVStoreOperations {
+	if in == nil {
+		return nil
+	}
+	out := new(KVStoreOperations)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeProxyReplacement) DeepCopyInto(out *KubeProxyReplacement) {
+	*out = *in
+	if in.DeviceList != nil {
+		in, out := &in.DeviceList, &out.DeviceList
+		*out = make([]*KubeProxyReplacementDeviceListItems0, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(KubeProxyReplacementDeviceListItems0)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Devices != nil {
+		in, out := &in.Devices, &out.Devices
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Features != nil {
+		in, out := &in.Features, &out.Features
+		*out = new(KubeProxyReplacementFeatures)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeProxyReplacement.
+func (in *KubeProxyReplacement) DeepCopy() *KubeProxyReplacement {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeProxyReplacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeProxyReplacementDeviceListItems0) DeepCopyInto(out *KubeProxyReplacementDeviceListItems0) {
+	*out = *in
+	if in.IP != nil {
+		in, out := &in.IP, &out.IP
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecondaryIP != nil {
+		in, out := &in.SecondaryIP, &out.SecondaryIP
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeProxyReplacementDeviceListItems0.
+func (in *KubeProxyReplacementDeviceListItems0) DeepCopy() *KubeProxyReplacementDeviceListItems0 {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeProxyReplacementDeviceListItems0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeProxyReplacementFeatures) DeepCopyInto(out *KubeProxyReplacementFeatures) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExternalIPs != nil {
+		in, out := &in.ExternalIPs, &out.ExternalIPs
+		*out = new(KubeProxyReplacementFeaturesExternalIPs)
+		**out = **in
+	}
+	if in.GracefulTermination != nil {
+		in, out := &in.GracefulTermination, &out.GracefulTermination
+		*out = new(KubeProxyReplacementFeaturesGracefulTermination)
+		**out = **in
+	}
+	if in.HostPort != nil {
+		in, out := &in.HostPort, &out.HostPort
+		*out = new(KubeProxyReplacementFeaturesHostPort)
+		**out = **in
+	}
+	if in.HostReachableServices != nil {
+		in, out := &in.HostReachableServices, &out.HostReachableServices
+		*out = new(KubeProxyReplacementFeaturesHostReachableServices)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Nat46X64 != nil {
+		in, out := &in.Nat46X64, &out.Nat46X64
+		*out = new(KubeProxyReplacementFeaturesNat46X64)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodePort != nil {
+		in, out := &in.NodePort, &out.NodePort
+		*out = new(KubeProxyReplacementFeaturesNodePort)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SessionAffinity != nil {
+		in, out := &in.SessionAffinity, &out.SessionAffinity
+		*out = new(KubeProxyReplacementFeaturesSessionAffinity)
+		**out = **in
+	}
+	if in.SocketLB != nil {
+		in, out := &in.SocketLB, &out.SocketLB
+		*out = new(KubeProxyReplacementFeaturesSocketLB)
+		**out = **in
+	}
+	if in.SocketLBTracing != nil {
+		in, out := &in.SocketLBTracing, &out.SocketLBTracing
+		*out = new(KubeProxyReplacementFeaturesSocketLBTracing)
+		**out = **in
+	}
+	if in.SourceRangeCheck != nil {
+		in, out := &in.SourceRangeCheck, &out.SourceRangeCheck
+		*out = new(KubeProxyReplacementFeaturesSourceRangeCheck)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeProxyReplacementFeatures.
+func (in *KubeProxyReplacementFeatures) DeepCopy() *KubeProxyReplacementFeatures {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeProxyReplacementFeatures)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeProxyReplacementFeaturesHostReachableServices) DeepCopyInto(out *KubeProxyReplacementFeaturesHostReachableServices) {
+	*out = *in
+	if in.Protocols != nil {
+		in, out := &in.Protocols, &out.Protocols
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeProxyReplacementFeaturesHostReachableServices.
+func (in *KubeProxyReplacementFeaturesHostReachableServices) DeepCopy() *KubeProxyReplacementFeaturesHostReachableServices {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeProxyReplacementFeaturesHostReachableServices)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeProxyReplacementFeaturesNat46X64) DeepCopyInto(out *KubeProxyReplacementFeaturesNat46X64) {
+	*out = *in
+	if in.Gateway != nil {
+		in, out := &in.Gateway, &out.Gateway
+		*out = new(KubeProxyReplacementFeaturesNat46X64Gateway)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(KubeProxyReplacementFeaturesNat46X64Service)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeProxyReplacementFeaturesNat46X64.
+func (in *KubeProxyReplacementFeaturesNat46X64) DeepCopy() *KubeProxyReplacementFeaturesNat46X64 {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeProxyReplacementFeaturesNat46X64)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeProxyReplacementFeaturesNat46X64Gateway) DeepCopyInto(out *KubeProxyReplacementFeaturesNat46X64Gateway) {
+	*out = *in
+	if in.Prefixes != nil {
+		in, out := &in.Prefixes, &out.Prefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeProxyReplacementFeaturesNat46X64Gateway.
+func (in *KubeProxyReplacementFeaturesNat46X64Gateway) DeepCopy() *KubeProxyReplacementFeaturesNat46X64Gateway {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeProxyReplacementFeaturesNat46X64Gateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeProxyReplacementFeaturesNodePort) DeepCopyInto(out *KubeProxyReplacementFeaturesNodePort) {
+	*out = *in
+	if in.AccelerationDevices != nil {
+		in, out := &in.AccelerationDevices, &out.AccelerationDevices
+		*out = make([]*KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0)
+				**out = **in
+			}
+		}
+	}
+	if in.AccelerationStatus != nil {
+		in, out := &in.AccelerationStatus, &out.AccelerationStatus
+		*out = new(Status)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeProxyReplacementFeaturesNodePort.
+func (in *KubeProxyReplacementFeaturesNodePort) DeepCopy() *KubeProxyReplacementFeaturesNodePort {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeProxyReplacementFeaturesNodePort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0) DeepCopyInto(out *KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0.
+func (in *KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0) DeepCopy() *KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0 {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerStatus) DeepCopyInto(out *LoadBalancerStatus) {
+	*out = *in
+	if in.Maglev != nil {
+		in, out := &in.Maglev, &out.Maglev
+		*out = new(MaglevStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerStatus.
+func (in *LoadBalancerStatus) DeepCopy() *LoadBalancerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaglevStatus) DeepCopyInto(out *MaglevStatus) {
+	*out = *in
+	if in.Tables != nil {
+		in, out := &in.Tables, &out.Tables
+		*out = make([]*MaglevTableStatus, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(MaglevTableStatus)
+				**out = **in
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaglevStatus.
+func (in *MaglevStatus) DeepCopy() *MaglevStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MaglevStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaglevTableStatus) DeepCopyInto(out *MaglevTableStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaglevTableStatus.
+func (in *MaglevTableStatus) DeepCopy() *MaglevTableStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MaglevTableStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MapPressureEntry) DeepCopyInto(out *MapPressureEntry) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MapPressureEntry.
+func (in *MapPressureEntry) DeepCopy() *MapPressureEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(MapPressureEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Masquerading) DeepCopyInto(out *Masquerading) {
 	*out = *in
 	if in.EnabledProtocols != nil {
 		in, out := &in.EnabledProtocols, &out.EnabledProtocols
 		*out = new(MasqueradingEnabledProtocols)
 		**out = **in
 	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(Status)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -599,6 +1090,49 @@ func (in *Masquerading) DeepCopy() *Masquerading {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleHealth) DeepCopyInto(out *ModuleHealth) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleHealth.
+func (in *ModuleHealth) DeepCopy() *ModuleHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModulesHealth) DeepCopyInto(out *ModulesHealth) {
+	*out = *in
+	if in.DegradedModules != nil {
+		in, out := &in.DegradedModules, &out.DegradedModules
+		*out = make([]*ModuleHealth, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ModuleHealth)
+				**out = **in
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModulesHealth.
+func (in *ModulesHealth) DeepCopy() *ModulesHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(ModulesHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in NamedPorts) DeepCopyInto(out *NamedPorts) {
 	{
@@ -625,6 +1159,53 @@ func (in NamedPorts) DeepCopy() NamedPorts {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NeighborConsistency) DeepCopyInto(out *NeighborConsistency) {
+	*out = *in
+	if in.Missing != nil {
+		in, out := &in.Missing, &out.Missing
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Stale != nil {
+		in, out := &in.Stale, &out.Stale
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(Status)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NeighborConsistency.
+func (in *NeighborConsistency) DeepCopy() *NeighborConsistency {
+	if in == nil {
+		return nil
+	}
+	out := new(NeighborConsistency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NeighborTable) DeepCopyInto(out *NeighborTable) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NeighborTable.
+func (in *NeighborTable) DeepCopy() *NeighborTable {
+	if in == nil {
+		return nil
+	}
+	out := new(NeighborTable)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeAddressing) DeepCopyInto(out *NodeAddressing) {
 	*out = *in
@@ -646,36 +1227,195 @@ func (in *NodeAddressing) DeepCopy() *NodeAddressing {
 	if in == nil {
 		return nil
 	}
-	out := new(NodeAddressing)
+	out := new(NodeAddressing)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeElement) DeepCopyInto(out *NodeElement) {
+	*out = *in
+	if in.HealthEndpointAddress != nil {
+		in, out := &in.HealthEndpointAddress, &out.HealthEndpointAddress
+		*out = new(NodeAddressing)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IngressAddress != nil {
+		in, out := &in.IngressAddress, &out.IngressAddress
+		*out = new(NodeAddressing)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrimaryAddress != nil {
+		in, out := &in.PrimaryAddress, &out.PrimaryAddress
+		*out = new(NodeAddressing)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecondaryAddresses != nil {
+		in, out := &in.SecondaryAddresses, &out.SecondaryAddresses
+		*out = make([]*NodeAddressingElement, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NodeAddressingElement)
+				**out = **in
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeElement.
+func (in *NodeElement) DeepCopy() *NodeElement {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeElement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyMapPressure) DeepCopyInto(out *PolicyMapPressure) {
+	*out = *in
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]*PolicyMapPressureEndpoint, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(PolicyMapPressureEndpoint)
+				**out = **in
+			}
+		}
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(Status)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyMapPressure.
+func (in *PolicyMapPressure) DeepCopy() *PolicyMapPressure {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyMapPressure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyMapPressureEndpoint) DeepCopyInto(out *PolicyMapPressureEndpoint) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyMapPressureEndpoint.
+func (in *PolicyMapPressureEndpoint) DeepCopy() *PolicyMapPressureEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyMapPressureEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyRecalculation) DeepCopyInto(out *PolicyRecalculation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyRecalculation.
+func (in *PolicyRecalculation) DeepCopy() *PolicyRecalculation {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyRecalculation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbeStatus) DeepCopyInto(out *ProbeStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeStatus.
+func (in *ProbeStatus) DeepCopy() *ProbeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyRedirectInconsistencies) DeepCopyInto(out *ProxyRedirectInconsistencies) {
+	*out = *in
+	if in.EndpointIds != nil {
+		in, out := &in.EndpointIds, &out.EndpointIds
+		*out = make([]int64, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyRedirectInconsistencies.
+func (in *ProxyRedirectInconsistencies) DeepCopy() *ProxyRedirectInconsistencies {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyRedirectInconsistencies)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyStatistics) DeepCopyInto(out *ProxyStatistics) {
+	*out = *in
+	if in.Statistics != nil {
+		in, out := &in.Statistics, &out.Statistics
+		*out = new(RequestResponseStatistics)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyStatistics.
+func (in *ProxyStatistics) DeepCopy() *ProxyStatistics {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyStatistics)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodeElement) DeepCopyInto(out *NodeElement) {
+func (in *ProxyStatus) DeepCopyInto(out *ProxyStatus) {
 	*out = *in
-	if in.HealthEndpointAddress != nil {
-		in, out := &in.HealthEndpointAddress, &out.HealthEndpointAddress
-		*out = new(NodeAddressing)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.IngressAddress != nil {
-		in, out := &in.IngressAddress, &out.IngressAddress
-		*out = new(NodeAddressing)
+	if in.EnvoyAdmin != nil {
+		in, out := &in.EnvoyAdmin, &out.EnvoyAdmin
+		*out = new(EnvoyAdminStatus)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.PrimaryAddress != nil {
-		in, out := &in.PrimaryAddress, &out.PrimaryAddress
-		*out = new(NodeAddressing)
+	if in.RedirectInconsistencies != nil {
+		in, out := &in.RedirectInconsistencies, &out.RedirectInconsistencies
+		*out = new(ProxyRedirectInconsistencies)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.SecondaryAddresses != nil {
-		in, out := &in.SecondaryAddresses, &out.SecondaryAddresses
-		*out = make([]*NodeAddressingElement, len(*in))
+	if in.Redirects != nil {
+		in, out := &in.Redirects, &out.Redirects
+		*out = make([]*ProxyRedirect, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NodeAddressingElement)
+				*out = new(ProxyRedirect)
 				**out = **in
 			}
 		}
@@ -683,60 +1423,62 @@ func (in *NodeElement) DeepCopyInto(out *NodeElement) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeElement.
-func (in *NodeElement) DeepCopy() *NodeElement {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyStatus.
+func (in *ProxyStatus) DeepCopy() *ProxyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NodeElement)
+	out := new(ProxyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProxyStatistics) DeepCopyInto(out *ProxyStatistics) {
+func (in *RateLimiterOperation) DeepCopyInto(out *RateLimiterOperation) {
 	*out = *in
-	if in.Statistics != nil {
-		in, out := &in.Statistics, &out.Statistics
-		*out = new(RequestResponseStatistics)
-		(*in).DeepCopyInto(*out)
-	}
+	in.MaxWaitDuration.DeepCopyInto(&out.MaxWaitDuration)
+	in.MeanWaitDuration.DeepCopyInto(&out.MeanWaitDuration)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyStatistics.
-func (in *ProxyStatistics) DeepCopy() *ProxyStatistics {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimiterOperation.
+func (in *RateLimiterOperation) DeepCopy() *RateLimiterOperation {
 	if in == nil {
 		return nil
 	}
-	out := new(ProxyStatistics)
+	out := new(RateLimiterOperation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProxyStatus) DeepCopyInto(out *ProxyStatus) {
+func (in *RateLimiterStatus) DeepCopyInto(out *RateLimiterStatus) {
 	*out = *in
-	if in.Redirects != nil {
-		in, out := &in.Redirects, &out.Redirects
-		*out = make([]*ProxyRedirect, len(*in))
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]*RateLimiterOperation, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ProxyRedirect)
+				*out = new(RateLimiterOperation)
 				**out = **in
 			}
 		}
 	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(Status)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyStatus.
-func (in *ProxyStatus) DeepCopy() *ProxyStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimiterStatus.
+func (in *RateLimiterStatus) DeepCopy() *RateLimiterStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ProxyStatus)
+	out := new(RateLimiterStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -836,13 +1578,126 @@ func (in *RequestResponseStatistics) DeepCopy() *RequestResponseStatistics {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreFailureReason) DeepCopyInto(out *RestoreFailureReason) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreFailureReason.
+func (in *RestoreFailureReason) DeepCopy() *RestoreFailureReason {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreFailureReason)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreStatus) DeepCopyInto(out *RestoreStatus) {
+	*out = *in
+	if in.FailureReasons != nil {
+		in, out := &in.FailureReasons, &out.FailureReasons
+		*out = make([]*RestoreFailureReason, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RestoreFailureReason)
+				**out = **in
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreStatus.
+func (in *RestoreStatus) DeepCopy() *RestoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceBackendStatus) DeepCopyInto(out *ServiceBackendStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBackendStatus.
+func (in *ServiceBackendStatus) DeepCopy() *ServiceBackendStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceBackendStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceBackendsStatus) DeepCopyInto(out *ServiceBackendsStatus) {
+	*out = *in
+	if in.Backends != nil {
+		in, out := &in.Backends, &out.Backends
+		*out = make([]*ServiceBackendStatus, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ServiceBackendStatus)
+				**out = **in
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBackendsStatus.
+func (in *ServiceBackendsStatus) DeepCopy() *ServiceBackendsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceBackendsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Status) DeepCopyInto(out *Status) {
+	*out = *in
+	if in.SubChecks != nil {
+		in, out := &in.SubChecks, &out.SubChecks
+		*out = make([]*SubCheckStatus, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(SubCheckStatus)
+				**out = **in
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Status.
+func (in *Status) DeepCopy() *Status {
+	if in == nil {
+		return nil
+	}
+	out := new(Status)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StatusResponse) DeepCopyInto(out *StatusResponse) {
 	*out = *in
 	if in.AuthCertificateProvider != nil {
 		in, out := &in.AuthCertificateProvider, &out.AuthCertificateProvider
 		*out = new(Status)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.BandwidthManager != nil {
 		in, out := &in.BandwidthManager, &out.BandwidthManager
@@ -854,10 +1709,15 @@ func (in *StatusResponse) DeepCopyInto(out *StatusResponse) {
 		*out = new(BPFMapStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BpfVerifier != nil {
+		in, out := &in.BpfVerifier, &out.BpfVerifier
+		*out = new(BPFVerifierStatus)
+		**out = **in
+	}
 	if in.Cilium != nil {
 		in, out := &in.Cilium, &out.Cilium
 		*out = new(Status)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ClockSource != nil {
 		in, out := &in.ClockSource, &out.ClockSource
@@ -882,12 +1742,17 @@ func (in *StatusResponse) DeepCopyInto(out *StatusResponse) {
 	if in.CniFile != nil {
 		in, out := &in.CniFile, &out.CniFile
 		*out = new(Status)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigConsistency != nil {
+		in, out := &in.ConfigConsistency, &out.ConfigConsistency
+		*out = new(ConfigConsistency)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ContainerRuntime != nil {
 		in, out := &in.ContainerRuntime, &out.ContainerRuntime
 		*out = new(Status)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Controllers != nil {
 		in, out := &in.Controllers, &out.Controllers
@@ -905,6 +1770,11 @@ func (in *StatusResponse) DeepCopyInto(out *StatusResponse) {
 		*out = new(EncryptionStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.FqdnCache != nil {
+		in, out := &in.FqdnCache, &out.FqdnCache
+		*out = new(FQDNCacheStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.HostFirewall != nil {
 		in, out := &in.HostFirewall, &out.HostFirewall
 		*out = new(HostFirewall)
@@ -915,16 +1785,31 @@ func (in *StatusResponse) DeepCopyInto(out *StatusResponse) {
 		*out = new(HubbleStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.IdentityDistribution != nil {
+		in, out := &in.IdentityDistribution, &out.IdentityDistribution
+		*out = new(IdentityDistribution)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.IdentityRange != nil {
 		in, out := &in.IdentityRange, &out.IdentityRange
 		*out = new(IdentityRange)
 		**out = **in
 	}
+	if in.IPConflicts != nil {
+		in, out := &in.IPConflicts, &out.IPConflicts
+		*out = new(IPConflicts)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Ipam != nil {
 		in, out := &in.Ipam, &out.Ipam
 		*out = new(IPAMStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.IptablesRules != nil {
+		in, out := &in.IptablesRules, &out.IptablesRules
+		*out = new(IptablesRulesStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.IPV4BigTCP != nil {
 		in, out := &in.IPV4BigTCP, &out.IPV4BigTCP
 		*out = new(IPV4BigTCP)
@@ -948,28 +1833,111 @@ func (in *StatusResponse) DeepCopyInto(out *StatusResponse) {
 	if in.Kvstore != nil {
 		in, out := &in.Kvstore, &out.Kvstore
 		*out = new(Status)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KvstoreOperations != nil {
+		in, out := &in.KvstoreOperations, &out.KvstoreOperations
+		*out = new(KVStoreOperations)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(LoadBalancerStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MapPressure != nil {
+		in, out := &in.MapPressure, &out.MapPressure
+		*out = make([]*MapPressureEntry, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(MapPressureEntry)
+				**out = **in
+			}
+		}
 	}
 	if in.Masquerading != nil {
 		in, out := &in.Masquerading, &out.Masquerading
 		*out = new(Masquerading)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Modules != nil {
+		in, out := &in.Modules, &out.Modules
+		*out = new(ModulesHealth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NeighborConsistency != nil {
+		in, out := &in.NeighborConsistency, &out.NeighborConsistency
+		*out = new(NeighborConsistency)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NeighborTable != nil {
+		in, out := &in.NeighborTable, &out.NeighborTable
+		*out = new(NeighborTable)
+		**out = **in
+	}
 	if in.NodeMonitor != nil {
 		in, out := &in.NodeMonitor, &out.NodeMonitor
 		*out = new(MonitorStatus)
 		**out = **in
 	}
+	if in.PolicyMapPressure != nil {
+		in, out := &in.PolicyMapPressure, &out.PolicyMapPressure
+		*out = new(PolicyMapPressure)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PolicyRecalculation != nil {
+		in, out := &in.PolicyRecalculation, &out.PolicyRecalculation
+		*out = new(PolicyRecalculation)
+		**out = **in
+	}
+	if in.Probes != nil {
+		in, out := &in.Probes, &out.Probes
+		*out = make([]*ProbeStatus, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ProbeStatus)
+				**out = **in
+			}
+		}
+	}
 	if in.Proxy != nil {
 		in, out := &in.Proxy, &out.Proxy
 		*out = new(ProxyStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RateLimiter != nil {
+		in, out := &in.RateLimiter, &out.RateLimiter
+		*out = new(RateLimiterStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Restore != nil {
+		in, out := &in.Restore, &out.Restore
+		*out = new(RestoreStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Routing != nil {
 		in, out := &in.Routing, &out.Routing
 		*out = new(Routing)
 		**out = **in
 	}
+	if in.Sctp != nil {
+		in, out := &in.Sctp, &out.Sctp
+		*out = new(Sctp)
+		**out = **in
+	}
+	if in.ServiceBackends != nil {
+		in, out := &in.ServiceBackends, &out.ServiceBackends
+		*out = make([]*ServiceBackendsStatus, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ServiceBackendsStatus)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.Srv6 != nil {
 		in, out := &in.Srv6, &out.Srv6
 		*out = new(Srv6)
@@ -982,6 +1950,22 @@ func (in *StatusResponse) DeepCopyInto(out *StatusResponse) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.Sysctls != nil {
+		in, out := &in.Sysctls, &out.Sysctls
+		*out = make([]*SysctlStatus, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(SysctlStatus)
+				**out = **in
+			}
+		}
+	}
+	if in.TunnelConsistency != nil {
+		in, out := &in.TunnelConsistency, &out.TunnelConsistency
+		*out = new(TunnelConsistencyStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -995,6 +1979,74 @@ func (in *StatusResponse) DeepCopy() *StatusResponse {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubCheckStatus) DeepCopyInto(out *SubCheckStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubCheckStatus.
+func (in *SubCheckStatus) DeepCopy() *SubCheckStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SubCheckStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SysctlStatus) DeepCopyInto(out *SysctlStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SysctlStatus.
+func (in *SysctlStatus) DeepCopy() *SysctlStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SysctlStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TunnelConsistencyStatus) DeepCopyInto(out *TunnelConsistencyStatus) {
+	*out = *in
+	if in.Mismatched != nil {
+		in, out := &in.Mismatched, &out.Mismatched
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Missing != nil {
+		in, out := &in.Missing, &out.Missing
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Orphaned != nil {
+		in, out := &in.Orphaned, &out.Orphaned
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(Status)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TunnelConsistencyStatus.
+func (in *TunnelConsistencyStatus) DeepCopy() *TunnelConsistencyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TunnelConsistencyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WireguardInterface) DeepCopyInto(out *WireguardInterface) {
 	*out = *in
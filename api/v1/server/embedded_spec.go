@@ -291,6 +291,24 @@ func init() {
         "parameters": [
           {
             "$ref": "#/parameters/labels"
+          },
+          {
+            "type": "integer",
+            "description": "Maximum number of endpoints to return. If more endpoints are\navailable, the response carries a continue token in the\nX-Cilium-List-Continue header. If unset, all endpoints are\nreturned in a single response.\n",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Opaque continue token returned in the X-Cilium-List-Continue\nheader of a previous response, to retrieve the next page.\n",
+            "name": "continue",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma-separated list of endpoint status fields to include in the\nresponse, e.g. \"identity,networking,state\". If unset, all fields\nare included.\n",
+            "name": "fields",
+            "in": "query"
           }
         ],
         "responses": {
@@ -301,8 +319,18 @@ func init() {
               "items": {
                 "$ref": "#/definitions/Endpoint"
               }
+            },
+            "headers": {
+              "X-Cilium-List-Continue": {
+                "type": "string",
+                "description": "Token to retrieve the next page of endpoints. Empty when\nthere are no further endpoints.\n"
+              }
             }
           },
+          "400": {
+            "description": "Invalid request (malformed continue token or unknown field name)",
+            "x-go-name": "Invalid"
+          },
           "404": {
             "description": "Endpoints with provided parameters not found"
           },
@@ -346,6 +374,26 @@ func init() {
         }
       }
     },
+    "/endpoint/gc": {
+      "get": {
+        "description": "Returns the results of the most recent endpoint garbage collection\ncycles, ordered from oldest to newest.\n",
+        "tags": [
+          "endpoint"
+        ],
+        "summary": "Retrieves the history of endpoint garbage collection cycles.",
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/EndpointGCCycle"
+              }
+            }
+          }
+        }
+      }
+    },
     "/endpoint/{id}": {
       "get": {
         "description": "Returns endpoint information\n",
@@ -867,6 +915,18 @@ func init() {
             "description": "If set to true, failure of the agent to connect to the Kubernetes control plane will cause the agent's health status to also fail.\n",
             "name": "require-k8s-connectivity",
             "in": "header"
+          },
+          {
+            "type": "boolean",
+            "description": "Verbose will return status sections omitted from the default payload, such as the per-service backend weights.\n",
+            "name": "verbose",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "Comma-separated list of status components to include in the\nresponse, e.g. \"encryption,kube-proxy-replacement\". Component\nnames match the field names of the status response. If unset,\nthe full status is returned.\n",
+            "name": "components",
+            "in": "query"
           }
         ],
         "responses": {
@@ -875,6 +935,13 @@ func init() {
             "schema": {
               "$ref": "#/definitions/StatusResponse"
             }
+          },
+          "400": {
+            "description": "Unknown status component",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "x-go-name": "Invalid"
           }
         }
       }
@@ -1733,6 +1800,68 @@ func init() {
           }
         }
       }
+    },
+    "/status/collector/stats": {
+      "get": {
+        "description": "Returns statistics about the probes run by the status collector, such\nas the number of probes currently running or failing.\n",
+        "tags": [
+          "daemon"
+        ],
+        "summary": "Get internal statistics of the status collector",
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/StatusCollectorStats"
+            }
+          }
+        }
+      }
+    },
+    "/status/probes": {
+      "put": {
+        "description": "Disables or re-enables scheduling of the named status probe.\nDisabled probes are no longer run and report as disabled rather\nthan going stale.\n",
+        "tags": [
+          "daemon"
+        ],
+        "summary": "Enable or disable a status probe",
+        "parameters": [
+          {
+            "name": "config",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/StatusProbeConfig"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success"
+          },
+          "404": {
+            "description": "Probe not found"
+          }
+        }
+      }
+    },
+    "/status/watch": {
+      "get": {
+        "description": "Streams the health and status information of the Cilium daemon as\nnewline-delimited JSON. A new status object is emitted whenever a\nstatus probe reports an update, and a heartbeat is emitted\nperiodically even when nothing changes.\n",
+        "tags": [
+          "daemon"
+        ],
+        "summary": "Stream health of Cilium daemon",
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
+          }
+        }
+      }
     }
   },
   "definitions": {
@@ -1796,9 +1925,17 @@ func init() {
             "$ref": "#/definitions/BPFMapEntry"
           }
         },
+        "id": {
+          "description": "ID of the BPF map as reported by the kernel",
+          "type": "integer"
+        },
         "path": {
           "description": "Path to BPF map",
           "type": "string"
+        },
+        "reused-pin": {
+          "description": "True if the agent re-used an existing pinned map instead of creating a new one",
+          "type": "boolean"
         }
       }
     },
@@ -1843,16 +1980,33 @@ func init() {
       }
     },
     "BPFMapProperties": {
-      "description": "BPF map properties",
+      "description": "BPF map properties\n\n+k8s:deepcopy-gen=true\n",
       "type": "object",
       "properties": {
+        "id": {
+          "description": "ID of the BPF map as reported by the kernel",
+          "type": "integer"
+        },
         "name": {
           "description": "Name of the BPF map",
           "type": "string"
         },
+        "pin-path": {
+          "description": "Path the BPF map is pinned to in the BPF filesystem",
+          "type": "string"
+        },
+        "reused-pin": {
+          "description": "True if the agent re-used an existing pinned map instead of creating a new one",
+          "type": "boolean"
+        },
         "size": {
           "description": "Size of the BPF map",
           "type": "integer"
+        },
+        "used": {
+          "description": "Number of entries in the BPF map when it was last sampled. Null if\nthe map has not been sampled yet, for example because it has not\nbeen created.\n",
+          "type": "integer",
+          "x-nullable": true
         }
       }
     },
@@ -1873,6 +2027,24 @@ func init() {
         }
       }
     },
+    "BPFVerifierStatus": {
+      "description": "Complexity of the most complex loaded BPF program, relative to the\nkernel verifier's instruction limit\n\n+k8s:deepcopy-gen=true\n",
+      "type": "object",
+      "properties": {
+        "instruction-limit": {
+          "description": "The verifier's complexity limit",
+          "type": "integer"
+        },
+        "program-name": {
+          "description": "Name of the most complex loaded BPF program",
+          "type": "string"
+        },
+        "verified-instructions": {
+          "description": "Number of instructions processed by the verifier during the last\nsuccessful load of the program",
+          "type": "integer"
+        }
+      }
+    },
     "BackendAddress": {
       "description": "Service backend address",
       "type": "object",
@@ -1943,6 +2115,10 @@ func init() {
         "enabled": {
           "description": "Is bandwidth manager enabled",
           "type": "boolean"
+        },
+        "reason": {
+          "description": "Human readable reason why the bandwidth manager is disabled",
+          "type": "string"
         }
       }
     },
@@ -2383,6 +2559,10 @@ func init() {
             "$ref": "#/definitions/RemoteCluster"
           }
         },
+        "num-contributing-clusters": {
+          "description": "Number of remote clusters contributing backends to global services",
+          "type": "integer"
+        },
         "num-global-services": {
           "description": "Number of global services",
           "type": "integer"
@@ -2451,6 +2631,48 @@ func init() {
         }
       }
     },
+    "ConfigConsistency": {
+      "description": "Consistency of the local datapath configuration against the settings\nadvertised by the other nodes of the cluster\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "checked-nodes": {
+          "description": "Number of peer nodes advertising a feature fingerprint",
+          "type": "integer"
+        },
+        "mismatches": {
+          "description": "Settings for which peer nodes diverge from the local configuration",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ConfigMismatch"
+          }
+        }
+      }
+    },
+    "ConfigMismatch": {
+      "description": "Peer nodes diverging from the local value of one setting\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "divergent-nodes": {
+          "description": "Number of nodes advertising a different value",
+          "type": "integer"
+        },
+        "example-nodes": {
+          "description": "Names of up to three divergent nodes",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "local-value": {
+          "description": "Value configured on the local node",
+          "type": "string"
+        },
+        "setting": {
+          "description": "Name of the divergent setting",
+          "type": "string"
+        }
+      }
+    },
     "ConfigurationMap": {
       "description": "Map of configuration key/value pairs.\n",
       "type": "object",
@@ -2813,6 +3035,24 @@ func init() {
         }
       }
     },
+    "EndpointBPFMap": {
+      "description": "A BPF map dedicated to an endpoint",
+      "type": "object",
+      "properties": {
+        "map-id": {
+          "description": "Kernel ID of the map. Zero if the map is not loaded.",
+          "type": "integer"
+        },
+        "name": {
+          "description": "Name of the map",
+          "type": "string"
+        },
+        "path": {
+          "description": "Path of the map pin in the BPF filesystem",
+          "type": "string"
+        }
+      }
+    },
     "EndpointBatchDeleteRequest": {
       "description": "Properties selecting a batch of endpoints to delete.\n",
       "type": "object",
@@ -2995,6 +3235,29 @@ func init() {
         }
       }
     },
+    "EndpointGCCycle": {
+      "description": "Result of a single endpoint garbage collection cycle",
+      "type": "object",
+      "properties": {
+        "endpoints-evaluated": {
+          "description": "Number of endpoints evaluated during the mark phase",
+          "type": "integer"
+        },
+        "endpoints-marked": {
+          "description": "Number of endpoints newly marked for removal",
+          "type": "integer"
+        },
+        "endpoints-swept": {
+          "description": "Number of endpoints removed during the sweep phase",
+          "type": "integer"
+        },
+        "timestamp": {
+          "description": "Time at which the cycle completed",
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
     "EndpointHealth": {
       "description": "Health of the endpoint\n\n+deepequal-gen=true",
       "type": "object",
@@ -3006,6 +3269,13 @@ func init() {
           "description": "Is this endpoint reachable",
           "type": "boolean"
         },
+        "missingProxyRedirects": {
+          "description": "Proxy redirects required by the endpoint's policy which are not installed",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
         "overallHealth": {
           "$ref": "#/definitions/EndpointHealthStatus"
         },
@@ -3173,6 +3443,10 @@ func init() {
       "description": "Policy information of an endpoint",
       "type": "object",
       "properties": {
+        "policy-map-pressure": {
+          "description": "Fraction of the endpoint's BPF policy map in use (0.0 to 1.0)",
+          "type": "number"
+        },
         "proxy-policy-revision": {
           "description": "The policy revision currently enforced in the proxy for this endpoint",
           "type": "integer"
@@ -3216,6 +3490,13 @@ func init() {
         "state"
       ],
       "properties": {
+        "bpf-maps": {
+          "description": "BPF maps dedicated to this endpoint",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/EndpointBPFMap"
+          }
+        },
         "controllers": {
           "description": "Status of internal controllers attached to this endpoint",
           "$ref": "#/definitions/ControllerStatuses"
@@ -3294,9 +3575,71 @@ func init() {
         "$ref": "#/definitions/EndpointStatusChange"
       }
     },
+    "EnvoyAdminStatus": {
+      "description": "Status of the Envoy admin endpoint\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "status": {
+          "description": "State of the admin endpoint probe",
+          "$ref": "#/definitions/Status"
+        },
+        "uptime-seconds": {
+          "description": "Uptime of the current Envoy epoch in seconds",
+          "type": "integer"
+        },
+        "version": {
+          "description": "Envoy version reported by the server_info endpoint",
+          "type": "string"
+        },
+        "xds-config-versions": {
+          "description": "Last accepted xDS configuration version per config type\n\n+k8s:deepcopy-gen=true",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "Error": {
       "type": "string"
     },
+    "FQDNCacheStatus": {
+      "description": "Statistics of the toFQDNs DNS cache and selector bookkeeping\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "alive-zombies": {
+          "description": "Zombie entries kept alive by the last garbage collection pass",
+          "type": "integer"
+        },
+        "deleted-zombies": {
+          "description": "Zombie entries deleted by the last garbage collection pass",
+          "type": "integer"
+        },
+        "ips": {
+          "description": "Number of IPs in the global cache",
+          "type": "integer"
+        },
+        "max-ips-per-hostname-utilization": {
+          "description": "Highest per-endpoint ratio of IPs cached for a single DNS name\nagainst the tofqdns-endpoint-max-ip-per-hostname limit",
+          "type": "number"
+        },
+        "names": {
+          "description": "Number of DNS names in the global cache",
+          "type": "integer"
+        },
+        "over-limit-endpoint-ids": {
+          "description": "Endpoints with at least one DNS name at or above the per-hostname\nIP limit",
+          "type": "array",
+          "items": {
+            "type": "integer"
+          }
+        },
+        "selectors": {
+          "description": "Number of registered toFQDNs selectors",
+          "type": "integer"
+        }
+      }
+    },
     "FrontendAddress": {
       "description": "Layer 4 address. The protocol is currently ignored, all services will\nbehave as if protocol any is specified. To restrict to a particular\nprotocol, use policy.\n",
       "type": "object",
@@ -3361,6 +3704,10 @@ func init() {
             "Disabled",
             "Enabled"
           ]
+        },
+        "reason": {
+          "description": "Human readable reason why the host firewall is disabled",
+          "type": "string"
         }
       }
     },
@@ -3501,36 +3848,84 @@ func init() {
         }
       }
     },
-    "IPListEntry": {
-      "description": "IP entry with metadata",
+    "IPConflict": {
+      "description": "IP address claimed by more than one owner\n\n+k8s:deepcopy-gen=true",
       "type": "object",
-      "required": [
-        "cidr",
-        "identity"
-      ],
       "properties": {
-        "cidr": {
-          "description": "Key of the entry in the form of a CIDR range",
-          "type": "string"
-        },
-        "encryptKey": {
-          "description": "The context ID for the encryption session",
-          "type": "integer"
+        "claimants": {
+          "description": "Owners claiming the IP address",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/IPConflictClaimant"
+          }
         },
-        "hostIP": {
-          "description": "IP address of the host",
+        "ip": {
+          "description": "The conflicting IP address",
           "type": "string"
-        },
-        "identity": {
-          "description": "Numerical identity assigned to the IP",
-          "type": "integer"
-        },
-        "metadata": {
-          "$ref": "#/definitions/IPListEntryMetadata"
         }
       }
     },
-    "IPListEntryMetadata": {
+    "IPConflictClaimant": {
+      "description": "A single owner of a conflicting IP address\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "owner": {
+          "description": "Owner of the claim, e.g. an endpoint or an ipcache entry",
+          "type": "string"
+        },
+        "source": {
+          "description": "Source the claim originates from",
+          "type": "string"
+        }
+      }
+    },
+    "IPConflicts": {
+      "description": "Status of IP address conflict detection\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "conflicts": {
+          "description": "List of detected IP address conflicts",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/IPConflict"
+          }
+        },
+        "status": {
+          "description": "State of the conflict detection, Failure if conflicts exist",
+          "$ref": "#/definitions/Status"
+        }
+      }
+    },
+    "IPListEntry": {
+      "description": "IP entry with metadata",
+      "type": "object",
+      "required": [
+        "cidr",
+        "identity"
+      ],
+      "properties": {
+        "cidr": {
+          "description": "Key of the entry in the form of a CIDR range",
+          "type": "string"
+        },
+        "encryptKey": {
+          "description": "The context ID for the encryption session",
+          "type": "integer"
+        },
+        "hostIP": {
+          "description": "IP address of the host",
+          "type": "string"
+        },
+        "identity": {
+          "description": "Numerical identity assigned to the IP",
+          "type": "integer"
+        },
+        "metadata": {
+          "$ref": "#/definitions/IPListEntryMetadata"
+        }
+      }
+    },
+    "IPListEntryMetadata": {
       "description": "Additional metadata assigned to an IP list entry",
       "type": "object",
       "properties": {
@@ -3564,6 +3959,10 @@ func init() {
         "maxGSO": {
           "description": "Maximum IPv4 GSO size",
           "type": "integer"
+        },
+        "reason": {
+          "description": "Human readable reason why IPv4 BIG TCP is disabled",
+          "type": "string"
         }
       }
     },
@@ -3582,6 +3981,10 @@ func init() {
         "maxGSO": {
           "description": "Maximum IPv6 GSO size",
           "type": "integer"
+        },
+        "reason": {
+          "description": "Human readable reason why IPv6 BIG TCP is disabled",
+          "type": "string"
         }
       }
     },
@@ -3634,6 +4037,44 @@ func init() {
         }
       }
     },
+    "IdentityDistribution": {
+      "description": "Distribution of local endpoints across security identities\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "identities": {
+          "description": "Top identities by local endpoint count",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/IdentityEndpointCount"
+          }
+        },
+        "total-identities": {
+          "description": "Number of distinct security identities in use by local endpoints",
+          "type": "integer"
+        }
+      }
+    },
+    "IdentityEndpointCount": {
+      "description": "Number of local endpoints using a security identity\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "endpoint-count": {
+          "description": "Number of local endpoints using this identity",
+          "type": "integer"
+        },
+        "identity": {
+          "description": "Numeric security identity",
+          "type": "integer"
+        },
+        "labels": {
+          "description": "Labels of the identity",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "IdentityEndpoints": {
       "description": "Security identities owned by endpoints on the local node",
       "type": "object",
@@ -3661,10 +4102,66 @@ func init() {
         }
       }
     },
+    "IptablesRulesStatus": {
+      "description": "Integrity of the iptables chains and rules installed by the agent\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "missing-rules": {
+          "description": "Cilium-owned iptables chains and rules that are expected but no longer present",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "K8sFamilyStatus": {
+      "description": "Connectivity to the kube-apiserver over a single address family\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "family": {
+          "description": "Address family of the probed connection",
+          "type": "string",
+          "enum": [
+            "ipv4",
+            "ipv6"
+          ]
+        },
+        "latency": {
+          "description": "Round trip time of the probe in nanoseconds",
+          "type": "integer"
+        },
+        "msg": {
+          "description": "Human readable status/error/warning message",
+          "type": "string"
+        },
+        "primary": {
+          "description": "True if this family carries the primary client connection to the apiserver",
+          "type": "boolean"
+        },
+        "state": {
+          "description": "State the probe reported for this family",
+          "type": "string",
+          "enum": [
+            "Ok",
+            "Warning",
+            "Failure",
+            "Disabled"
+          ]
+        }
+      }
+    },
     "K8sStatus": {
       "description": "Status of Kubernetes integration\n\n+k8s:deepcopy-gen=true",
       "type": "object",
       "properties": {
+        "families": {
+          "description": "Per-address-family connectivity to the kube-apiserver, populated on dual-stack clusters when the apiserver is reachable over both families",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/K8sFamilyStatus"
+          }
+        },
         "k8s-api-versions": {
           "type": "array",
           "items": {
@@ -3675,6 +4172,10 @@ func init() {
           "description": "Human readable status/error/warning message",
           "type": "string"
         },
+        "request-rate": {
+          "description": "Recent rate of requests to the kube-apiserver in requests per second",
+          "type": "number"
+        },
         "state": {
           "description": "State the component is in",
           "type": "string",
@@ -3684,6 +4185,49 @@ func init() {
             "Failure",
             "Disabled"
           ]
+        },
+        "throttle-events": {
+          "description": "Total count of requests delayed by the client-side rate limiter",
+          "type": "integer"
+        }
+      }
+    },
+    "KVStoreOperationStats": {
+      "description": "Rolling statistics of one key/value store operation type\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "count": {
+          "description": "Number of operations observed within the window",
+          "type": "integer"
+        },
+        "errors": {
+          "description": "Number of failed operations within the window",
+          "type": "integer"
+        },
+        "operation": {
+          "description": "Name of the operation",
+          "type": "string"
+        },
+        "p95-latency-seconds": {
+          "description": "95th percentile latency in seconds of the operations within the window",
+          "type": "number"
+        }
+      }
+    },
+    "KVStoreOperations": {
+      "description": "Rolling statistics of key/value store client operations\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "operations": {
+          "description": "Per-operation statistics",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/KVStoreOperationStats"
+          }
+        },
+        "window-seconds": {
+          "description": "Length in seconds of the rolling window the statistics cover",
+          "type": "integer"
         }
       }
     },
@@ -3715,7 +4259,7 @@ func init() {
             "type": "object",
             "properties": {
               "ip": {
-                "description": "\n\n+k8s:deepcopy-gen=true",
+                "description": "Primary IP addresses of the device\n\n+k8s:deepcopy-gen=true",
                 "type": "array",
                 "items": {
                   "type": "string"
@@ -3723,6 +4267,13 @@ func init() {
               },
               "name": {
                 "type": "string"
+              },
+              "secondaryIP": {
+                "description": "Secondary IP addresses of the device\n\n+k8s:deepcopy-gen=true",
+                "type": "array",
+                "items": {
+                  "type": "string"
+                }
               }
             }
           }
@@ -3823,6 +4374,7 @@ func init() {
               }
             },
             "nodePort": {
+              "description": "\n\n+k8s:deepcopy-gen=true",
               "type": "object",
               "properties": {
                 "acceleration": {
@@ -3834,6 +4386,37 @@ func init() {
                     "Best-Effort"
                   ]
                 },
+                "accelerationDevices": {
+                  "description": "\n\n+k8s:deepcopy-gen=true",
+                  "type": "array",
+                  "items": {
+                    "description": "\n\n+k8s:deepcopy-gen=true",
+                    "type": "object",
+                    "properties": {
+                      "attachedMode": {
+                        "type": "string",
+                        "enum": [
+                          "Native",
+                          "Generic",
+                          "None"
+                        ]
+                      },
+                      "device": {
+                        "type": "string"
+                      },
+                      "fallbackReason": {
+                        "type": "string"
+                      },
+                      "requestedMode": {
+                        "type": "string"
+                      }
+                    }
+                  }
+                },
+                "accelerationStatus": {
+                  "description": "Status of XDP acceleration across devices. Warning if any\ndevice fell back from the requested acceleration mode.",
+                  "$ref": "#/definitions/Status"
+                },
                 "algorithm": {
                   "type": "string",
                   "enum": [
@@ -3884,6 +4467,26 @@ func init() {
               "properties": {
                 "enabled": {
                   "type": "boolean"
+                },
+                "hostns-only-rejected-connections": {
+                  "description": "Number of connections rejected because socket-LB is\nrestricted to the host namespace",
+                  "type": "integer"
+                },
+                "no-backend-connections": {
+                  "description": "Number of service lookups that found no backend",
+                  "type": "integer"
+                },
+                "tcp-translations": {
+                  "description": "Number of TCP connections translated by socket-LB",
+                  "type": "integer"
+                },
+                "udp-translations": {
+                  "description": "Number of UDP connections translated by socket-LB",
+                  "type": "integer"
+                },
+                "warning": {
+                  "description": "Warning about sustained socket-LB connection failures, if\nany",
+                  "type": "string"
                 }
               }
             },
@@ -3894,6 +4497,18 @@ func init() {
                   "type": "boolean"
                 }
               }
+            },
+            "sourceRangeCheck": {
+              "type": "object",
+              "properties": {
+                "enabled": {
+                  "type": "boolean"
+                },
+                "restricted-services": {
+                  "description": "Number of services with loadBalancerSourceRanges restrictions programmed in the datapath",
+                  "type": "integer"
+                }
+              }
             }
           }
         },
@@ -3903,6 +4518,10 @@ func init() {
             "True",
             "False"
           ]
+        },
+        "natTableUtilization": {
+          "description": "Fraction of the global NAT table currently in use",
+          "type": "number"
         }
       }
     },
@@ -4052,6 +4671,71 @@ func init() {
         "type": "string"
       }
     },
+    "LoadBalancerStatus": {
+      "description": "Status of load balancer datapath reconciliation\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "maglev": {
+          "description": "Status of the Maglev lookup tables",
+          "$ref": "#/definitions/MaglevStatus"
+        }
+      }
+    },
+    "MaglevStatus": {
+      "description": "Health of the Maglev lookup tables and aggregate backend selection\nstatistics\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "inner-maps-allocated": {
+          "description": "Number of inner lookup table maps currently allocated",
+          "type": "integer"
+        },
+        "inner-maps-maximum": {
+          "description": "Maximum number of inner lookup table maps supported by the outer maps",
+          "type": "integer"
+        },
+        "services-maglev": {
+          "description": "Number of services using the Maglev backend selection algorithm",
+          "type": "integer"
+        },
+        "services-random": {
+          "description": "Number of services using the random backend selection algorithm",
+          "type": "integer"
+        },
+        "table-size": {
+          "description": "Size of the Maglev lookup tables",
+          "type": "integer"
+        },
+        "tables": {
+          "description": "Per-service lookup table reconciliation status",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/MaglevTableStatus"
+          }
+        }
+      }
+    },
+    "MaglevTableStatus": {
+      "description": "Reconciliation status of a single service's Maglev lookup table\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "ipv6": {
+          "description": "True if the table belongs to the IPv6 outer map",
+          "type": "boolean"
+        },
+        "last-error": {
+          "description": "Error of the most recent failed reconciliation of the table, if any",
+          "type": "string"
+        },
+        "populated": {
+          "description": "True if the lookup table was successfully populated",
+          "type": "boolean"
+        },
+        "service-id": {
+          "description": "Reverse NAT ID of the service owning the table",
+          "type": "integer"
+        }
+      }
+    },
     "MapEvent": {
       "description": "Event on Map",
       "type": "object",
@@ -4092,10 +4776,44 @@ func init() {
         }
       }
     },
+    "MapPressureEntry": {
+      "description": "Fill level and growth trend of a connection tracking or NAT BPF map\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "deletion-rate": {
+          "description": "Entries removed by garbage collection per second, averaged over the\nsampling window",
+          "type": "number"
+        },
+        "fill-ratio": {
+          "description": "Fraction of the map capacity currently in use",
+          "type": "number"
+        },
+        "name": {
+          "description": "Name of the map",
+          "type": "string"
+        },
+        "time-to-full-seconds": {
+          "description": "Estimated seconds until the map is full based on the recent growth\nrate. Only meaningful if trending-to-full is true.",
+          "type": "integer"
+        },
+        "trending-to-full": {
+          "description": "True if the map is growing towards its capacity",
+          "type": "boolean"
+        }
+      }
+    },
     "Masquerading": {
       "description": "Status of masquerading\n\n+k8s:deepcopy-gen=true",
       "type": "object",
       "properties": {
+        "alloc-failures-ipv4": {
+          "description": "Number of IPv4 SNAT port allocation failures observed over the last\nprobe interval.",
+          "type": "integer"
+        },
+        "alloc-failures-ipv6": {
+          "description": "Number of IPv6 SNAT port allocation failures observed over the last\nprobe interval.",
+          "type": "integer"
+        },
         "enabled": {
           "type": "boolean"
         },
@@ -4124,6 +4842,10 @@ func init() {
             "iptables"
           ]
         },
+        "reason": {
+          "description": "Human readable reason why masquerading is disabled",
+          "type": "string"
+        },
         "snat-exclusion-cidr": {
           "description": "This field is obsolete, please use snat-exclusion-cidr-v4 or snat-exclusion-cidr-v6.",
           "type": "string"
@@ -4135,6 +4857,14 @@ func init() {
         "snat-exclusion-cidr-v6": {
           "description": "SnatExclusionCIDRv6 exempts SNAT from being performed on any packet sent to\nan IPv6 address that belongs to this CIDR.\nFor IPv6 we only do masquerading in iptables mode.",
           "type": "string"
+        },
+        "snat-port-range": {
+          "description": "Source port range from which BPF masquerading allocates SNAT ports.",
+          "type": "string"
+        },
+        "status": {
+          "description": "Overall health of masquerading. Warning if SNAT port allocation\nfailures were observed over the last probe interval.",
+          "$ref": "#/definitions/Status"
         }
       }
     },
@@ -4181,6 +4911,49 @@ func init() {
         }
       }
     },
+    "ModuleHealth": {
+      "description": "Health of an individual hive module\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "last-ok": {
+          "description": "Timestamp of the last OK status, formatted as RFC3339",
+          "type": "string"
+        },
+        "message": {
+          "description": "Status message of the worst component in the module",
+          "type": "string"
+        },
+        "module-id": {
+          "description": "Fully qualified module identifier",
+          "type": "string"
+        }
+      }
+    },
+    "ModulesHealth": {
+      "description": "Aggregated health of hive modules as reported through the modular\nhealth provider\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "degraded": {
+          "description": "Number of modules in Degraded state",
+          "type": "integer"
+        },
+        "degraded-modules": {
+          "description": "Modules currently reporting a degraded status",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ModuleHealth"
+          }
+        },
+        "ok": {
+          "description": "Number of modules in OK state",
+          "type": "integer"
+        },
+        "stopped": {
+          "description": "Number of modules in Stopped state",
+          "type": "integer"
+        }
+      }
+    },
     "MonitorStatus": {
       "description": "Status of the node monitor",
       "properties": {
@@ -4233,6 +5006,56 @@ func init() {
         "$ref": "#/definitions/Port"
       }
     },
+    "NeighborConsistency": {
+      "description": "Consistency of the kernel neighbor entries for node next-hops against\nthe set of known remote nodes\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "bpf-entries": {
+          "description": "Number of entries in the BPF neighbor maps",
+          "type": "integer"
+        },
+        "missing": {
+          "description": "Nodes whose next hop has no kernel neighbor entry",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "stale": {
+          "description": "Nodes whose next-hop neighbor entry exists but failed address\nresolution",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "status": {
+          "description": "Outcome of the consistency comparison, with one sub-check per discrepancy class",
+          "$ref": "#/definitions/Status"
+        }
+      }
+    },
+    "NeighborTable": {
+      "description": "Health of the kernel neighbor (ARP/NDP) entries managed for node\nnext-hops\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "entries": {
+          "description": "Number of neighbor entries managed for node next-hops",
+          "type": "integer"
+        },
+        "failed": {
+          "description": "Number of managed entries in FAILED state",
+          "type": "integer"
+        },
+        "incomplete": {
+          "description": "Number of managed entries in INCOMPLETE state",
+          "type": "integer"
+        },
+        "last-refresh": {
+          "description": "Time of the most recent neighbor refresh, formatted as RFC3339",
+          "type": "string"
+        }
+      }
+    },
     "NodeAddressing": {
       "description": "Addressing information of a node for all address families\n\n+k8s:deepcopy-gen=true",
       "type": "object",
@@ -4334,6 +5157,71 @@ func init() {
         }
       }
     },
+    "PolicyMapPressure": {
+      "description": "Utilization of the per-endpoint BPF policy maps\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "endpoints": {
+          "description": "Endpoints with the highest policy map utilization, plus any endpoint that has experienced an insert failure",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/PolicyMapPressureEndpoint"
+          }
+        },
+        "map-max-entries": {
+          "description": "Maximum number of entries in a per-endpoint policy map",
+          "type": "integer"
+        },
+        "status": {
+          "description": "Outcome of the utilization check, degraded when policy map inserts have failed",
+          "$ref": "#/definitions/Status"
+        }
+      }
+    },
+    "PolicyMapPressureEndpoint": {
+      "description": "Policy map utilization of a single endpoint\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "endpoint-id": {
+          "description": "ID of the endpoint",
+          "type": "integer"
+        },
+        "insert-failures": {
+          "description": "Number of failed policy map inserts for this endpoint",
+          "type": "integer"
+        },
+        "used": {
+          "description": "Number of entries in use in the endpoint's policy map",
+          "type": "integer"
+        },
+        "utilization": {
+          "description": "Fraction of the endpoint's policy map in use (0.0 to 1.0)",
+          "type": "number"
+        }
+      }
+    },
+    "PolicyRecalculation": {
+      "description": "Status of the most recent recalculation of the policy of all endpoints\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "duration": {
+          "description": "Duration of the most recent completed recalculation, in nanoseconds",
+          "type": "integer"
+        },
+        "in-progress": {
+          "description": "True while a recalculation is currently running",
+          "type": "boolean"
+        },
+        "reason": {
+          "description": "Reason the most recent recalculation was triggered",
+          "type": "string"
+        },
+        "timestamp": {
+          "description": "Time at which the most recent recalculation started, formatted as RFC3339",
+          "type": "string"
+        }
+      }
+    },
     "PolicyRule": {
       "description": "A policy rule including the rule labels it derives from",
       "properties": {
@@ -4441,6 +5329,33 @@ func init() {
         }
       }
     },
+    "ProbeStatus": {
+      "description": "Execution statistics of a single status probe\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "consecutive-failures": {
+          "description": "Number of consecutive failures or timeouts of the probe",
+          "type": "integer"
+        },
+        "disabled": {
+          "description": "True if the probe has been disabled at runtime and is no longer scheduled",
+          "type": "boolean"
+        },
+        "last-duration": {
+          "description": "Duration of the most recent completed execution of the probe",
+          "type": "string",
+          "format": "duration"
+        },
+        "name": {
+          "description": "Name of the probe",
+          "type": "string"
+        },
+        "total-failures": {
+          "description": "Total number of failures or timeouts of the probe since the agent started",
+          "type": "integer"
+        }
+      }
+    },
     "ProxyRedirect": {
       "description": "Configured proxy redirection state",
       "type": "object",
@@ -4459,6 +5374,23 @@ func init() {
         }
       }
     },
+    "ProxyRedirectInconsistencies": {
+      "description": "Consistency of proxy redirects with the policy of local endpoints\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "endpoint-count": {
+          "description": "Number of endpoints with a missing or inconsistent proxy redirect",
+          "type": "integer"
+        },
+        "endpoint-ids": {
+          "description": "IDs of up to the first five endpoints with a missing or inconsistent proxy redirect",
+          "type": "array",
+          "items": {
+            "type": "integer"
+          }
+        }
+      }
+    },
     "ProxyStatistics": {
       "description": "Statistics of a set of proxy redirects for an endpoint\n\n+k8s:deepcopy-gen=true",
       "type": "object",
@@ -4493,6 +5425,10 @@ func init() {
       "description": "Status of proxy\n\n+k8s:deepcopy-gen=true",
       "type": "object",
       "properties": {
+        "envoy-admin": {
+          "description": "Status of the Envoy admin endpoint",
+          "$ref": "#/definitions/EnvoyAdminStatus"
+        },
         "envoy-deployment-mode": {
           "description": "Deployment mode of Envoy L7 proxy",
           "type": "string",
@@ -4509,6 +5445,10 @@ func init() {
           "description": "Port range used for proxying",
           "type": "string"
         },
+        "redirect-inconsistencies": {
+          "description": "Consistency of configured redirects with endpoint policy",
+          "$ref": "#/definitions/ProxyRedirectInconsistencies"
+        },
         "redirects": {
           "description": "Detailed description of configured redirects",
           "type": "array",
@@ -4526,6 +5466,55 @@ func init() {
         }
       }
     },
+    "RateLimiterOperation": {
+      "description": "Rate limiting statistics of a single rate-limited operation\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "burst": {
+          "description": "Currently applied burst size",
+          "type": "integer"
+        },
+        "limit": {
+          "description": "Currently applied rate limit in requests per second, 0 if no rate\nlimit is configured",
+          "type": "number"
+        },
+        "max-wait-duration": {
+          "description": "Longest wait duration among the most recent requests",
+          "type": "string",
+          "format": "duration"
+        },
+        "mean-wait-duration": {
+          "description": "Mean wait duration over the most recent requests",
+          "type": "string",
+          "format": "duration"
+        },
+        "name": {
+          "description": "Name of the rate-limited operation",
+          "type": "string"
+        },
+        "rejected": {
+          "description": "Total number of requests rejected or dropped by the rate limiter",
+          "type": "integer"
+        }
+      }
+    },
+    "RateLimiterStatus": {
+      "description": "Saturation of the API rate limiters and the datapath rate limiter\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "operations": {
+          "description": "Per-operation rate limiter statistics",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RateLimiterOperation"
+          }
+        },
+        "status": {
+          "description": "Overall state, Warning when the rejection count of any operation\ngrew since the previous probe run",
+          "$ref": "#/definitions/Status"
+        }
+      }
+    },
     "Recorder": {
       "description": "Collection of wildcard filters for pcap recorder",
       "type": "object",
@@ -4782,10 +5771,62 @@ func init() {
         }
       }
     },
+    "RestoreFailureReason": {
+      "description": "Endpoint restore failures attributed to a single reason\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "count": {
+          "description": "Number of endpoints which failed with this reason",
+          "type": "integer"
+        },
+        "reason": {
+          "description": "Reason the restore of the endpoints failed",
+          "type": "string"
+        }
+      }
+    },
+    "RestoreStatus": {
+      "description": "Outcome of the endpoint restore performed when the agent started\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "deleted": {
+          "description": "Number of endpoints deleted during restore because they could not be associated with a workload",
+          "type": "integer"
+        },
+        "duration": {
+          "description": "Duration of the restore including the regeneration of the restored endpoints, in nanoseconds",
+          "type": "integer"
+        },
+        "failed": {
+          "description": "Number of endpoints which could not be restored",
+          "type": "integer"
+        },
+        "failure-reasons": {
+          "description": "Restore failures grouped by reason, most common first",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RestoreFailureReason"
+          }
+        },
+        "restored": {
+          "description": "Number of endpoints restored successfully",
+          "type": "integer"
+        },
+        "timestamp": {
+          "description": "Time at which the restore completed, formatted as RFC3339",
+          "type": "string"
+        }
+      }
+    },
     "Routing": {
       "description": "Status of routing",
       "type": "object",
       "properties": {
+        "direct-routing-device-mtu": {
+          "description": "MTU of the direct routing device. Zero if the inter-host routing\nmode is tunnel.",
+          "type": "integer",
+          "x-go-name": "DirectRoutingDeviceMTU"
+        },
         "inter-host-routing-mode": {
           "description": "Datapath routing mode for cross-cluster connectivity",
           "type": "string",
@@ -4808,6 +5849,20 @@ func init() {
         }
       }
     },
+    "Sctp": {
+      "description": "Status of the SCTP support",
+      "type": "object",
+      "properties": {
+        "datapath-enabled": {
+          "description": "True if the loaded datapath was compiled with SCTP handling",
+          "type": "boolean"
+        },
+        "enabled": {
+          "description": "True if SCTP support is enabled in the agent configuration",
+          "type": "boolean"
+        }
+      }
+    },
     "SelectorCache": {
       "description": "cache of which identities match selectors in the policy repository",
       "type": "array",
@@ -4866,6 +5921,45 @@ func init() {
         }
       }
     },
+    "ServiceBackendStatus": {
+      "description": "Programmed weight and state of a single service backend\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "address": {
+          "description": "Address of the backend",
+          "type": "string"
+        },
+        "state": {
+          "description": "State of the backend (active, terminating, quarantined, maintenance)",
+          "type": "string"
+        },
+        "weight": {
+          "description": "Programmed weight of the backend",
+          "type": "integer"
+        }
+      }
+    },
+    "ServiceBackendsStatus": {
+      "description": "Backends of a service with their programmed weights and states\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "backends": {
+          "description": "Backends of the service",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ServiceBackendStatus"
+          }
+        },
+        "frontend-address": {
+          "description": "Frontend address of the service",
+          "type": "string"
+        },
+        "name": {
+          "description": "Fully qualified name of the service",
+          "type": "string"
+        }
+      }
+    },
     "ServiceSpec": {
       "description": "Configuration of a service",
       "type": "object",
@@ -4978,6 +6072,10 @@ func init() {
         "enabled": {
           "type": "boolean"
         },
+        "reason": {
+          "description": "Human readable reason why SRv6 is disabled",
+          "type": "string"
+        },
         "srv6EncapMode": {
           "type": "string",
           "enum": [
@@ -5010,13 +6108,31 @@ func init() {
       }
     },
     "Status": {
-      "description": "Status of an individual component",
+      "description": "Status of an individual component\n\n+k8s:deepcopy-gen=true",
       "type": "object",
       "properties": {
         "msg": {
           "description": "Human readable status/error/warning message",
           "type": "string"
         },
+        "reason-code": {
+          "description": "Machine readable code for the cause of the state. Only set on the\noverall Cilium status of a status response.",
+          "type": "string",
+          "enum": [
+            "OK",
+            "STALE_PROBES",
+            "KVSTORE_UNAVAILABLE",
+            "CONTAINER_RUNTIME_NOT_READY",
+            "K8S_UNREACHABLE",
+            "NAT_TABLE_PRESSURE",
+            "MAP_PRESSURE",
+            "CNI_WRITE_FAILED",
+            "CLUSTERMESH_DEGRADED",
+            "FQDN_CACHE_LIMIT",
+            "CONTROLLERS_FAILING",
+            "MODULES_DEGRADED"
+          ]
+        },
         "state": {
           "description": "State the component is in",
           "type": "string",
@@ -5026,6 +6142,58 @@ func init() {
             "Failure",
             "Disabled"
           ]
+        },
+        "sub-checks": {
+          "description": "Outcome of the individual checks performed by the probe, for\nprobes that verify several things",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SubCheckStatus"
+          }
+        }
+      }
+    },
+    "StatusCollectorStats": {
+      "description": "Internal statistics of the status collector",
+      "type": "object",
+      "properties": {
+        "cycle-count": {
+          "description": "Total number of completed probe executions",
+          "type": "integer"
+        },
+        "failed-probes": {
+          "description": "Number of probes whose most recent execution failed",
+          "type": "integer"
+        },
+        "last-cycle-duration": {
+          "description": "Duration of the most recently completed probe execution",
+          "type": "string",
+          "format": "duration"
+        },
+        "leaked-probes": {
+          "description": "Total number of probe invocations abandoned because their goroutine did not return",
+          "type": "integer"
+        },
+        "running-probes": {
+          "description": "Number of probes currently being executed",
+          "type": "integer"
+        },
+        "total-probes": {
+          "description": "Total number of probes registered with the collector",
+          "type": "integer"
+        }
+      }
+    },
+    "StatusProbeConfig": {
+      "description": "Scheduling configuration of a single status probe",
+      "type": "object",
+      "properties": {
+        "enabled": {
+          "description": "True if the probe is to be scheduled",
+          "type": "boolean"
+        },
+        "name": {
+          "description": "Name of the probe",
+          "type": "string"
         }
       }
     },
@@ -5049,6 +6217,10 @@ func init() {
           "description": "Status of BPF maps",
           "$ref": "#/definitions/BPFMapStatus"
         },
+        "bpf-verifier": {
+          "description": "Status of BPF program complexity relative to the verifier limit",
+          "$ref": "#/definitions/BPFVerifierStatus"
+        },
         "cilium": {
           "description": "Status of Cilium daemon",
           "$ref": "#/definitions/Status"
@@ -5077,6 +6249,10 @@ func init() {
           "description": "Status of the CNI configuration file",
           "$ref": "#/definitions/Status"
         },
+        "config-consistency": {
+          "description": "Consistency of the datapath configuration across the cluster",
+          "$ref": "#/definitions/ConfigConsistency"
+        },
         "container-runtime": {
           "description": "Status of local container runtime",
           "$ref": "#/definitions/Status"
@@ -5093,6 +6269,10 @@ func init() {
           "description": "Status of transparent encryption",
           "$ref": "#/definitions/EncryptionStatus"
         },
+        "fqdn-cache": {
+          "description": "Statistics of the toFQDNs DNS cache and selectors",
+          "$ref": "#/definitions/FQDNCacheStatus"
+        },
         "host-firewall": {
           "description": "Status of the host firewall",
           "$ref": "#/definitions/HostFirewall"
@@ -5101,14 +6281,26 @@ func init() {
           "description": "Status of Hubble server",
           "$ref": "#/definitions/HubbleStatus"
         },
+        "identity-distribution": {
+          "description": "Distribution of local endpoints across security identities",
+          "$ref": "#/definitions/IdentityDistribution"
+        },
         "identity-range": {
           "description": "Status of identity range of the cluster",
           "$ref": "#/definitions/IdentityRange"
         },
+        "ip-conflicts": {
+          "description": "Status of IP address conflict detection",
+          "$ref": "#/definitions/IPConflicts"
+        },
         "ipam": {
           "description": "Status of IP address management",
           "$ref": "#/definitions/IPAMStatus"
         },
+        "iptables-rules": {
+          "description": "Integrity of the iptables rules installed by the agent",
+          "$ref": "#/definitions/IptablesRulesStatus"
+        },
         "ipv4-big-tcp": {
           "description": "Status of IPv4 BIG TCP",
           "$ref": "#/definitions/IPV4BigTCP"
@@ -5129,22 +6321,87 @@ func init() {
           "description": "Status of key/value datastore",
           "$ref": "#/definitions/Status"
         },
+        "kvstore-operations": {
+          "description": "Rolling statistics of key/value store client operations",
+          "$ref": "#/definitions/KVStoreOperations"
+        },
+        "last-shutdown-reason": {
+          "description": "Reason the agent shut down before this run, \"unknown\" if no record of the previous shutdown is available",
+          "type": "string"
+        },
+        "load-balancer": {
+          "description": "Status of load balancer datapath reconciliation",
+          "$ref": "#/definitions/LoadBalancerStatus"
+        },
+        "map-pressure": {
+          "description": "Fill level of the connection tracking and NAT maps",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/MapPressureEntry"
+          }
+        },
         "masquerading": {
           "description": "Status of masquerading",
           "$ref": "#/definitions/Masquerading"
         },
+        "modules": {
+          "description": "Health of hive modules",
+          "$ref": "#/definitions/ModulesHealth"
+        },
+        "neighbor-consistency": {
+          "description": "Consistency of the kernel neighbor entries for node next-hops against the set of known remote nodes",
+          "$ref": "#/definitions/NeighborConsistency"
+        },
+        "neighbor-table": {
+          "description": "Health of the kernel neighbor entries managed for node next-hops",
+          "$ref": "#/definitions/NeighborTable"
+        },
         "nodeMonitor": {
           "description": "Status of the node monitor",
           "$ref": "#/definitions/MonitorStatus"
         },
+        "policy-map-pressure": {
+          "description": "Utilization of the per-endpoint BPF policy maps",
+          "$ref": "#/definitions/PolicyMapPressure"
+        },
+        "policy-recalculation": {
+          "description": "Status of the most recent recalculation of the policy of all endpoints",
+          "$ref": "#/definitions/PolicyRecalculation"
+        },
+        "probes": {
+          "description": "Execution statistics of the status probes",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ProbeStatus"
+          }
+        },
         "proxy": {
           "description": "Status of proxy",
           "$ref": "#/definitions/ProxyStatus"
         },
+        "rate-limiter": {
+          "description": "Saturation of the API and datapath rate limiters",
+          "$ref": "#/definitions/RateLimiterStatus"
+        },
+        "restore": {
+          "description": "Outcome of the endpoint restore performed when the agent started",
+          "$ref": "#/definitions/RestoreStatus"
+        },
         "routing": {
           "description": "Status of routing",
           "$ref": "#/definitions/Routing"
         },
+        "sctp": {
+          "description": "Status of SCTP support",
+          "$ref": "#/definitions/Sctp"
+        },
+        "service-backends": {
+          "description": "Per-service backend weights and states, only reported when verbose status was requested",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ServiceBackendsStatus"
+          }
+        },
         "srv6": {
           "description": "Status of SRv6",
           "$ref": "#/definitions/Srv6"
@@ -5157,6 +6414,70 @@ func init() {
             "type": "string",
             "format": "date-time"
           }
+        },
+        "start-time": {
+          "description": "Time at which the agent started, formatted as RFC3339",
+          "type": "string"
+        },
+        "sysctls": {
+          "description": "Status of the host sysctl parameters that Cilium depends on",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SysctlStatus"
+          }
+        },
+        "tunnel-consistency": {
+          "description": "Consistency of the BPF tunnel map against the known nodes",
+          "$ref": "#/definitions/TunnelConsistencyStatus"
+        },
+        "uptime": {
+          "description": "Time since the agent started",
+          "type": "string"
+        }
+      }
+    },
+    "SubCheckStatus": {
+      "description": "Outcome of an individual check performed by a status probe\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "msg": {
+          "description": "Human readable message describing the finding",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the check",
+          "type": "string"
+        },
+        "state": {
+          "description": "State the check found",
+          "type": "string",
+          "enum": [
+            "Ok",
+            "Warning",
+            "Failure"
+          ]
+        }
+      }
+    },
+    "SysctlStatus": {
+      "description": "Status of a sysctl parameter that Cilium depends on\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "current-value": {
+          "description": "Value the parameter currently has on the host",
+          "type": "string"
+        },
+        "expected-value": {
+          "description": "Value Cilium requires the parameter to have",
+          "type": "string"
+        },
+        "mismatch": {
+          "description": "True if the current value deviates from the expected one",
+          "type": "boolean"
+        },
+        "name": {
+          "description": "Name of the sysctl parameter",
+          "type": "string"
         }
       }
     },
@@ -5199,6 +6520,37 @@ func init() {
         }
       }
     },
+    "TunnelConsistencyStatus": {
+      "description": "Consistency of the BPF tunnel map against the set of known remote nodes\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "mismatched": {
+          "description": "Tunnel map entries pointing to an unexpected tunnel endpoint",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "missing": {
+          "description": "Expected tunnel map entries that are absent from the map",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "orphaned": {
+          "description": "Tunnel map entries without a corresponding known node",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "status": {
+          "description": "Outcome of the consistency comparison, with one sub-check per discrepancy class",
+          "$ref": "#/definitions/Status"
+        }
+      }
+    },
     "WireguardInterface": {
       "description": "Status of a WireGuard interface\n\n+k8s:deepcopy-gen=true",
       "properties": {
@@ -5811,6 +7163,24 @@ func init() {
             "schema": {
               "$ref": "#/definitions/Labels"
             }
+          },
+          {
+            "type": "integer",
+            "description": "Maximum number of endpoints to return. If more endpoints are\navailable, the response carries a continue token in the\nX-Cilium-List-Continue header. If unset, all endpoints are\nreturned in a single response.\n",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Opaque continue token returned in the X-Cilium-List-Continue\nheader of a previous response, to retrieve the next page.\n",
+            "name": "continue",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Comma-separated list of endpoint status fields to include in the\nresponse, e.g. \"identity,networking,state\". If unset, all fields\nare included.\n",
+            "name": "fields",
+            "in": "query"
           }
         ],
         "responses": {
@@ -5821,8 +7191,18 @@ func init() {
               "items": {
                 "$ref": "#/definitions/Endpoint"
               }
+            },
+            "headers": {
+              "X-Cilium-List-Continue": {
+                "type": "string",
+                "description": "Token to retrieve the next page of endpoints. Empty when\nthere are no further endpoints.\n"
+              }
             }
           },
+          "400": {
+            "description": "Invalid request (malformed continue token or unknown field name)",
+            "x-go-name": "Invalid"
+          },
           "404": {
             "description": "Endpoints with provided parameters not found"
           },
@@ -5871,6 +7251,26 @@ func init() {
         }
       }
     },
+    "/endpoint/gc": {
+      "get": {
+        "description": "Returns the results of the most recent endpoint garbage collection\ncycles, ordered from oldest to newest.\n",
+        "tags": [
+          "endpoint"
+        ],
+        "summary": "Retrieves the history of endpoint garbage collection cycles.",
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/EndpointGCCycle"
+              }
+            }
+          }
+        }
+      }
+    },
     "/endpoint/{id}": {
       "get": {
         "description": "Returns endpoint information\n",
@@ -6467,6 +7867,18 @@ func init() {
             "description": "If set to true, failure of the agent to connect to the Kubernetes control plane will cause the agent's health status to also fail.\n",
             "name": "require-k8s-connectivity",
             "in": "header"
+          },
+          {
+            "type": "boolean",
+            "description": "Verbose will return status sections omitted from the default payload, such as the per-service backend weights.\n",
+            "name": "verbose",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "Comma-separated list of status components to include in the\nresponse, e.g. \"encryption,kube-proxy-replacement\". Component\nnames match the field names of the status response. If unset,\nthe full status is returned.\n",
+            "name": "components",
+            "in": "query"
           }
         ],
         "responses": {
@@ -6475,6 +7887,13 @@ func init() {
             "schema": {
               "$ref": "#/definitions/StatusResponse"
             }
+          },
+          "400": {
+            "description": "Unknown status component",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "x-go-name": "Invalid"
           }
         }
       }
@@ -7450,6 +8869,68 @@ func init() {
           }
         }
       }
+    },
+    "/status/collector/stats": {
+      "get": {
+        "description": "Returns statistics about the probes run by the status collector, such\nas the number of probes currently running or failing.\n",
+        "tags": [
+          "daemon"
+        ],
+        "summary": "Get internal statistics of the status collector",
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/StatusCollectorStats"
+            }
+          }
+        }
+      }
+    },
+    "/status/probes": {
+      "put": {
+        "description": "Disables or re-enables scheduling of the named status probe.\nDisabled probes are no longer run and report as disabled rather\nthan going stale.\n",
+        "tags": [
+          "daemon"
+        ],
+        "summary": "Enable or disable a status probe",
+        "parameters": [
+          {
+            "name": "config",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/StatusProbeConfig"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success"
+          },
+          "404": {
+            "description": "Probe not found"
+          }
+        }
+      }
+    },
+    "/status/watch": {
+      "get": {
+        "description": "Streams the health and status information of the Cilium daemon as\nnewline-delimited JSON. A new status object is emitted whenever a\nstatus probe reports an update, and a heartbeat is emitted\nperiodically even when nothing changes.\n",
+        "tags": [
+          "daemon"
+        ],
+        "summary": "Stream health of Cilium daemon",
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
+          }
+        }
+      }
     }
   },
   "definitions": {
@@ -7513,9 +8994,17 @@ func init() {
             "$ref": "#/definitions/BPFMapEntry"
           }
         },
+        "id": {
+          "description": "ID of the BPF map as reported by the kernel",
+          "type": "integer"
+        },
         "path": {
           "description": "Path to BPF map",
           "type": "string"
+        },
+        "reused-pin": {
+          "description": "True if the agent re-used an existing pinned map instead of creating a new one",
+          "type": "boolean"
         }
       }
     },
@@ -7560,16 +9049,33 @@ func init() {
       }
     },
     "BPFMapProperties": {
-      "description": "BPF map properties",
+      "description": "BPF map properties\n\n+k8s:deepcopy-gen=true\n",
       "type": "object",
       "properties": {
+        "id": {
+          "description": "ID of the BPF map as reported by the kernel",
+          "type": "integer"
+        },
         "name": {
           "description": "Name of the BPF map",
           "type": "string"
         },
+        "pin-path": {
+          "description": "Path the BPF map is pinned to in the BPF filesystem",
+          "type": "string"
+        },
+        "reused-pin": {
+          "description": "True if the agent re-used an existing pinned map instead of creating a new one",
+          "type": "boolean"
+        },
         "size": {
           "description": "Size of the BPF map",
           "type": "integer"
+        },
+        "used": {
+          "description": "Number of entries in the BPF map when it was last sampled. Null if\nthe map has not been sampled yet, for example because it has not\nbeen created.\n",
+          "type": "integer",
+          "x-nullable": true
         }
       }
     },
@@ -7581,12 +9087,30 @@ func init() {
           "description": "Ratio of total system memory to use for dynamic sizing of BPF maps",
           "type": "number"
         },
-        "maps": {
-          "description": "BPF maps",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/BPFMapProperties"
-          }
+        "maps": {
+          "description": "BPF maps",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BPFMapProperties"
+          }
+        }
+      }
+    },
+    "BPFVerifierStatus": {
+      "description": "Complexity of the most complex loaded BPF program, relative to the\nkernel verifier's instruction limit\n\n+k8s:deepcopy-gen=true\n",
+      "type": "object",
+      "properties": {
+        "instruction-limit": {
+          "description": "The verifier's complexity limit",
+          "type": "integer"
+        },
+        "program-name": {
+          "description": "Name of the most complex loaded BPF program",
+          "type": "string"
+        },
+        "verified-instructions": {
+          "description": "Number of instructions processed by the verifier during the last\nsuccessful load of the program",
+          "type": "integer"
         }
       }
     },
@@ -7660,6 +9184,10 @@ func init() {
         "enabled": {
           "description": "Is bandwidth manager enabled",
           "type": "boolean"
+        },
+        "reason": {
+          "description": "Human readable reason why the bandwidth manager is disabled",
+          "type": "string"
         }
       }
     },
@@ -8100,6 +9628,10 @@ func init() {
             "$ref": "#/definitions/RemoteCluster"
           }
         },
+        "num-contributing-clusters": {
+          "description": "Number of remote clusters contributing backends to global services",
+          "type": "integer"
+        },
         "num-global-services": {
           "description": "Number of global services",
           "type": "integer"
@@ -8168,6 +9700,48 @@ func init() {
         }
       }
     },
+    "ConfigConsistency": {
+      "description": "Consistency of the local datapath configuration against the settings\nadvertised by the other nodes of the cluster\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "checked-nodes": {
+          "description": "Number of peer nodes advertising a feature fingerprint",
+          "type": "integer"
+        },
+        "mismatches": {
+          "description": "Settings for which peer nodes diverge from the local configuration",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ConfigMismatch"
+          }
+        }
+      }
+    },
+    "ConfigMismatch": {
+      "description": "Peer nodes diverging from the local value of one setting\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "divergent-nodes": {
+          "description": "Number of nodes advertising a different value",
+          "type": "integer"
+        },
+        "example-nodes": {
+          "description": "Names of up to three divergent nodes",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "local-value": {
+          "description": "Value configured on the local node",
+          "type": "string"
+        },
+        "setting": {
+          "description": "Name of the divergent setting",
+          "type": "string"
+        }
+      }
+    },
     "ConfigurationMap": {
       "description": "Map of configuration key/value pairs.\n",
       "type": "object",
@@ -8605,6 +10179,24 @@ func init() {
         }
       }
     },
+    "EndpointBPFMap": {
+      "description": "A BPF map dedicated to an endpoint",
+      "type": "object",
+      "properties": {
+        "map-id": {
+          "description": "Kernel ID of the map. Zero if the map is not loaded.",
+          "type": "integer"
+        },
+        "name": {
+          "description": "Name of the map",
+          "type": "string"
+        },
+        "path": {
+          "description": "Path of the map pin in the BPF filesystem",
+          "type": "string"
+        }
+      }
+    },
     "EndpointBatchDeleteRequest": {
       "description": "Properties selecting a batch of endpoints to delete.\n",
       "type": "object",
@@ -8787,6 +10379,29 @@ func init() {
         }
       }
     },
+    "EndpointGCCycle": {
+      "description": "Result of a single endpoint garbage collection cycle",
+      "type": "object",
+      "properties": {
+        "endpoints-evaluated": {
+          "description": "Number of endpoints evaluated during the mark phase",
+          "type": "integer"
+        },
+        "endpoints-marked": {
+          "description": "Number of endpoints newly marked for removal",
+          "type": "integer"
+        },
+        "endpoints-swept": {
+          "description": "Number of endpoints removed during the sweep phase",
+          "type": "integer"
+        },
+        "timestamp": {
+          "description": "Time at which the cycle completed",
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
     "EndpointHealth": {
       "description": "Health of the endpoint\n\n+deepequal-gen=true",
       "type": "object",
@@ -8798,6 +10413,13 @@ func init() {
           "description": "Is this endpoint reachable",
           "type": "boolean"
         },
+        "missingProxyRedirects": {
+          "description": "Proxy redirects required by the endpoint's policy which are not installed",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
         "overallHealth": {
           "$ref": "#/definitions/EndpointHealthStatus"
         },
@@ -8965,6 +10587,10 @@ func init() {
       "description": "Policy information of an endpoint",
       "type": "object",
       "properties": {
+        "policy-map-pressure": {
+          "description": "Fraction of the endpoint's BPF policy map in use (0.0 to 1.0)",
+          "type": "number"
+        },
         "proxy-policy-revision": {
           "description": "The policy revision currently enforced in the proxy for this endpoint",
           "type": "integer"
@@ -9008,6 +10634,13 @@ func init() {
         "state"
       ],
       "properties": {
+        "bpf-maps": {
+          "description": "BPF maps dedicated to this endpoint",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/EndpointBPFMap"
+          }
+        },
         "controllers": {
           "description": "Status of internal controllers attached to this endpoint",
           "$ref": "#/definitions/ControllerStatuses"
@@ -9086,9 +10719,71 @@ func init() {
         "$ref": "#/definitions/EndpointStatusChange"
       }
     },
+    "EnvoyAdminStatus": {
+      "description": "Status of the Envoy admin endpoint\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "status": {
+          "description": "State of the admin endpoint probe",
+          "$ref": "#/definitions/Status"
+        },
+        "uptime-seconds": {
+          "description": "Uptime of the current Envoy epoch in seconds",
+          "type": "integer"
+        },
+        "version": {
+          "description": "Envoy version reported by the server_info endpoint",
+          "type": "string"
+        },
+        "xds-config-versions": {
+          "description": "Last accepted xDS configuration version per config type\n\n+k8s:deepcopy-gen=true",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "Error": {
       "type": "string"
     },
+    "FQDNCacheStatus": {
+      "description": "Statistics of the toFQDNs DNS cache and selector bookkeeping\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "alive-zombies": {
+          "description": "Zombie entries kept alive by the last garbage collection pass",
+          "type": "integer"
+        },
+        "deleted-zombies": {
+          "description": "Zombie entries deleted by the last garbage collection pass",
+          "type": "integer"
+        },
+        "ips": {
+          "description": "Number of IPs in the global cache",
+          "type": "integer"
+        },
+        "max-ips-per-hostname-utilization": {
+          "description": "Highest per-endpoint ratio of IPs cached for a single DNS name\nagainst the tofqdns-endpoint-max-ip-per-hostname limit",
+          "type": "number"
+        },
+        "names": {
+          "description": "Number of DNS names in the global cache",
+          "type": "integer"
+        },
+        "over-limit-endpoint-ids": {
+          "description": "Endpoints with at least one DNS name at or above the per-hostname\nIP limit",
+          "type": "array",
+          "items": {
+            "type": "integer"
+          }
+        },
+        "selectors": {
+          "description": "Number of registered toFQDNs selectors",
+          "type": "integer"
+        }
+      }
+    },
     "FrontendAddress": {
       "description": "Layer 4 address. The protocol is currently ignored, all services will\nbehave as if protocol any is specified. To restrict to a particular\nprotocol, use policy.\n",
       "type": "object",
@@ -9153,6 +10848,10 @@ func init() {
             "Disabled",
             "Enabled"
           ]
+        },
+        "reason": {
+          "description": "Human readable reason why the host firewall is disabled",
+          "type": "string"
         }
       }
     },
@@ -9332,6 +11031,54 @@ func init() {
         }
       }
     },
+    "IPConflict": {
+      "description": "IP address claimed by more than one owner\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "claimants": {
+          "description": "Owners claiming the IP address",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/IPConflictClaimant"
+          }
+        },
+        "ip": {
+          "description": "The conflicting IP address",
+          "type": "string"
+        }
+      }
+    },
+    "IPConflictClaimant": {
+      "description": "A single owner of a conflicting IP address\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "owner": {
+          "description": "Owner of the claim, e.g. an endpoint or an ipcache entry",
+          "type": "string"
+        },
+        "source": {
+          "description": "Source the claim originates from",
+          "type": "string"
+        }
+      }
+    },
+    "IPConflicts": {
+      "description": "Status of IP address conflict detection\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "conflicts": {
+          "description": "List of detected IP address conflicts",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/IPConflict"
+          }
+        },
+        "status": {
+          "description": "State of the conflict detection, Failure if conflicts exist",
+          "$ref": "#/definitions/Status"
+        }
+      }
+    },
     "IPListEntry": {
       "description": "IP entry with metadata",
       "type": "object",
@@ -9395,6 +11142,10 @@ func init() {
         "maxGSO": {
           "description": "Maximum IPv4 GSO size",
           "type": "integer"
+        },
+        "reason": {
+          "description": "Human readable reason why IPv4 BIG TCP is disabled",
+          "type": "string"
         }
       }
     },
@@ -9413,6 +11164,10 @@ func init() {
         "maxGSO": {
           "description": "Maximum IPv6 GSO size",
           "type": "integer"
+        },
+        "reason": {
+          "description": "Human readable reason why IPv6 BIG TCP is disabled",
+          "type": "string"
         }
       }
     },
@@ -9465,6 +11220,44 @@ func init() {
         }
       }
     },
+    "IdentityDistribution": {
+      "description": "Distribution of local endpoints across security identities\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "identities": {
+          "description": "Top identities by local endpoint count",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/IdentityEndpointCount"
+          }
+        },
+        "total-identities": {
+          "description": "Number of distinct security identities in use by local endpoints",
+          "type": "integer"
+        }
+      }
+    },
+    "IdentityEndpointCount": {
+      "description": "Number of local endpoints using a security identity\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "endpoint-count": {
+          "description": "Number of local endpoints using this identity",
+          "type": "integer"
+        },
+        "identity": {
+          "description": "Numeric security identity",
+          "type": "integer"
+        },
+        "labels": {
+          "description": "Labels of the identity",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "IdentityEndpoints": {
       "description": "Security identities owned by endpoints on the local node",
       "type": "object",
@@ -9492,10 +11285,66 @@ func init() {
         }
       }
     },
+    "IptablesRulesStatus": {
+      "description": "Integrity of the iptables chains and rules installed by the agent\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "missing-rules": {
+          "description": "Cilium-owned iptables chains and rules that are expected but no longer present",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "K8sFamilyStatus": {
+      "description": "Connectivity to the kube-apiserver over a single address family\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "family": {
+          "description": "Address family of the probed connection",
+          "type": "string",
+          "enum": [
+            "ipv4",
+            "ipv6"
+          ]
+        },
+        "latency": {
+          "description": "Round trip time of the probe in nanoseconds",
+          "type": "integer"
+        },
+        "msg": {
+          "description": "Human readable status/error/warning message",
+          "type": "string"
+        },
+        "primary": {
+          "description": "True if this family carries the primary client connection to the apiserver",
+          "type": "boolean"
+        },
+        "state": {
+          "description": "State the probe reported for this family",
+          "type": "string",
+          "enum": [
+            "Ok",
+            "Warning",
+            "Failure",
+            "Disabled"
+          ]
+        }
+      }
+    },
     "K8sStatus": {
       "description": "Status of Kubernetes integration\n\n+k8s:deepcopy-gen=true",
       "type": "object",
       "properties": {
+        "families": {
+          "description": "Per-address-family connectivity to the kube-apiserver, populated on dual-stack clusters when the apiserver is reachable over both families",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/K8sFamilyStatus"
+          }
+        },
         "k8s-api-versions": {
           "type": "array",
           "items": {
@@ -9506,6 +11355,10 @@ func init() {
           "description": "Human readable status/error/warning message",
           "type": "string"
         },
+        "request-rate": {
+          "description": "Recent rate of requests to the kube-apiserver in requests per second",
+          "type": "number"
+        },
         "state": {
           "description": "State the component is in",
           "type": "string",
@@ -9515,6 +11368,49 @@ func init() {
             "Failure",
             "Disabled"
           ]
+        },
+        "throttle-events": {
+          "description": "Total count of requests delayed by the client-side rate limiter",
+          "type": "integer"
+        }
+      }
+    },
+    "KVStoreOperationStats": {
+      "description": "Rolling statistics of one key/value store operation type\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "count": {
+          "description": "Number of operations observed within the window",
+          "type": "integer"
+        },
+        "errors": {
+          "description": "Number of failed operations within the window",
+          "type": "integer"
+        },
+        "operation": {
+          "description": "Name of the operation",
+          "type": "string"
+        },
+        "p95-latency-seconds": {
+          "description": "95th percentile latency in seconds of the operations within the window",
+          "type": "number"
+        }
+      }
+    },
+    "KVStoreOperations": {
+      "description": "Rolling statistics of key/value store client operations\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "operations": {
+          "description": "Per-operation statistics",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/KVStoreOperationStats"
+          }
+        },
+        "window-seconds": {
+          "description": "Length in seconds of the rolling window the statistics cover",
+          "type": "integer"
         }
       }
     },
@@ -9641,6 +11537,7 @@ func init() {
               }
             },
             "nodePort": {
+              "description": "\n\n+k8s:deepcopy-gen=true",
               "type": "object",
               "properties": {
                 "acceleration": {
@@ -9652,6 +11549,17 @@ func init() {
                     "Best-Effort"
                   ]
                 },
+                "accelerationDevices": {
+                  "description": "\n\n+k8s:deepcopy-gen=true",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0"
+                  }
+                },
+                "accelerationStatus": {
+                  "description": "Status of XDP acceleration across devices. Warning if any\ndevice fell back from the requested acceleration mode.",
+                  "$ref": "#/definitions/Status"
+                },
                 "algorithm": {
                   "type": "string",
                   "enum": [
@@ -9689,15 +11597,43 @@ func init() {
                 }
               }
             },
-            "sessionAffinity": {
+            "sessionAffinity": {
+              "type": "object",
+              "properties": {
+                "enabled": {
+                  "type": "boolean"
+                }
+              }
+            },
+            "socketLB": {
               "type": "object",
               "properties": {
                 "enabled": {
                   "type": "boolean"
+                },
+                "hostns-only-rejected-connections": {
+                  "description": "Number of connections rejected because socket-LB is\nrestricted to the host namespace",
+                  "type": "integer"
+                },
+                "no-backend-connections": {
+                  "description": "Number of service lookups that found no backend",
+                  "type": "integer"
+                },
+                "tcp-translations": {
+                  "description": "Number of TCP connections translated by socket-LB",
+                  "type": "integer"
+                },
+                "udp-translations": {
+                  "description": "Number of UDP connections translated by socket-LB",
+                  "type": "integer"
+                },
+                "warning": {
+                  "description": "Warning about sustained socket-LB connection failures, if\nany",
+                  "type": "string"
                 }
               }
             },
-            "socketLB": {
+            "socketLBTracing": {
               "type": "object",
               "properties": {
                 "enabled": {
@@ -9705,11 +11641,15 @@ func init() {
                 }
               }
             },
-            "socketLBTracing": {
+            "sourceRangeCheck": {
               "type": "object",
               "properties": {
                 "enabled": {
                   "type": "boolean"
+                },
+                "restricted-services": {
+                  "description": "Number of services with loadBalancerSourceRanges restrictions programmed in the datapath",
+                  "type": "integer"
                 }
               }
             }
@@ -9721,6 +11661,10 @@ func init() {
             "True",
             "False"
           ]
+        },
+        "natTableUtilization": {
+          "description": "Fraction of the global NAT table currently in use",
+          "type": "number"
         }
       }
     },
@@ -9729,7 +11673,7 @@ func init() {
       "type": "object",
       "properties": {
         "ip": {
-          "description": "\n\n+k8s:deepcopy-gen=true",
+          "description": "Primary IP addresses of the device\n\n+k8s:deepcopy-gen=true",
           "type": "array",
           "items": {
             "type": "string"
@@ -9737,6 +11681,13 @@ func init() {
         },
         "name": {
           "type": "string"
+        },
+        "secondaryIP": {
+          "description": "Secondary IP addresses of the device\n\n+k8s:deepcopy-gen=true",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
@@ -9827,6 +11778,7 @@ func init() {
           }
         },
         "nodePort": {
+          "description": "\n\n+k8s:deepcopy-gen=true",
           "type": "object",
           "properties": {
             "acceleration": {
@@ -9838,6 +11790,17 @@ func init() {
                 "Best-Effort"
               ]
             },
+            "accelerationDevices": {
+              "description": "\n\n+k8s:deepcopy-gen=true",
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0"
+              }
+            },
+            "accelerationStatus": {
+              "description": "Status of XDP acceleration across devices. Warning if any\ndevice fell back from the requested acceleration mode.",
+              "$ref": "#/definitions/Status"
+            },
             "algorithm": {
               "type": "string",
               "enum": [
@@ -9888,6 +11851,26 @@ func init() {
           "properties": {
             "enabled": {
               "type": "boolean"
+            },
+            "hostns-only-rejected-connections": {
+              "description": "Number of connections rejected because socket-LB is\nrestricted to the host namespace",
+              "type": "integer"
+            },
+            "no-backend-connections": {
+              "description": "Number of service lookups that found no backend",
+              "type": "integer"
+            },
+            "tcp-translations": {
+              "description": "Number of TCP connections translated by socket-LB",
+              "type": "integer"
+            },
+            "udp-translations": {
+              "description": "Number of UDP connections translated by socket-LB",
+              "type": "integer"
+            },
+            "warning": {
+              "description": "Warning about sustained socket-LB connection failures, if\nany",
+              "type": "string"
             }
           }
         },
@@ -9898,6 +11881,18 @@ func init() {
               "type": "boolean"
             }
           }
+        },
+        "sourceRangeCheck": {
+          "type": "object",
+          "properties": {
+            "enabled": {
+              "type": "boolean"
+            },
+            "restricted-services": {
+              "description": "Number of services with loadBalancerSourceRanges restrictions programmed in the datapath",
+              "type": "integer"
+            }
+          }
         }
       }
     },
@@ -9997,6 +11992,7 @@ func init() {
       }
     },
     "KubeProxyReplacementFeaturesNodePort": {
+      "description": "\n\n+k8s:deepcopy-gen=true",
       "type": "object",
       "properties": {
         "acceleration": {
@@ -10008,6 +12004,17 @@ func init() {
             "Best-Effort"
           ]
         },
+        "accelerationDevices": {
+          "description": "\n\n+k8s:deepcopy-gen=true",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0"
+          }
+        },
+        "accelerationStatus": {
+          "description": "Status of XDP acceleration across devices. Warning if any\ndevice fell back from the requested acceleration mode.",
+          "$ref": "#/definitions/Status"
+        },
         "algorithm": {
           "type": "string",
           "enum": [
@@ -10045,6 +12052,29 @@ func init() {
         }
       }
     },
+    "KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0": {
+      "description": "\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "attachedMode": {
+          "type": "string",
+          "enum": [
+            "Native",
+            "Generic",
+            "None"
+          ]
+        },
+        "device": {
+          "type": "string"
+        },
+        "fallbackReason": {
+          "type": "string"
+        },
+        "requestedMode": {
+          "type": "string"
+        }
+      }
+    },
     "KubeProxyReplacementFeaturesSessionAffinity": {
       "type": "object",
       "properties": {
@@ -10058,6 +12088,26 @@ func init() {
       "properties": {
         "enabled": {
           "type": "boolean"
+        },
+        "hostns-only-rejected-connections": {
+          "description": "Number of connections rejected because socket-LB is\nrestricted to the host namespace",
+          "type": "integer"
+        },
+        "no-backend-connections": {
+          "description": "Number of service lookups that found no backend",
+          "type": "integer"
+        },
+        "tcp-translations": {
+          "description": "Number of TCP connections translated by socket-LB",
+          "type": "integer"
+        },
+        "udp-translations": {
+          "description": "Number of UDP connections translated by socket-LB",
+          "type": "integer"
+        },
+        "warning": {
+          "description": "Warning about sustained socket-LB connection failures, if\nany",
+          "type": "string"
         }
       }
     },
@@ -10069,6 +12119,18 @@ func init() {
         }
       }
     },
+    "KubeProxyReplacementFeaturesSourceRangeCheck": {
+      "type": "object",
+      "properties": {
+        "enabled": {
+          "type": "boolean"
+        },
+        "restricted-services": {
+          "description": "Number of services with loadBalancerSourceRanges restrictions programmed in the datapath",
+          "type": "integer"
+        }
+      }
+    },
     "L4Policy": {
       "description": "L4 endpoint policy",
       "type": "object",
@@ -10215,6 +12277,71 @@ func init() {
         "type": "string"
       }
     },
+    "LoadBalancerStatus": {
+      "description": "Status of load balancer datapath reconciliation\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "maglev": {
+          "description": "Status of the Maglev lookup tables",
+          "$ref": "#/definitions/MaglevStatus"
+        }
+      }
+    },
+    "MaglevStatus": {
+      "description": "Health of the Maglev lookup tables and aggregate backend selection\nstatistics\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "inner-maps-allocated": {
+          "description": "Number of inner lookup table maps currently allocated",
+          "type": "integer"
+        },
+        "inner-maps-maximum": {
+          "description": "Maximum number of inner lookup table maps supported by the outer maps",
+          "type": "integer"
+        },
+        "services-maglev": {
+          "description": "Number of services using the Maglev backend selection algorithm",
+          "type": "integer"
+        },
+        "services-random": {
+          "description": "Number of services using the random backend selection algorithm",
+          "type": "integer"
+        },
+        "table-size": {
+          "description": "Size of the Maglev lookup tables",
+          "type": "integer"
+        },
+        "tables": {
+          "description": "Per-service lookup table reconciliation status",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/MaglevTableStatus"
+          }
+        }
+      }
+    },
+    "MaglevTableStatus": {
+      "description": "Reconciliation status of a single service's Maglev lookup table\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "ipv6": {
+          "description": "True if the table belongs to the IPv6 outer map",
+          "type": "boolean"
+        },
+        "last-error": {
+          "description": "Error of the most recent failed reconciliation of the table, if any",
+          "type": "string"
+        },
+        "populated": {
+          "description": "True if the lookup table was successfully populated",
+          "type": "boolean"
+        },
+        "service-id": {
+          "description": "Reverse NAT ID of the service owning the table",
+          "type": "integer"
+        }
+      }
+    },
     "MapEvent": {
       "description": "Event on Map",
       "type": "object",
@@ -10255,10 +12382,44 @@ func init() {
         }
       }
     },
+    "MapPressureEntry": {
+      "description": "Fill level and growth trend of a connection tracking or NAT BPF map\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "deletion-rate": {
+          "description": "Entries removed by garbage collection per second, averaged over the\nsampling window",
+          "type": "number"
+        },
+        "fill-ratio": {
+          "description": "Fraction of the map capacity currently in use",
+          "type": "number"
+        },
+        "name": {
+          "description": "Name of the map",
+          "type": "string"
+        },
+        "time-to-full-seconds": {
+          "description": "Estimated seconds until the map is full based on the recent growth\nrate. Only meaningful if trending-to-full is true.",
+          "type": "integer"
+        },
+        "trending-to-full": {
+          "description": "True if the map is growing towards its capacity",
+          "type": "boolean"
+        }
+      }
+    },
     "Masquerading": {
       "description": "Status of masquerading\n\n+k8s:deepcopy-gen=true",
       "type": "object",
       "properties": {
+        "alloc-failures-ipv4": {
+          "description": "Number of IPv4 SNAT port allocation failures observed over the last\nprobe interval.",
+          "type": "integer"
+        },
+        "alloc-failures-ipv6": {
+          "description": "Number of IPv6 SNAT port allocation failures observed over the last\nprobe interval.",
+          "type": "integer"
+        },
         "enabled": {
           "type": "boolean"
         },
@@ -10287,6 +12448,10 @@ func init() {
             "iptables"
           ]
         },
+        "reason": {
+          "description": "Human readable reason why masquerading is disabled",
+          "type": "string"
+        },
         "snat-exclusion-cidr": {
           "description": "This field is obsolete, please use snat-exclusion-cidr-v4 or snat-exclusion-cidr-v6.",
           "type": "string"
@@ -10298,6 +12463,14 @@ func init() {
         "snat-exclusion-cidr-v6": {
           "description": "SnatExclusionCIDRv6 exempts SNAT from being performed on any packet sent to\nan IPv6 address that belongs to this CIDR.\nFor IPv6 we only do masquerading in iptables mode.",
           "type": "string"
+        },
+        "snat-port-range": {
+          "description": "Source port range from which BPF masquerading allocates SNAT ports.",
+          "type": "string"
+        },
+        "status": {
+          "description": "Overall health of masquerading. Warning if SNAT port allocation\nfailures were observed over the last probe interval.",
+          "$ref": "#/definitions/Status"
         }
       }
     },
@@ -10358,6 +12531,49 @@ func init() {
         }
       }
     },
+    "ModuleHealth": {
+      "description": "Health of an individual hive module\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "last-ok": {
+          "description": "Timestamp of the last OK status, formatted as RFC3339",
+          "type": "string"
+        },
+        "message": {
+          "description": "Status message of the worst component in the module",
+          "type": "string"
+        },
+        "module-id": {
+          "description": "Fully qualified module identifier",
+          "type": "string"
+        }
+      }
+    },
+    "ModulesHealth": {
+      "description": "Aggregated health of hive modules as reported through the modular\nhealth provider\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "degraded": {
+          "description": "Number of modules in Degraded state",
+          "type": "integer"
+        },
+        "degraded-modules": {
+          "description": "Modules currently reporting a degraded status",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ModuleHealth"
+          }
+        },
+        "ok": {
+          "description": "Number of modules in OK state",
+          "type": "integer"
+        },
+        "stopped": {
+          "description": "Number of modules in Stopped state",
+          "type": "integer"
+        }
+      }
+    },
     "MonitorStatus": {
       "description": "Status of the node monitor",
       "properties": {
@@ -10410,6 +12626,56 @@ func init() {
         "$ref": "#/definitions/Port"
       }
     },
+    "NeighborConsistency": {
+      "description": "Consistency of the kernel neighbor entries for node next-hops against\nthe set of known remote nodes\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "bpf-entries": {
+          "description": "Number of entries in the BPF neighbor maps",
+          "type": "integer"
+        },
+        "missing": {
+          "description": "Nodes whose next hop has no kernel neighbor entry",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "stale": {
+          "description": "Nodes whose next-hop neighbor entry exists but failed address\nresolution",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "status": {
+          "description": "Outcome of the consistency comparison, with one sub-check per discrepancy class",
+          "$ref": "#/definitions/Status"
+        }
+      }
+    },
+    "NeighborTable": {
+      "description": "Health of the kernel neighbor (ARP/NDP) entries managed for node\nnext-hops\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "entries": {
+          "description": "Number of neighbor entries managed for node next-hops",
+          "type": "integer"
+        },
+        "failed": {
+          "description": "Number of managed entries in FAILED state",
+          "type": "integer"
+        },
+        "incomplete": {
+          "description": "Number of managed entries in INCOMPLETE state",
+          "type": "integer"
+        },
+        "last-refresh": {
+          "description": "Time of the most recent neighbor refresh, formatted as RFC3339",
+          "type": "string"
+        }
+      }
+    },
     "NodeAddressing": {
       "description": "Addressing information of a node for all address families\n\n+k8s:deepcopy-gen=true",
       "type": "object",
@@ -10511,6 +12777,71 @@ func init() {
         }
       }
     },
+    "PolicyMapPressure": {
+      "description": "Utilization of the per-endpoint BPF policy maps\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "endpoints": {
+          "description": "Endpoints with the highest policy map utilization, plus any endpoint that has experienced an insert failure",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/PolicyMapPressureEndpoint"
+          }
+        },
+        "map-max-entries": {
+          "description": "Maximum number of entries in a per-endpoint policy map",
+          "type": "integer"
+        },
+        "status": {
+          "description": "Outcome of the utilization check, degraded when policy map inserts have failed",
+          "$ref": "#/definitions/Status"
+        }
+      }
+    },
+    "PolicyMapPressureEndpoint": {
+      "description": "Policy map utilization of a single endpoint\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "endpoint-id": {
+          "description": "ID of the endpoint",
+          "type": "integer"
+        },
+        "insert-failures": {
+          "description": "Number of failed policy map inserts for this endpoint",
+          "type": "integer"
+        },
+        "used": {
+          "description": "Number of entries in use in the endpoint's policy map",
+          "type": "integer"
+        },
+        "utilization": {
+          "description": "Fraction of the endpoint's policy map in use (0.0 to 1.0)",
+          "type": "number"
+        }
+      }
+    },
+    "PolicyRecalculation": {
+      "description": "Status of the most recent recalculation of the policy of all endpoints\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "duration": {
+          "description": "Duration of the most recent completed recalculation, in nanoseconds",
+          "type": "integer"
+        },
+        "in-progress": {
+          "description": "True while a recalculation is currently running",
+          "type": "boolean"
+        },
+        "reason": {
+          "description": "Reason the most recent recalculation was triggered",
+          "type": "string"
+        },
+        "timestamp": {
+          "description": "Time at which the most recent recalculation started, formatted as RFC3339",
+          "type": "string"
+        }
+      }
+    },
     "PolicyRule": {
       "description": "A policy rule including the rule labels it derives from",
       "properties": {
@@ -10618,6 +12949,33 @@ func init() {
         }
       }
     },
+    "ProbeStatus": {
+      "description": "Execution statistics of a single status probe\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "consecutive-failures": {
+          "description": "Number of consecutive failures or timeouts of the probe",
+          "type": "integer"
+        },
+        "disabled": {
+          "description": "True if the probe has been disabled at runtime and is no longer scheduled",
+          "type": "boolean"
+        },
+        "last-duration": {
+          "description": "Duration of the most recent completed execution of the probe",
+          "type": "string",
+          "format": "duration"
+        },
+        "name": {
+          "description": "Name of the probe",
+          "type": "string"
+        },
+        "total-failures": {
+          "description": "Total number of failures or timeouts of the probe since the agent started",
+          "type": "integer"
+        }
+      }
+    },
     "ProxyRedirect": {
       "description": "Configured proxy redirection state",
       "type": "object",
@@ -10636,6 +12994,23 @@ func init() {
         }
       }
     },
+    "ProxyRedirectInconsistencies": {
+      "description": "Consistency of proxy redirects with the policy of local endpoints\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "endpoint-count": {
+          "description": "Number of endpoints with a missing or inconsistent proxy redirect",
+          "type": "integer"
+        },
+        "endpoint-ids": {
+          "description": "IDs of up to the first five endpoints with a missing or inconsistent proxy redirect",
+          "type": "array",
+          "items": {
+            "type": "integer"
+          }
+        }
+      }
+    },
     "ProxyStatistics": {
       "description": "Statistics of a set of proxy redirects for an endpoint\n\n+k8s:deepcopy-gen=true",
       "type": "object",
@@ -10670,6 +13045,10 @@ func init() {
       "description": "Status of proxy\n\n+k8s:deepcopy-gen=true",
       "type": "object",
       "properties": {
+        "envoy-admin": {
+          "description": "Status of the Envoy admin endpoint",
+          "$ref": "#/definitions/EnvoyAdminStatus"
+        },
         "envoy-deployment-mode": {
           "description": "Deployment mode of Envoy L7 proxy",
           "type": "string",
@@ -10686,6 +13065,10 @@ func init() {
           "description": "Port range used for proxying",
           "type": "string"
         },
+        "redirect-inconsistencies": {
+          "description": "Consistency of configured redirects with endpoint policy",
+          "$ref": "#/definitions/ProxyRedirectInconsistencies"
+        },
         "redirects": {
           "description": "Detailed description of configured redirects",
           "type": "array",
@@ -10703,6 +13086,55 @@ func init() {
         }
       }
     },
+    "RateLimiterOperation": {
+      "description": "Rate limiting statistics of a single rate-limited operation\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "burst": {
+          "description": "Currently applied burst size",
+          "type": "integer"
+        },
+        "limit": {
+          "description": "Currently applied rate limit in requests per second, 0 if no rate\nlimit is configured",
+          "type": "number"
+        },
+        "max-wait-duration": {
+          "description": "Longest wait duration among the most recent requests",
+          "type": "string",
+          "format": "duration"
+        },
+        "mean-wait-duration": {
+          "description": "Mean wait duration over the most recent requests",
+          "type": "string",
+          "format": "duration"
+        },
+        "name": {
+          "description": "Name of the rate-limited operation",
+          "type": "string"
+        },
+        "rejected": {
+          "description": "Total number of requests rejected or dropped by the rate limiter",
+          "type": "integer"
+        }
+      }
+    },
+    "RateLimiterStatus": {
+      "description": "Saturation of the API rate limiters and the datapath rate limiter\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "operations": {
+          "description": "Per-operation rate limiter statistics",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RateLimiterOperation"
+          }
+        },
+        "status": {
+          "description": "Overall state, Warning when the rejection count of any operation\ngrew since the previous probe run",
+          "$ref": "#/definitions/Status"
+        }
+      }
+    },
     "Recorder": {
       "description": "Collection of wildcard filters for pcap recorder",
       "type": "object",
@@ -10959,10 +13391,62 @@ func init() {
         }
       }
     },
+    "RestoreFailureReason": {
+      "description": "Endpoint restore failures attributed to a single reason\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "count": {
+          "description": "Number of endpoints which failed with this reason",
+          "type": "integer"
+        },
+        "reason": {
+          "description": "Reason the restore of the endpoints failed",
+          "type": "string"
+        }
+      }
+    },
+    "RestoreStatus": {
+      "description": "Outcome of the endpoint restore performed when the agent started\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "deleted": {
+          "description": "Number of endpoints deleted during restore because they could not be associated with a workload",
+          "type": "integer"
+        },
+        "duration": {
+          "description": "Duration of the restore including the regeneration of the restored endpoints, in nanoseconds",
+          "type": "integer"
+        },
+        "failed": {
+          "description": "Number of endpoints which could not be restored",
+          "type": "integer"
+        },
+        "failure-reasons": {
+          "description": "Restore failures grouped by reason, most common first",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RestoreFailureReason"
+          }
+        },
+        "restored": {
+          "description": "Number of endpoints restored successfully",
+          "type": "integer"
+        },
+        "timestamp": {
+          "description": "Time at which the restore completed, formatted as RFC3339",
+          "type": "string"
+        }
+      }
+    },
     "Routing": {
       "description": "Status of routing",
       "type": "object",
       "properties": {
+        "direct-routing-device-mtu": {
+          "description": "MTU of the direct routing device. Zero if the inter-host routing\nmode is tunnel.",
+          "type": "integer",
+          "x-go-name": "DirectRoutingDeviceMTU"
+        },
         "inter-host-routing-mode": {
           "description": "Datapath routing mode for cross-cluster connectivity",
           "type": "string",
@@ -10985,6 +13469,20 @@ func init() {
         }
       }
     },
+    "Sctp": {
+      "description": "Status of the SCTP support",
+      "type": "object",
+      "properties": {
+        "datapath-enabled": {
+          "description": "True if the loaded datapath was compiled with SCTP handling",
+          "type": "boolean"
+        },
+        "enabled": {
+          "description": "True if SCTP support is enabled in the agent configuration",
+          "type": "boolean"
+        }
+      }
+    },
     "SelectorCache": {
       "description": "cache of which identities match selectors in the policy repository",
       "type": "array",
@@ -11043,6 +13541,45 @@ func init() {
         }
       }
     },
+    "ServiceBackendStatus": {
+      "description": "Programmed weight and state of a single service backend\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "address": {
+          "description": "Address of the backend",
+          "type": "string"
+        },
+        "state": {
+          "description": "State of the backend (active, terminating, quarantined, maintenance)",
+          "type": "string"
+        },
+        "weight": {
+          "description": "Programmed weight of the backend",
+          "type": "integer"
+        }
+      }
+    },
+    "ServiceBackendsStatus": {
+      "description": "Backends of a service with their programmed weights and states\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "backends": {
+          "description": "Backends of the service",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ServiceBackendStatus"
+          }
+        },
+        "frontend-address": {
+          "description": "Frontend address of the service",
+          "type": "string"
+        },
+        "name": {
+          "description": "Fully qualified name of the service",
+          "type": "string"
+        }
+      }
+    },
     "ServiceSpec": {
       "description": "Configuration of a service",
       "type": "object",
@@ -11223,6 +13760,10 @@ func init() {
         "enabled": {
           "type": "boolean"
         },
+        "reason": {
+          "description": "Human readable reason why SRv6 is disabled",
+          "type": "string"
+        },
         "srv6EncapMode": {
           "type": "string",
           "enum": [
@@ -11255,13 +13796,31 @@ func init() {
       }
     },
     "Status": {
-      "description": "Status of an individual component",
+      "description": "Status of an individual component\n\n+k8s:deepcopy-gen=true",
       "type": "object",
       "properties": {
         "msg": {
           "description": "Human readable status/error/warning message",
           "type": "string"
         },
+        "reason-code": {
+          "description": "Machine readable code for the cause of the state. Only set on the\noverall Cilium status of a status response.",
+          "type": "string",
+          "enum": [
+            "OK",
+            "STALE_PROBES",
+            "KVSTORE_UNAVAILABLE",
+            "CONTAINER_RUNTIME_NOT_READY",
+            "K8S_UNREACHABLE",
+            "NAT_TABLE_PRESSURE",
+            "MAP_PRESSURE",
+            "CNI_WRITE_FAILED",
+            "CLUSTERMESH_DEGRADED",
+            "FQDN_CACHE_LIMIT",
+            "CONTROLLERS_FAILING",
+            "MODULES_DEGRADED"
+          ]
+        },
         "state": {
           "description": "State the component is in",
           "type": "string",
@@ -11271,6 +13830,58 @@ func init() {
             "Failure",
             "Disabled"
           ]
+        },
+        "sub-checks": {
+          "description": "Outcome of the individual checks performed by the probe, for\nprobes that verify several things",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SubCheckStatus"
+          }
+        }
+      }
+    },
+    "StatusCollectorStats": {
+      "description": "Internal statistics of the status collector",
+      "type": "object",
+      "properties": {
+        "cycle-count": {
+          "description": "Total number of completed probe executions",
+          "type": "integer"
+        },
+        "failed-probes": {
+          "description": "Number of probes whose most recent execution failed",
+          "type": "integer"
+        },
+        "last-cycle-duration": {
+          "description": "Duration of the most recently completed probe execution",
+          "type": "string",
+          "format": "duration"
+        },
+        "leaked-probes": {
+          "description": "Total number of probe invocations abandoned because their goroutine did not return",
+          "type": "integer"
+        },
+        "running-probes": {
+          "description": "Number of probes currently being executed",
+          "type": "integer"
+        },
+        "total-probes": {
+          "description": "Total number of probes registered with the collector",
+          "type": "integer"
+        }
+      }
+    },
+    "StatusProbeConfig": {
+      "description": "Scheduling configuration of a single status probe",
+      "type": "object",
+      "properties": {
+        "enabled": {
+          "description": "True if the probe is to be scheduled",
+          "type": "boolean"
+        },
+        "name": {
+          "description": "Name of the probe",
+          "type": "string"
         }
       }
     },
@@ -11294,6 +13905,10 @@ func init() {
           "description": "Status of BPF maps",
           "$ref": "#/definitions/BPFMapStatus"
         },
+        "bpf-verifier": {
+          "description": "Status of BPF program complexity relative to the verifier limit",
+          "$ref": "#/definitions/BPFVerifierStatus"
+        },
         "cilium": {
           "description": "Status of Cilium daemon",
           "$ref": "#/definitions/Status"
@@ -11322,6 +13937,10 @@ func init() {
           "description": "Status of the CNI configuration file",
           "$ref": "#/definitions/Status"
         },
+        "config-consistency": {
+          "description": "Consistency of the datapath configuration across the cluster",
+          "$ref": "#/definitions/ConfigConsistency"
+        },
         "container-runtime": {
           "description": "Status of local container runtime",
           "$ref": "#/definitions/Status"
@@ -11338,6 +13957,10 @@ func init() {
           "description": "Status of transparent encryption",
           "$ref": "#/definitions/EncryptionStatus"
         },
+        "fqdn-cache": {
+          "description": "Statistics of the toFQDNs DNS cache and selectors",
+          "$ref": "#/definitions/FQDNCacheStatus"
+        },
         "host-firewall": {
           "description": "Status of the host firewall",
           "$ref": "#/definitions/HostFirewall"
@@ -11346,14 +13969,26 @@ func init() {
           "description": "Status of Hubble server",
           "$ref": "#/definitions/HubbleStatus"
         },
+        "identity-distribution": {
+          "description": "Distribution of local endpoints across security identities",
+          "$ref": "#/definitions/IdentityDistribution"
+        },
         "identity-range": {
           "description": "Status of identity range of the cluster",
           "$ref": "#/definitions/IdentityRange"
         },
+        "ip-conflicts": {
+          "description": "Status of IP address conflict detection",
+          "$ref": "#/definitions/IPConflicts"
+        },
         "ipam": {
           "description": "Status of IP address management",
           "$ref": "#/definitions/IPAMStatus"
         },
+        "iptables-rules": {
+          "description": "Integrity of the iptables rules installed by the agent",
+          "$ref": "#/definitions/IptablesRulesStatus"
+        },
         "ipv4-big-tcp": {
           "description": "Status of IPv4 BIG TCP",
           "$ref": "#/definitions/IPV4BigTCP"
@@ -11374,22 +14009,87 @@ func init() {
           "description": "Status of key/value datastore",
           "$ref": "#/definitions/Status"
         },
+        "kvstore-operations": {
+          "description": "Rolling statistics of key/value store client operations",
+          "$ref": "#/definitions/KVStoreOperations"
+        },
+        "last-shutdown-reason": {
+          "description": "Reason the agent shut down before this run, \"unknown\" if no record of the previous shutdown is available",
+          "type": "string"
+        },
+        "load-balancer": {
+          "description": "Status of load balancer datapath reconciliation",
+          "$ref": "#/definitions/LoadBalancerStatus"
+        },
+        "map-pressure": {
+          "description": "Fill level of the connection tracking and NAT maps",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/MapPressureEntry"
+          }
+        },
         "masquerading": {
           "description": "Status of masquerading",
           "$ref": "#/definitions/Masquerading"
         },
+        "modules": {
+          "description": "Health of hive modules",
+          "$ref": "#/definitions/ModulesHealth"
+        },
+        "neighbor-consistency": {
+          "description": "Consistency of the kernel neighbor entries for node next-hops against the set of known remote nodes",
+          "$ref": "#/definitions/NeighborConsistency"
+        },
+        "neighbor-table": {
+          "description": "Health of the kernel neighbor entries managed for node next-hops",
+          "$ref": "#/definitions/NeighborTable"
+        },
         "nodeMonitor": {
           "description": "Status of the node monitor",
           "$ref": "#/definitions/MonitorStatus"
         },
+        "policy-map-pressure": {
+          "description": "Utilization of the per-endpoint BPF policy maps",
+          "$ref": "#/definitions/PolicyMapPressure"
+        },
+        "policy-recalculation": {
+          "description": "Status of the most recent recalculation of the policy of all endpoints",
+          "$ref": "#/definitions/PolicyRecalculation"
+        },
+        "probes": {
+          "description": "Execution statistics of the status probes",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ProbeStatus"
+          }
+        },
         "proxy": {
           "description": "Status of proxy",
           "$ref": "#/definitions/ProxyStatus"
         },
+        "rate-limiter": {
+          "description": "Saturation of the API and datapath rate limiters",
+          "$ref": "#/definitions/RateLimiterStatus"
+        },
+        "restore": {
+          "description": "Outcome of the endpoint restore performed when the agent started",
+          "$ref": "#/definitions/RestoreStatus"
+        },
         "routing": {
           "description": "Status of routing",
           "$ref": "#/definitions/Routing"
         },
+        "sctp": {
+          "description": "Status of SCTP support",
+          "$ref": "#/definitions/Sctp"
+        },
+        "service-backends": {
+          "description": "Per-service backend weights and states, only reported when verbose status was requested",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ServiceBackendsStatus"
+          }
+        },
         "srv6": {
           "description": "Status of SRv6",
           "$ref": "#/definitions/Srv6"
@@ -11402,6 +14102,70 @@ func init() {
             "type": "string",
             "format": "date-time"
           }
+        },
+        "start-time": {
+          "description": "Time at which the agent started, formatted as RFC3339",
+          "type": "string"
+        },
+        "sysctls": {
+          "description": "Status of the host sysctl parameters that Cilium depends on",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SysctlStatus"
+          }
+        },
+        "tunnel-consistency": {
+          "description": "Consistency of the BPF tunnel map against the known nodes",
+          "$ref": "#/definitions/TunnelConsistencyStatus"
+        },
+        "uptime": {
+          "description": "Time since the agent started",
+          "type": "string"
+        }
+      }
+    },
+    "SubCheckStatus": {
+      "description": "Outcome of an individual check performed by a status probe\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "msg": {
+          "description": "Human readable message describing the finding",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the check",
+          "type": "string"
+        },
+        "state": {
+          "description": "State the check found",
+          "type": "string",
+          "enum": [
+            "Ok",
+            "Warning",
+            "Failure"
+          ]
+        }
+      }
+    },
+    "SysctlStatus": {
+      "description": "Status of a sysctl parameter that Cilium depends on\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "current-value": {
+          "description": "Value the parameter currently has on the host",
+          "type": "string"
+        },
+        "expected-value": {
+          "description": "Value Cilium requires the parameter to have",
+          "type": "string"
+        },
+        "mismatch": {
+          "description": "True if the current value deviates from the expected one",
+          "type": "boolean"
+        },
+        "name": {
+          "description": "Name of the sysctl parameter",
+          "type": "string"
         }
       }
     },
@@ -11444,6 +14208,37 @@ func init() {
         }
       }
     },
+    "TunnelConsistencyStatus": {
+      "description": "Consistency of the BPF tunnel map against the set of known remote nodes\n\n+k8s:deepcopy-gen=true",
+      "type": "object",
+      "properties": {
+        "mismatched": {
+          "description": "Tunnel map entries pointing to an unexpected tunnel endpoint",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "missing": {
+          "description": "Expected tunnel map entries that are absent from the map",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "orphaned": {
+          "description": "Tunnel map entries without a corresponding known node",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "status": {
+          "description": "Outcome of the consistency comparison, with one sub-check per discrepancy class",
+          "$ref": "#/definitions/Status"
+        }
+      }
+    },
     "WireguardInterface": {
       "description": "Status of a WireGuard interface\n\n+k8s:deepcopy-gen=true",
       "properties": {
@@ -102,6 +102,9 @@ func NewCiliumAPIAPI(spec *loads.Document) *CiliumAPIAPI {
 		EndpointGetEndpointHandler: endpoint.GetEndpointHandlerFunc(func(params endpoint.GetEndpointParams) middleware.Responder {
 			return middleware.NotImplemented("operation endpoint.GetEndpoint has not yet been implemented")
 		}),
+		EndpointGetEndpointGcHandler: endpoint.GetEndpointGcHandlerFunc(func(params endpoint.GetEndpointGcParams) middleware.Responder {
+			return middleware.NotImplemented("operation endpoint.GetEndpointGc has not yet been implemented")
+		}),
 		EndpointGetEndpointIDHandler: endpoint.GetEndpointIDHandlerFunc(func(params endpoint.GetEndpointIDParams) middleware.Responder {
 			return middleware.NotImplemented("operation endpoint.GetEndpointID has not yet been implemented")
 		}),
@@ -180,6 +183,12 @@ func NewCiliumAPIAPI(spec *loads.Document) *CiliumAPIAPI {
 		ServiceGetServiceIDHandler: service.GetServiceIDHandlerFunc(func(params service.GetServiceIDParams) middleware.Responder {
 			return middleware.NotImplemented("operation service.GetServiceID has not yet been implemented")
 		}),
+		DaemonGetStatusCollectorStatsHandler: daemon.GetStatusCollectorStatsHandlerFunc(func(params daemon.GetStatusCollectorStatsParams) middleware.Responder {
+			return middleware.NotImplemented("operation daemon.GetStatusCollectorStats has not yet been implemented")
+		}),
+		DaemonGetStatusWatchHandler: daemon.GetStatusWatchHandlerFunc(func(params daemon.GetStatusWatchParams) middleware.Responder {
+			return middleware.NotImplemented("operation daemon.GetStatusWatch has not yet been implemented")
+		}),
 		DaemonPatchConfigHandler: daemon.PatchConfigHandlerFunc(func(params daemon.PatchConfigParams) middleware.Responder {
 			return middleware.NotImplemented("operation daemon.PatchConfig has not yet been implemented")
 		}),
@@ -213,6 +222,9 @@ func NewCiliumAPIAPI(spec *loads.Document) *CiliumAPIAPI {
 		ServicePutServiceIDHandler: service.PutServiceIDHandlerFunc(func(params service.PutServiceIDParams) middleware.Responder {
 			return middleware.NotImplemented("operation service.PutServiceID has not yet been implemented")
 		}),
+		DaemonPutStatusProbesHandler: daemon.PutStatusProbesHandlerFunc(func(params daemon.PutStatusProbesParams) middleware.Responder {
+			return middleware.NotImplemented("operation daemon.PutStatusProbes has not yet been implemented")
+		}),
 	}
 }
 
@@ -281,6 +293,8 @@ type CiliumAPIAPI struct {
 	DaemonGetDebuginfoHandler daemon.GetDebuginfoHandler
 	// EndpointGetEndpointHandler sets the operation handler for the get endpoint operation
 	EndpointGetEndpointHandler endpoint.GetEndpointHandler
+	// EndpointGetEndpointGcHandler sets the operation handler for the get endpoint gc operation
+	EndpointGetEndpointGcHandler endpoint.GetEndpointGcHandler
 	// EndpointGetEndpointIDHandler sets the operation handler for the get endpoint ID operation
 	EndpointGetEndpointIDHandler endpoint.GetEndpointIDHandler
 	// EndpointGetEndpointIDConfigHandler sets the operation handler for the get endpoint ID config operation
@@ -333,6 +347,10 @@ type CiliumAPIAPI struct {
 	ServiceGetServiceHandler service.GetServiceHandler
 	// ServiceGetServiceIDHandler sets the operation handler for the get service ID operation
 	ServiceGetServiceIDHandler service.GetServiceIDHandler
+	// DaemonGetStatusCollectorStatsHandler sets the operation handler for the get status collector stats operation
+	DaemonGetStatusCollectorStatsHandler daemon.GetStatusCollectorStatsHandler
+	// DaemonGetStatusWatchHandler sets the operation handler for the get status watch operation
+	DaemonGetStatusWatchHandler daemon.GetStatusWatchHandler
 	// DaemonPatchConfigHandler sets the operation handler for the patch config operation
 	DaemonPatchConfigHandler daemon.PatchConfigHandler
 	// EndpointPatchEndpointIDHandler sets the operation handler for the patch endpoint ID operation
@@ -355,6 +373,8 @@ type CiliumAPIAPI struct {
 	RecorderPutRecorderIDHandler recorder.PutRecorderIDHandler
 	// ServicePutServiceIDHandler sets the operation handler for the put service ID operation
 	ServicePutServiceIDHandler service.PutServiceIDHandler
+	// DaemonPutStatusProbesHandler sets the operation handler for the put status probes operation
+	DaemonPutStatusProbesHandler daemon.PutStatusProbesHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -480,6 +500,9 @@ func (o *CiliumAPIAPI) Validate() error {
 	if o.EndpointGetEndpointHandler == nil {
 		unregistered = append(unregistered, "endpoint.GetEndpointHandler")
 	}
+	if o.EndpointGetEndpointGcHandler == nil {
+		unregistered = append(unregistered, "endpoint.GetEndpointGcHandler")
+	}
 	if o.EndpointGetEndpointIDHandler == nil {
 		unregistered = append(unregistered, "endpoint.GetEndpointIDHandler")
 	}
@@ -558,6 +581,12 @@ func (o *CiliumAPIAPI) Validate() error {
 	if o.ServiceGetServiceIDHandler == nil {
 		unregistered = append(unregistered, "service.GetServiceIDHandler")
 	}
+	if o.DaemonGetStatusCollectorStatsHandler == nil {
+		unregistered = append(unregistered, "daemon.GetStatusCollectorStatsHandler")
+	}
+	if o.DaemonGetStatusWatchHandler == nil {
+		unregistered = append(unregistered, "daemon.GetStatusWatchHandler")
+	}
 	if o.DaemonPatchConfigHandler == nil {
 		unregistered = append(unregistered, "daemon.PatchConfigHandler")
 	}
@@ -591,6 +620,9 @@ func (o *CiliumAPIAPI) Validate() error {
 	if o.ServicePutServiceIDHandler == nil {
 		unregistered = append(unregistered, "service.PutServiceIDHandler")
 	}
+	if o.DaemonPutStatusProbesHandler == nil {
+		unregistered = append(unregistered, "daemon.PutStatusProbesHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -746,6 +778,10 @@ func (o *CiliumAPIAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/endpoint/gc"] = endpoint.NewGetEndpointGc(o.context, o.EndpointGetEndpointGcHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/endpoint/{id}"] = endpoint.NewGetEndpointID(o.context, o.EndpointGetEndpointIDHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -847,6 +883,14 @@ func (o *CiliumAPIAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/service/{id}"] = service.NewGetServiceID(o.context, o.ServiceGetServiceIDHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/status/collector/stats"] = daemon.NewGetStatusCollectorStats(o.context, o.DaemonGetStatusCollectorStatsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/status/watch"] = daemon.NewGetStatusWatch(o.context, o.DaemonGetStatusWatchHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
@@ -891,6 +935,10 @@ func (o *CiliumAPIAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/service/{id}"] = service.NewPutServiceID(o.context, o.ServicePutServiceIDHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/status/probes"] = daemon.NewPutStatusProbes(o.context, o.DaemonPutStatusProbesHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
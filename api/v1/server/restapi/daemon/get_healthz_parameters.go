@@ -12,6 +12,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -46,12 +47,25 @@ type GetHealthzParams struct {
 	  In: header
 	*/
 	Brief *bool
+	/*Comma-separated list of status components to include in the
+	response, e.g. "encryption,kube-proxy-replacement". Component
+	names match the field names of the status response. If unset,
+	the full status is returned.
+
+	  In: query
+	*/
+	Components *string
 	/*If set to true, failure of the agent to connect to the Kubernetes control plane will cause the agent's health status to also fail.
 
 	  In: header
 	  Default: true
 	*/
 	RequireK8sConnectivity *bool
+	/*Verbose will return status sections omitted from the default payload, such as the per-service backend weights.
+
+	  In: header
+	*/
+	Verbose *bool
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -63,19 +77,48 @@ func (o *GetHealthzParams) BindRequest(r *http.Request, route *middleware.Matche
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
 	if err := o.bindBrief(r.Header[http.CanonicalHeaderKey("brief")], true, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
+	qComponents, qhkComponents, _ := qs.GetOK("components")
+	if err := o.bindComponents(qComponents, qhkComponents, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.bindRequireK8sConnectivity(r.Header[http.CanonicalHeaderKey("require-k8s-connectivity")], true, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	if err := o.bindVerbose(r.Header[http.CanonicalHeaderKey("verbose")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+// bindComponents binds and validates parameter Components from query.
+func (o *GetHealthzParams) bindComponents(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Components = &raw
+
+	return nil
+}
+
 // bindBrief binds and validates parameter Brief from header.
 func (o *GetHealthzParams) bindBrief(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -120,3 +163,25 @@ func (o *GetHealthzParams) bindRequireK8sConnectivity(rawData []string, hasKey b
 
 	return nil
 }
+
+// bindVerbose binds and validates parameter Verbose from header.
+func (o *GetHealthzParams) bindVerbose(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("verbose", "header", "bool", raw)
+	}
+	o.Verbose = &value
+
+	return nil
+}
@@ -60,3 +60,46 @@ func (o *GetHealthzOK) WriteResponse(rw http.ResponseWriter, producer runtime.Pr
 		}
 	}
 }
+
+// GetHealthzInvalidCode is the HTTP code returned for type GetHealthzInvalid
+const GetHealthzInvalidCode int = 400
+
+/*
+GetHealthzInvalid Unknown status component
+
+swagger:response getHealthzInvalid
+*/
+type GetHealthzInvalid struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.Error `json:"body,omitempty"`
+}
+
+// NewGetHealthzInvalid creates GetHealthzInvalid with default headers values
+func NewGetHealthzInvalid() *GetHealthzInvalid {
+
+	return &GetHealthzInvalid{}
+}
+
+// WithPayload adds the payload to the get healthz invalid response
+func (o *GetHealthzInvalid) WithPayload(payload models.Error) *GetHealthzInvalid {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get healthz invalid response
+func (o *GetHealthzInvalid) SetPayload(payload models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetHealthzInvalid) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
@@ -25,6 +25,12 @@ GetEndpointOK Success
 swagger:response getEndpointOK
 */
 type GetEndpointOK struct {
+	/*Token to retrieve the next page of endpoints. Empty when
+	there are no further endpoints.
+
+
+	*/
+	XCiliumListContinue string `json:"X-Cilium-List-Continue"`
 
 	/*
 	  In: Body
@@ -38,6 +44,17 @@ func NewGetEndpointOK() *GetEndpointOK {
 	return &GetEndpointOK{}
 }
 
+// WithXCiliumListContinue adds the xCiliumListContinue to the get endpoint o k response
+func (o *GetEndpointOK) WithXCiliumListContinue(xCiliumListContinue string) *GetEndpointOK {
+	o.XCiliumListContinue = xCiliumListContinue
+	return o
+}
+
+// SetXCiliumListContinue sets the xCiliumListContinue to the get endpoint o k response
+func (o *GetEndpointOK) SetXCiliumListContinue(xCiliumListContinue string) {
+	o.XCiliumListContinue = xCiliumListContinue
+}
+
 // WithPayload adds the payload to the get endpoint o k response
 func (o *GetEndpointOK) WithPayload(payload []*models.Endpoint) *GetEndpointOK {
 	o.Payload = payload
@@ -52,6 +69,13 @@ func (o *GetEndpointOK) SetPayload(payload []*models.Endpoint) {
 // WriteResponse to the client
 func (o *GetEndpointOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header X-Cilium-List-Continue
+
+	xCiliumListContinue := o.XCiliumListContinue
+	if xCiliumListContinue != "" {
+		rw.Header().Set("X-Cilium-List-Continue", xCiliumListContinue)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if payload == nil {
@@ -64,6 +88,31 @@ func (o *GetEndpointOK) WriteResponse(rw http.ResponseWriter, producer runtime.P
 	}
 }
 
+// GetEndpointInvalidCode is the HTTP code returned for type GetEndpointInvalid
+const GetEndpointInvalidCode int = 400
+
+/*
+GetEndpointInvalid Invalid request (malformed continue token or unknown field name)
+
+swagger:response getEndpointInvalid
+*/
+type GetEndpointInvalid struct {
+}
+
+// NewGetEndpointInvalid creates GetEndpointInvalid with default headers values
+func NewGetEndpointInvalid() *GetEndpointInvalid {
+
+	return &GetEndpointInvalid{}
+}
+
+// WriteResponse to the client
+func (o *GetEndpointInvalid) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(400)
+}
+
 // GetEndpointNotFoundCode is the HTTP code returned for type GetEndpointNotFound
 const GetEndpointNotFoundCode int = 404
 
@@ -83,6 +83,7 @@ type apiParams struct {
 	DaemonGetConfigHandler               daemon.GetConfigHandler
 	DaemonGetDebuginfoHandler            daemon.GetDebuginfoHandler
 	EndpointGetEndpointHandler           endpoint.GetEndpointHandler
+	EndpointGetEndpointGcHandler         endpoint.GetEndpointGcHandler
 	EndpointGetEndpointIDHandler         endpoint.GetEndpointIDHandler
 	EndpointGetEndpointIDConfigHandler   endpoint.GetEndpointIDConfigHandler
 	EndpointGetEndpointIDHealthzHandler  endpoint.GetEndpointIDHealthzHandler
@@ -109,6 +110,8 @@ type apiParams struct {
 	RecorderGetRecorderMasksHandler      recorder.GetRecorderMasksHandler
 	ServiceGetServiceHandler             service.GetServiceHandler
 	ServiceGetServiceIDHandler           service.GetServiceIDHandler
+	DaemonGetStatusCollectorStatsHandler daemon.GetStatusCollectorStatsHandler
+	DaemonGetStatusWatchHandler          daemon.GetStatusWatchHandler
 	DaemonPatchConfigHandler             daemon.PatchConfigHandler
 	EndpointPatchEndpointIDHandler       endpoint.PatchEndpointIDHandler
 	EndpointPatchEndpointIDConfigHandler endpoint.PatchEndpointIDConfigHandler
@@ -120,6 +123,7 @@ type apiParams struct {
 	PolicyPutPolicyHandler               policy.PutPolicyHandler
 	RecorderPutRecorderIDHandler         recorder.PutRecorderIDHandler
 	ServicePutServiceIDHandler           service.PutServiceIDHandler
+	DaemonPutStatusProbesHandler         daemon.PutStatusProbesHandler
 }
 
 func newAPI(p apiParams) *restapi.CiliumAPIAPI {
@@ -143,6 +147,7 @@ func newAPI(p apiParams) *restapi.CiliumAPIAPI {
 	api.DaemonGetConfigHandler = p.DaemonGetConfigHandler
 	api.DaemonGetDebuginfoHandler = p.DaemonGetDebuginfoHandler
 	api.EndpointGetEndpointHandler = p.EndpointGetEndpointHandler
+	api.EndpointGetEndpointGcHandler = p.EndpointGetEndpointGcHandler
 	api.EndpointGetEndpointIDHandler = p.EndpointGetEndpointIDHandler
 	api.EndpointGetEndpointIDConfigHandler = p.EndpointGetEndpointIDConfigHandler
 	api.EndpointGetEndpointIDHealthzHandler = p.EndpointGetEndpointIDHealthzHandler
@@ -169,6 +174,8 @@ func newAPI(p apiParams) *restapi.CiliumAPIAPI {
 	api.RecorderGetRecorderMasksHandler = p.RecorderGetRecorderMasksHandler
 	api.ServiceGetServiceHandler = p.ServiceGetServiceHandler
 	api.ServiceGetServiceIDHandler = p.ServiceGetServiceIDHandler
+	api.DaemonGetStatusCollectorStatsHandler = p.DaemonGetStatusCollectorStatsHandler
+	api.DaemonGetStatusWatchHandler = p.DaemonGetStatusWatchHandler
 	api.DaemonPatchConfigHandler = p.DaemonPatchConfigHandler
 	api.EndpointPatchEndpointIDHandler = p.EndpointPatchEndpointIDHandler
 	api.EndpointPatchEndpointIDConfigHandler = p.EndpointPatchEndpointIDConfigHandler
@@ -180,6 +187,7 @@ func newAPI(p apiParams) *restapi.CiliumAPIAPI {
 	api.PolicyPutPolicyHandler = p.PolicyPutPolicyHandler
 	api.RecorderPutRecorderIDHandler = p.RecorderPutRecorderIDHandler
 	api.ServicePutServiceIDHandler = p.ServicePutServiceIDHandler
+	api.DaemonPutStatusProbesHandler = p.DaemonPutStatusProbesHandler
 
 	// Inject custom middleware if provided by Hive
 	if p.Middleware != nil {
@@ -266,8 +266,10 @@ static __always_inline int __sock4_xlate_fwd(struct bpf_sock_addr *ctx,
 	struct lb4_backend l7backend;
 #endif
 
-	if (is_defined(ENABLE_SOCKET_LB_HOST_ONLY) && !in_hostns)
+	if (is_defined(ENABLE_SOCKET_LB_HOST_ONLY) && !in_hostns) {
+		update_metrics(0, METRIC_EGRESS, REASON_SOCK_LB_REJECT_HOSTNS);
 		return -ENXIO;
+	}
 
 	if (!udp_only && !sock_proto_enabled(protocol))
 		return -ENOTSUP;
@@ -400,6 +402,9 @@ out:
 	ctx->user_ip4 = backend->address;
 	ctx_set_port(ctx, backend->port);
 
+	update_metrics(0, METRIC_EGRESS, protocol == IPPROTO_TCP ?
+		       REASON_SOCK_LB_XLATE_TCP : REASON_SOCK_LB_XLATE_UDP);
+
 	return 0;
 }
 
@@ -978,8 +983,10 @@ static __always_inline int __sock6_xlate_fwd(struct bpf_sock_addr *ctx,
 	struct lb6_backend l7backend;
 #endif
 
-	if (is_defined(ENABLE_SOCKET_LB_HOST_ONLY) && !in_hostns)
+	if (is_defined(ENABLE_SOCKET_LB_HOST_ONLY) && !in_hostns) {
+		update_metrics(0, METRIC_EGRESS, REASON_SOCK_LB_REJECT_HOSTNS);
 		return -ENXIO;
+	}
 
 	if (!udp_only && !sock_proto_enabled(protocol))
 		return -ENOTSUP;
@@ -1079,6 +1086,9 @@ out:
 	ctx_set_v6_address(ctx, &backend->address);
 	ctx_set_port(ctx, backend->port);
 
+	update_metrics(0, METRIC_EGRESS, protocol == IPPROTO_TCP ?
+		       REASON_SOCK_LB_XLATE_TCP : REASON_SOCK_LB_XLATE_UDP);
+
 	return 0;
 #else
 	return sock6_xlate_v4_in_v6(ctx, udp_only);
@@ -665,6 +665,9 @@ enum {
 #define REASON_MISSED_CUSTOM_CALL	11
 #define REASON_DECRYPTING			12
 #define REASON_ENCRYPTING			13
+#define REASON_SOCK_LB_REJECT_HOSTNS	14
+#define REASON_SOCK_LB_XLATE_TCP	15
+#define REASON_SOCK_LB_XLATE_UDP	16
 
 /* Lookup scope for externalTrafficPolicy=Local */
 #define LB_LOOKUP_SCOPE_EXT	0
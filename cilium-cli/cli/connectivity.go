@@ -149,7 +149,7 @@ func newCmdConnectivityTest(hooks api.Hooks) *cobra.Command {
 	cmd.Flags().StringVar(&params.AgentDaemonSetName, "agent-daemonset-name", defaults.AgentDaemonSetName, "Name of cilium agent daemonset")
 	cmd.Flags().StringVar(&params.AgentPodSelector, "agent-pod-selector", defaults.AgentPodSelector, "Label on cilium-agent pods to select with")
 	cmd.Flags().StringVar(&params.CiliumPodSelector, "cilium-pod-selector", defaults.CiliumPodSelector, "Label selector matching all cilium-related pods")
-	cmd.Flags().Var(option.NewNamedMapOptions("node-selector", &params.NodeSelector, nil), "node-selector", "Restrict connectivity pods to nodes matching this label")
+	cmd.Flags().Var(option.NewNamedMapOptions("node-selector", &params.NodeSelector, nil), "node-selector", "Restrict connectivity pods and node-targeted tests to nodes matching these labels")
 	cmd.Flags().StringVar(&params.MultiCluster, "multi-cluster", "", "Test across clusters to given context")
 	cmd.Flags().StringSliceVar(&tests, "test", []string{}, "Run tests that match one of the given regular expressions, skip tests by starting the expression with '!', target Scenarios with e.g. '/pod-to-cidr'")
 	cmd.Flags().StringVar(&params.FlowValidation, "flow-validation", check.FlowValidationModeWarning, "Enable Hubble flow validation { disabled | warning | strict }")
@@ -157,8 +157,11 @@ func newCmdConnectivityTest(hooks api.Hooks) *cobra.Command {
 	cmd.Flags().StringVar(&params.AssumeCiliumVersion, "assume-cilium-version", "", "Assume Cilium version for connectivity tests")
 	cmd.Flags().BoolVarP(&params.Verbose, "verbose", "v", false, "Show informational messages and don't buffer any lines")
 	cmd.Flags().BoolVarP(&params.Timestamp, "timestamp", "t", false, "Show timestamp in messages")
+	cmd.Flags().StringVar(&params.LogFormat, "log-format", check.LogFormatEmoji, "Log output format { emoji | jsonl }")
 	cmd.Flags().BoolVarP(&params.PauseOnFail, "pause-on-fail", "p", false, "Pause execution on test failure")
+	cmd.Flags().BoolVar(&params.Pause, "pause", false, "Pause execution at points instrumented with ConnectivityTest.Pause")
 	cmd.Flags().StringVar(&params.ExternalTarget, "external-target", "one.one.one.one.", "Domain name to use as external target in connectivity tests")
+	cmd.Flags().StringVar(&params.ExternalTargetsFile, "external-targets-file", "", "File with external targets, one per line with optional key=value annotations (protocol, port, dns-only)")
 	cmd.Flags().StringVar(&params.ExternalOtherTarget, "external-other-target", "k8s.io.", "Domain name to use as a second external target in connectivity tests")
 	cmd.Flags().StringVar(&params.ExternalTargetCANamespace, "external-target-ca-namespace", "", "Namespace of the CA secret for the external target. Used by client-egress-l7-tls test cases.")
 	cmd.Flags().StringVar(&params.ExternalTargetCAName, "external-target-ca-name", "cabundle", "Name of the CA secret for the external target. Used by client-egress-l7-tls test cases.")
@@ -169,6 +172,14 @@ func newCmdConnectivityTest(hooks api.Hooks) *cobra.Command {
 	cmd.Flags().StringSliceVar(&params.NodeCIDRs, "node-cidr", nil, "one or more CIDRs that cover all nodes in the cluster")
 	cmd.Flags().StringVar(&params.JunitFile, "junit-file", "", "Generate junit report and write to file")
 	cmd.Flags().Var(option.NewNamedMapOptions("junit-property", &params.JunitProperties, nil), "junit-property", "Add key=value properties to the generated junit file")
+	cmd.Flags().StringVar(&params.OtelEndpoint, "otel-endpoint", "", "OTLP/HTTP endpoint to export a trace of the test run to (e.g. localhost:4318)")
+	cmd.Flags().StringVar(&params.BaselineFile, "baseline-file", "", "Compare results against a baseline summary file and fail only on newly-failing tests. The file is created when it does not exist yet")
+	cmd.Flags().BoolVar(&params.BaselineStrict, "baseline-strict", false, "Fail the run when an action's p95 latency regresses beyond the baseline tolerance")
+	cmd.Flags().Float64Var(&params.BaselineLatencyTolerance, "baseline-latency-tolerance", 25, "Maximum allowed p95 latency regression over the baseline, in percent")
+	cmd.Flags().BoolVar(&params.PreflightSkipNodeCheck, "preflight-skip-node-check", false, "Skip the pre-flight check for schedulable nodes")
+	cmd.Flags().BoolVar(&params.PreflightSkipPodStartupCheck, "preflight-skip-pod-startup-check", false, "Skip the pre-flight check that a trivial pod starts in the test namespace")
+	cmd.Flags().BoolVar(&params.PreflightSkipAPICheck, "preflight-skip-api-check", false, "Skip the pre-flight check for Cilium agent and Hubble Relay API reachability")
+	cmd.Flags().BoolVar(&params.RequireFullNodePairCoverage, "require-full-node-pair-coverage", false, "Schedule additional client pods so every node pair is covered by pod-to-pod tests")
 	cmd.Flags().BoolVar(&params.SkipIPCacheCheck, "skip-ip-cache-check", true, "Skip IPCache check")
 	cmd.Flags().MarkHidden("skip-ip-cache-check")
 	cmd.Flags().BoolVar(&params.IncludeUnsafeTests, "include-unsafe-tests", false, "Include tests which can modify cluster nodes state")
@@ -196,6 +207,8 @@ func newCmdConnectivityTest(hooks api.Hooks) *cobra.Command {
 	cmd.Flags().UintVar(&params.CurlParallel, "curl-parallel", defaults.CurlParallel, "Number of parallel requests in curl commands (0 to disable)")
 
 	cmd.Flags().BoolVar(&params.CollectSysdumpOnFailure, "collect-sysdump-on-failure", false, "Collect sysdump after a test fails")
+	cmd.Flags().StringVar(&params.SysdumpPolicy, "sysdump-policy", "each", "Policy for sysdump collection on test failure (each, once, once-per-scenario)")
+	cmd.Flags().BoolVar(&params.CollectPodMetrics, "collect-pod-metrics", false, "Sample the client and server pods' CPU and memory usage around each test and report the deltas")
 
 	sysdump.InitSysdumpFlags(cmd, &params.SysdumpOptions, "sysdump-", hooks)
 
@@ -304,6 +317,7 @@ func registerCommonFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&params.TestNamespace, "test-namespace", defaults.ConnectivityCheckNamespace, "Namespace to perform the connectivity in (always suffixed with a sequence number to be compliant with test-concurrency param, e.g.: cilium-test-1)")
 	flags.Var(option.NewNamedMapOptions("namespace-labels", &params.NamespaceLabels, nil), "namespace-labels", "Add labels to the connectivity test namespace")
 	flags.Var(option.NewNamedMapOptions("namespace-annotations", &params.NamespaceAnnotations, nil), "namespace-annotations", "Add annotations to the connectivity test namespace")
+	flags.BoolVar(&params.SkipNamespaceMutation, "skip-namespace-mutation", false, "Do not apply namespace labels/annotations to pre-existing test namespaces")
 	flags.MarkHidden("namespace-annotations")
 	flags.Var(&params.DeploymentAnnotations, "deployment-pod-annotations", "Add annotations to the connectivity pods, e.g. '{\"client\":{\"foo\":\"bar\"}}'")
 	flags.MarkHidden("deployment-pod-annotations")
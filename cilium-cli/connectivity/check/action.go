@@ -12,7 +12,9 @@ import (
 	"io"
 	"math"
 	"net"
+	"reflect"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -80,9 +82,21 @@ type Action struct {
 	// started is the timestamp the test started
 	started time.Time
 
+	// duration is the time the Action's test function took to execute.
+	duration time.Duration
+
 	// failed is true when Fail was called on the Action
 	failed bool
 
+	// skipped is true when Skip was called on the Action
+	skipped bool
+
+	// skipReason records why the Action was skipped
+	skipReason string
+
+	// assertions are the structured comparisons recorded via Assert
+	assertions []Assertion
+
 	// Output from action if there is any
 	cmdOutput string
 
@@ -155,10 +169,18 @@ func (a *Action) Scenario() Scenario {
 //
 // This method is to be called from a Scenario implementation.
 func (a *Action) Run(f func(*Action)) {
+	span := a.test.ctx.tracer.startSpan(a.test.scenarioSpan, a.name, a.traceAttributes())
+	defer func() { span.end(a.failed) }()
+
+	a.test.ctx.recordNodePairCoverage(a)
+
 	a.Logf("[.] Action [%s]", a)
 
-	// Emit unbuffered progress indicator.
-	a.test.ctx.logger.Printf(a.test, ".")
+	// Emit unbuffered progress indicator. Progress dots would corrupt the
+	// jsonl stream, so they are limited to the human-readable format.
+	if !a.test.ctx.jsonl() {
+		a.test.ctx.logger.Printf(a.test, ".")
+	}
 
 	// Retrieve Prometheus metrics only if there are expectations.
 	for _, m := range a.expIngress.Metrics {
@@ -214,9 +236,17 @@ func (a *Action) Run(f func(*Action)) {
 		}
 	}
 
+	// Optionally introduce a fault before the action runs, recovering from
+	// it once the action has completed.
+	if recovery := a.maybeInjectFault(); recovery != nil {
+		defer recovery()
+	}
+
 	// Execute the given test function.
 	// Might call Fatal().
+	start := time.Now()
 	f(a)
+	a.duration = time.Since(start)
 
 	// Print flow buffer if any failures or warnings occurred.
 	// TODO(timo): printFlows is a misnomer, this function actually prints
@@ -227,6 +257,19 @@ func (a *Action) Run(f func(*Action)) {
 	}
 }
 
+// traceAttributes returns the key attributes recorded on the Action's span.
+func (a *Action) traceAttributes() map[string]string {
+	attrs := map[string]string{"ip.family": a.ipFam.String()}
+	if a.src != nil {
+		attrs["source.pod"] = a.src.Name()
+		attrs["source.node"] = a.src.NodeName()
+	}
+	if a.dst != nil {
+		attrs["destination.peer"] = a.dst.Name()
+	}
+	return attrs
+}
+
 // collectMetricsPerSource retrieves metrics for the given source.
 func (a *Action) collectMetricsPerSource(m MetricsResult) error {
 	if _, ok := a.metricsPerSource[m.Source.Name]; !ok {
@@ -244,6 +287,105 @@ func (a *Action) fail() {
 	a.failed = true
 }
 
+// Skip marks the Action as skipped for the given reason. Skipped Actions are
+// reported instead of counting as failed; the Scenario is expected to return
+// from its test function without executing any commands.
+func (a *Action) Skip(reason string) {
+	a.skipped = true
+	a.skipReason = reason
+	a.Logf("[-] Skipping Action [%s] (%s)", a, reason)
+}
+
+// Skipped returns true if Skip was called on the Action.
+func (a *Action) Skipped() bool {
+	return a.skipped
+}
+
+// Duration returns the time the Action's test function took to execute, or
+// zero if the Action has not run yet.
+func (a *Action) Duration() time.Duration {
+	return a.duration
+}
+
+// SkipReason returns the reason recorded by Skip, or an empty string if the
+// Action was not skipped.
+func (a *Action) SkipReason() string {
+	return a.skipReason
+}
+
+// RequireSCTP checks whether the kernel on the source Pod's node has SCTP
+// support and skips the Action with a structured reason when it does not, as
+// is the case when the sctp kernel module is not loaded. It returns false if
+// the Action was skipped.
+func (a *Action) RequireSCTP(ctx context.Context) bool {
+	if a.src == nil {
+		a.Fatalf("No source Pod to check SCTP support from")
+	}
+
+	// /proc/net/sctp only exists once the kernel SCTP module is loaded.
+	_, err := a.src.K8sClient.ExecInPod(ctx, a.src.Pod.Namespace, a.src.Pod.Name,
+		a.src.Pod.Labels["name"], []string{"test", "-d", "/proc/net/sctp"})
+	if err != nil {
+		a.Skip(fmt.Sprintf("kernel on node %s lacks SCTP support", a.src.NodeName()))
+		return false
+	}
+
+	return true
+}
+
+// Assertion is a structured expected-vs-actual comparison recorded on an
+// Action via Assert.
+type Assertion struct {
+	Name     string `json:"name"`
+	Expected string `json:"expected"`
+	Actual   string `json:"actual"`
+	Passed   bool   `json:"passed"`
+}
+
+// String returns a readable one-line summary of the Assertion.
+func (as Assertion) String() string {
+	if as.Passed {
+		return fmt.Sprintf("%s: %s", as.Name, as.Actual)
+	}
+	return fmt.Sprintf("%s: expected %s, got %s", as.Name, as.Expected, as.Actual)
+}
+
+// Assert records a comparison of an expected and an actual value. If the
+// values differ, a failure message is logged and the Action is marked as
+// failed. The recorded assertions are included in the reports generated for
+// the Action. Returns true if the values are equal.
+func (a *Action) Assert(name string, expected, actual interface{}) bool {
+	assertion := Assertion{
+		Name:     name,
+		Expected: fmt.Sprintf("%v", expected),
+		Actual:   fmt.Sprintf("%v", actual),
+		Passed:   reflect.DeepEqual(expected, actual),
+	}
+	a.assertions = append(a.assertions, assertion)
+
+	if !assertion.Passed {
+		a.Failf("assertion %s", assertion)
+	}
+
+	return assertion.Passed
+}
+
+// Assertions returns a copy of the assertions recorded on the Action.
+func (a *Action) Assertions() []Assertion {
+	return slices.Clone(a.assertions)
+}
+
+// failedAssertions returns the recorded assertions that did not pass.
+func (a *Action) failedAssertions() []Assertion {
+	var failed []Assertion
+	for _, as := range a.assertions {
+		if !as.Passed {
+			failed = append(failed, as)
+		}
+	}
+	return failed
+}
+
 // WriteDataToPod writes data to a file in the source pod
 // It does this by using a shell command, writing huge files should be avoided
 func (a *Action) WriteDataToPod(ctx context.Context, filePath string, data []byte) {
@@ -646,7 +788,68 @@ func (a *Action) GetEgressRequirements(p FlowParameters) (reqs []filters.FlowSet
 			}
 		}
 	case UDP:
-		a.Fail("UDP egress flow matching not implemented yet")
+		udpRequest := filters.UDP(0, a.dst.Port())
+		udpResponse := filters.UDP(a.dst.Port(), 0)
+		if p.AltDstPort != 0 && p.AltDstPort != a.dst.Port() {
+			udpRequest = filters.Or(filters.UDP(0, p.AltDstPort), udpRequest)
+			udpResponse = filters.Or(filters.UDP(p.AltDstPort, 0), udpResponse)
+		}
+
+		if a.expEgress.Drop || a.expEgress.EgressDrop {
+			dropFilter := filters.Drop()
+			if a.expEgress.EgressDrop {
+				dropFilter = filters.Drop(filters.WithEgress(), filters.WithDropFunc(a.expEgress.DropReasonFunc))
+			}
+			egress = filters.FlowSetRequirement{
+				First: filters.FlowRequirement{Filter: filters.And(ipRequest, udpRequest), Msg: "UDP request"},
+				Last:  filters.FlowRequirement{Filter: filters.And(ipRequest, udpRequest, dropFilter), Msg: "Drop"},
+				Except: []filters.FlowRequirement{
+					{Filter: filters.And(ipResponse, udpResponse), Msg: "UDP response"},
+				},
+			}
+		} else {
+			// UDP has no handshake or teardown, only the request datagram
+			// and its echo are expected.
+			egress = filters.FlowSetRequirement{
+				First: filters.FlowRequirement{Filter: filters.And(ipRequest, udpRequest), Msg: "UDP request"},
+				Last:  filters.FlowRequirement{Filter: filters.And(ipResponse, udpResponse), Msg: "UDP response", SkipOnAggregation: true},
+				Except: []filters.FlowRequirement{
+					{Filter: filters.And(filters.Or(filters.And(ipRequest, udpRequest), filters.And(ipResponse, udpResponse)), filters.Drop()), Msg: "Drop"},
+				},
+			}
+		}
+	case SCTP:
+		sctpRequest := filters.SCTP(0, a.dst.Port())
+		sctpResponse := filters.SCTP(a.dst.Port(), 0)
+		if p.AltDstPort != 0 && p.AltDstPort != a.dst.Port() {
+			sctpRequest = filters.Or(filters.SCTP(0, p.AltDstPort), sctpRequest)
+			sctpResponse = filters.Or(filters.SCTP(p.AltDstPort, 0), sctpResponse)
+		}
+
+		if a.expEgress.Drop || a.expEgress.EgressDrop {
+			dropFilter := filters.Drop()
+			if a.expEgress.EgressDrop {
+				dropFilter = filters.Drop(filters.WithEgress(), filters.WithDropFunc(a.expEgress.DropReasonFunc))
+			}
+			egress = filters.FlowSetRequirement{
+				First: filters.FlowRequirement{Filter: filters.And(ipRequest, sctpRequest), Msg: "SCTP request"},
+				Last:  filters.FlowRequirement{Filter: filters.And(ipRequest, sctpRequest, dropFilter), Msg: "Drop"},
+				Except: []filters.FlowRequirement{
+					{Filter: filters.And(ipResponse, sctpResponse), Msg: "SCTP response"},
+				},
+			}
+		} else {
+			// The observed SCTP flows carry only the port numbers, not the
+			// chunk types, so the INIT/SHUTDOWN handshakes cannot be told
+			// apart and the association boils down to a request/response pair.
+			egress = filters.FlowSetRequirement{
+				First: filters.FlowRequirement{Filter: filters.And(ipRequest, sctpRequest), Msg: "SCTP request"},
+				Last:  filters.FlowRequirement{Filter: filters.And(ipResponse, sctpResponse), Msg: "SCTP response", SkipOnAggregation: true},
+				Except: []filters.FlowRequirement{
+					{Filter: filters.And(filters.Or(filters.And(ipRequest, sctpRequest), filters.And(ipResponse, sctpResponse)), filters.Drop()), Msg: "Drop"},
+				},
+			}
+		}
 	default:
 		a.Failf("Invalid egress flow matching protocol %d", p.Protocol)
 	}
@@ -767,7 +970,63 @@ func (a *Action) GetIngressRequirements(p FlowParameters) []filters.FlowSetRequi
 			}
 		}
 	case UDP:
-		a.Fail("UDP ingress flow matching not implemented yet")
+		udpRequest := filters.UDP(0, a.dst.Port())
+		udpResponse := filters.UDP(a.dst.Port(), 0)
+		if p.AltDstPort != 0 {
+			udpRequest = filters.Or(filters.UDP(0, p.AltDstPort), udpRequest)
+			udpResponse = filters.Or(filters.UDP(p.AltDstPort, 0), udpResponse)
+		}
+
+		if a.expIngress.Drop || a.expIngress.IngressDrop {
+			dropFilter := filters.Drop()
+			if a.expIngress.IngressDrop {
+				dropFilter = filters.Drop(filters.WithIngress(), filters.WithDropFunc(a.expIngress.DropReasonFunc))
+			}
+			ingress = filters.FlowSetRequirement{
+				First: filters.FlowRequirement{Filter: filters.And(ipRequest, udpRequest), Msg: "UDP request"},
+				Last:  filters.FlowRequirement{Filter: filters.And(ipRequest, udpRequest, dropFilter), Msg: "Drop"},
+				Except: []filters.FlowRequirement{
+					{Filter: filters.And(ipResponse, udpResponse), Msg: "UDP response"},
+				},
+			}
+		} else {
+			ingress = filters.FlowSetRequirement{
+				First: filters.FlowRequirement{Filter: filters.And(ipRequest, udpRequest), Msg: "UDP request"},
+				Last:  filters.FlowRequirement{Filter: filters.And(ipResponse, udpResponse), Msg: "UDP response", SkipOnAggregation: true},
+				Except: []filters.FlowRequirement{
+					{Filter: filters.And(ipRequest, udpRequest, filters.Drop()), Msg: "Drop"},
+				},
+			}
+		}
+	case SCTP:
+		sctpRequest := filters.SCTP(0, a.dst.Port())
+		sctpResponse := filters.SCTP(a.dst.Port(), 0)
+		if p.AltDstPort != 0 {
+			sctpRequest = filters.Or(filters.SCTP(0, p.AltDstPort), sctpRequest)
+			sctpResponse = filters.Or(filters.SCTP(p.AltDstPort, 0), sctpResponse)
+		}
+
+		if a.expIngress.Drop || a.expIngress.IngressDrop {
+			dropFilter := filters.Drop()
+			if a.expIngress.IngressDrop {
+				dropFilter = filters.Drop(filters.WithIngress(), filters.WithDropFunc(a.expIngress.DropReasonFunc))
+			}
+			ingress = filters.FlowSetRequirement{
+				First: filters.FlowRequirement{Filter: filters.And(ipRequest, sctpRequest), Msg: "SCTP request"},
+				Last:  filters.FlowRequirement{Filter: filters.And(ipRequest, sctpRequest, dropFilter), Msg: "Drop"},
+				Except: []filters.FlowRequirement{
+					{Filter: filters.And(ipResponse, sctpResponse), Msg: "SCTP response"},
+				},
+			}
+		} else {
+			ingress = filters.FlowSetRequirement{
+				First: filters.FlowRequirement{Filter: filters.And(ipRequest, sctpRequest), Msg: "SCTP request"},
+				Last:  filters.FlowRequirement{Filter: filters.And(ipResponse, sctpResponse), Msg: "SCTP response", SkipOnAggregation: true},
+				Except: []filters.FlowRequirement{
+					{Filter: filters.And(ipRequest, sctpRequest, filters.Drop()), Msg: "Drop"},
+				},
+			}
+		}
 	default:
 		a.Failf("Invalid ingress flow matching protocol %d", p.Protocol)
 	}
@@ -1058,7 +1317,7 @@ func (a *Action) validateMetric(ctx context.Context, node string, result Metrics
 		// Collect the new metrics.
 		newMetrics, err := a.collectPrometheusMetricsForNode(result.Source, node)
 		if err != nil {
-			a.Failf("failed to collect new metrics on node %s: %w", node, err)
+			a.Failf("failed to collect new metrics on node %s: %s", node, err)
 			return
 		}
 
@@ -1095,3 +1354,11 @@ func (a *Action) CurlCommandWithOutput(peer TestPeer, opts ...string) []string {
 func (a *Action) CurlCommand(peer TestPeer, opts ...string) []string {
 	return a.test.ctx.CurlCommand(peer, a.IPFamily(), a.expectingSuccess(), opts)
 }
+
+func (a *Action) UDPEchoCommand(peer TestPeer, payloadSize int) []string {
+	return a.test.ctx.UDPEchoCommand(peer, a.IPFamily(), payloadSize)
+}
+
+func (a *Action) SCTPCommand(peer TestPeer) []string {
+	return a.test.ctx.SCTPCommand(peer, a.IPFamily())
+}
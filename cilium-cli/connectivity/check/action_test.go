@@ -4,9 +4,11 @@
 package check
 
 import (
+	"bytes"
 	"strings"
 	"testing"
 
+	"github.com/cilium/cilium/cilium-cli/utils/features"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -69,3 +71,32 @@ rtt min/avg/max/mdev = 5.780/5.895/6.010/0.115 ms`,
 		})
 	}
 }
+
+func TestAction_Assert(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewConcurrentLogger(buf)
+	logger.Start()
+	defer logger.Stop()
+
+	ct := &ConnectivityTest{
+		params: Parameters{Writer: buf},
+		logger: logger,
+	}
+	mytest := NewTest("assert-test", false, false)
+	mytest.ctx = ct
+	a := newAction(mytest, "assert-action", nil, nil, nil, features.IPFamilyAny)
+
+	assert.True(t, a.Assert("HTTP status code", 200, 200))
+	assert.False(t, a.failed)
+	assert.Empty(t, a.failedAssertions())
+
+	assert.False(t, a.Assert("HTTP status code", 200, 403))
+	assert.True(t, a.failed)
+	assert.True(t, mytest.failed)
+
+	assertions := a.Assertions()
+	assert.Len(t, assertions, 2)
+	assert.Equal(t, "HTTP status code: 200", assertions[0].String())
+	assert.Equal(t, "HTTP status code: expected 200, got 403", assertions[1].String())
+	assert.Len(t, a.failedAssertions(), 1)
+}
@@ -0,0 +1,273 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package check
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	baselineStatusPassed  = "passed"
+	baselineStatusFailed  = "failed"
+	baselineStatusSkipped = "skipped"
+)
+
+// BaselineTestResult is the recorded outcome of a single test in a baseline
+// summary.
+type BaselineTestResult struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	// FailedFamilies are the IP families in which the test had failed
+	// actions, so that a later run can tell a known-bad IPv4 failure
+	// apart from a new IPv6 regression of the same test.
+	FailedFamilies []string `json:"failed_families,omitempty"`
+}
+
+// BaselineActionLatency records the latency percentiles observed for actions
+// sharing the same test, scenario and action name.
+type BaselineActionLatency struct {
+	Name    string  `json:"name"`
+	Samples int     `json:"samples"`
+	P50     float64 `json:"p50_ms"`
+	P95     float64 `json:"p95_ms"`
+	P99     float64 `json:"p99_ms"`
+}
+
+// BaselineSummary is the JSON summary format consumed through
+// Parameters.BaselineFile to compare a run against an earlier one.
+type BaselineSummary struct {
+	Tests     []BaselineTestResult    `json:"tests"`
+	Actions   []BaselineActionLatency `json:"actions,omitempty"`
+	NodePairs []NodePairCoverage      `json:"node_pairs,omitempty"`
+}
+
+// CurrentBaseline returns the summary of this run in the baseline format, so
+// it can be stored and used as the baseline for a later run.
+func (ct *ConnectivityTest) CurrentBaseline() *BaselineSummary {
+	summary := &BaselineSummary{
+		Actions:   ct.actionLatencies(),
+		NodePairs: ct.NodePairCoverageMatrix(),
+	}
+	for _, t := range ct.tests {
+		result := BaselineTestResult{Name: t.Name(), Status: baselineStatusPassed}
+		if t.skipped {
+			result.Status = baselineStatusSkipped
+		} else if t.failed {
+			result.Status = baselineStatusFailed
+			result.FailedFamilies = failedFamilies(t)
+		}
+		summary.Tests = append(summary.Tests, result)
+	}
+	return summary
+}
+
+// failedFamilies returns the sorted, deduplicated IP families of the failed
+// actions of the given test.
+func failedFamilies(t *Test) []string {
+	fams := map[string]struct{}{}
+	for _, a := range t.failedActions() {
+		fams[a.IPFamily().String()] = struct{}{}
+	}
+	out := make([]string, 0, len(fams))
+	for f := range fams {
+		out = append(out, f)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// actionLatencies aggregates the durations of all executed actions into
+// latency percentiles, keyed by test, scenario and action name.
+func (ct *ConnectivityTest) actionLatencies() []BaselineActionLatency {
+	samples := map[string][]time.Duration{}
+	for _, t := range ct.tests {
+		for s, actions := range t.scenarios {
+			for _, a := range actions {
+				if a.skipped || a.duration <= 0 {
+					continue
+				}
+				// scenarioName already includes the test name.
+				key := fmt.Sprintf("%s/%s", t.scenarioName(s), a.name)
+				samples[key] = append(samples[key], a.duration)
+			}
+		}
+	}
+
+	latencies := make([]BaselineActionLatency, 0, len(samples))
+	for key, durations := range samples {
+		slices.Sort(durations)
+		latencies = append(latencies, BaselineActionLatency{
+			Name:    key,
+			Samples: len(durations),
+			P50:     latencyPercentile(durations, 50),
+			P95:     latencyPercentile(durations, 95),
+			P99:     latencyPercentile(durations, 99),
+		})
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i].Name < latencies[j].Name })
+	return latencies
+}
+
+// latencyPercentile returns the given percentile of the sorted samples in
+// milliseconds.
+func latencyPercentile(sorted []time.Duration, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := int(float64(len(sorted))*p/100+0.5) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	if idx >= len(sorted) {
+		idx = len(sorted) - 1
+	}
+	return float64(sorted[idx]) / float64(time.Millisecond)
+}
+
+// loadBaseline reads a baseline summary file.
+func loadBaseline(path string) (*BaselineSummary, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var summary BaselineSummary
+	if err := json.Unmarshal(data, &summary); err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
+
+// writeBaseline stores the summary of this run at the given path, to serve as
+// the baseline for later runs.
+func (ct *ConnectivityTest) writeBaseline(path string) error {
+	data, err := json.MarshalIndent(ct.CurrentBaseline(), "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// compareBaseline reports both the pass/fail diff and the latency diff of
+// this run against the baseline. The pass/fail diff determines the verdict;
+// latency regressions only contribute in strict mode.
+func (ct *ConnectivityTest) compareBaseline(baseline *BaselineSummary) error {
+	if err := ct.reportBaselineDiff(baseline); err != nil {
+		return err
+	}
+	return ct.reportLatencyDiff(baseline)
+}
+
+// reportBaselineDiff compares the outcome of this run against the baseline
+// and reports newly failing, fixed and still failing tests. Only tests that
+// did not fail in the baseline drive the verdict; known-bad tests are
+// reported but tolerated.
+// newFailedFamilies returns the families in current that the baseline did
+// not record as failing. Baselines written before per-family tracking carry
+// no family information; for those, nil is returned so the comparison falls
+// back to the test-level verdict.
+func newFailedFamilies(current, base []string) []string {
+	if len(base) == 0 {
+		return nil
+	}
+	var out []string
+	for _, f := range current {
+		if !slices.Contains(base, f) {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+func (ct *ConnectivityTest) reportBaselineDiff(baseline *BaselineSummary) error {
+	results := make(map[string]BaselineTestResult, len(baseline.Tests))
+	for _, t := range baseline.Tests {
+		results[t.Name] = t
+	}
+
+	var newFailures, newPasses, stillFailing []string
+	for _, t := range ct.tests {
+		if t.skipped {
+			continue
+		}
+		base := results[t.Name()]
+		knownBad := base.Status == baselineStatusFailed
+		switch {
+		case t.failed && knownBad:
+			// When both sides carry per-family information, a test
+			// that now fails in a family that passed in the baseline
+			// is a new regression, not a known-bad failure.
+			if fams := newFailedFamilies(failedFamilies(t), base.FailedFamilies); len(fams) > 0 {
+				newFailures = append(newFailures, fmt.Sprintf("%s (%s)", t.Name(), strings.Join(fams, ", ")))
+			} else {
+				stillFailing = append(stillFailing, t.Name())
+			}
+		case t.failed:
+			newFailures = append(newFailures, t.Name())
+		case knownBad:
+			newPasses = append(newPasses, t.Name())
+		}
+	}
+
+	ct.Header(fmt.Sprintf("🔍 Baseline Comparison [%s]", ct.params.BaselineFile))
+	ct.Logf("%d new failures, %d new passes (fixed), %d still failing",
+		len(newFailures), len(newPasses), len(stillFailing))
+	for _, name := range newFailures {
+		ct.Logf("  ❌ new failure: [%s]", name)
+	}
+	for _, name := range newPasses {
+		ct.Logf("  ✅ fixed: [%s]", name)
+	}
+	for _, name := range stillFailing {
+		ct.Logf("  ⚠️  still failing: [%s]", name)
+	}
+
+	if len(newFailures) > 0 {
+		return fmt.Errorf("[%s] %d tests failed that did not fail in the baseline", ct.params.TestNamespace, len(newFailures))
+	}
+	return nil
+}
+
+// reportLatencyDiff compares the p95 latency of this run's actions against
+// the baseline and reports actions that regressed beyond the configured
+// tolerance. Actions present on only one side are ignored. Regressions are
+// warnings unless Parameters.BaselineStrict is set, in which case they fail
+// the run.
+func (ct *ConnectivityTest) reportLatencyDiff(baseline *BaselineSummary) error {
+	if len(baseline.Actions) == 0 {
+		return nil
+	}
+
+	base := make(map[string]BaselineActionLatency, len(baseline.Actions))
+	for _, a := range baseline.Actions {
+		base[a.Name] = a
+	}
+
+	regressions := 0
+	for _, cur := range ct.actionLatencies() {
+		prev, ok := base[cur.Name]
+		if !ok || prev.P95 <= 0 {
+			continue
+		}
+		pct := (cur.P95 - prev.P95) / prev.P95 * 100
+		if pct > ct.params.BaselineLatencyTolerance {
+			if regressions == 0 {
+				ct.Header(fmt.Sprintf("⏱️  Latency Baseline Comparison [%s]", ct.params.BaselineFile))
+			}
+			regressions++
+			ct.Logf("  ⚠️  p95 regression: [%s] %.1fms -> %.1fms (+%.1f%%)", cur.Name, prev.P95, cur.P95, pct)
+		}
+	}
+
+	if regressions > 0 && ct.params.BaselineStrict {
+		return fmt.Errorf("[%s] %d actions regressed beyond %.1f%% of their baseline p95 latency",
+			ct.params.TestNamespace, regressions, ct.params.BaselineLatencyTolerance)
+	}
+	return nil
+}
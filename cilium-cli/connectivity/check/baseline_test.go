@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package check
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/cilium/cilium-cli/utils/features"
+)
+
+func TestLoadBaseline(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	data := `{"tests":[{"name":"pod-to-pod","status":"passed"},{"name":"pod-to-world","status":"failed"}],` +
+		`"actions":[{"name":"pod-to-pod/scenario/curl-0","samples":4,"p50_ms":10,"p95_ms":20,"p99_ms":30}]}`
+	require.NoError(t, os.WriteFile(path, []byte(data), 0o644))
+
+	baseline, err := loadBaseline(path)
+	require.NoError(t, err)
+	require.Equal(t, &BaselineSummary{
+		Tests: []BaselineTestResult{
+			{Name: "pod-to-pod", Status: "passed"},
+			{Name: "pod-to-world", Status: "failed"},
+		},
+		Actions: []BaselineActionLatency{
+			{Name: "pod-to-pod/scenario/curl-0", Samples: 4, P50: 10, P95: 20, P99: 30},
+		},
+	}, baseline)
+
+	_, err = loadBaseline(filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+}
+
+func TestReportBaselineDiff(t *testing.T) {
+	buf := &bytes.Buffer{}
+	ct := &ConnectivityTest{
+		params: Parameters{Writer: buf, TestNamespace: "cilium-test", BaselineFile: "baseline.json"},
+	}
+	mkTest := func(name string, failed, skipped bool) *Test {
+		test := NewTest(name, false, false)
+		test.ctx = ct
+		test.failed = failed
+		test.skipped = skipped
+		return test
+	}
+	ct.tests = []*Test{
+		mkTest("passing", false, false),
+		mkTest("new-failure", true, false),
+		mkTest("still-failing", true, false),
+		mkTest("fixed", false, false),
+		mkTest("skipped", false, true),
+	}
+	baseline := &BaselineSummary{
+		Tests: []BaselineTestResult{
+			{Name: "passing", Status: baselineStatusPassed},
+			{Name: "still-failing", Status: baselineStatusFailed},
+			{Name: "fixed", Status: baselineStatusFailed},
+		},
+	}
+
+	err := ct.reportBaselineDiff(baseline)
+	require.ErrorContains(t, err, "1 tests failed that did not fail in the baseline")
+	out := buf.String()
+	require.Contains(t, out, "1 new failures, 1 new passes (fixed), 1 still failing")
+	require.Contains(t, out, "new failure: [new-failure]")
+	require.Contains(t, out, "fixed: [fixed]")
+	require.Contains(t, out, "still failing: [still-failing]")
+
+	// Known-bad failures alone do not fail the run.
+	ct.tests = []*Test{
+		mkTest("still-failing", true, false),
+	}
+	require.NoError(t, ct.reportBaselineDiff(baseline))
+
+	// A known-bad test that now fails in a family that passed in the
+	// baseline is a new failure, not a known-bad one.
+	scenario := &fakeScenario{}
+	famTest := mkTest("family-regression", true, false)
+	a := newAction(famTest, "curl-0", scenario, nil, nil, features.IPFamilyV6)
+	a.failed = true
+	famTest.scenarios[scenario] = []*Action{a}
+	ct.tests = []*Test{famTest}
+	baseline = &BaselineSummary{
+		Tests: []BaselineTestResult{
+			{Name: "family-regression", Status: baselineStatusFailed, FailedFamilies: []string{"ipv4"}},
+		},
+	}
+	buf.Reset()
+	err = ct.reportBaselineDiff(baseline)
+	require.ErrorContains(t, err, "1 tests failed that did not fail in the baseline")
+	require.Contains(t, buf.String(), "new failure: [family-regression (ipv6)]")
+
+	// Without per-family information in the baseline, the comparison
+	// falls back to the test-level verdict.
+	baseline.Tests[0].FailedFamilies = nil
+	require.NoError(t, ct.reportBaselineDiff(baseline))
+}
+
+func TestLatencyPercentile(t *testing.T) {
+	sorted := []time.Duration{
+		10 * time.Millisecond,
+		20 * time.Millisecond,
+		30 * time.Millisecond,
+		40 * time.Millisecond,
+	}
+	require.Equal(t, float64(20), latencyPercentile(sorted, 50))
+	require.Equal(t, float64(40), latencyPercentile(sorted, 95))
+	require.Equal(t, float64(10), latencyPercentile(sorted[:1], 99))
+	require.Equal(t, float64(0), latencyPercentile(nil, 95))
+}
+
+func TestReportLatencyDiff(t *testing.T) {
+	mkCT := func(strict bool, durations ...time.Duration) *ConnectivityTest {
+		ct := &ConnectivityTest{
+			params: Parameters{
+				Writer:                   &bytes.Buffer{},
+				TestNamespace:            "cilium-test",
+				BaselineFile:             "baseline.json",
+				BaselineStrict:           strict,
+				BaselineLatencyTolerance: 25,
+			},
+		}
+		test := NewTest("pod-to-pod", false, false)
+		test.ctx = ct
+		scenario := &fakeScenario{}
+		for _, d := range durations {
+			a := newAction(test, "curl-0", scenario, nil, nil, features.IPFamilyV4)
+			a.duration = d
+			test.scenarios[scenario] = append(test.scenarios[scenario], a)
+		}
+		ct.tests = []*Test{test}
+		return ct
+	}
+
+	baseline := &BaselineSummary{
+		Actions: []BaselineActionLatency{
+			{Name: "pod-to-pod/fake-scenario/curl-0", Samples: 1, P50: 10, P95: 10, P99: 10},
+		},
+	}
+
+	// Within tolerance.
+	require.NoError(t, mkCT(true, 12*time.Millisecond).reportLatencyDiff(baseline))
+
+	// Regression beyond tolerance warns, but only fails in strict mode.
+	require.NoError(t, mkCT(false, 20*time.Millisecond).reportLatencyDiff(baseline))
+	err := mkCT(true, 20*time.Millisecond).reportLatencyDiff(baseline)
+	require.ErrorContains(t, err, "1 actions regressed")
+
+	// Actions missing from the baseline are ignored.
+	ct := mkCT(true, 20*time.Millisecond)
+	require.NoError(t, ct.reportLatencyDiff(&BaselineSummary{
+		Actions: []BaselineActionLatency{{Name: "other/fake-scenario/curl-0", P95: 10}},
+	}))
+}
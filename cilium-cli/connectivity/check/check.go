@@ -9,6 +9,7 @@ import (
 	"io"
 	"maps"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -57,28 +58,34 @@ func (p *PerfParameters) GetTolerations() []corev1.Toleration {
 }
 
 type Parameters struct {
-	AssumeCiliumVersion    string
-	CiliumNamespace        string
-	TestNamespace          string
-	TestNamespaceIndex     int
-	TestConcurrency        int
-	SingleNode             bool
-	PrintFlows             bool
-	ForceDeploy            bool
-	Hubble                 bool
-	HubbleServer           string
-	K8sLocalHostTest       bool
-	MultiCluster           string
-	RunTests               []*regexp.Regexp
-	SkipTests              []*regexp.Regexp
-	PostTestSleepDuration  time.Duration
-	FlowValidation         string
-	AllFlows               bool
-	Writer                 io.ReadWriter
-	Verbose                bool
-	Debug                  bool
-	Timestamp              bool
-	PauseOnFail            bool
+	AssumeCiliumVersion   string
+	CiliumNamespace       string
+	TestNamespace         string
+	TestNamespaceIndex    int
+	TestConcurrency       int
+	SingleNode            bool
+	PrintFlows            bool
+	ForceDeploy           bool
+	Hubble                bool
+	HubbleServer          string
+	K8sLocalHostTest      bool
+	MultiCluster          string
+	RunTests              []*regexp.Regexp
+	SkipTests             []*regexp.Regexp
+	PostTestSleepDuration time.Duration
+	FlowValidation        string
+	AllFlows              bool
+	Writer                io.ReadWriter
+	Verbose               bool
+	Debug                 bool
+	Timestamp             bool
+	LogFormat             string
+	PauseOnFail           bool
+	Pause                 bool
+
+	// FaultInjector, when non-nil, is invoked before every action to
+	// introduce a fault and verify recovery. See FaultInjector for details.
+	FaultInjector          FaultInjector
 	SkipIPCacheCheck       bool
 	Perf                   bool
 	PerfParameters         PerfParameters
@@ -97,7 +104,9 @@ type Parameters struct {
 	DeploymentAnnotations  annotationsMap
 	NamespaceLabels        map[string]string
 	NamespaceAnnotations   map[string]string
+	SkipNamespaceMutation  bool
 	ExternalTarget         string
+	ExternalTargetsFile    string
 	ExternalOtherTarget    string
 	ExternalCIDR           string
 	ExternalIP             string
@@ -112,9 +121,24 @@ type Parameters struct {
 	NodesWithoutCiliumIPs  []nodesWithoutCiliumIP
 	JunitFile              string
 	JunitProperties        map[string]string
-	ImpersonateAs          string
-	ImpersonateGroups      []string
-	IPFamilies             []string
+	OtelEndpoint           string
+	BaselineFile           string
+	BaselineStrict         bool
+	// BaselineLatencyTolerance is the maximum allowed p95 latency regression
+	// over the baseline, in percent.
+	BaselineLatencyTolerance float64
+	// PreflightSkip* disable individual pre-flight environment checks for
+	// unusual environments.
+	PreflightSkipNodeCheck       bool
+	PreflightSkipPodStartupCheck bool
+	PreflightSkipAPICheck        bool
+	// RequireFullNodePairCoverage schedules additional client pods so that
+	// every (source node, destination node) pair is covered by the
+	// pod-to-pod tests, up to a cap.
+	RequireFullNodePairCoverage bool
+	ImpersonateAs               string
+	ImpersonateGroups           []string
+	IPFamilies                  []string
 
 	IncludeConnDisruptTest              bool
 	IncludeConnDisruptTestNSTraffic     bool
@@ -148,8 +172,11 @@ type Parameters struct {
 	CurlParallel   uint
 
 	CollectSysdumpOnFailure bool
+	SysdumpPolicy           string
 	SysdumpOptions          sysdump.Options
 
+	CollectPodMetrics bool
+
 	ExternalTargetCANamespace string
 	ExternalTargetCAName      string
 
@@ -269,9 +296,45 @@ func (p Parameters) validate() error {
 		return fmt.Errorf("invalid flow validation mode %q", p.FlowValidation)
 	}
 
+	switch p.LogFormat {
+	case "", LogFormatEmoji, LogFormatJSONL:
+	default:
+		return fmt.Errorf("invalid log format %q", p.LogFormat)
+	}
+
+	if _, err := ParseSysdumpPolicy(p.SysdumpPolicy); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// EnvironmentFingerprint returns report properties describing how the test
+// environment was set up, so that reports show what was applied.
+func (p Parameters) EnvironmentFingerprint() map[string]string {
+	fingerprint := map[string]string{}
+	if len(p.NamespaceLabels) > 0 {
+		fingerprint["namespace-labels"] = renderKVMap(p.NamespaceLabels)
+	}
+	if len(p.NamespaceAnnotations) > 0 {
+		fingerprint["namespace-annotations"] = renderKVMap(p.NamespaceAnnotations)
+	}
+	if len(p.NodeSelector) > 0 {
+		fingerprint["node-selector"] = renderKVMap(p.NodeSelector)
+	}
+	return fingerprint
+}
+
+// renderKVMap renders a map as a comma-separated key=value list with sorted
+// keys.
+func renderKVMap(kv map[string]string) string {
+	pairs := make([]string, 0, len(kv))
+	for _, k := range slices.Sorted(maps.Keys(kv)) {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", k, kv[k]))
+	}
+	return strings.Join(pairs, ",")
+}
+
 // testEnabled returns true if the given test is allowed to run.
 func (p Parameters) testEnabled(test string) bool {
 	// Skip 'test' if any SkipTest matches.
@@ -331,6 +394,7 @@ const (
 	TCP L4Protocol = iota
 	UDP
 	ICMP
+	SCTP
 )
 
 // FlowParameters defines parameters for test result flow matching
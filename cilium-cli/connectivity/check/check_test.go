@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 )
 
 func TestAnnotationMap(t *testing.T) {
@@ -69,3 +70,51 @@ func TestAnnotationMap(t *testing.T) {
 	assert.Equal(t, a.Match("other"), annotations(nil))
 
 }
+
+func TestEnvironmentFingerprint(t *testing.T) {
+	p := Parameters{}
+	assert.Empty(t, p.EnvironmentFingerprint())
+
+	p.NamespaceLabels = map[string]string{
+		"pod-security.kubernetes.io/enforce": "privileged",
+		"istio-injection":                    "disabled",
+	}
+	p.NamespaceAnnotations = map[string]string{"owner": "ci"}
+	p.NodeSelector = map[string]string{"pool": "canary"}
+	fp := p.EnvironmentFingerprint()
+	assert.Equal(t, "istio-injection=disabled,pod-security.kubernetes.io/enforce=privileged",
+		fp["namespace-labels"])
+	assert.Equal(t, "owner=ci", fp["namespace-annotations"])
+	assert.Equal(t, "pool=canary", fp["node-selector"])
+}
+
+func TestNodeLabelSelector(t *testing.T) {
+	ct := &ConnectivityTest{}
+	assert.Empty(t, ct.nodeLabelSelector())
+
+	ct.params.NodeSelector = map[string]string{"pool": "canary", "arch": "arm64"}
+	assert.Equal(t, "arch=arm64,pool=canary", ct.nodeLabelSelector())
+}
+
+func TestMergeNamespaceMetadata(t *testing.T) {
+	ns := &corev1.Namespace{}
+
+	// Nothing requested, nothing changed.
+	assert.False(t, mergeNamespaceMetadata(ns, nil, nil))
+
+	assert.True(t, mergeNamespaceMetadata(ns,
+		map[string]string{"istio-injection": "disabled"},
+		map[string]string{"owner": "ci"}))
+	assert.Equal(t, "disabled", ns.Labels["istio-injection"])
+	assert.Equal(t, "ci", ns.Annotations["owner"])
+
+	// Applying the same metadata again is a no-op.
+	assert.False(t, mergeNamespaceMetadata(ns,
+		map[string]string{"istio-injection": "disabled"},
+		map[string]string{"owner": "ci"}))
+
+	// A differing value is updated.
+	assert.True(t, mergeNamespaceMetadata(ns,
+		map[string]string{"istio-injection": "enabled"}, nil))
+	assert.Equal(t, "enabled", ns.Labels["istio-injection"])
+}
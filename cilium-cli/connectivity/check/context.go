@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/fs"
 	"maps"
 	"net"
 	"net/netip"
@@ -22,6 +23,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/cilium/cilium/api/v1/observer"
 	"github.com/cilium/cilium/cilium-cli/connectivity/perf/common"
@@ -95,6 +97,22 @@ type ConnectivityTest struct {
 
 	lastFlowTimestamps map[string]time.Time
 
+	// External targets loaded from Parameters.ExternalTargetsFile,
+	// annotated with their ground-truth probe results.
+	externalTargets []*ExternalTarget
+
+	// tracer records spans of the test run for OTLP export, nil when
+	// Parameters.OtelEndpoint is unset.
+	tracer *tracer
+
+	// coverage records the (source node, destination node) pairs
+	// exercised by pod-to-pod actions.
+	coverage *nodePairTracker
+
+	// coverageClientDeployments are the names of the additional per-node
+	// client deployments created by Parameters.RequireFullNodePairCoverage.
+	coverageClientDeployments []string
+
 	nodes              map[string]*corev1.Node
 	controlPlaneNodes  map[string]*corev1.Node
 	nodesWithoutCilium map[string]struct{}
@@ -134,9 +152,11 @@ func (ct *ConnectivityTest) debug() bool {
 	return ct.params.Debug
 }
 
-// timestamp returns the value of the user-provided timestamp flag.
+// timestamp returns the value of the user-provided timestamp flag. It is
+// always false in the jsonl log format, where every record carries its own
+// ts field.
 func (ct *ConnectivityTest) timestamp() bool {
-	return ct.params.Timestamp
+	return ct.params.Timestamp && !ct.jsonl()
 }
 
 // actions returns a list of all Actions registered under the test context.
@@ -248,17 +268,33 @@ func NewConnectivityTest(
 		lastFlowTimestamps:       make(map[string]time.Time),
 		Features:                 features.Set{},
 		CodeOwners:               owners,
+		coverage:                 &nodePairTracker{},
+	}
+
+	if p.OtelEndpoint != "" {
+		k.tracer = newTracer(p.OtelEndpoint)
 	}
 
 	return k, nil
 }
 
+// TraceID returns the identifier of the trace recorded for this run, or an
+// empty string when tracing is disabled.
+func (ct *ConnectivityTest) TraceID() string {
+	return ct.tracer.TraceID()
+}
+
 // AddTest adds a new test scope within the ConnectivityTest and returns a new Test.
 func (ct *ConnectivityTest) AddTest(t *Test) *Test {
 	if _, ok := ct.testNames[t.name]; ok {
 		ct.Fatalf("test %s exists in suite", t.name)
 	}
 	t.ctx = ct
+	// Apply the user-provided default policy. Tests requiring a specific
+	// policy override it via WithSysdumpPolicy.
+	if policy, err := ParseSysdumpPolicy(ct.params.SysdumpPolicy); err == nil {
+		t.sysdumpPolicy = policy
+	}
 	ct.tests = append(ct.tests, t)
 	ct.testNames[t.name] = struct{}{}
 	return t
@@ -320,6 +356,9 @@ func (ct *ConnectivityTest) SetupAndValidate(ctx context.Context, extra SetupHoo
 }
 
 func (ct *ConnectivityTest) setupAndValidate(ctx context.Context, extra SetupHooks) error {
+	if err := ct.loadExternalTargets(); err != nil {
+		return err
+	}
 	if err := ct.detectSingleNode(ctx); err != nil {
 		return err
 	}
@@ -363,12 +402,25 @@ func (ct *ConnectivityTest) setupAndValidate(ctx context.Context, extra SetupHoo
 		ct.Info("Monitor aggregation detected, will skip some flow validation steps")
 	}
 
+	if err := ct.preflight(ctx); err != nil {
+		return err
+	}
+	if ct.params.MultiCluster != "" {
+		for _, pod := range ct.ciliumPods {
+			if err := WaitForClusterMesh(ctx, ct, pod); err != nil {
+				return err
+			}
+		}
+	}
 	if err := ct.deploy(ctx); err != nil {
 		return err
 	}
 	if err := ct.validateDeployment(ctx); err != nil {
 		return err
 	}
+	if err := ct.probeExternalTargets(ctx); err != nil {
+		return err
+	}
 	if ct.params.Hubble {
 		if err := ct.enableHubbleClient(ctx); err != nil {
 			return fmt.Errorf("unable to create hubble client: %w", err)
@@ -434,6 +486,17 @@ func (ct *ConnectivityTest) Run(ctx context.Context) error {
 	if len(ct.tests) == 0 {
 		return nil
 	}
+
+	// Export the recorded trace both on completion and on cancellation
+	// through SIGINT, which unwinds Run via context cancellation.
+	defer func() {
+		flushCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := ct.tracer.flush(flushCtx); err != nil {
+			ct.Warnf("Unable to export trace to %s: %s", ct.params.OtelEndpoint, err)
+		}
+	}()
+
 	// Execute all tests in the order they were registered by the test suite.
 	for i, t := range ct.tests {
 		if err := ctx.Err(); err != nil {
@@ -511,7 +574,12 @@ func (ct *ConnectivityTest) Cleanup() {
 
 // skip marks the Test as skipped.
 func (ct *ConnectivityTest) skip(t *Test, index int, reason string) {
-	ct.logger.Printf(t, "[=] [%s] Skipping test [%s] [%d/%d] (%s)\n", ct.params.TestNamespace, t.Name(), index, len(t.ctx.tests), reason)
+	if ct.jsonl() {
+		ct.logger.Print(t, jsonlLine("info", t.Name(), "",
+			fmt.Sprintf("[%s] Skipping test [%d/%d] (%s)", ct.params.TestNamespace, index, len(t.ctx.tests), reason)))
+	} else {
+		ct.logger.Printf(t, "[=] [%s] Skipping test [%s] [%d/%d] (%s)\n", ct.params.TestNamespace, t.Name(), index, len(t.ctx.tests), reason)
+	}
 	t.skipped = true
 }
 
@@ -528,6 +596,20 @@ func (ct *ConnectivityTest) report() error {
 	nss := len(skippedScenarios)
 	nf := len(failed)
 
+	var baseline *BaselineSummary
+	if ct.params.BaselineFile != "" {
+		var err error
+		baseline, err = loadBaseline(ct.params.BaselineFile)
+		if errors.Is(err, fs.ErrNotExist) {
+			// No baseline recorded yet, this run becomes the baseline.
+			baseline = nil
+		} else if err != nil {
+			return fmt.Errorf("loading baseline from %s: %w", ct.params.BaselineFile, err)
+		}
+	}
+
+	ct.reportNodePairCoverage()
+
 	if nf > 0 {
 		ct.Header(fmt.Sprintf("📋 Test Report [%s]", ct.params.TestNamespace))
 
@@ -536,16 +618,26 @@ func (ct *ConnectivityTest) report() error {
 
 		ct.Failf("%d/%d tests failed (%d/%d actions), %d tests skipped, %d scenarios skipped:", nf, nt-nst, fa, na, nst, nss)
 
-		// List all failed actions by test.
+		// List all failed actions by test, grouped by IP family so that
+		// single-family regressions of dual-stack tests stand out.
 		failedActions := 0
 		for _, t := range failed {
 			ct.Logf("Test [%s]:", t.Name())
-			for _, a := range t.failedActions() {
-				failedActions++
-				ct.Log("  ❌", a)
-				ct.LogOwners(a.Scenario())
+			for _, fam := range []features.IPFamily{features.IPFamilyV4, features.IPFamilyV6, features.IPFamilyAny} {
+				for _, a := range t.failedActions() {
+					if a.ipFam != fam {
+						continue
+					}
+					failedActions++
+					ct.Logf("  ❌ [%s] %s", fam, a)
+					ct.LogOwners(a.Scenario())
+				}
 			}
 		}
+
+		if rollup := ct.familyRollup(); rollup != "" {
+			ct.Logf("Per-family results: %s", rollup)
+		}
 		if len(failed) > 0 && failedActions == 0 {
 			allScenarios := make([]ownedScenario, 0, len(failed))
 			for _, t := range failed {
@@ -561,9 +653,26 @@ func (ct *ConnectivityTest) report() error {
 			ct.LogOwners(allScenarios...)
 		}
 
+		if baseline != nil {
+			// With a baseline, only tests that did not fail in the
+			// baseline determine the verdict.
+			return ct.compareBaseline(baseline)
+		}
+
 		return fmt.Errorf("[%s] %d tests failed", ct.params.TestNamespace, nf)
 	}
 
+	if baseline != nil {
+		if err := ct.compareBaseline(baseline); err != nil {
+			return err
+		}
+	} else if ct.params.BaselineFile != "" {
+		if err := ct.writeBaseline(ct.params.BaselineFile); err != nil {
+			return fmt.Errorf("writing baseline to %s: %w", ct.params.BaselineFile, err)
+		}
+		ct.Infof("📄 Baseline written to %s", ct.params.BaselineFile)
+	}
+
 	if ct.params.Perf && !ct.params.PerfParameters.NetQos {
 		ct.Header(fmt.Sprintf("🔥 Network Performance Test Summary [%s]:", ct.params.TestNamespace))
 		ct.Logf("%s", strings.Repeat("-", 200))
@@ -614,10 +723,54 @@ func (ct *ConnectivityTest) report() error {
 	}
 
 	ct.Headerf("✅ [%s] All %d tests (%d actions) successful, %d tests skipped, %d scenarios skipped.", ct.params.TestNamespace, nt-nst, na, nst, nss)
+	if rollup := ct.familyRollup(); rollup != "" {
+		ct.Logf("Per-family results: %s", rollup)
+	}
 
 	return nil
 }
 
+// familyRollup summarizes the executed actions per IP family, e.g.
+// "IPv4: 120/120 passed, IPv6: 112/120 passed". Actions not bound to a
+// specific family are reported as "other". Families without any executed
+// action are omitted.
+func (ct *ConnectivityTest) familyRollup() string {
+	type tally struct {
+		passed, total int
+	}
+	tallies := map[features.IPFamily]*tally{}
+	for _, a := range ct.actions() {
+		if a.skipped {
+			continue
+		}
+		tl := tallies[a.ipFam]
+		if tl == nil {
+			tl = &tally{}
+			tallies[a.ipFam] = tl
+		}
+		tl.total++
+		if !a.failed {
+			tl.passed++
+		}
+	}
+
+	labels := []struct {
+		fam   features.IPFamily
+		label string
+	}{
+		{features.IPFamilyV4, "IPv4"},
+		{features.IPFamilyV6, "IPv6"},
+		{features.IPFamilyAny, "other"},
+	}
+	var parts []string
+	for _, l := range labels {
+		if tl := tallies[l.fam]; tl != nil {
+			parts = append(parts, fmt.Sprintf("%s: %d/%d passed", l.label, tl.passed, tl.total))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
 func (ct *ConnectivityTest) enableHubbleClient(ctx context.Context) error {
 	ct.Log("🔭 Enabling Hubble telescope...")
 
@@ -826,18 +979,22 @@ func (ct *ConnectivityTest) detectSingleNode(ctx context.Context) error {
 		return nil
 	}
 
-	daemonSet, err := ct.client.GetDaemonSet(ctx, ct.params.CiliumNamespace, ct.params.AgentDaemonSetName, metav1.GetOptions{})
-	if err != nil {
-		ct.Fatal("Unable to determine status of Cilium DaemonSet. Run \"cilium status\" for more details")
-		return fmt.Errorf("unable to determine status of Cilium DaemonSet: %w", err)
-	}
+	// The DaemonSet shortcut below covers the whole cluster, not the
+	// selected subset, so skip it when a node selector is in effect.
+	if len(ct.params.NodeSelector) == 0 {
+		daemonSet, err := ct.client.GetDaemonSet(ctx, ct.params.CiliumNamespace, ct.params.AgentDaemonSetName, metav1.GetOptions{})
+		if err != nil {
+			ct.Fatal("Unable to determine status of Cilium DaemonSet. Run \"cilium status\" for more details")
+			return fmt.Errorf("unable to determine status of Cilium DaemonSet: %w", err)
+		}
 
-	if daemonSet.Status.DesiredNumberScheduled == 1 {
-		ct.params.SingleNode = true
-		return nil
+		if daemonSet.Status.DesiredNumberScheduled == 1 {
+			ct.params.SingleNode = true
+			return nil
+		}
 	}
 
-	nodes, err := ct.client.ListNodes(ctx, metav1.ListOptions{})
+	nodes, err := ct.client.ListNodes(ctx, metav1.ListOptions{LabelSelector: ct.nodeLabelSelector()})
 	if err != nil {
 		ct.Fatal("Unable to list nodes.")
 		return fmt.Errorf("unable to list nodes: %w", err)
@@ -855,6 +1012,14 @@ func (ct *ConnectivityTest) detectSingleNode(ctx context.Context) error {
 		}
 	}
 
+	// With a node selector, multi-node tests were implicitly requested;
+	// refuse to silently degrade to a single-node run if the selection is
+	// too small.
+	if len(ct.params.NodeSelector) > 0 && numWorkerNodes < 2 {
+		return fmt.Errorf("only %d schedulable node(s) match --node-selector %s, but multi-node tests were requested; widen the selector or pass --single-node",
+			numWorkerNodes, ct.nodeLabelSelector())
+	}
+
 	ct.params.SingleNode = numWorkerNodes == 1
 	if ct.params.SingleNode {
 		ct.Info("Single-node environment detected, enabling single-node connectivity test")
@@ -914,15 +1079,31 @@ func (ct *ConnectivityTest) initCiliumPods(ctx context.Context) error {
 	return nil
 }
 
+// nodeLabelSelector renders the --node-selector parameter as a list option
+// selector. Node discovery is restricted to the selection so that
+// node-targeted tests (node-to-node, host firewall) only cover nodes the test
+// workloads can be scheduled on. Returns the empty string when no selector
+// was given, which selects all nodes.
+func (ct *ConnectivityTest) nodeLabelSelector() string {
+	if len(ct.params.NodeSelector) == 0 {
+		return ""
+	}
+	return labels.SelectorFromSet(ct.params.NodeSelector).String()
+}
+
 func (ct *ConnectivityTest) getNodes(ctx context.Context) error {
 	ct.nodes = make(map[string]*corev1.Node)
 	ct.controlPlaneNodes = make(map[string]*corev1.Node)
 	ct.nodesWithoutCilium = make(map[string]struct{})
-	nodeList, err := ct.client.ListNodes(ctx, metav1.ListOptions{})
+	nodeList, err := ct.client.ListNodes(ctx, metav1.ListOptions{LabelSelector: ct.nodeLabelSelector()})
 	if err != nil {
 		return fmt.Errorf("unable to list K8s Nodes: %w", err)
 	}
 
+	if len(ct.params.NodeSelector) > 0 && len(nodeList.Items) == 0 {
+		return fmt.Errorf("no nodes match --node-selector %s", ct.nodeLabelSelector())
+	}
+
 	for _, node := range nodeList.Items {
 		if canNodeRunCilium(&node) {
 			if isControlPlane(&node) {
@@ -1047,6 +1228,57 @@ func (ct *ConnectivityTest) PingCommand(peer TestPeer, ipFam features.IPFamily,
 	return cmd
 }
 
+// UDPEchoCommand returns the command to send a single UDP datagram carrying a
+// payload of payloadSize bytes to the peer and wait for it to be echoed back.
+// Payload sizes exceeding the path MTU force the datagram to be fragmented.
+func (ct *ConnectivityTest) UDPEchoCommand(peer TestPeer, ipFam features.IPFamily, payloadSize int) []string {
+	nc := []string{"nc", "-u"}
+
+	if requestTimeout := ct.params.RequestTimeout.Seconds(); requestTimeout > 0.0 {
+		nc = append(nc, "-w", strconv.FormatFloat(requestTimeout, 'f', -1, 64))
+	}
+
+	switch ipFam {
+	case features.IPFamilyV4:
+		nc = append(nc, "-4")
+	case features.IPFamilyV6:
+		nc = append(nc, "-6")
+	}
+
+	nc = append(nc, peer.Address(ipFam), fmt.Sprint(peer.Port()))
+
+	// nc exits successfully even when no reply ever arrives, so require a
+	// non-empty echo for the command to succeed. The echoed size is printed
+	// as commands without output are considered inconclusive and retried.
+	payload := fmt.Sprintf("dd if=/dev/zero bs=%d count=1 2>/dev/null | tr '\\0' 'U'", payloadSize)
+	script := fmt.Sprintf("out=$(%s | %s); echo \"echoed ${#out}/%d bytes\"; test -n \"$out\"",
+		payload, strings.Join(nc, " "), payloadSize)
+	return []string{"sh", "-c", script}
+}
+
+// SCTPCommand returns the command to open an SCTP association to the peer and
+// shut it down again without transferring any payload.
+func (ct *ConnectivityTest) SCTPCommand(peer TestPeer, ipFam features.IPFamily) []string {
+	cmd := []string{"ncat", "--sctp", "--send-only"}
+
+	if connectTimeout := ct.params.ConnectTimeout.Seconds(); connectTimeout > 0.0 {
+		cmd = append(cmd, "-w", strconv.FormatFloat(connectTimeout, 'f', -1, 64))
+	}
+
+	switch ipFam {
+	case features.IPFamilyV4:
+		cmd = append(cmd, "-4")
+	case features.IPFamilyV6:
+		cmd = append(cmd, "-6")
+	}
+
+	cmd = append(cmd, peer.Address(ipFam), fmt.Sprint(peer.Port()))
+
+	// Print a marker on success, as commands without output are considered
+	// inconclusive and retried.
+	return []string{"sh", "-c", strings.Join(cmd, " ") + " </dev/null && echo \"SCTP association succeeded\""}
+}
+
 func (ct *ConnectivityTest) DigCommand(peer TestPeer, ipFam features.IPFamily) []string {
 	cmd := []string{"dig", "+time=2", "kubernetes"}
 
@@ -0,0 +1,217 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package check
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/cilium/cilium/cilium-cli/k8s"
+	"github.com/cilium/cilium/pkg/lock"
+)
+
+const (
+	// coverageClientCap bounds the number of additional per-node client
+	// deployments created by Parameters.RequireFullNodePairCoverage.
+	coverageClientCap = 10
+
+	// coverageClientNamePrefix is the name prefix of the additional
+	// per-node client deployments.
+	coverageClientNamePrefix = "client-coverage-"
+)
+
+// NodePairCoverage is the recorded pod-to-pod coverage of a single
+// (source node, destination node) pair.
+type NodePairCoverage struct {
+	SourceNode      string `json:"source_node"`
+	DestinationNode string `json:"destination_node"`
+	CrossZone       bool   `json:"cross_zone"`
+	Actions         int    `json:"actions"`
+}
+
+// nodePairKey identifies a (source node, destination node) pair.
+type nodePairKey struct {
+	src, dst string
+}
+
+// nodePairTracker records the node pairs exercised by pod-to-pod actions.
+// It is held by pointer so that ConnectivityTest remains copyable.
+type nodePairTracker struct {
+	mu    lock.Mutex
+	pairs map[nodePairKey]*NodePairCoverage
+}
+
+// recordNodePairCoverage records the node pair exercised by the Action, if
+// both peers are pods on known nodes.
+func (ct *ConnectivityTest) recordNodePairCoverage(a *Action) {
+	if ct.coverage == nil || a.src == nil {
+		return
+	}
+	var dstNode string
+	switch dst := a.dst.(type) {
+	case Pod:
+		dstNode = dst.NodeName()
+	case *Pod:
+		dstNode = dst.NodeName()
+	default:
+		return
+	}
+	srcNode := a.src.NodeName()
+	if srcNode == "" || dstNode == "" {
+		return
+	}
+
+	ct.coverage.mu.Lock()
+	defer ct.coverage.mu.Unlock()
+	if ct.coverage.pairs == nil {
+		ct.coverage.pairs = make(map[nodePairKey]*NodePairCoverage)
+	}
+	key := nodePairKey{src: srcNode, dst: dstNode}
+	entry, ok := ct.coverage.pairs[key]
+	if !ok {
+		entry = &NodePairCoverage{
+			SourceNode:      srcNode,
+			DestinationNode: dstNode,
+			CrossZone:       ct.crossesZones(srcNode, dstNode),
+		}
+		ct.coverage.pairs[key] = entry
+	}
+	entry.Actions++
+}
+
+// crossesZones returns true when the two nodes carry different
+// topology.kubernetes.io/zone labels.
+func (ct *ConnectivityTest) crossesZones(srcNode, dstNode string) bool {
+	src, dst := ct.nodes[srcNode], ct.nodes[dstNode]
+	if src == nil || dst == nil {
+		return false
+	}
+	srcZone, dstZone := src.Labels[corev1.LabelTopologyZone], dst.Labels[corev1.LabelTopologyZone]
+	return srcZone != "" && dstZone != "" && srcZone != dstZone
+}
+
+// NodePairCoverageMatrix returns the coverage of all (source node,
+// destination node) pairs between nodes running Cilium, including the
+// same-node pairs. Uncovered pairs have zero actions.
+func (ct *ConnectivityTest) NodePairCoverageMatrix() []NodePairCoverage {
+	if ct.coverage == nil {
+		return nil
+	}
+	var nodes []string
+	for name := range ct.nodes {
+		if _, ok := ct.nodesWithoutCilium[name]; ok {
+			continue
+		}
+		nodes = append(nodes, name)
+	}
+	slices.Sort(nodes)
+
+	ct.coverage.mu.Lock()
+	defer ct.coverage.mu.Unlock()
+	matrix := make([]NodePairCoverage, 0, len(nodes)*len(nodes))
+	for _, src := range nodes {
+		for _, dst := range nodes {
+			if entry, ok := ct.coverage.pairs[nodePairKey{src: src, dst: dst}]; ok {
+				matrix = append(matrix, *entry)
+				continue
+			}
+			matrix = append(matrix, NodePairCoverage{
+				SourceNode:      src,
+				DestinationNode: dst,
+				CrossZone:       ct.crossesZones(src, dst),
+			})
+		}
+	}
+	return matrix
+}
+
+// reportNodePairCoverage prints the matrix of covered and uncovered node
+// pairs at the end of the run.
+func (ct *ConnectivityTest) reportNodePairCoverage() {
+	if ct.coverage == nil {
+		return
+	}
+	ct.coverage.mu.Lock()
+	recorded := len(ct.coverage.pairs)
+	ct.coverage.mu.Unlock()
+	if recorded == 0 {
+		return
+	}
+
+	matrix := ct.NodePairCoverageMatrix()
+	covered := 0
+	for _, pair := range matrix {
+		if pair.Actions > 0 {
+			covered++
+		}
+	}
+
+	ct.Header(fmt.Sprintf("🗺️  Node Pair Coverage [%s]: %d/%d pairs covered", ct.params.TestNamespace, covered, len(matrix)))
+	for _, pair := range matrix {
+		zone := ""
+		if pair.CrossZone {
+			zone = " (cross-zone)"
+		}
+		if pair.Actions > 0 {
+			ct.Logf("  ✅ %s -> %s%s: %d actions", pair.SourceNode, pair.DestinationNode, zone, pair.Actions)
+		} else {
+			ct.Logf("  ❌ %s -> %s%s: not covered", pair.SourceNode, pair.DestinationNode, zone)
+		}
+	}
+}
+
+// deployCoverageClients creates an additional client deployment pinned to
+// each node, so that every (source node, destination node) pair can be
+// exercised, up to coverageClientCap deployments.
+func (ct *ConnectivityTest) deployCoverageClients(ctx context.Context) error {
+	var nodes []string
+	for name, node := range ct.nodes {
+		if _, ok := ct.nodesWithoutCilium[name]; ok {
+			continue
+		}
+		if node.Spec.Unschedulable {
+			continue
+		}
+		nodes = append(nodes, name)
+	}
+	slices.Sort(nodes)
+	if len(nodes) > coverageClientCap {
+		ct.Warnf("Limiting node pair coverage clients to %d of %d nodes", coverageClientCap, len(nodes))
+		nodes = nodes[:coverageClientCap]
+	}
+
+	for i, node := range nodes {
+		name := fmt.Sprintf("%s%d", coverageClientNamePrefix, i)
+		ct.coverageClientDeployments = append(ct.coverageClientDeployments, name)
+		_, err := ct.clients.src.GetDeployment(ctx, ct.params.TestNamespace, name, metav1.GetOptions{})
+		if err == nil {
+			continue
+		}
+		ct.Logf("✨ [%s] Deploying %s deployment on node %s...", ct.clients.src.ClusterName(), name, node)
+		deployment := newDeployment(deploymentParameters{
+			Name:        name,
+			Kind:        kindClientName,
+			Image:       ct.params.CurlImage,
+			Command:     []string{"/usr/bin/pause"},
+			Labels:      map[string]string{"other": "client-coverage"},
+			Annotations: ct.params.DeploymentAnnotations.Match(name),
+			NodeSelector: map[string]string{
+				corev1.LabelHostname: node,
+			},
+		})
+		_, err = ct.clients.src.CreateServiceAccount(ctx, ct.params.TestNamespace, k8s.NewServiceAccount(name), metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("unable to create service account %s: %w", name, err)
+		}
+		_, err = ct.clients.src.CreateDeployment(ctx, ct.params.TestNamespace, deployment, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("unable to create deployment %s: %w", name, err)
+		}
+	}
+	return nil
+}
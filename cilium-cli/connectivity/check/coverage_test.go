@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package check
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func coveragePod(node string) Pod {
+	return Pod{
+		Pod: &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "pod-" + node},
+			Spec:       corev1.PodSpec{NodeName: node},
+		},
+	}
+}
+
+func coverageNode(name, zone string) *corev1.Node {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if zone != "" {
+		node.Labels = map[string]string{corev1.LabelTopologyZone: zone}
+	}
+	return node
+}
+
+func TestNodePairCoverageMatrix(t *testing.T) {
+	ct := &ConnectivityTest{
+		nodes: map[string]*corev1.Node{
+			"node-a": coverageNode("node-a", "zone-1"),
+			"node-b": coverageNode("node-b", "zone-2"),
+			"node-c": coverageNode("node-c", ""),
+		},
+		nodesWithoutCilium: map[string]struct{}{"node-c": {}},
+		coverage:           &nodePairTracker{},
+	}
+
+	src := coveragePod("node-a")
+	dst := coveragePod("node-b")
+	ct.recordNodePairCoverage(&Action{src: &src, dst: dst})
+	ct.recordNodePairCoverage(&Action{src: &src, dst: dst})
+	ct.recordNodePairCoverage(&Action{src: &src, dst: src})
+	// Non-pod destinations are not recorded.
+	ct.recordNodePairCoverage(&Action{src: &src, dst: Service{}})
+
+	matrix := ct.NodePairCoverageMatrix()
+	require.Equal(t, []NodePairCoverage{
+		{SourceNode: "node-a", DestinationNode: "node-a", Actions: 1},
+		{SourceNode: "node-a", DestinationNode: "node-b", CrossZone: true, Actions: 2},
+		{SourceNode: "node-b", DestinationNode: "node-a", CrossZone: true},
+		{SourceNode: "node-b", DestinationNode: "node-b"},
+	}, matrix)
+}
@@ -630,7 +630,7 @@ func (ct *ConnectivityTest) deployNamespace(ctx context.Context) error {
 			}
 		}
 
-		_, err := client.GetNamespace(ctx, ct.params.TestNamespace, metav1.GetOptions{})
+		ns, err := client.GetNamespace(ctx, ct.params.TestNamespace, metav1.GetOptions{})
 		if err != nil {
 			ct.Logf("✨ [%s] Creating namespace %s for connectivity check...", client.ClusterName(), ct.params.TestNamespace)
 			namespace := &corev1.Namespace{
@@ -644,12 +644,52 @@ func (ct *ConnectivityTest) deployNamespace(ctx context.Context) error {
 			if err != nil {
 				return fmt.Errorf("unable to create namespace %s: %w", ct.params.TestNamespace, err)
 			}
+			continue
+		}
+
+		// The namespace existed before the suite ran. Apply the requested
+		// labels and annotations to it as well, so that admission policies
+		// treat it like a namespace the suite created, unless the user opted
+		// out of mutating pre-existing namespaces.
+		if ct.params.SkipNamespaceMutation {
+			continue
+		}
+		if mergeNamespaceMetadata(ns, ct.params.NamespaceLabels, ct.params.NamespaceAnnotations) {
+			ct.Logf("✨ [%s] Applying labels/annotations to existing namespace %s...", client.ClusterName(), ct.params.TestNamespace)
+			if _, err := client.UpdateNamespace(ctx, ns, metav1.UpdateOptions{}); err != nil {
+				return fmt.Errorf("unable to update namespace %s: %w", ct.params.TestNamespace, err)
+			}
 		}
 	}
 
 	return nil
 }
 
+// mergeNamespaceMetadata merges the given labels and annotations into the
+// namespace object and reports whether anything changed.
+func mergeNamespaceMetadata(ns *corev1.Namespace, lbls, annotations map[string]string) bool {
+	changed := false
+	for k, v := range lbls {
+		if ns.Labels[k] != v {
+			if ns.Labels == nil {
+				ns.Labels = map[string]string{}
+			}
+			ns.Labels[k] = v
+			changed = true
+		}
+	}
+	for k, v := range annotations {
+		if ns.Annotations[k] != v {
+			if ns.Annotations == nil {
+				ns.Annotations = map[string]string{}
+			}
+			ns.Annotations[k] = v
+			changed = true
+		}
+	}
+	return changed
+}
+
 // deploy ensures the test Namespace, Services and Deployments are running on the cluster.
 func (ct *ConnectivityTest) deploy(ctx context.Context) error {
 	if err := ct.deployNamespace(ctx); err != nil {
@@ -945,6 +985,12 @@ func (ct *ConnectivityTest) deploy(ctx context.Context) error {
 		}
 	}
 
+	if ct.params.RequireFullNodePairCoverage {
+		if err := ct.deployCoverageClients(ctx); err != nil {
+			return err
+		}
+	}
+
 	// 4th client scheduled on the control plane
 	if ct.params.K8sLocalHostTest {
 		ct.Logf("✨ [%s] Deploying %s deployment...", ct.clients.src.ClusterName(), clientCPDeployment)
@@ -1918,6 +1964,7 @@ func (ct *ConnectivityTest) deploymentList() (srcList []string, dstList []string
 	if ct.params.MultiCluster == "" && !ct.params.SingleNode {
 		srcList = append(srcList, client3DeploymentName)
 	}
+	srcList = append(srcList, ct.coverageClientDeployments...)
 
 	if ct.params.IncludeConnDisruptTest && ct.params.TestNamespaceIndex == 0 {
 		// We append the server and client deployment names to two different
@@ -1967,6 +2014,11 @@ func (ct *ConnectivityTest) deleteDeployments(ctx context.Context, client *k8s.C
 	_ = client.DeleteDeployment(ctx, ct.params.TestNamespace, client2DeploymentName, metav1.DeleteOptions{})
 	_ = client.DeleteDeployment(ctx, ct.params.TestNamespace, client3DeploymentName, metav1.DeleteOptions{})
 	_ = client.DeleteDeployment(ctx, ct.params.TestNamespace, socatClientDeploymentName, metav1.DeleteOptions{})
+	for i := range coverageClientCap {
+		name := fmt.Sprintf("%s%d", coverageClientNamePrefix, i)
+		_ = client.DeleteDeployment(ctx, ct.params.TestNamespace, name, metav1.DeleteOptions{})
+		_ = client.DeleteServiceAccount(ctx, ct.params.TestNamespace, name, metav1.DeleteOptions{})
+	}
 	_ = client.DeleteDeployment(ctx, ct.params.TestNamespace, socatServerDaemonsetName, metav1.DeleteOptions{}) // Q:Daemonset in here is OK?
 	_ = client.DeleteServiceAccount(ctx, ct.params.TestNamespace, echoSameNodeDeploymentName, metav1.DeleteOptions{})
 	_ = client.DeleteServiceAccount(ctx, ct.params.TestNamespace, echoOtherNodeDeploymentName, metav1.DeleteOptions{})
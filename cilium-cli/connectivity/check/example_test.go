@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package check_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/cilium/cilium/cilium-cli/api"
+	"github.com/cilium/cilium/cilium-cli/connectivity/check"
+	"github.com/cilium/cilium/cilium-cli/connectivity/tests"
+	"github.com/cilium/cilium/cilium-cli/k8s"
+)
+
+// Example demonstrates how to embed the connectivity suite into another
+// program: construct a ConnectivityTest with programmatic Parameters,
+// register a subset of tests, run them, and consume the structured Results
+// instead of parsing the CLI output.
+func Example() {
+	client, err := k8s.NewClient("", "", "kube-system", "", nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	params := check.Parameters{
+		CiliumNamespace: "kube-system",
+		TestNamespace:   "cilium-test-1",
+		FlowValidation:  check.FlowValidationModeDisabled,
+		Writer:          os.Stdout,
+	}
+
+	hooks := &api.NopHooks{}
+	ct, err := check.NewConnectivityTest(client, params, hooks, check.NewConcurrentLogger(os.Stdout), nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ct.AddTest(check.NewTest("pod-to-pod", params.Verbose, params.Debug)).
+		WithScenarios(tests.PodToPod())
+
+	ctx := context.Background()
+	if err := ct.SetupAndValidate(ctx, hooks); err != nil {
+		log.Fatal(err)
+	}
+	if err := ct.Run(ctx); err != nil {
+		log.Fatal(err)
+	}
+
+	results := ct.Results()
+	fmt.Printf("%d passed, %d failed, %d skipped\n", results.Passed(), results.Failed(), results.Skipped())
+	for _, test := range results.Tests {
+		if test.Status != check.TestStatusFailed {
+			continue
+		}
+		for _, failure := range test.Failures {
+			fmt.Printf("%s: %s\n", test.Name, failure.Name)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package check
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ExternalTarget is one entry from Parameters.ExternalTargetsFile, an
+// external destination with optional per-target annotations.
+type ExternalTarget struct {
+	// Name is the domain name or address of the target.
+	Name string
+
+	// Protocol restricts the scenarios to http or https requests. When
+	// empty, both protocols are exercised.
+	Protocol string
+
+	// Port overrides the default port of the protocol.
+	Port int
+
+	// DNSOnly indicates that the target is only expected to resolve;
+	// no requests are sent to it.
+	DNSOnly bool
+
+	// probeErr is the reason the ground-truth probe failed, empty when
+	// the probe passed or has not run.
+	probeErr string
+}
+
+// URL returns the target URL for the given protocol, honoring the port
+// override.
+func (t *ExternalTarget) URL(protocol string) string {
+	if t.Port > 0 {
+		return fmt.Sprintf("%s://%s:%d", protocol, t.Name, t.Port)
+	}
+	return protocol + "://" + t.Name
+}
+
+// ProbeReason returns why the ground-truth probe failed, or an empty string
+// if the target is usable.
+func (t *ExternalTarget) ProbeReason() string {
+	return t.probeErr
+}
+
+// parseExternalTargets reads one target per line, each optionally followed by
+// key=value annotations: protocol=http|https, port=<number> and
+// dns-only=true. Empty lines and lines starting with '#' are ignored.
+func parseExternalTargets(r io.Reader) ([]*ExternalTarget, error) {
+	var targets []*ExternalTarget
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		target := &ExternalTarget{Name: fields[0]}
+		for _, field := range fields[1:] {
+			key, value, ok := strings.Cut(field, "=")
+			if !ok {
+				return nil, fmt.Errorf("line %d: annotation %q is not of the form key=value", line, field)
+			}
+			switch key {
+			case "protocol":
+				if value != "http" && value != "https" {
+					return nil, fmt.Errorf("line %d: unsupported protocol %q", line, value)
+				}
+				target.Protocol = value
+			case "port":
+				port, err := strconv.Atoi(value)
+				if err != nil || port < 1 || port > 65535 {
+					return nil, fmt.Errorf("line %d: invalid port %q", line, value)
+				}
+				target.Port = port
+			case "dns-only":
+				dnsOnly, err := strconv.ParseBool(value)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: invalid dns-only value %q", line, value)
+				}
+				target.DNSOnly = dnsOnly
+			default:
+				return nil, fmt.Errorf("line %d: unknown annotation key %q", line, key)
+			}
+		}
+		targets = append(targets, target)
+	}
+
+	return targets, scanner.Err()
+}
+
+// loadExternalTargets populates ct.externalTargets from
+// Parameters.ExternalTargetsFile, if set.
+func (ct *ConnectivityTest) loadExternalTargets() error {
+	if ct.params.ExternalTargetsFile == "" {
+		return nil
+	}
+
+	f, err := os.Open(ct.params.ExternalTargetsFile)
+	if err != nil {
+		return fmt.Errorf("unable to open external targets file: %w", err)
+	}
+	defer f.Close()
+
+	targets, err := parseExternalTargets(f)
+	if err != nil {
+		return fmt.Errorf("unable to parse external targets file %s: %w", ct.params.ExternalTargetsFile, err)
+	}
+	ct.externalTargets = targets
+	return nil
+}
+
+// ExternalTargets returns the targets loaded from
+// Parameters.ExternalTargetsFile, including those whose ground-truth probe
+// failed. Scenarios iterating over the targets are expected to skip the
+// latter, logging ProbeReason.
+func (ct *ConnectivityTest) ExternalTargets() []*ExternalTarget {
+	return ct.externalTargets
+}
+
+// probeExternalTargets establishes ground truth for every external target by
+// resolving and probing it from a host network pod, outside the cluster's pod
+// datapath. A failing probe does not abort the run; the target is marked so
+// scenarios skip it with a logged reason.
+func (ct *ConnectivityTest) probeExternalTargets(ctx context.Context) error {
+	if len(ct.externalTargets) == 0 {
+		return nil
+	}
+
+	var probe Pod
+	found := false
+	for _, pod := range ct.hostNetNSPodsByNode {
+		probe = pod
+		found = true
+		break
+	}
+	if !found {
+		ct.Warn("No host network pod available, skipping external target ground-truth probes")
+		return nil
+	}
+
+	ct.Logf("🛫 Probing %d external targets from host network pod %s...", len(ct.externalTargets), probe.Name())
+	for _, target := range ct.externalTargets {
+		if _, err := probe.K8sClient.ExecInPod(ctx, probe.Pod.Namespace, probe.Pod.Name, "",
+			[]string{"nslookup", target.Name}); err != nil {
+			target.probeErr = fmt.Sprintf("DNS resolution failed: %s", err)
+			ct.Logf("  ❌ %s: %s", target.Name, target.probeErr)
+			continue
+		}
+		if target.DNSOnly {
+			ct.Logf("  ✅ %s (DNS only)", target.Name)
+			continue
+		}
+
+		protocol := target.Protocol
+		if protocol == "" {
+			protocol = "https"
+		}
+		url := target.URL(protocol)
+		cmd := []string{
+			"curl", "--silent", "--fail", "--show-error", "--output", "/dev/null",
+			"--connect-timeout", strconv.FormatFloat(ct.params.ConnectTimeout.Seconds(), 'f', -1, 64),
+			"--max-time", strconv.FormatFloat(ct.params.RequestTimeout.Seconds(), 'f', -1, 64),
+			url,
+		}
+		if _, err := probe.K8sClient.ExecInPod(ctx, probe.Pod.Namespace, probe.Pod.Name, "", cmd); err != nil {
+			target.probeErr = fmt.Sprintf("probe of %s failed: %s", url, err)
+			ct.Logf("  ❌ %s: %s", target.Name, target.probeErr)
+			continue
+		}
+		ct.Logf("  ✅ %s", target.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package check
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseExternalTargets(t *testing.T) {
+	input := `# internal targets
+one.one.one.one.
+registry.corp.example. protocol=https port=8443
+
+ntp.corp.example. dns-only=true
+proxy.corp.example. protocol=http
+`
+	targets, err := parseExternalTargets(strings.NewReader(input))
+	require.NoError(t, err)
+	require.Equal(t, []*ExternalTarget{
+		{Name: "one.one.one.one."},
+		{Name: "registry.corp.example.", Protocol: "https", Port: 8443},
+		{Name: "ntp.corp.example.", DNSOnly: true},
+		{Name: "proxy.corp.example.", Protocol: "http"},
+	}, targets)
+
+	for _, invalid := range []string{
+		"target.example. protocol=ftp",
+		"target.example. port=0",
+		"target.example. port=http",
+		"target.example. dns-only=maybe",
+		"target.example. frobnicate=true",
+		"target.example. protocol",
+	} {
+		_, err := parseExternalTargets(strings.NewReader(invalid))
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestExternalTargetURL(t *testing.T) {
+	target := &ExternalTarget{Name: "registry.corp.example."}
+	require.Equal(t, "https://registry.corp.example.", target.URL("https"))
+	target.Port = 8443
+	require.Equal(t, "https://registry.corp.example.:8443", target.URL("https"))
+}
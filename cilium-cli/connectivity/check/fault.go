@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package check
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// FaultInjector introduces faults between the actions of a connectivity test
+// to validate that connectivity recovers. Implementations are provided by
+// callers embedding the framework, for example to delete a pod or add
+// latency via tc. When no injector is configured the suite runs unchanged.
+type FaultInjector interface {
+	// Inject is called before an action runs and may introduce a fault.
+	// It returns a description of the injected fault and a function
+	// recovering from it, called once the action has completed. An empty
+	// fault description means no fault was injected for this action, and
+	// a nil recovery function means no recovery is required.
+	Inject(ctx context.Context, t *Test, action string) (fault string, recovery func(context.Context) error, err error)
+}
+
+// FaultInjection records a fault introduced by a FaultInjector and its
+// recovery outcome.
+type FaultInjection struct {
+	// Action is the name of the action the fault was injected before.
+	Action string
+
+	// Fault describes the injected fault.
+	Fault string
+
+	// Injected and Recovered are the times at which the fault was
+	// introduced and recovered from.
+	Injected  time.Time
+	Recovered time.Time
+
+	// Error holds the recovery error, if any.
+	Error string
+}
+
+func (f FaultInjection) String() string {
+	s := fmt.Sprintf("fault %q injected before %s, recovered after %v", f.Fault, f.Action, f.Recovered.Sub(f.Injected).Round(time.Millisecond))
+	if f.Error != "" {
+		s += fmt.Sprintf(" (recovery failed: %s)", f.Error)
+	}
+	return s
+}
+
+// maybeInjectFault asks the configured FaultInjector for a fault to introduce
+// before the action runs. It returns the recovery hook to invoke once the
+// action has completed, or nil when no injector is configured or no fault
+// applies to this action.
+func (a *Action) maybeInjectFault() func() {
+	injector := a.test.ctx.params.FaultInjector
+	if injector == nil {
+		return nil
+	}
+
+	ctx := context.TODO()
+	fault, recovery, err := injector.Inject(ctx, a.test, a.String())
+	if err != nil {
+		a.Failf("Fault injection failed: %s", err)
+		return nil
+	}
+	if fault == "" {
+		return nil
+	}
+
+	a.Logf("💥 Injected fault: %s", fault)
+	record := FaultInjection{
+		Action:   a.String(),
+		Fault:    fault,
+		Injected: time.Now(),
+	}
+
+	return func() {
+		if recovery != nil {
+			if err := recovery(ctx); err != nil {
+				record.Error = err.Error()
+				a.Failf("Fault recovery failed: %s", err)
+			}
+		}
+		record.Recovered = time.Now()
+		a.Logf("💥 Recovered from fault: %s", fault)
+		a.test.faultInjections = append(a.test.faultInjections, record)
+	}
+}
+
+// FaultInjections returns the faults injected while the test ran.
+func (t *Test) FaultInjections() []FaultInjection {
+	return t.faultInjections
+}
@@ -50,33 +50,65 @@ func (j *JUnitCollector) Collect(ct *ConnectivityTest) {
 	if j.testSuite.Timestamp == "" {
 		j.testSuite.Timestamp = ct.tests[0].startTime.Format("2006-01-02T15:04:05")
 	}
+	// Record the trace ID so the report can be correlated with the
+	// exported trace.
+	if id := ct.TraceID(); id != "" {
+		exists := false
+		for _, p := range j.testSuite.Properties.Properties {
+			if p.Name == "TraceID" {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			j.testSuite.Properties.Properties = append(j.testSuite.Properties.Properties,
+				junit.Property{Name: "TraceID", Value: id})
+		}
+	}
 	for _, t := range ct.tests {
+		result := t.Result()
 		test := &junit.TestCase{
-			Name:      t.Name(),
+			Name:      result.Name,
 			Classname: "connectivity test",
-			Status:    "passed",
-			Time:      t.completionTime.Sub(t.startTime).Seconds(),
+			Status:    string(result.Status),
+			Time:      result.Duration.Seconds(),
 		}
 		j.testSuite.Tests++
 		j.testSuite.Time += test.Time
 
-		if t.skipped {
-			test.Status = "skipped"
-			test.Skipped = &junit.Skipped{Message: t.Name() + " skipped"}
+		switch result.Status {
+		case TestStatusSkipped:
+			test.Skipped = &junit.Skipped{Message: result.Name + " skipped"}
 			j.testSuite.Skipped++
 			test.Time = 0
-		} else if t.failed {
-			test.Status = "failed"
-			test.Failure = &junit.Failure{Message: t.Name() + " failed", Type: "failure"}
+		case TestStatusFailed:
+			test.Failure = &junit.Failure{Message: result.Name + " failed", Type: "failure"}
 			j.testSuite.Failures++
 			msgs := []string{}
-			for _, a := range t.failedActions() {
-				owners := ct.GetOwners(a.Scenario())
-				msgs = append(msgs, fmt.Sprintf("%s%sOwners: %s", a, MetadataDelimiter, strings.Join(owners, ", ")))
+			for _, f := range result.Failures {
+				msg := fmt.Sprintf("%s%sIPFamily: %s, Owners: %s", f.Name, MetadataDelimiter, f.IPFamily, strings.Join(f.Owners, ", "))
+				for _, as := range f.Assertions {
+					msg += fmt.Sprintf("\nassertion %s", as)
+				}
+				msgs = append(msgs, msg)
 			}
 			test.Failure.Value = strings.Join(msgs, "\n")
 		}
 
+		var outLines []string
+		for _, u := range t.PodResourceUsage() {
+			outLines = append(outLines, u.String())
+		}
+		for _, f := range t.FaultInjections() {
+			outLines = append(outLines, f.String())
+		}
+		for _, p := range t.PolicyPropagations() {
+			outLines = append(outLines, p.String())
+		}
+		if len(outLines) > 0 {
+			test.SystemOut = strings.Join(outLines, "\n")
+		}
+
 		j.testSuite.TestCases = append(j.testSuite.TestCases, test)
 	}
 }
@@ -6,12 +6,15 @@ package check
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"os/signal"
 	"regexp"
 	"runtime"
+	"strings"
 	"syscall"
 	"time"
 
@@ -28,6 +31,67 @@ const (
 	testPrefix = "  "
 )
 
+// Log output formats accepted by --log-format.
+const (
+	// LogFormatEmoji is the default human-readable format with
+	// emoji-prefixed lines.
+	LogFormatEmoji = "emoji"
+	// LogFormatJSONL emits one JSON object per line for machine ingestion.
+	LogFormatJSONL = "jsonl"
+)
+
+// jsonlEntry is a single record of the stream emitted with
+// --log-format=jsonl.
+type jsonlEntry struct {
+	Level  string `json:"level"`
+	Test   string `json:"test,omitempty"`
+	Action string `json:"action,omitempty"`
+	Msg    string `json:"msg"`
+	Ts     string `json:"ts"`
+}
+
+// levelForPrefix maps the emoji prefixes of the human-readable format to the
+// level field of the jsonl format.
+func levelForPrefix(prefix string) string {
+	switch prefix {
+	case debug:
+		return "debug"
+	case warn:
+		return "warn"
+	case fail:
+		return "fail"
+	case fatal:
+		return "fatal"
+	default:
+		return "info"
+	}
+}
+
+// jsonlLine renders a single jsonl record, terminated by a newline.
+func jsonlLine(level, test, action, msg string) []byte {
+	out, err := json.Marshal(jsonlEntry{
+		Level:  level,
+		Test:   test,
+		Action: action,
+		Msg:    strings.TrimRight(msg, "\n"),
+		Ts:     time.Now().Format(time.RFC3339),
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal log record: %w", err))
+	}
+	return append(out, '\n')
+}
+
+// jsonl reports whether the machine-readable jsonl log format is enabled.
+func (ct *ConnectivityTest) jsonl() bool {
+	return ct.params.LogFormat == LogFormatJSONL
+}
+
+// logJSONL emits a suite-level jsonl record.
+func (ct *ConnectivityTest) logJSONL(level string, a ...interface{}) {
+	mustWrite(ct.params.Writer, jsonlLine(level, "", "", fmt.Sprintln(a...)))
+}
+
 // Logger abstracts the logging functionalities implemented by the
 // test suite, individual tests and actions.
 type Logger interface {
@@ -59,12 +123,20 @@ var _ Logger = (*Action)(nil)
 
 // Header prints a newline followed by a formatted message.
 func (ct *ConnectivityTest) Header(a ...interface{}) {
+	if ct.jsonl() {
+		ct.logJSONL("info", a...)
+		return
+	}
 	fmt.Fprintln(ct.params.Writer, "")
 	fmt.Fprintln(ct.params.Writer, a...)
 }
 
 // Headerf prints a newline followed by a formatted message.
 func (ct *ConnectivityTest) Headerf(format string, a ...interface{}) {
+	if ct.jsonl() {
+		ct.logJSONL("info", fmt.Sprintf(format+"\n", a...))
+		return
+	}
 	fmt.Fprintf(ct.params.Writer, "\n"+format+"\n", a...)
 }
 
@@ -77,6 +149,10 @@ func (ct *ConnectivityTest) Timestamp() {
 
 // Log logs a message.
 func (ct *ConnectivityTest) Log(a ...interface{}) {
+	if ct.jsonl() {
+		ct.logJSONL("info", a...)
+		return
+	}
 	ct.Timestamp()
 	fmt.Fprintln(ct.params.Writer, a...)
 }
@@ -188,6 +264,10 @@ func (ct *ConnectivityTest) LogOwners(scenarios ...ownedScenario) {
 
 // Logf logs a formatted message.
 func (ct *ConnectivityTest) Logf(format string, a ...interface{}) {
+	if ct.jsonl() {
+		ct.logJSONL("info", fmt.Sprintf(format+"\n", a...))
+		return
+	}
 	ct.Timestamp()
 	fmt.Fprintf(ct.params.Writer, format+"\n", a...)
 }
@@ -195,6 +275,10 @@ func (ct *ConnectivityTest) Logf(format string, a ...interface{}) {
 // Debug logs a debug message.
 func (ct *ConnectivityTest) Debug(a ...interface{}) {
 	if ct.debug() {
+		if ct.jsonl() {
+			ct.logJSONL("debug", a...)
+			return
+		}
 		ct.Timestamp()
 		fmt.Fprint(ct.params.Writer, debug+" ")
 		fmt.Fprintln(ct.params.Writer, a...)
@@ -204,6 +288,10 @@ func (ct *ConnectivityTest) Debug(a ...interface{}) {
 // Debugf logs a formatted debug message.
 func (ct *ConnectivityTest) Debugf(format string, a ...interface{}) {
 	if ct.debug() {
+		if ct.jsonl() {
+			ct.logJSONL("debug", fmt.Sprintf(format+"\n", a...))
+			return
+		}
 		ct.Timestamp()
 		fmt.Fprint(ct.params.Writer, debug+" ")
 		fmt.Fprintf(ct.params.Writer, format+"\n", a...)
@@ -212,6 +300,10 @@ func (ct *ConnectivityTest) Debugf(format string, a ...interface{}) {
 
 // Info logs an informational message.
 func (ct *ConnectivityTest) Info(a ...interface{}) {
+	if ct.jsonl() {
+		ct.logJSONL("info", a...)
+		return
+	}
 	ct.Timestamp()
 	fmt.Fprint(ct.params.Writer, info+" ")
 	fmt.Fprintln(ct.params.Writer, a...)
@@ -219,13 +311,42 @@ func (ct *ConnectivityTest) Info(a ...interface{}) {
 
 // Infof logs a formatted informational message.
 func (ct *ConnectivityTest) Infof(format string, a ...interface{}) {
+	if ct.jsonl() {
+		ct.logJSONL("info", fmt.Sprintf(format+"\n", a...))
+		return
+	}
 	ct.Timestamp()
 	fmt.Fprint(ct.params.Writer, info+" ")
 	fmt.Fprintf(ct.params.Writer, format+"\n", a...)
 }
 
+// Pause prints the given reason and waits for the user to press the Enter
+// key or interrupt the run, allowing cluster state to be inspected
+// mid-scenario. It is a no-op unless --pause is given, so scenarios
+// instrumented for interactive debugging do not block CI runs.
+func (ct *ConnectivityTest) Pause(reason string) {
+	if !ct.params.Pause {
+		return
+	}
+	ct.Infof("Pausing (%s), press the Enter key to continue:", reason)
+	cont := make(chan struct{})
+	go func() {
+		fmt.Scanln()
+		close(cont)
+	}()
+	ctx, _ := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	select {
+	case <-cont:
+	case <-ctx.Done():
+	}
+}
+
 // Warn logs a warning message.
 func (ct *ConnectivityTest) Warn(a ...interface{}) {
+	if ct.jsonl() {
+		ct.logJSONL("warn", a...)
+		return
+	}
 	ct.Timestamp()
 	fmt.Fprint(ct.params.Writer, warn+" ")
 	fmt.Fprintln(ct.params.Writer, a...)
@@ -233,6 +354,10 @@ func (ct *ConnectivityTest) Warn(a ...interface{}) {
 
 // Warnf logs a formatted warning message.
 func (ct *ConnectivityTest) Warnf(format string, a ...interface{}) {
+	if ct.jsonl() {
+		ct.logJSONL("warn", fmt.Sprintf(format+"\n", a...))
+		return
+	}
 	ct.Timestamp()
 	fmt.Fprint(ct.params.Writer, warn+" ")
 	fmt.Fprintf(ct.params.Writer, format+"\n", a...)
@@ -240,6 +365,10 @@ func (ct *ConnectivityTest) Warnf(format string, a ...interface{}) {
 
 // Fail logs a failure message.
 func (ct *ConnectivityTest) Fail(a ...interface{}) {
+	if ct.jsonl() {
+		ct.logJSONL("fail", a...)
+		return
+	}
 	ct.Timestamp()
 	fmt.Fprint(ct.params.Writer, fail+" ")
 	fmt.Fprintln(ct.params.Writer, a...)
@@ -247,6 +376,10 @@ func (ct *ConnectivityTest) Fail(a ...interface{}) {
 
 // Failf logs a formatted failure message.
 func (ct *ConnectivityTest) Failf(format string, a ...interface{}) {
+	if ct.jsonl() {
+		ct.logJSONL("fail", fmt.Sprintf(format+"\n", a...))
+		return
+	}
 	ct.Timestamp()
 	fmt.Fprint(ct.params.Writer, fail+" ")
 	fmt.Fprintf(ct.params.Writer, format+"\n", a...)
@@ -254,6 +387,10 @@ func (ct *ConnectivityTest) Failf(format string, a ...interface{}) {
 
 // Fatal logs an error.
 func (ct *ConnectivityTest) Fatal(a ...interface{}) {
+	if ct.jsonl() {
+		ct.logJSONL("fatal", a...)
+		return
+	}
 	ct.Timestamp()
 	fmt.Fprint(ct.params.Writer, fatal+" ")
 	fmt.Fprintln(ct.params.Writer, a...)
@@ -261,6 +398,10 @@ func (ct *ConnectivityTest) Fatal(a ...interface{}) {
 
 // Fatalf logs a formatted error.
 func (ct *ConnectivityTest) Fatalf(format string, a ...interface{}) {
+	if ct.jsonl() {
+		ct.logJSONL("fatal", fmt.Sprintf(format+"\n", a...))
+		return
+	}
 	ct.Timestamp()
 	fmt.Fprint(ct.params.Writer, fatal+" ")
 	fmt.Fprintf(ct.params.Writer, format+"\n", a...)
@@ -275,8 +416,9 @@ func (ct *ConnectivityTest) Fatalf(format string, a ...interface{}) {
 
 // log takes out a read lock and logs a message to the Test's internal buffer.
 // If the internal log buffer is nil, write to user-specified writer instead.
-// Prefix is an optional prefix to the message.
-func (t *Test) log(prefix string, a ...interface{}) {
+// Prefix is an optional prefix to the message, action optionally attributes
+// the message to a scenario action.
+func (t *Test) log(prefix, action string, a ...interface{}) {
 	t.logMu.RLock()
 	defer t.logMu.RUnlock()
 
@@ -285,6 +427,13 @@ func (t *Test) log(prefix string, a ...interface{}) {
 		b = t.ctx.params.Writer
 	}
 
+	if t.ctx.jsonl() {
+		// Each line in the buffer is a complete JSON record, so a flush
+		// still emits discrete records.
+		mustWrite(b, jsonlLine(levelForPrefix(prefix), t.Name(), action, fmt.Sprintln(a...)))
+		return
+	}
+
 	if t.ctx.timestamp() {
 		fmt.Fprint(b, timestamp())
 	}
@@ -303,7 +452,7 @@ func (t *Test) log(prefix string, a ...interface{}) {
 // logf takes out a read lock and logs a formatted message to the Test's
 // internal buffer. If the internal log buffer is nil, write to user-specified
 // writer instead.
-func (t *Test) logf(format string, a ...interface{}) {
+func (t *Test) logf(prefix, action, format string, a ...interface{}) {
 	t.logMu.RLock()
 	defer t.logMu.RUnlock()
 
@@ -312,10 +461,18 @@ func (t *Test) logf(format string, a ...interface{}) {
 		b = t.ctx.params.Writer
 	}
 
+	if t.ctx.jsonl() {
+		mustWrite(b, jsonlLine(levelForPrefix(prefix), t.Name(), action, fmt.Sprintf(format+"\n", a...)))
+		return
+	}
+
 	if t.ctx.timestamp() {
 		fmt.Fprint(b, timestamp())
 	}
 
+	if prefix != "" {
+		format = prefix + " " + format
+	}
 	fmt.Fprintf(b, testPrefix+format+"\n", a...)
 }
 
@@ -329,8 +486,11 @@ func (t *Test) flush() {
 		return
 	}
 
-	// Terminate progress so far.
-	fmt.Fprintln(t.ctx.params.Writer)
+	// Terminate progress so far. The jsonl stream carries no progress
+	// indicators that would need a terminating newline.
+	if !t.ctx.jsonl() {
+		fmt.Fprintln(t.ctx.params.Writer)
+	}
 
 	buf := &bytes.Buffer{}
 	if _, err := io.Copy(buf, t.logBuf); err != nil {
@@ -344,44 +504,44 @@ func (t *Test) flush() {
 
 // Log logs a message.
 func (t *Test) Log(a ...interface{}) {
-	t.log("", a...)
+	t.log("", "", a...)
 }
 
 // Logf logs a formatted message.
 func (t *Test) Logf(format string, a ...interface{}) {
-	t.logf(format, a...)
+	t.logf("", "", format, a...)
 }
 
 // Debug logs a debug message.
 func (t *Test) Debug(a ...interface{}) {
 	if t.ctx.debug() {
-		t.log(debug, a...)
+		t.log(debug, "", a...)
 	}
 }
 
 // Debugf logs a formatted debug message.
 func (t *Test) Debugf(format string, a ...interface{}) {
 	if t.ctx.debug() {
-		t.logf(debug+" "+format, a...)
+		t.logf(debug, "", format, a...)
 	}
 }
 
 // Info logs an informational message.
 func (t *Test) Info(a ...interface{}) {
-	t.log(info, a...)
+	t.log(info, "", a...)
 }
 
 // Infof logs a formatted informational message.
 func (t *Test) Infof(format string, a ...interface{}) {
-	t.logf(info+" "+format, a...)
+	t.logf(info, "", format, a...)
 }
 
-func (t *Test) failCommon() {
+func (t *Test) failCommon(msg string) {
 	alreadyFailed := t.failed
 	t.failed = true
 	t.flush()
 	if t.ctx.params.PauseOnFail {
-		t.log("Pausing after action failure, press the Enter key to continue:")
+		t.log("", "", "Pausing after action failure, press the Enter key to continue:")
 		cont := make(chan struct{})
 		go func() {
 			fmt.Scanln()
@@ -393,19 +553,44 @@ func (t *Test) failCommon() {
 		case <-ctx.Done():
 		}
 	}
-	if t.ctx.params.CollectSysdumpOnFailure &&
-		(t.sysdumpPolicy == SysdumpPolicyEach || (t.sysdumpPolicy == SysdumpPolicyOnce && !alreadyFailed)) {
+	if !alreadyFailed {
+		t.logAgentState()
+	}
+	if t.ctx.params.CollectSysdumpOnFailure && t.shouldCollectSysdump(alreadyFailed, msg) {
 		t.collectSysdump()
 	}
 }
 
+// shouldCollectSysdump returns true if the test's sysdump policy requests a
+// sysdump for the given failure.
+func (t *Test) shouldCollectSysdump(alreadyFailed bool, msg string) bool {
+	switch t.sysdumpPolicy {
+	case SysdumpPolicyEach:
+		return true
+	case SysdumpPolicyOnce:
+		return !alreadyFailed
+	case SysdumpPolicyOncePerScenario:
+		scenario := ""
+		if t.currentScenario != nil {
+			scenario = t.scenarioName(t.currentScenario)
+		}
+		key := fmt.Sprintf("%s/%x", scenario, sha256.Sum256([]byte(msg)))
+		if _, ok := t.sysdumpsCollected[key]; ok {
+			return false
+		}
+		t.sysdumpsCollected[key] = struct{}{}
+		return true
+	}
+	return false
+}
+
 // Fail marks the Test as failed and logs a failure message.
 //
 // Flushes the Test's internal log buffer. Any further logs against the Test
 // will go directly to the user-specified writer.
 func (t *Test) Fail(a ...interface{}) {
-	t.log(fail, a...)
-	t.failCommon()
+	t.log(fail, "", a...)
+	t.failCommon(fmt.Sprint(a...))
 }
 
 // Failf marks the Test as failed and logs a formatted failure message.
@@ -413,23 +598,23 @@ func (t *Test) Fail(a ...interface{}) {
 // Flushes the Test's internal log buffer. Any further logs against the Test
 // will go directly to the user-specified writer.
 func (t *Test) Failf(format string, a ...interface{}) {
-	t.logf(fail+" "+format, a...)
-	t.failCommon()
+	t.logf(fail, "", format, a...)
+	t.failCommon(fmt.Sprintf(format, a...))
 }
 
 // Fatal marks the test as failed, logs an error and exits the
 // calling goroutine.
 func (t *Test) Fatal(a ...interface{}) {
-	t.log(fatal, a...)
-	t.failCommon()
+	t.log(fatal, "", a...)
+	t.failCommon(fmt.Sprint(a...))
 	runtime.Goexit()
 }
 
 // Fatalf marks the test as failed, logs a formatted error and exits the
 // calling goroutine.
 func (t *Test) Fatalf(format string, a ...interface{}) {
-	t.logf(fatal+" "+format, a...)
-	t.failCommon()
+	t.logf(fatal, "", format, a...)
+	t.failCommon(fmt.Sprintf(format, a...))
 	runtime.Goexit()
 }
 
@@ -439,60 +624,66 @@ func (t *Test) Fatalf(format string, a ...interface{}) {
 
 // Log logs a message.
 func (a *Action) Log(s ...interface{}) {
-	a.test.Log(s...)
+	a.test.log("", a.name, s...)
 }
 
 // Logf logs a formatted message.
 func (a *Action) Logf(format string, s ...interface{}) {
-	a.test.Logf(format, s...)
+	a.test.logf("", a.name, format, s...)
 }
 
 // Debug logs a debug message.
 func (a *Action) Debug(s ...interface{}) {
 	if a.test.ctx.debug() {
-		a.test.Debug(s...)
+		a.test.log(debug, a.name, s...)
 	}
 }
 
 // Debugf logs a formatted debug message.
 func (a *Action) Debugf(format string, s ...interface{}) {
 	if a.test.ctx.debug() {
-		a.test.Debugf(format, s...)
+		a.test.logf(debug, a.name, format, s...)
 	}
 }
 
 // Info logs a debug message.
 func (a *Action) Info(s ...interface{}) {
-	a.test.Info(s...)
+	a.test.log(info, a.name, s...)
 }
 
 // Infof logs a formatted debug message.
 func (a *Action) Infof(format string, s ...interface{}) {
-	a.test.Infof(format, s...)
+	a.test.logf(info, a.name, format, s...)
 }
 
 // Fail must be called when the Action is unsuccessful.
 func (a *Action) Fail(s ...interface{}) {
 	a.fail()
-	a.test.Fail(s...)
+	a.test.log(fail, a.name, s...)
+	a.test.failCommon(fmt.Sprint(s...))
 }
 
 // Failf must be called when the Action is unsuccessful.
 func (a *Action) Failf(format string, s ...interface{}) {
 	a.fail()
-	a.test.Failf(format, s...)
+	a.test.logf(fail, a.name, format, s...)
+	a.test.failCommon(fmt.Sprintf(format, s...))
 }
 
 // Fatal must be called when an irrecoverable error was encountered during the Action.
 func (a *Action) Fatal(s ...interface{}) {
 	a.fail()
-	a.test.Fatal(s...)
+	a.test.log(fatal, a.name, s...)
+	a.test.failCommon(fmt.Sprint(s...))
+	runtime.Goexit()
 }
 
 // Fatalf must be called when an irrecoverable error was encountered during the Action.
 func (a *Action) Fatalf(format string, s ...interface{}) {
 	a.fail()
-	a.test.Fatalf(format, s...)
+	a.test.logf(fatal, a.name, format, s...)
+	a.test.failCommon(fmt.Sprintf(format, s...))
+	runtime.Goexit()
 }
 
 func timestamp() string {
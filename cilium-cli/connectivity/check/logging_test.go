@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package check
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func parseJSONLLines(t *testing.T, out string) []jsonlEntry {
+	t.Helper()
+
+	var entries []jsonlEntry
+	for _, line := range strings.Split(strings.TrimRight(out, "\n"), "\n") {
+		var e jsonlEntry
+		require.NoError(t, json.Unmarshal([]byte(line), &e), "line %q is not valid JSON", line)
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+func TestJSONLLogFormat(t *testing.T) {
+	buf := &bytes.Buffer{}
+	ct := &ConnectivityTest{
+		params: Parameters{
+			Writer:    buf,
+			LogFormat: LogFormatJSONL,
+			// The per-record ts field replaces the timestamp prefix; a
+			// prefix would corrupt the stream.
+			Timestamp: true,
+		},
+	}
+
+	ct.Info("hello", "world")
+	ct.Warnf("count %d", 3)
+	ct.Fail("broken")
+
+	entries := parseJSONLLines(t, buf.String())
+	require.Len(t, entries, 3)
+
+	require.Equal(t, "info", entries[0].Level)
+	require.Equal(t, "hello world", entries[0].Msg)
+	require.NotEmpty(t, entries[0].Ts)
+	require.Empty(t, entries[0].Test)
+
+	require.Equal(t, "warn", entries[1].Level)
+	require.Equal(t, "count 3", entries[1].Msg)
+
+	require.Equal(t, "fail", entries[2].Level)
+	require.Equal(t, "broken", entries[2].Msg)
+}
+
+func TestJSONLFlushDiscreteRecords(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewConcurrentLogger(buf)
+	logger.Start()
+
+	ct := &ConnectivityTest{
+		params: Parameters{Writer: buf, LogFormat: LogFormatJSONL},
+		logger: logger,
+	}
+	test := &Test{
+		ctx:    ct,
+		name:   "test-1",
+		logBuf: &bytes.Buffer{},
+	}
+	action := &Action{test: test, name: "curl-0"}
+
+	// Messages are buffered until the test is flushed on failure.
+	test.Info("buffered one")
+	action.Logf("buffered %s", "two")
+	require.Empty(t, buf.String())
+
+	test.flush()
+	logger.FinishTest(test)
+	logger.Stop()
+
+	// The buffered lines must come out as discrete JSON records, not as
+	// one blob.
+	entries := parseJSONLLines(t, buf.String())
+	require.Len(t, entries, 2)
+
+	require.Equal(t, "info", entries[0].Level)
+	require.Equal(t, "test-1", entries[0].Test)
+	require.Equal(t, "buffered one", entries[0].Msg)
+
+	require.Equal(t, "test-1", entries[1].Test)
+	require.Equal(t, "curl-0", entries[1].Action)
+	require.Equal(t, "buffered two", entries[1].Msg)
+}
@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package check
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultPingCount is the number of ICMP echo requests sent by Ping when the
+// requirements do not specify one.
+const defaultPingCount = 5
+
+// PingRequirements configure a ping-based reachability Action. As every
+// Action runs against a single IP family, callers can express per-family
+// loss thresholds by passing different requirements per family.
+type PingRequirements struct {
+	// Count is the number of ICMP echo requests to send. Defaults to
+	// defaultPingCount when left zero.
+	Count int
+
+	// MaxLossPct is the maximum percentage of lost echo requests tolerated
+	// before the Action is failed.
+	MaxLossPct float64
+
+	// ExternalTarget indicates that the destination is outside the cluster
+	// and ICMP may legitimately be filtered on the path. Total loss is then
+	// recorded as indeterminate by skipping the Action instead of failing
+	// it.
+	ExternalTarget bool
+}
+
+// pingStats are the statistics parsed from the summary printed by ping.
+type pingStats struct {
+	Transmitted int
+	Received    int
+	LossPct     float64
+
+	// RTT statistics, zero when no replies were received.
+	RTTMin time.Duration
+	RTTAvg time.Duration
+	RTTMax time.Duration
+}
+
+var (
+	// Both the iputils ("5 received, 0% packet loss, time 4005ms") and the
+	// BusyBox ("5 packets received, 0% packet loss") summary formats are
+	// matched.
+	pingSummaryPattern = regexp.MustCompile(`(\d+) packets transmitted, (\d+) (?:packets )?received.*?([\d.]+)% packet loss`)
+	pingRTTPattern     = regexp.MustCompile(`(?:rtt|round-trip) min/avg/max(?:/mdev)? = ([\d.]+)/([\d.]+)/([\d.]+)`)
+)
+
+// parsePingStats extracts the transmission summary and RTT statistics from
+// the output of ping.
+func parsePingStats(output string) (pingStats, error) {
+	var stats pingStats
+
+	m := pingSummaryPattern.FindStringSubmatch(output)
+	if m == nil {
+		return stats, fmt.Errorf("no ping summary found in output")
+	}
+
+	var err error
+	if stats.Transmitted, err = strconv.Atoi(m[1]); err != nil {
+		return stats, fmt.Errorf("invalid transmitted count %q: %w", m[1], err)
+	}
+	if stats.Received, err = strconv.Atoi(m[2]); err != nil {
+		return stats, fmt.Errorf("invalid received count %q: %w", m[2], err)
+	}
+	if stats.LossPct, err = strconv.ParseFloat(m[3], 64); err != nil {
+		return stats, fmt.Errorf("invalid loss percentage %q: %w", m[3], err)
+	}
+
+	if m := pingRTTPattern.FindStringSubmatch(output); m != nil {
+		ms := func(s string) time.Duration {
+			v, _ := strconv.ParseFloat(s, 64)
+			return time.Duration(v * float64(time.Millisecond))
+		}
+		stats.RTTMin, stats.RTTAvg, stats.RTTMax = ms(m[1]), ms(m[2]), ms(m[3])
+	}
+
+	return stats, nil
+}
+
+// Ping sends ICMP echo requests from the Action's source pod to the peer and
+// fails the Action when the measured packet loss exceeds the threshold given
+// in the requirements. The expected EchoRequest/EchoReply (or drop) flows are
+// validated through the regular GetEgressRequirements and
+// GetIngressRequirements with Protocol: ICMP.
+func (a *Action) Ping(ctx context.Context, peer TestPeer, req PingRequirements) {
+	if a.src == nil {
+		a.Fatalf("No source Pod to ping from")
+	}
+
+	count := req.Count
+	if count <= 0 {
+		count = defaultPingCount
+	}
+
+	// The trailing -c overrides the single echo request sent by default.
+	cmd := a.test.ctx.PingCommand(peer, a.ipFam, "-c", strconv.Itoa(count))
+
+	a.Debug("Executing command", cmd)
+	output, errOutput, err := a.src.K8sClient.ExecInPodWithStderr(ctx,
+		a.src.Pod.Namespace, a.src.Pod.Name, a.src.Pod.Labels["name"], cmd)
+	a.cmdOutput = output.String()
+
+	stats, parseErr := parsePingStats(output.String())
+	if parseErr != nil {
+		if req.ExternalTarget {
+			a.Skip(fmt.Sprintf("ICMP to external target %s indeterminate: %s", peer.Name(), parseErr))
+			return
+		}
+		a.Failf("command %q failed: %s: %s %s", strings.Join(cmd, " "), parseErr,
+			strings.TrimSpace(errOutput.String()), err)
+		return
+	}
+
+	if stats.Received == 0 && req.ExternalTarget {
+		a.Skip(fmt.Sprintf("ICMP to external target %s appears filtered (%d/%d replies)",
+			peer.Name(), stats.Received, stats.Transmitted))
+		return
+	}
+
+	if stats.LossPct > req.MaxLossPct {
+		a.Failf("%.0f%% packet loss pinging %s exceeds the %.0f%% threshold (%d/%d replies)",
+			stats.LossPct, peer.Name(), req.MaxLossPct, stats.Received, stats.Transmitted)
+		return
+	}
+
+	a.Logf("🏓 ping %s: %d/%d replies (%.0f%% loss), rtt min/avg/max = %s/%s/%s",
+		peer.Name(), stats.Received, stats.Transmitted, stats.LossPct,
+		stats.RTTMin, stats.RTTAvg, stats.RTTMax)
+}
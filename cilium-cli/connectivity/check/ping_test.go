@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package check
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePingStatsIputils(t *testing.T) {
+	out := `PING 10.0.0.1 (10.0.0.1) 56(84) bytes of data.
+64 bytes from 10.0.0.1: icmp_seq=1 ttl=63 time=0.254 ms
+
+--- 10.0.0.1 ping statistics ---
+5 packets transmitted, 4 received, 20% packet loss, time 4005ms
+rtt min/avg/max/mdev = 0.102/0.254/0.512/0.131 ms`
+
+	stats, err := parsePingStats(out)
+	require.NoError(t, err)
+	require.Equal(t, 5, stats.Transmitted)
+	require.Equal(t, 4, stats.Received)
+	require.InDelta(t, 20.0, stats.LossPct, 0.001)
+	require.Equal(t, 102*time.Microsecond, stats.RTTMin)
+	require.Equal(t, 254*time.Microsecond, stats.RTTAvg)
+	require.Equal(t, 512*time.Microsecond, stats.RTTMax)
+}
+
+func TestParsePingStatsBusybox(t *testing.T) {
+	out := `PING fd00::1 (fd00::1): 56 data bytes
+
+--- fd00::1 ping statistics ---
+3 packets transmitted, 3 packets received, 0% packet loss
+round-trip min/avg/max = 0.072/0.112/0.230 ms`
+
+	stats, err := parsePingStats(out)
+	require.NoError(t, err)
+	require.Equal(t, 3, stats.Transmitted)
+	require.Equal(t, 3, stats.Received)
+	require.Zero(t, stats.LossPct)
+	require.Equal(t, 112*time.Microsecond, stats.RTTAvg)
+}
+
+func TestParsePingStatsTotalLoss(t *testing.T) {
+	out := `PING 192.0.2.1 (192.0.2.1) 56(84) bytes of data.
+
+--- 192.0.2.1 ping statistics ---
+5 packets transmitted, 0 received, 100% packet loss, time 4096ms`
+
+	stats, err := parsePingStats(out)
+	require.NoError(t, err)
+	require.Equal(t, 5, stats.Transmitted)
+	require.Zero(t, stats.Received)
+	require.InDelta(t, 100.0, stats.LossPct, 0.001)
+	require.Zero(t, stats.RTTAvg)
+}
+
+func TestParsePingStatsNoSummary(t *testing.T) {
+	_, err := parsePingStats("ping: sendmsg: Operation not permitted")
+	require.Error(t, err)
+}
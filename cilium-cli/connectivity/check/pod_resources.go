@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package check
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// podResourceSample is a point-in-time reading of a pod's cumulative CPU time
+// and current memory usage, taken from the pod's cgroup.
+type podResourceSample struct {
+	cpuUsage time.Duration
+	memory   int64
+}
+
+// PodResourceUsage describes the resources a pod consumed over the duration
+// of a single test.
+type PodResourceUsage struct {
+	// Pod is the namespaced name of the pod.
+	Pod string
+
+	// CPUUsage is the CPU time the pod consumed during the test.
+	CPUUsage time.Duration
+
+	// MemoryDelta is the change in the pod's current memory usage between
+	// the start and the end of the test, in bytes. Negative when the pod
+	// released memory.
+	MemoryDelta int64
+}
+
+func (u PodResourceUsage) String() string {
+	return fmt.Sprintf("%s: cpu +%v, memory %+d KiB", u.Pod, u.CPUUsage, u.MemoryDelta/1024)
+}
+
+// parseCgroupStats parses the concatenated contents of a pod's cpu.stat and
+// memory.current cgroup v2 files.
+func parseCgroupStats(out string) (podResourceSample, error) {
+	var (
+		sample   podResourceSample
+		foundCPU bool
+		foundMem bool
+	)
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		fields := strings.Fields(line)
+		switch {
+		case len(fields) == 2 && fields[0] == "usage_usec":
+			usec, err := strconv.ParseInt(fields[1], 10, 64)
+			if err != nil {
+				return sample, fmt.Errorf("parsing usage_usec: %w", err)
+			}
+			sample.cpuUsage = time.Duration(usec) * time.Microsecond
+			foundCPU = true
+		case len(fields) == 1:
+			// memory.current consists of a single value in bytes.
+			mem, err := strconv.ParseInt(fields[0], 10, 64)
+			if err != nil {
+				continue
+			}
+			sample.memory = mem
+			foundMem = true
+		}
+	}
+	if !foundCPU || !foundMem {
+		return sample, fmt.Errorf("incomplete cgroup statistics: %q", out)
+	}
+	return sample, nil
+}
+
+// samplePodResources reads the current CPU and memory usage of all client and
+// echo pods from their cgroup. Pods that cannot be sampled, for example
+// because the image lacks a shell utility or the node does not use cgroup v2,
+// are skipped with a debug message.
+func (t *Test) samplePodResources(ctx context.Context) map[string]podResourceSample {
+	samples := make(map[string]podResourceSample)
+	for _, pods := range []map[string]Pod{t.Context().ClientPods(), t.Context().EchoPods()} {
+		for _, pod := range pods {
+			cmd := []string{"cat", "/sys/fs/cgroup/cpu.stat", "/sys/fs/cgroup/memory.current"}
+			out, err := pod.K8sClient.ExecInPod(ctx, pod.Pod.Namespace, pod.Pod.Name, "", cmd)
+			if err != nil {
+				t.Debugf("Unable to read cgroup statistics from pod %s: %s", pod.Name(), err)
+				continue
+			}
+			sample, err := parseCgroupStats(out.String())
+			if err != nil {
+				t.Debugf("Unable to parse cgroup statistics from pod %s: %s", pod.Name(), err)
+				continue
+			}
+			samples[pod.Name()] = sample
+		}
+	}
+	return samples
+}
+
+// collectPodResourceUsage takes a second resource sample of the pods sampled
+// at the start of the test and computes the per-pod deltas.
+func (t *Test) collectPodResourceUsage(ctx context.Context) {
+	if t.startResources == nil {
+		return
+	}
+	end := t.samplePodResources(ctx)
+	for pod, start := range t.startResources {
+		e, ok := end[pod]
+		if !ok {
+			continue
+		}
+		t.resourceUsage = append(t.resourceUsage, PodResourceUsage{
+			Pod:         pod,
+			CPUUsage:    e.cpuUsage - start.cpuUsage,
+			MemoryDelta: e.memory - start.memory,
+		})
+	}
+	sort.Slice(t.resourceUsage, func(i, j int) bool {
+		return t.resourceUsage[i].Pod < t.resourceUsage[j].Pod
+	})
+	for _, u := range t.resourceUsage {
+		t.Logf("📊 Pod resource usage: %s", u)
+	}
+}
+
+// PodResourceUsage returns the per-pod resource deltas collected during the
+// test, sorted by pod name. Empty unless the test ran with
+// --collect-pod-metrics.
+func (t *Test) PodResourceUsage() []PodResourceUsage {
+	return t.resourceUsage
+}
@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package check
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCgroupStats(t *testing.T) {
+	out := "usage_usec 1500000\nuser_usec 1000000\nsystem_usec 500000\n4194304\n"
+	sample, err := parseCgroupStats(out)
+	assert.NoError(t, err)
+	assert.Equal(t, 1500*time.Millisecond, sample.cpuUsage)
+	assert.Equal(t, int64(4194304), sample.memory)
+
+	_, err = parseCgroupStats("usage_usec 1500000\n")
+	assert.Error(t, err)
+
+	_, err = parseCgroupStats("")
+	assert.Error(t, err)
+}
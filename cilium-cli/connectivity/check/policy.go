@@ -7,8 +7,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	networkingv1 "k8s.io/api/networking/v1"
@@ -59,22 +61,86 @@ func (ct *ConnectivityTest) getCiliumPolicyRevisions(ctx context.Context) (map[P
 	return revisions, nil
 }
 
-// waitCiliumPolicyRevisions waits for the Cilium policy revisions to be bumped
+// PolicyPropagation records how long a Cilium agent took to realize a policy
+// change applied through the API server.
+type PolicyPropagation struct {
+	// Pod identifies the Cilium agent pod the policy propagated to.
+	Pod string
+
+	// OldRevision is the agent's policy revision before the change was
+	// applied, NewRevision the revision the agent was waited on to reach.
+	OldRevision int
+	NewRevision int
+
+	// Latency is the time between the policy apply and the agent reporting
+	// all endpoints realized at or beyond NewRevision.
+	Latency time.Duration
+}
+
+// String returns a readable one-line summary of the PolicyPropagation.
+func (p PolicyPropagation) String() string {
+	return fmt.Sprintf("Policy propagation to %s (revision %d -> %d) took %s",
+		p.Pod, p.OldRevision, p.NewRevision, p.Latency)
+}
+
+// PolicyPropagations returns the per-agent policy propagation latencies
+// measured while applying the Test's network policies.
+func (t *Test) PolicyPropagations() []PolicyPropagation {
+	return slices.Clone(t.policyPropagations)
+}
+
+// waitCiliumPolicyRevisions waits in parallel for all Cilium agents to reach
+// their expected policy revisions, recording the per-agent propagation
+// latency for the timing report.
 // TODO: Improve error returns here, currently not possible for the caller to reliably detect timeout.
 func (t *Test) waitCiliumPolicyRevisions(ctx context.Context, revisions map[Pod]int, deltas map[string]int) error {
-	var err error
+	start := time.Now()
+
+	var (
+		wg         sync.WaitGroup
+		mu         lock.Mutex
+		lastErr    error
+		reached    []Pod
+		propagated []PolicyPropagation
+	)
 	for pod, oldRevision := range revisions {
-		delta := deltas[pod.K8sClient.ClusterName()]
-		err = waitCiliumPolicyRevision(ctx, pod, oldRevision+delta, defaults.PolicyWaitTimeout)
-		if err == nil {
+		wg.Add(1)
+		go func(pod Pod, oldRevision int) {
+			defer wg.Done()
+			newRevision := oldRevision + deltas[pod.K8sClient.ClusterName()]
+			err := waitCiliumPolicyRevision(ctx, pod, newRevision, defaults.PolicyWaitTimeout)
+			latency := time.Since(start)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				lastErr = err
+				return
+			}
 			t.Debugf("Pod %s/%s revision > %d", pod.K8sClient.ClusterName(), pod.Name(), oldRevision)
-			delete(revisions, pod)
-		}
+			propagated = append(propagated, PolicyPropagation{
+				Pod:         pod.Name(),
+				OldRevision: oldRevision,
+				NewRevision: newRevision,
+				Latency:     latency,
+			})
+			reached = append(reached, pod)
+		}(pod, oldRevision)
+	}
+	wg.Wait()
+
+	for _, pod := range reached {
+		delete(revisions, pod)
+	}
+	for _, p := range propagated {
+		t.Infof("📜 %s", p)
 	}
+	t.policyPropagations = append(t.policyPropagations, propagated...)
+
 	if len(revisions) == 0 {
 		return nil
 	}
-	return err
+	return lastErr
 }
 
 // getCiliumPolicyRevision returns the current policy revision of a Cilium pod.
@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package check
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/cilium/cilium/api/v1/observer"
+)
+
+const (
+	// preflightCanaryPodName is the name of the trivial pod scheduled to
+	// verify that the test namespace accepts and runs workloads.
+	preflightCanaryPodName = "preflight-canary"
+
+	// preflightPodStartupTimeout is the deadline for the canary pod to
+	// reach the Running phase.
+	preflightPodStartupTimeout = 2 * time.Minute
+)
+
+// preflightCheck is a single environment validation run before any test.
+type preflightCheck struct {
+	name string
+	skip bool
+	run  func(ctx context.Context) error
+}
+
+// preflight validates the cluster environment before any test runs, so that
+// environment problems surface as a single clear report instead of cascading
+// test failures. Individual checks can be skipped through their corresponding
+// parameters for unusual environments.
+func (ct *ConnectivityTest) preflight(ctx context.Context) error {
+	checks := []preflightCheck{
+		{"node-readiness", ct.params.PreflightSkipNodeCheck, ct.preflightNodes},
+		{"pod-startup", ct.params.PreflightSkipPodStartupCheck, ct.preflightPodStartup},
+		{"api-reachability", ct.params.PreflightSkipAPICheck, ct.preflightAPIReachability},
+	}
+
+	ct.Header(fmt.Sprintf("🛫 Pre-flight checks [%s]", ct.params.TestNamespace))
+	var failures []string
+	for _, c := range checks {
+		if c.skip {
+			ct.Logf("  ➖ %s: skipped on request", c.name)
+			continue
+		}
+		if err := c.run(ctx); err != nil {
+			ct.Logf("  ❌ %s: %s", c.name, err)
+			failures = append(failures, fmt.Sprintf("%s: %s", c.name, err))
+			continue
+		}
+		ct.Logf("  ✅ %s", c.name)
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("environment not ready, %d pre-flight checks failed:\n  %s",
+			len(failures), strings.Join(failures, "\n  "))
+	}
+	return nil
+}
+
+// preflightNodes verifies that enough schedulable nodes are available for the
+// selected tests. Cordoned nodes and nodes carrying NoSchedule or NoExecute
+// taints are not counted.
+func (ct *ConnectivityTest) preflightNodes(_ context.Context) error {
+	required := 2
+	if ct.params.SingleNode {
+		required = 1
+	}
+
+	schedulable := 0
+	for _, node := range ct.nodes {
+		if node.Spec.Unschedulable {
+			continue
+		}
+		tainted := false
+		for _, taint := range node.Spec.Taints {
+			if taint.Effect == corev1.TaintEffectNoSchedule || taint.Effect == corev1.TaintEffectNoExecute {
+				tainted = true
+				break
+			}
+		}
+		if tainted {
+			continue
+		}
+		schedulable++
+	}
+
+	if schedulable < required {
+		return fmt.Errorf("%d schedulable nodes available, %d required", schedulable, required)
+	}
+	return nil
+}
+
+// preflightPodStartup verifies that the test namespace can be created and
+// that a trivial pod reaches Running within a deadline. This catches
+// PodSecurity admission, quota and scheduling problems before they fail
+// every test.
+func (ct *ConnectivityTest) preflightPodStartup(ctx context.Context) error {
+	if err := ct.deployNamespace(ctx); err != nil {
+		return err
+	}
+
+	client := ct.K8sClient()
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   preflightCanaryPodName,
+			Labels: map[string]string{"kind": "preflight"},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+			Containers: []corev1.Container{{
+				Name:    "canary",
+				Image:   ct.params.CurlImage,
+				Command: []string{"/bin/sh", "-c", "sleep 300"},
+			}},
+		},
+	}
+
+	if _, err := client.CreatePod(ctx, ct.params.TestNamespace, pod, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("unable to create canary pod in namespace %s: %w", ct.params.TestNamespace, err)
+	}
+	defer func() {
+		if err := client.DeletePod(ctx, ct.params.TestNamespace, preflightCanaryPodName, metav1.DeleteOptions{}); err != nil {
+			ct.Debugf("Unable to delete canary pod: %s", err)
+		}
+	}()
+
+	waitCtx, cancel := context.WithTimeout(ctx, preflightPodStartupTimeout)
+	defer cancel()
+	phase := corev1.PodPhase("Unknown")
+	for {
+		current, err := client.GetPod(waitCtx, ct.params.TestNamespace, preflightCanaryPodName, metav1.GetOptions{})
+		if err == nil {
+			if current.Status.Phase == corev1.PodRunning {
+				return nil
+			}
+			phase = current.Status.Phase
+		}
+
+		select {
+		case <-time.After(PollInterval):
+		case <-waitCtx.Done():
+			return fmt.Errorf("canary pod did not reach Running within %s (last phase: %s)",
+				preflightPodStartupTimeout, phase)
+		}
+	}
+}
+
+// preflightAPIReachability verifies that Hubble Relay responds when flow
+// validation is requested and that the Cilium agent API responds on every
+// node.
+func (ct *ConnectivityTest) preflightAPIReachability(ctx context.Context) error {
+	if ct.params.Hubble {
+		conn, err := grpc.NewClient(ct.params.HubbleServer, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return fmt.Errorf("unable to create Hubble Relay client for %s: %w", ct.params.HubbleServer, err)
+		}
+		defer conn.Close()
+		if _, err := observer.NewObserverClient(conn).ServerStatus(ctx, &observer.ServerStatusRequest{}); err != nil {
+			return fmt.Errorf("Hubble Relay at %s is not reachable: %w", ct.params.HubbleServer, err)
+		}
+	}
+
+	for _, pod := range ct.ciliumPods {
+		if err := WaitForCiliumStatus(ctx, ct, pod); err != nil {
+			return fmt.Errorf("cilium agent on %s is not ready: %w", pod.Name(), err)
+		}
+	}
+	return nil
+}
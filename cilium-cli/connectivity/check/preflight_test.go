@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package check
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestPreflightNodes(t *testing.T) {
+	mkNode := func(unschedulable bool, taints ...corev1.Taint) *corev1.Node {
+		return &corev1.Node{
+			Spec: corev1.NodeSpec{
+				Unschedulable: unschedulable,
+				Taints:        taints,
+			},
+		}
+	}
+	noSchedule := corev1.Taint{Key: "node.kubernetes.io/unreachable", Effect: corev1.TaintEffectNoSchedule}
+	preferNoSchedule := corev1.Taint{Key: "example.com/soft", Effect: corev1.TaintEffectPreferNoSchedule}
+
+	ct := &ConnectivityTest{
+		nodes: map[string]*corev1.Node{
+			"worker-1": mkNode(false),
+			"worker-2": mkNode(false, preferNoSchedule),
+		},
+	}
+	require.NoError(t, ct.preflightNodes(context.Background()))
+
+	// Cordoned and NoSchedule-tainted nodes do not count.
+	ct.nodes = map[string]*corev1.Node{
+		"worker-1": mkNode(false),
+		"worker-2": mkNode(true),
+		"worker-3": mkNode(false, noSchedule),
+	}
+	err := ct.preflightNodes(context.Background())
+	require.ErrorContains(t, err, "1 schedulable nodes available, 2 required")
+
+	// A single schedulable node suffices in single-node mode.
+	ct.params.SingleNode = true
+	require.NoError(t, ct.preflightNodes(context.Background()))
+}
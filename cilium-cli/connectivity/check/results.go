@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package check
+
+import (
+	"time"
+)
+
+// TestStatus is the outcome of a single connectivity test.
+type TestStatus string
+
+const (
+	TestStatusPassed  TestStatus = "passed"
+	TestStatusFailed  TestStatus = "failed"
+	TestStatusSkipped TestStatus = "skipped"
+)
+
+// ActionFailure describes a single failed action of a test.
+type ActionFailure struct {
+	// Name identifies the action, including its test and scenario.
+	Name string
+	// IPFamily is the IP family the action ran with ("ipv4", "ipv6" or
+	// "any"), so dual-stack failures can be told apart without parsing
+	// the action name.
+	IPFamily string
+	// Assertions are the failed assertions recorded by the action, if any.
+	Assertions []string
+	// Owners are the code owners of the scenario the action belongs to,
+	// empty unless Parameters.LogCodeOwners is set.
+	Owners []string
+}
+
+// TestResult is the structured outcome of a single Test.
+type TestResult struct {
+	Name     string
+	Status   TestStatus
+	Duration time.Duration
+	Failures []ActionFailure
+}
+
+// Results is the aggregated outcome of a connectivity test run. It allows
+// callers embedding the suite as a library to inspect the results
+// programmatically instead of parsing the CLI output or relying on the
+// process exit code.
+type Results struct {
+	Tests []TestResult
+}
+
+// Passed returns the number of tests that passed.
+func (r Results) Passed() int { return r.count(TestStatusPassed) }
+
+// Failed returns the number of tests that failed.
+func (r Results) Failed() int { return r.count(TestStatusFailed) }
+
+// Skipped returns the number of tests that were skipped.
+func (r Results) Skipped() int { return r.count(TestStatusSkipped) }
+
+// Ok returns true when no test failed.
+func (r Results) Ok() bool { return r.Failed() == 0 }
+
+func (r Results) count(status TestStatus) int {
+	n := 0
+	for _, t := range r.Tests {
+		if t.Status == status {
+			n++
+		}
+	}
+	return n
+}
+
+// Result returns the structured outcome of the Test.
+func (t *Test) Result() TestResult {
+	res := TestResult{Name: t.Name(), Status: TestStatusPassed}
+	if !t.startTime.IsZero() && !t.completionTime.IsZero() {
+		res.Duration = t.completionTime.Sub(t.startTime)
+	}
+	switch {
+	case t.skipped:
+		res.Status = TestStatusSkipped
+		res.Duration = 0
+	case t.failed:
+		res.Status = TestStatusFailed
+		for _, a := range t.failedActions() {
+			failure := ActionFailure{
+				Name:     a.String(),
+				IPFamily: a.IPFamily().String(),
+				Owners:   t.ctx.GetOwners(a.Scenario()),
+			}
+			for _, as := range a.failedAssertions() {
+				failure.Assertions = append(failure.Assertions, as.String())
+			}
+			res.Failures = append(res.Failures, failure)
+		}
+	}
+	return res
+}
+
+// Results returns the structured outcomes of all registered tests. It is
+// only meaningful once Run has returned.
+func (ct *ConnectivityTest) Results() Results {
+	res := Results{Tests: make([]TestResult, 0, len(ct.tests))}
+	for _, t := range ct.tests {
+		res.Tests = append(res.Tests, t.Result())
+	}
+	return res
+}
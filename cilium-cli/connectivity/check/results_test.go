@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package check
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/cilium/cilium-cli/utils/features"
+)
+
+// mkResultsCT builds a ConnectivityTest with a passed, a skipped and a
+// failed test, the latter with one failed action.
+func mkResultsCT() *ConnectivityTest {
+	ct := &ConnectivityTest{
+		params: Parameters{Writer: &bytes.Buffer{}},
+	}
+	scenario := &fakeScenario{}
+
+	passed := NewTest("passed", false, false)
+	passed.ctx = ct
+	passed.startTime = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	passed.completionTime = passed.startTime.Add(3 * time.Second)
+
+	skipped := NewTest("skipped", false, false)
+	skipped.ctx = ct
+	skipped.skipped = true
+
+	failed := NewTest("failed", false, false)
+	failed.ctx = ct
+	failed.failed = true
+	failed.startTime = time.Date(2025, 1, 1, 0, 0, 10, 0, time.UTC)
+	failed.completionTime = failed.startTime.Add(time.Second)
+	a := newAction(failed, "curl-0", scenario, nil, nil, features.IPFamilyV4)
+	a.failed = true
+	a.assertions = append(a.assertions, Assertion{Name: "exit-code", Expected: "0", Actual: "28"})
+	failed.scenarios[scenario] = []*Action{a}
+
+	ct.tests = []*Test{passed, skipped, failed}
+	return ct
+}
+
+func TestResults(t *testing.T) {
+	ct := mkResultsCT()
+
+	results := ct.Results()
+	require.Equal(t, 1, results.Passed())
+	require.Equal(t, 1, results.Failed())
+	require.Equal(t, 1, results.Skipped())
+	require.False(t, results.Ok())
+
+	require.Equal(t, TestResult{
+		Name:     "passed",
+		Status:   TestStatusPassed,
+		Duration: 3 * time.Second,
+	}, results.Tests[0])
+	require.Equal(t, TestResult{
+		Name:   "skipped",
+		Status: TestStatusSkipped,
+	}, results.Tests[1])
+
+	failed := results.Tests[2]
+	require.Equal(t, TestStatusFailed, failed.Status)
+	require.Equal(t, time.Second, failed.Duration)
+	require.Len(t, failed.Failures, 1)
+	require.Equal(t, "failed/fake-scenario/curl-0", failed.Failures[0].Name)
+	require.Equal(t, "ipv4", failed.Failures[0].IPFamily)
+	require.Equal(t, []string{"exit-code: expected 0, got 28"}, failed.Failures[0].Assertions)
+}
+
+// TestFamilyRollup verifies the per-family summary line of the test report.
+func TestFamilyRollup(t *testing.T) {
+	ct := mkResultsCT()
+	require.Equal(t, "IPv4: 0/1 passed", ct.familyRollup())
+
+	// A passing IPv6 action adds a second family to the rollup.
+	passed := ct.tests[0]
+	scenario := &fakeScenario{}
+	passed.scenarios[scenario] = []*Action{
+		newAction(passed, "curl-1", scenario, nil, nil, features.IPFamilyV6),
+	}
+	require.Equal(t, "IPv4: 0/1 passed, IPv6: 1/1 passed", ct.familyRollup())
+}
+
+// TestJUnitCollectorCompatibility guards that the junit report built from
+// the structured Results is identical to the one the CLI produced before.
+func TestJUnitCollectorCompatibility(t *testing.T) {
+	ct := mkResultsCT()
+
+	j := NewJUnitCollector(nil, "junit.xml")
+	j.Collect(ct)
+
+	suite := j.testSuite
+	require.Equal(t, 3, suite.Tests)
+	require.Equal(t, 1, suite.Skipped)
+	require.Equal(t, 1, suite.Failures)
+
+	require.Equal(t, "passed", suite.TestCases[0].Status)
+	require.Equal(t, 3.0, suite.TestCases[0].Time)
+
+	require.Equal(t, "skipped", suite.TestCases[1].Status)
+	require.Equal(t, 0.0, suite.TestCases[1].Time)
+	require.NotNil(t, suite.TestCases[1].Skipped)
+
+	require.Equal(t, "failed", suite.TestCases[2].Status)
+	require.NotNil(t, suite.TestCases[2].Failure)
+	require.Equal(t, "failed failed", suite.TestCases[2].Failure.Message)
+	require.Equal(t,
+		"failed/fake-scenario/curl-0"+MetadataDelimiter+"IPFamily: ipv4, Owners: \nassertion exit-code: expected 0, got 28",
+		suite.TestCases[2].Failure.Value)
+}
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package check
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+)
+
+// ScenarioFactory constructs a Scenario from a set of string parameters, as
+// supplied by externally defined scenario descriptions.
+type ScenarioFactory func(params map[string]string) (Scenario, error)
+
+var (
+	scenarioFactoriesMu sync.Mutex
+	scenarioFactories   = map[string]ScenarioFactory{}
+)
+
+// RegisterScenarioFactory registers a scenario factory under the given name.
+// Built-in scenarios register themselves at init time; binaries embedding the
+// connectivity test framework may register additional factories before
+// running tests. Registering a name twice panics, as it indicates conflicting
+// scenario definitions.
+func RegisterScenarioFactory(name string, fn ScenarioFactory) {
+	scenarioFactoriesMu.Lock()
+	defer scenarioFactoriesMu.Unlock()
+
+	if _, ok := scenarioFactories[name]; ok {
+		panic(fmt.Sprintf("scenario factory %q already registered", name))
+	}
+	scenarioFactories[name] = fn
+}
+
+// NewScenarioFromRegistry instantiates the scenario registered under the
+// given name with the given parameters.
+func NewScenarioFromRegistry(name string, params map[string]string) (Scenario, error) {
+	scenarioFactoriesMu.Lock()
+	fn, ok := scenarioFactories[name]
+	scenarioFactoriesMu.Unlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unknown scenario %q, registered scenarios: %v",
+			name, RegisteredScenarioNames())
+	}
+	return fn(params)
+}
+
+// RegisteredScenarioNames returns the sorted names of all registered scenario
+// factories.
+func RegisteredScenarioNames() []string {
+	scenarioFactoriesMu.Lock()
+	defer scenarioFactoriesMu.Unlock()
+
+	return slices.Sorted(maps.Keys(scenarioFactories))
+}
+
+// RegisterScenarioFactory registers a scenario factory under the given name.
+// It is a convenience wrapper around the package-level registry for callers
+// holding a ConnectivityTest.
+func (ct *ConnectivityTest) RegisterScenarioFactory(name string, fn ScenarioFactory) {
+	RegisterScenarioFactory(name, fn)
+}
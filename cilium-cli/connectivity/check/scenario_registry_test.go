@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package check
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeScenario struct {
+	ScenarioBase
+	param string
+}
+
+func (s *fakeScenario) Name() string                     { return "fake-scenario" }
+func (s *fakeScenario) Run(ctx context.Context, t *Test) {}
+
+func TestScenarioRegistry(t *testing.T) {
+	RegisterScenarioFactory("fake-scenario", func(params map[string]string) (Scenario, error) {
+		return &fakeScenario{param: params["param"]}, nil
+	})
+
+	s, err := NewScenarioFromRegistry("fake-scenario", map[string]string{"param": "value"})
+	require.NoError(t, err)
+	require.Equal(t, "fake-scenario", s.Name())
+	require.Equal(t, "value", s.(*fakeScenario).param)
+
+	_, err = NewScenarioFromRegistry("no-such-scenario", nil)
+	require.Error(t, err)
+
+	require.Contains(t, RegisteredScenarioNames(), "fake-scenario")
+
+	require.Panics(t, func() {
+		RegisterScenarioFactory("fake-scenario", func(params map[string]string) (Scenario, error) {
+			return nil, nil
+		})
+	})
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package check
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/cilium/cilium/api/v1/models"
+	"github.com/cilium/cilium/cilium-cli/defaults"
+)
+
+// AgentStatus wraps the agent status API response with typed accessors for
+// the subsystems the connectivity test bases decisions on, so that readiness
+// checks do not depend on the wording of the CLI output.
+type AgentStatus struct {
+	*models.StatusResponse
+}
+
+// CiliumStatus queries the status API of the given Cilium agent pod with a
+// JSON status query and parses the response, so that callers inspect typed
+// fields instead of grepping the human-readable CLI output.
+func (p Pod) CiliumStatus(ctx context.Context) (*AgentStatus, error) {
+	stdout, err := p.K8sClient.ExecInPod(ctx, p.Pod.Namespace, p.Pod.Name,
+		defaults.AgentContainerName, []string{"cilium", "status", "-o", "json"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch cilium status on %s: %w", p.Name(), err)
+	}
+
+	st := &models.StatusResponse{}
+	if err := json.Unmarshal(stdout.Bytes(), st); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cilium status on %s: %w", p.Name(), err)
+	}
+
+	return &AgentStatus{StatusResponse: st}, nil
+}
+
+// IsOK returns true when the overall Cilium state is Ok.
+func (s *AgentStatus) IsOK() bool {
+	return s.Cilium != nil && s.Cilium.State == models.StatusStateOk
+}
+
+// IsKvstoreOK returns true unless the kvstore section explicitly reports a
+// degraded state. An absent section means the agent does not report on the
+// kvstore.
+func (s *AgentStatus) IsKvstoreOK() bool {
+	if s.Kvstore == nil {
+		return true
+	}
+	return s.Kvstore.State == models.StatusStateOk || s.Kvstore.State == models.StatusStateDisabled
+}
+
+// IsClusterMeshReady returns true when every configured remote cluster
+// reports ready. It returns true when clustermesh is not configured.
+func (s *AgentStatus) IsClusterMeshReady() bool {
+	if s.ClusterMesh == nil {
+		return true
+	}
+	for _, c := range s.ClusterMesh.Clusters {
+		if !c.Ready {
+			return false
+		}
+	}
+	return true
+}
+
+// FailingControllers returns one "name: last failure message" entry per
+// controller that reports a failure.
+func (s *AgentStatus) FailingControllers() []string {
+	var failing []string
+	for _, c := range s.Controllers {
+		if c.Status != nil && c.Status.LastFailureMsg != "" {
+			failing = append(failing, fmt.Sprintf("%s: %s", c.Name, c.Status.LastFailureMsg))
+		}
+	}
+	slices.Sort(failing)
+	return failing
+}
+
+// StaleProbes returns the names of the status probes that have not completed
+// in time, sorted alphabetically.
+func (s *AgentStatus) StaleProbes() []string {
+	probes := make([]string, 0, len(s.Stale))
+	for probe := range s.Stale {
+		probes = append(probes, probe)
+	}
+	slices.Sort(probes)
+	return probes
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package check
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/cilium/api/v1/models"
+)
+
+func TestAgentStatusIsOK(t *testing.T) {
+	st := &AgentStatus{StatusResponse: &models.StatusResponse{}}
+	require.False(t, st.IsOK())
+
+	st.Cilium = &models.Status{State: models.StatusStateOk}
+	require.True(t, st.IsOK())
+
+	st.Cilium.State = models.StatusStateFailure
+	require.False(t, st.IsOK())
+}
+
+func TestAgentStatusIsKvstoreOK(t *testing.T) {
+	st := &AgentStatus{StatusResponse: &models.StatusResponse{}}
+	require.True(t, st.IsKvstoreOK())
+
+	st.Kvstore = &models.Status{State: models.StatusStateDisabled}
+	require.True(t, st.IsKvstoreOK())
+
+	st.Kvstore.State = models.StatusStateFailure
+	require.False(t, st.IsKvstoreOK())
+}
+
+func TestAgentStatusIsClusterMeshReady(t *testing.T) {
+	st := &AgentStatus{StatusResponse: &models.StatusResponse{}}
+	require.True(t, st.IsClusterMeshReady())
+
+	st.ClusterMesh = &models.ClusterMeshStatus{
+		Clusters: []*models.RemoteCluster{
+			{Name: "one", Ready: true},
+			{Name: "two", Ready: false},
+		},
+	}
+	require.False(t, st.IsClusterMeshReady())
+
+	st.ClusterMesh.Clusters[1].Ready = true
+	require.True(t, st.IsClusterMeshReady())
+}
+
+func TestAgentStatusFailingControllers(t *testing.T) {
+	st := &AgentStatus{StatusResponse: &models.StatusResponse{
+		Controllers: models.ControllerStatuses{
+			{Name: "healthy", Status: &models.ControllerStatusStatus{}},
+			{Name: "broken", Status: &models.ControllerStatusStatus{LastFailureMsg: "boom"}},
+			{Name: "no-status"},
+		},
+	}}
+
+	require.Equal(t, []string{"broken: boom"}, st.FailingControllers())
+}
+
+func TestAgentStatusStaleProbes(t *testing.T) {
+	st := &AgentStatus{StatusResponse: &models.StatusResponse{}}
+	require.Empty(t, st.StaleProbes())
+
+	st.Stale = map[string]strfmt.DateTime{
+		"kvstore":  {},
+		"cluster":  {},
+		"kube-dns": {},
+	}
+	require.Equal(t, []string{"cluster", "kube-dns", "kvstore"}, st.StaleProbes())
+}
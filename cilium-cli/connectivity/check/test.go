@@ -12,6 +12,7 @@ import (
 	"io"
 	"net"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/blang/semver/v4"
@@ -63,13 +64,14 @@ func NewTest(name string, verbose bool, debug bool) *Test {
 		panic("empty test name")
 	}
 	test := &Test{
-		name:        name,
-		scenarios:   make(map[Scenario][]*Action),
-		resources:   []k8s.Object{},
-		clrps:       make(map[string]*ciliumv2.CiliumLocalRedirectPolicy),
-		logBuf:      &bytes.Buffer{}, // maintain internal buffer by default
-		conditionFn: nil,
-		verbose:     verbose,
+		name:              name,
+		scenarios:         make(map[Scenario][]*Action),
+		resources:         []k8s.Object{},
+		clrps:             make(map[string]*ciliumv2.CiliumLocalRedirectPolicy),
+		sysdumpsCollected: make(map[string]struct{}),
+		logBuf:            &bytes.Buffer{}, // maintain internal buffer by default
+		conditionFn:       nil,
+		verbose:           verbose,
 	}
 	// Setting the internal buffer to nil causes the logger to
 	// write directly to stdout in verbose or debug mode.
@@ -129,6 +131,14 @@ type Test struct {
 	// A custom sysdump policy for the given test.
 	sysdumpPolicy SysdumpPolicy
 
+	// Scenario currently being executed, used to scope sysdump
+	// deduplication with SysdumpPolicyOncePerScenario.
+	currentScenario Scenario
+
+	// Failure messages for which a sysdump was already collected, keyed by
+	// scenario name and failure message hash.
+	sysdumpsCollected map[string]struct{}
+
 	// List of callbacks to be executed before the test run as additional setup.
 	before []SetupFunc
 
@@ -140,6 +150,25 @@ type Test struct {
 	// Completion time of the test.
 	completionTime time.Time
 
+	// Pod resource samples taken at the start of the test, and the per-pod
+	// deltas computed on completion. Only populated when the
+	// CollectPodMetrics parameter is set.
+	startResources map[string]podResourceSample
+	resourceUsage  []PodResourceUsage
+
+	// Faults injected between actions by the configured FaultInjector,
+	// empty when fault injection is not enabled.
+	faultInjections []FaultInjection
+
+	// Per-agent policy propagation latencies measured while applying the
+	// test's network policies.
+	policyPropagations []PolicyPropagation
+
+	// Spans of the Test and the Scenario currently executing, nil when
+	// tracing is disabled.
+	span         *traceSpan
+	scenarioSpan *traceSpan
+
 	// Buffer to store output until it's flushed by a failure.
 	// Unused when run in verbose or debug mode.
 	logMu   lock.RWMutex
@@ -153,6 +182,10 @@ type Test struct {
 
 	// List of functions to be called when Run() returns.
 	finalizers []func(ctx context.Context) error
+
+	// Cleanup functions registered via Cleanup(), protected by cleanupMu.
+	cleanupMu lock.Mutex
+	cleanups  []func()
 }
 
 func (t *Test) String() string {
@@ -305,6 +338,22 @@ func (t *Test) willRun() (bool, string) {
 func (t *Test) finalize() {
 	t.Debug("Finalizing Test", t.Name())
 
+	// Run the cleanup functions registered during the test before the
+	// finalizers, in last added, first called order. A cleanup function may
+	// itself register further cleanups, which run after it returns.
+	for {
+		t.cleanupMu.Lock()
+		n := len(t.cleanups)
+		if n == 0 {
+			t.cleanupMu.Unlock()
+			break
+		}
+		f := t.cleanups[n-1]
+		t.cleanups = t.cleanups[:n-1]
+		t.cleanupMu.Unlock()
+		f()
+	}
+
 	// Iterate finalizers in backward order.
 	// As an example, first we create secrets that are referenced in policies.
 	// When performing cleanup, we want to first delete policies and then secrets.
@@ -344,17 +393,33 @@ func (t *Test) Run(ctx context.Context, index int) error {
 
 	// Store start time of the Test.
 	t.startTime = time.Now()
+	t.span = t.ctx.tracer.startSpan(nil, t.Name(), nil)
 	// Store completion of the Test when function is returned
 	defer func() {
+		t.span.end(t.failed)
 		t.completionTime = time.Now()
+		if t.ctx.params.CollectPodMetrics {
+			// Use a detached context so that the final sample is
+			// taken even when the user interrupted the test run.
+			t.collectPodResourceUsage(context.TODO())
+		}
 	}()
 
-	t.ctx.logger.Printf(t, "[=] [%s] Test [%s] [%d/%d]\n", t.ctx.params.TestNamespace, t.Name(), index, len(t.ctx.tests))
+	if t.ctx.jsonl() {
+		t.ctx.logger.Print(t, jsonlLine("info", t.Name(), "",
+			fmt.Sprintf("[%s] Test [%d/%d]", t.ctx.params.TestNamespace, index, len(t.ctx.tests))))
+	} else {
+		t.ctx.logger.Printf(t, "[=] [%s] Test [%s] [%d/%d]\n", t.ctx.params.TestNamespace, t.Name(), index, len(t.ctx.tests))
+	}
 
 	if err := t.setup(ctx); err != nil {
 		return fmt.Errorf("setting up test: %w", err)
 	}
 
+	if t.ctx.params.CollectPodMetrics {
+		t.startResources = t.samplePodResources(ctx)
+	}
+
 	for _, cb := range t.before {
 		if err := cb(ctx, t, t.ctx); err != nil {
 			return fmt.Errorf("additional test setup callback: %w", err)
@@ -382,10 +447,28 @@ func (t *Test) Run(ctx context.Context, index int) error {
 
 		t.Logf("[-] Scenario [%s]", t.scenarioName(s))
 
-		s.Run(ctx, t)
-	}
-
-	if t.logBuf != nil {
+		t.currentScenario = s
+		t.scenarioSpan = t.ctx.tracer.startSpan(t.span, t.scenarioName(s), nil)
+		func() {
+			// End the span through a defer, since Scenario.Run() can
+			// call Fatal() and unwind via Goexit.
+			defer func() {
+				failed := false
+				for _, a := range t.scenarios[s] {
+					if a.failed {
+						failed = true
+						break
+					}
+				}
+				t.scenarioSpan.end(failed)
+			}()
+			s.Run(ctx, t)
+		}()
+		t.scenarioSpan = nil
+		t.currentScenario = nil
+	}
+
+	if t.logBuf != nil && !t.ctx.jsonl() {
 		t.ctx.logger.Printf(t, "\n")
 	}
 
@@ -751,6 +834,17 @@ func (t *Test) WithFinalizer(f func(context.Context) error) *Test {
 	return t
 }
 
+// Cleanup registers a function to be called when the test completes, whether
+// it passed, failed or was terminated by Fatal. Cleanup functions are called
+// in last added, first called order, analogous to testing.T.Cleanup. Unlike
+// WithFinalizer, it is intended to be called from within scenarios to tear
+// down resources they created, and is safe for concurrent use.
+func (t *Test) Cleanup(f func()) {
+	t.cleanupMu.Lock()
+	defer t.cleanupMu.Unlock()
+	t.cleanups = append(t.cleanups, f)
+}
+
 // SysdumpPolicy represents a policy for sysdump collection in case of test failure.
 type SysdumpPolicy int
 
@@ -761,10 +855,31 @@ const (
 	// SysdumpPolicyOnce enables capturing only one sysdump for the given test,
 	// independently of the number of failures.
 	SysdumpPolicyOnce
+	// SysdumpPolicyOncePerScenario enables capturing one sysdump per unique
+	// failure message within each scenario, so that the same underlying issue
+	// failing every action of a scenario yields a single sysdump, while
+	// distinct failure modes are still captured.
+	SysdumpPolicyOncePerScenario
 	// SysdumpPolicyNever disables sysdump collection for the given test.
 	SysdumpPolicyNever
 )
 
+// ParseSysdumpPolicy parses the user-provided name of a sysdump policy. The
+// empty string maps to SysdumpPolicyEach, matching the behavior when no
+// explicit policy is specified.
+func ParseSysdumpPolicy(policy string) (SysdumpPolicy, error) {
+	switch policy {
+	case "", "each":
+		return SysdumpPolicyEach, nil
+	case "once":
+		return SysdumpPolicyOnce, nil
+	case "once-per-scenario":
+		return SysdumpPolicyOncePerScenario, nil
+	default:
+		return SysdumpPolicyEach, fmt.Errorf("invalid sysdump policy %q", policy)
+	}
+}
+
 // WithSysdumpPolicy enables tuning the policy for capturing the sysdump in case
 // of test failure, which takes effect only when sysdumps have been requested by
 // the user. It is intended to be used to limit the number of sysdumps generated
@@ -832,9 +947,39 @@ func (t *Test) EgressGatewayNode() string {
 	return ""
 }
 
+// logAgentState logs the failing controllers and stale probes of every
+// Cilium agent at the time of a test failure, so that agent-side problems
+// can be correlated without unpacking a sysdump.
+func (t *Test) logAgentState() {
+	ctx, cancel := context.WithTimeout(context.Background(), ShortTimeout)
+	defer cancel()
+
+	for _, pod := range t.ctx.CiliumPods() {
+		st, err := pod.CiliumStatus(ctx)
+		if err != nil {
+			t.Logf("Unable to fetch cilium status on %s: %s", pod.Name(), err)
+			continue
+		}
+		if !st.IsOK() {
+			t.Logf("Cilium agent %s reports state %s", pod.Name(), st.Cilium.State)
+		}
+		for _, ctrl := range st.FailingControllers() {
+			t.Logf("Cilium agent %s has a failing controller: %s", pod.Name(), ctrl)
+		}
+		if stale := st.StaleProbes(); len(stale) > 0 {
+			t.Logf("Cilium agent %s has stale probes: %s", pod.Name(), strings.Join(stale, ", "))
+		}
+	}
+}
+
 func (t *Test) collectSysdump() {
+	options := t.ctx.params.SysdumpOptions
+	if id := t.ctx.TraceID(); id != "" {
+		// Correlate the artifact with the exported trace.
+		options.OutputFileName += "-trace-" + id
+	}
 	for _, client := range t.ctx.Clients() {
-		collector, err := sysdump.NewCollector(client, t.ctx.params.SysdumpOptions, t.ctx.sysdumpHooks, time.Now())
+		collector, err := sysdump.NewCollector(client, options, t.ctx.sysdumpHooks, time.Now())
 		if err != nil {
 			t.Failf("Failed to create sysdump collector: %v", err)
 			return
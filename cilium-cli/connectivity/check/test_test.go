@@ -4,6 +4,7 @@
 package check
 
 import (
+	"bytes"
 	"reflect"
 	"testing"
 
@@ -77,3 +78,31 @@ func TestWithCondition(t *testing.T) {
 		WithCondition(func() bool { return true })
 	assert.False(t, mytest.checkConditions())
 }
+
+func TestTest_Cleanup(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewConcurrentLogger(buf)
+	logger.Start()
+	defer logger.Stop()
+
+	ct := &ConnectivityTest{
+		params: Parameters{Writer: buf},
+		logger: logger,
+	}
+	mytest := NewTest("cleanup-test", false, false)
+	mytest.ctx = ct
+
+	var order []string
+	mytest.Cleanup(func() { order = append(order, "first") })
+	mytest.Cleanup(func() {
+		order = append(order, "second")
+		// Cleanups registered from within a cleanup run afterwards.
+		mytest.Cleanup(func() { order = append(order, "nested") })
+	})
+
+	mytest.finalize()
+
+	// Last added, first called; nested cleanups run once their parent returns.
+	assert.Equal(t, []string{"second", "nested", "first"}, order)
+	assert.Empty(t, mytest.cleanups)
+}
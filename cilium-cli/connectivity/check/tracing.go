@@ -0,0 +1,215 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package check
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cilium/cilium/pkg/lock"
+)
+
+// OTLP span kind and status codes, see the OpenTelemetry protocol
+// specification.
+const (
+	otlpSpanKindInternal = 1
+	otlpStatusCodeOK     = 1
+	otlpStatusCodeError  = 2
+)
+
+// otlp* model the OTLP/HTTP JSON trace encoding, so no collector SDK is
+// required to export a trace.
+type otlpAnyValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpStatus struct {
+	Code int `json:"code"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Status            otlpStatus     `json:"status"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope   `json:"scope"`
+	Spans []*otlpSpan `json:"spans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpTraceRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+// tracer records one trace for a connectivity test run and exports it to an
+// OTLP/HTTP collector endpoint. A nil tracer records nothing, so run paths
+// can be instrumented unconditionally.
+type tracer struct {
+	endpoint string
+	traceID  string
+
+	mu    lock.Mutex
+	spans []*otlpSpan
+}
+
+// newTracer returns a tracer exporting to the given OTLP/HTTP endpoint.
+func newTracer(endpoint string) *tracer {
+	return &tracer{
+		endpoint: endpoint,
+		traceID:  randomHex(16),
+	}
+}
+
+// randomHex returns n random bytes in hexadecimal encoding.
+func randomHex(n int) string {
+	b := make([]byte, n)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// TraceID returns the identifier of the recorded trace, or an empty string
+// when tracing is disabled.
+func (tr *tracer) TraceID() string {
+	if tr == nil {
+		return ""
+	}
+	return tr.traceID
+}
+
+// traceSpan is a handle for an in-flight span. Nil handles are no-ops.
+type traceSpan struct {
+	span *otlpSpan
+}
+
+// startSpan records the start of a new span below parent. Both the tracer
+// and parent may be nil.
+func (tr *tracer) startSpan(parent *traceSpan, name string, attrs map[string]string) *traceSpan {
+	if tr == nil {
+		return nil
+	}
+
+	span := &otlpSpan{
+		TraceID:           tr.traceID,
+		SpanID:            randomHex(8),
+		Name:              name,
+		Kind:              otlpSpanKindInternal,
+		StartTimeUnixNano: strconv.FormatInt(time.Now().UnixNano(), 10),
+	}
+	if parent != nil && parent.span != nil {
+		span.ParentSpanID = parent.span.SpanID
+	}
+	for _, key := range slices.Sorted(maps.Keys(attrs)) {
+		span.Attributes = append(span.Attributes, otlpKeyValue{Key: key, Value: otlpAnyValue{StringValue: attrs[key]}})
+	}
+
+	tr.mu.Lock()
+	tr.spans = append(tr.spans, span)
+	tr.mu.Unlock()
+
+	return &traceSpan{span: span}
+}
+
+// end records the completion of the span with the given failure status.
+func (s *traceSpan) end(failed bool) {
+	if s == nil {
+		return
+	}
+	s.span.EndTimeUnixNano = strconv.FormatInt(time.Now().UnixNano(), 10)
+	if failed {
+		s.span.Status.Code = otlpStatusCodeError
+	} else {
+		s.span.Status.Code = otlpStatusCodeOK
+	}
+}
+
+// flush exports all recorded spans to the configured endpoint. It is called
+// on run completion; interruption through SIGINT reaches it via the deferred
+// graceful-shutdown path in Run.
+func (tr *tracer) flush(ctx context.Context) error {
+	if tr == nil {
+		return nil
+	}
+
+	tr.mu.Lock()
+	spans := tr.spans
+	tr.spans = nil
+	tr.mu.Unlock()
+	if len(spans) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(otlpTraceRequest{
+		ResourceSpans: []otlpResourceSpans{{
+			Resource: otlpResource{
+				Attributes: []otlpKeyValue{
+					{Key: "service.name", Value: otlpAnyValue{StringValue: "cilium-cli-connectivity"}},
+				},
+			},
+			ScopeSpans: []otlpScopeSpans{{
+				Scope: otlpScope{Name: "cilium-cli"},
+				Spans: spans,
+			}},
+		}},
+	})
+	if err != nil {
+		return err
+	}
+
+	endpoint := tr.endpoint
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "http://" + endpoint
+	}
+	url := strings.TrimSuffix(endpoint, "/") + "/v1/traces"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("collector returned status %s", resp.Status)
+	}
+	return nil
+}
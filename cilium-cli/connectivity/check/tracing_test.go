@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package check
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracerNoop(t *testing.T) {
+	// A nil tracer must be safe to use on all run paths.
+	var tr *tracer
+	require.Empty(t, tr.TraceID())
+	span := tr.startSpan(nil, "test", nil)
+	span.end(false)
+	require.NoError(t, tr.flush(context.Background()))
+}
+
+func TestTracerFlush(t *testing.T) {
+	var payload otlpTraceRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/traces", r.URL.Path)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(body, &payload))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	tr := newTracer(server.URL)
+	require.Len(t, tr.TraceID(), 32)
+
+	parent := tr.startSpan(nil, "test", nil)
+	child := tr.startSpan(parent, "scenario/action", map[string]string{"ip.family": "ipv4"})
+	child.end(true)
+	parent.end(false)
+
+	require.NoError(t, tr.flush(context.Background()))
+
+	require.Len(t, payload.ResourceSpans, 1)
+	spans := payload.ResourceSpans[0].ScopeSpans[0].Spans
+	require.Len(t, spans, 2)
+	require.Equal(t, "test", spans[0].Name)
+	require.Empty(t, spans[0].ParentSpanID)
+	require.Equal(t, otlpStatusCodeOK, spans[0].Status.Code)
+	require.Equal(t, spans[0].SpanID, spans[1].ParentSpanID)
+	require.Equal(t, otlpStatusCodeError, spans[1].Status.Code)
+	require.Equal(t, []otlpKeyValue{{Key: "ip.family", Value: otlpAnyValue{StringValue: "ipv4"}}}, spans[1].Attributes)
+
+	// Spans are drained by a successful flush.
+	require.NoError(t, tr.flush(context.Background()))
+}
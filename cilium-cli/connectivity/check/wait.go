@@ -138,6 +138,78 @@ func WaitForCoreDNS(ctx context.Context, log Logger, client Pod) error {
 	}
 }
 
+// WaitForCiliumStatus polls the status API of the given Cilium agent pod
+// until the agent reports a healthy state: overall status Ok, no failing
+// controllers and no stale probes.
+func WaitForCiliumStatus(ctx context.Context, log Logger, agent Pod) error {
+	log.Logf("⌛ [%s] Waiting for Cilium agent %s to report a healthy status...",
+		agent.K8sClient.ClusterName(), agent.Name())
+
+	ctx, cancel := context.WithTimeout(ctx, ShortTimeout)
+	defer cancel()
+	for {
+		var err error
+		st, serr := agent.CiliumStatus(ctx)
+		switch {
+		case serr != nil:
+			err = serr
+		case !st.IsOK():
+			err = fmt.Errorf("agent reports state %s", st.Cilium.State)
+		case !st.IsKvstoreOK():
+			err = fmt.Errorf("kvstore reports state %s", st.Kvstore.State)
+		case len(st.FailingControllers()) > 0:
+			err = fmt.Errorf("failing controllers: %s", strings.Join(st.FailingControllers(), ", "))
+		case len(st.StaleProbes()) > 0:
+			err = fmt.Errorf("stale probes: %s", strings.Join(st.StaleProbes(), ", "))
+		default:
+			return nil
+		}
+
+		log.Debugf("[%s] Cilium agent %s is not yet healthy: %s",
+			agent.K8sClient.ClusterName(), agent.Name(), err)
+
+		select {
+		case <-time.After(PollInterval):
+		case <-ctx.Done():
+			return fmt.Errorf("timeout reached waiting for Cilium agent %s to report a healthy status (last error: %w)",
+				agent.Name(), err)
+		}
+	}
+}
+
+// WaitForClusterMesh polls the status API of the given Cilium agent pod
+// until every configured remote cluster reports ready, so that multicluster
+// tests do not start before the clustermesh connections are established.
+func WaitForClusterMesh(ctx context.Context, log Logger, agent Pod) error {
+	log.Logf("⌛ [%s] Waiting for Cilium agent %s to report all remote clusters ready...",
+		agent.K8sClient.ClusterName(), agent.Name())
+
+	ctx, cancel := context.WithTimeout(ctx, LongTimeout)
+	defer cancel()
+	for {
+		var err error
+		st, serr := agent.CiliumStatus(ctx)
+		switch {
+		case serr != nil:
+			err = serr
+		case !st.IsClusterMeshReady():
+			err = errors.New("not all remote clusters are ready")
+		default:
+			return nil
+		}
+
+		log.Debugf("[%s] Clustermesh on Cilium agent %s is not yet ready: %s",
+			agent.K8sClient.ClusterName(), agent.Name(), err)
+
+		select {
+		case <-time.After(PollInterval):
+		case <-ctx.Done():
+			return fmt.Errorf("timeout reached waiting for clustermesh on Cilium agent %s to become ready (last error: %w)",
+				agent.Name(), err)
+		}
+	}
+}
+
 // Service waits until the specified service is created and can be retrieved.
 func WaitForServiceRetrieval(ctx context.Context, log Logger, client *k8s.Client, namespace string, name string) (Service, error) {
 	log.Logf("⌛ [%s] Retrieving service %s/%s ...", client.ClusterName(), namespace, name)
@@ -28,12 +28,18 @@ type FlowContext struct {
 	// Subsequent UDP matches using the same FlowContext will match this stored port number.
 	// Keyed by the known destination port so that we can track multiple connections at the same time
 	udpPorts portMap
+
+	// sctpPorts is filled in when matching a wildcarded source port for an SCTP packet.
+	// Subsequent SCTP matches using the same FlowContext will match this stored port number.
+	// Keyed by the known destination port so that we can track multiple connections at the same time
+	sctpPorts portMap
 }
 
 func NewFlowContext() FlowContext {
 	return FlowContext{
-		tcpPorts: make(portMap, 1),
-		udpPorts: make(portMap, 1),
+		tcpPorts:  make(portMap, 1),
+		udpPorts:  make(portMap, 1),
+		sctpPorts: make(portMap, 1),
 	}
 }
 
@@ -252,8 +258,8 @@ func ICMPv6(typ uint32) FlowFilterImplementation {
 }
 
 type udpFilter struct {
-	srcPort int
-	dstPort int
+	srcPort uint32
+	dstPort uint32
 }
 
 func (u *udpFilter) Match(flow *flowpb.Flow, fc *FlowContext) bool {
@@ -267,11 +273,11 @@ func (u *udpFilter) Match(flow *flowpb.Flow, fc *FlowContext) bool {
 		return false
 	}
 
-	if u.srcPort != 0 && udp.SourcePort != uint32(u.srcPort) {
+	if u.srcPort != 0 && udp.SourcePort != u.srcPort {
 		return false
 	}
 
-	if u.dstPort != 0 && udp.DestinationPort != uint32(u.dstPort) {
+	if u.dstPort != 0 && udp.DestinationPort != u.dstPort {
 		return false
 	}
 
@@ -290,14 +296,14 @@ func (u *udpFilter) String(fc *FlowContext) string {
 	var s []string
 	srcPort := u.srcPort
 	if srcPort == 0 {
-		srcPort = int(fc.udpPorts[uint32(u.dstPort)])
+		srcPort = fc.udpPorts[u.dstPort]
 	}
 	if srcPort != 0 {
 		s = append(s, fmt.Sprintf("srcPort=%d", srcPort))
 	}
 	dstPort := u.dstPort
 	if dstPort == 0 {
-		dstPort = int(fc.udpPorts[uint32(u.srcPort)])
+		dstPort = fc.udpPorts[u.srcPort]
 	}
 	if dstPort != 0 {
 		s = append(s, fmt.Sprintf("dstPort=%d", dstPort))
@@ -306,10 +312,69 @@ func (u *udpFilter) String(fc *FlowContext) string {
 }
 
 // UDP matches on UDP packets with the specified source and destination ports
-func UDP(srcPort, dstPort int) FlowFilterImplementation {
+func UDP(srcPort, dstPort uint32) FlowFilterImplementation {
 	return &udpFilter{srcPort: srcPort, dstPort: dstPort}
 }
 
+type sctpFilter struct {
+	srcPort uint32
+	dstPort uint32
+}
+
+func (s *sctpFilter) Match(flow *flowpb.Flow, fc *FlowContext) bool {
+	l4 := flow.GetL4()
+	if l4 == nil {
+		return false
+	}
+
+	sctp := l4.GetSCTP()
+	if sctp == nil {
+		return false
+	}
+
+	if s.srcPort != 0 && sctp.SourcePort != s.srcPort {
+		return false
+	}
+
+	if s.dstPort != 0 && sctp.DestinationPort != s.dstPort {
+		return false
+	}
+
+	if s.srcPort == 0 { // wildcarded source port
+		fc.sctpPorts[sctp.DestinationPort] = sctp.SourcePort
+	}
+	// Match previously seen (ephemeral) source port as the destination port?
+	if s.dstPort == 0 && fc.sctpPorts[sctp.SourcePort] != sctp.DestinationPort {
+		return false
+	}
+
+	return true
+}
+
+func (s *sctpFilter) String(fc *FlowContext) string {
+	var out []string
+	srcPort := s.srcPort
+	if srcPort == 0 {
+		srcPort = fc.sctpPorts[s.dstPort]
+	}
+	if srcPort != 0 {
+		out = append(out, fmt.Sprintf("srcPort=%d", srcPort))
+	}
+	dstPort := s.dstPort
+	if dstPort == 0 {
+		dstPort = fc.sctpPorts[s.srcPort]
+	}
+	if dstPort != 0 {
+		out = append(out, fmt.Sprintf("dstPort=%d", dstPort))
+	}
+	return "sctp(" + strings.Join(out, ",") + ")"
+}
+
+// SCTP matches on SCTP packets with the specified source and destination ports
+func SCTP(srcPort, dstPort uint32) FlowFilterImplementation {
+	return &sctpFilter{srcPort: srcPort, dstPort: dstPort}
+}
+
 type tcpFlagsFilter struct {
 	syn, ack, fin, rst bool
 }
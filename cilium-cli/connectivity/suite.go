@@ -6,6 +6,7 @@ package connectivity
 import (
 	"context"
 	"errors"
+	"maps"
 
 	"github.com/cilium/cilium/cilium-cli/connectivity/builder"
 	"github.com/cilium/cilium/cilium-cli/connectivity/check"
@@ -30,7 +31,12 @@ func Run(ctx context.Context, connTests []*check.ConnectivityTest, extra Hooks)
 	if err != nil {
 		return err
 	}
-	junitCollector := check.NewJUnitCollector(connTests[0].Params().JunitProperties, connTests[0].Params().JunitFile)
+	// Include the environment fingerprint in the report properties, so that
+	// reports show how the test namespaces were set up.
+	junitProperties := map[string]string{}
+	maps.Copy(junitProperties, connTests[0].Params().JunitProperties)
+	maps.Copy(junitProperties, connTests[0].Params().EnvironmentFingerprint())
+	junitCollector := check.NewJUnitCollector(junitProperties, connTests[0].Params().JunitFile)
 	for i := range suiteBuilders {
 		if e := suiteBuilders[i](connTests, extra.AddConnectivityTests); e != nil {
 			return e
@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package tests
+
+import (
+	"github.com/cilium/cilium/cilium-cli/connectivity/check"
+)
+
+// init registers the built-in scenarios which can be instantiated by name
+// from external scenario definitions. None of them take parameters, so the
+// factories ignore the params map.
+func init() {
+	register := func(name string, newScenario func() check.Scenario) {
+		check.RegisterScenarioFactory(name, func(_ map[string]string) (check.Scenario, error) {
+			return newScenario(), nil
+		})
+	}
+
+	register("pod-to-pod", func() check.Scenario { return PodToPod() })
+	register("pod-to-service", func() check.Scenario { return PodToService() })
+	register("pod-to-ingress-service", func() check.Scenario { return PodToIngress() })
+	register("pod-to-remote-nodeport", PodToRemoteNodePort)
+	register("pod-to-local-nodeport", PodToLocalNodePort)
+	register("pod-to-world", func() check.Scenario { return PodToWorld() })
+	register("pod-to-host", PodToHost)
+	register("pod-to-hostport", PodToHostPort)
+	register("host-to-pod", HostToPod)
+	register("client-to-client", ClientToClient)
+}
@@ -48,10 +48,33 @@ func (s *podToWorld) Name() string {
 }
 
 func (s *podToWorld) Run(ctx context.Context, t *check.Test) {
-	extTarget := t.Context().Params().ExternalTarget
-	http := check.HTTPEndpoint(extTarget+"-http", "http://"+extTarget)
-	https := check.HTTPEndpoint(extTarget+"-https", "https://"+extTarget)
-	httpsindex := check.HTTPEndpoint(extTarget+"-https-index", fmt.Sprintf("https://%s/index.html", extTarget))
+	// With no targets file, fall back to the single --external-target.
+	targets := t.Context().ExternalTargets()
+	if len(targets) == 0 {
+		targets = []*check.ExternalTarget{{Name: t.Context().Params().ExternalTarget}}
+	}
+
+	for _, target := range targets {
+		if reason := target.ProbeReason(); reason != "" {
+			t.Infof("Skipping external target %s: ground-truth probe failed (%s)", target.Name, reason)
+			continue
+		}
+		if target.DNSOnly {
+			t.Infof("Skipping requests to DNS-only external target %s", target.Name)
+			continue
+		}
+		s.runTarget(ctx, t, target)
+	}
+}
+
+// runTarget exercises a single external target from each client Pod. Action
+// names include the target, so results are broken out per target in the
+// reports.
+func (s *podToWorld) runTarget(ctx context.Context, t *check.Test, target *check.ExternalTarget) {
+	extTarget := target.Name
+	http := check.HTTPEndpoint(extTarget+"-http", target.URL("http"))
+	https := check.HTTPEndpoint(extTarget+"-https", target.URL("https"))
+	httpsindex := check.HTTPEndpoint(extTarget+"-https-index", target.URL("https")+"/index.html")
 
 	fp := check.FlowParameters{
 		DNSRequired: true,
@@ -71,25 +94,29 @@ func (s *podToWorld) Run(ctx context.Context, t *check.Test) {
 			}
 
 			// With http, over port 80.
-			httpOpts := s.rc.CurlOptions(http, ipFam, client, ct.Params())
-			t.NewAction(s, fmt.Sprintf("http-to-%s-%s-%d", extTarget, ipFam, i), &client, http, ipFam).Run(func(a *check.Action) {
-				a.ExecInPod(ctx, a.CurlCommand(http, httpOpts...))
-				a.ValidateFlows(ctx, client, a.GetEgressRequirements(fp))
-			})
-
-			// With https, over port 443.
-			httpsOpts := s.rc.CurlOptions(https, ipFam, client, ct.Params())
-			t.NewAction(s, fmt.Sprintf("https-to-%s-%s-%d", extTarget, ipFam, i), &client, https, ipFam).Run(func(a *check.Action) {
-				a.ExecInPod(ctx, a.CurlCommand(https, httpsOpts...))
-				a.ValidateFlows(ctx, client, a.GetEgressRequirements(fp))
-			})
+			if target.Protocol == "" || target.Protocol == "http" {
+				httpOpts := s.rc.CurlOptions(http, ipFam, client, ct.Params())
+				t.NewAction(s, fmt.Sprintf("http-to-%s-%s-%d", extTarget, ipFam, i), &client, http, ipFam).Run(func(a *check.Action) {
+					a.ExecInPod(ctx, a.CurlCommand(http, httpOpts...))
+					a.ValidateFlows(ctx, client, a.GetEgressRequirements(fp))
+				})
+			}
 
-			// With https, over port 443, index.html.
-			httpsindexOpts := s.rc.CurlOptions(httpsindex, ipFam, client, ct.Params())
-			t.NewAction(s, fmt.Sprintf("https-to-%s-index-%s-%d", extTarget, ipFam, i), &client, httpsindex, ipFam).Run(func(a *check.Action) {
-				a.ExecInPod(ctx, a.CurlCommand(httpsindex, httpsindexOpts...))
-				a.ValidateFlows(ctx, client, a.GetEgressRequirements(fp))
-			})
+			if target.Protocol == "" || target.Protocol == "https" {
+				// With https, over port 443.
+				httpsOpts := s.rc.CurlOptions(https, ipFam, client, ct.Params())
+				t.NewAction(s, fmt.Sprintf("https-to-%s-%s-%d", extTarget, ipFam, i), &client, https, ipFam).Run(func(a *check.Action) {
+					a.ExecInPod(ctx, a.CurlCommand(https, httpsOpts...))
+					a.ValidateFlows(ctx, client, a.GetEgressRequirements(fp))
+				})
+
+				// With https, over port 443, index.html.
+				httpsindexOpts := s.rc.CurlOptions(httpsindex, ipFam, client, ct.Params())
+				t.NewAction(s, fmt.Sprintf("https-to-%s-index-%s-%d", extTarget, ipFam, i), &client, httpsindex, ipFam).Run(func(a *check.Action) {
+					a.ExecInPod(ctx, a.CurlCommand(httpsindex, httpsindexOpts...))
+					a.ValidateFlows(ctx, client, a.GetEgressRequirements(fp))
+				})
+			}
 		})
 
 		i++
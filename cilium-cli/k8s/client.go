@@ -290,6 +290,10 @@ func (c *Client) GetNamespace(ctx context.Context, namespace string, options met
 	return c.Clientset.CoreV1().Namespaces().Get(ctx, namespace, options)
 }
 
+func (c *Client) UpdateNamespace(ctx context.Context, namespace *corev1.Namespace, opts metav1.UpdateOptions) (*corev1.Namespace, error) {
+	return c.Clientset.CoreV1().Namespaces().Update(ctx, namespace, opts)
+}
+
 func (c *Client) DeleteNamespace(ctx context.Context, namespace string, opts metav1.DeleteOptions) error {
 	return c.Clientset.CoreV1().Namespaces().Delete(ctx, namespace, opts)
 }
@@ -1258,6 +1258,17 @@ func (c *Collector) Run() error {
 				return nil
 			},
 		},
+		{
+			CreatesSubtasks: true,
+			Description:     "Collecting status introspection data from Cilium pods",
+			Quick:           true,
+			Task: func(_ context.Context) error {
+				if err := c.submitCiliumIntrospectionTasks(c.CiliumPods); err != nil {
+					return fmt.Errorf("failed to collect status introspection data: %w", err)
+				}
+				return nil
+			},
+		},
 		{
 			CreatesSubtasks: true,
 			Description:     "Collecting profiling data from Cilium Operator pods",
@@ -2453,6 +2464,96 @@ func (c *Collector) submitCiliumBugtoolTasks(pods []*corev1.Pod) error {
 	return nil
 }
 
+// introspectionDump describes one introspection surface of the agent that is
+// collected by running a command in the agent container and storing its
+// output as a per-node file.
+type introspectionDump struct {
+	filename string
+	cmd      []string
+}
+
+// statusSnapshotPath extracts the value of the --status-snapshot-file flag
+// from the agent container of the given pod. It returns an empty string when
+// the agent does not write a status snapshot.
+func statusSnapshotPath(pod *corev1.Pod) string {
+	for _, container := range pod.Spec.Containers {
+		if container.Name != ciliumAgentContainerName {
+			continue
+		}
+		for _, arg := range slices.Concat(container.Command, container.Args) {
+			if path, ok := strings.CutPrefix(arg, "--status-snapshot-file="); ok {
+				return path
+			}
+		}
+	}
+	return ""
+}
+
+// submitCiliumIntrospectionTasks collects the status introspection surfaces
+// of each Cilium agent pod: the endpoint garbage collection cycle history,
+// the verbose status including the stale probe list, and the on-host status
+// snapshot file when the agent is configured to write one. Each surface is
+// stored as a per-node JSON file. Agents too old to support one of the
+// commands fail only the corresponding subtask, with a note that shows up in
+// the sysdump summary.
+func (c *Collector) submitCiliumIntrospectionTasks(pods []*corev1.Pod) error {
+	for _, p := range pods {
+		p := p
+		if !podIsRunningAndHasContainer(p, ciliumAgentContainerName) {
+			continue
+		}
+
+		dumps := []introspectionDump{
+			{
+				filename: fmt.Sprintf(ciliumEndpointGCHistoryFileName, p.Name),
+				cmd:      []string{"cilium", "endpoint", "gc", "--history", "-o", "json"},
+			},
+			{
+				filename: fmt.Sprintf(ciliumStatusProbesFileName, p.Name),
+				cmd:      []string{"cilium", "status", "--verbose", "-o", "json"},
+			},
+		}
+		if path := statusSnapshotPath(p); path != "" {
+			dumps = append(dumps, introspectionDump{
+				filename: fmt.Sprintf(ciliumStatusSnapshotFileName, p.Name),
+				cmd:      []string{catCommand, path},
+			})
+		}
+
+		for _, d := range dumps {
+			d := d
+			if err := c.Pool.Submit(d.filename, func(ctx context.Context) error {
+				if err := c.WithFileSink(d.filename, func(out io.Writer) error {
+					var stderr bytes.Buffer
+
+					err := c.Client.ExecInPodWithWriters(ctx, nil, p.Namespace, p.Name, ciliumAgentContainerName, d.cmd, out, &stderr)
+					if err != nil {
+						stderrStr := stderr.String()
+						if strings.Contains(stderrStr, "Usage:") || strings.Contains(stderrStr, "unknown command") {
+							// Older agents do not know the command yet. Leave a
+							// hint in the summary instead of the cobra usage
+							// dump, which buries the actual problem.
+							stderrStr = "unknown command - this is expected if not supported by this Cilium version"
+						}
+
+						return fmt.Errorf("%w: %s", err, stderrStr)
+					}
+
+					return nil
+				}); err != nil {
+					return fmt.Errorf("failed to collect introspection data from %s/%s: %w", p.Namespace, p.Name, err)
+				}
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("failed to submit %s task: %w", d.filename, err)
+			}
+		}
+	}
+
+	return nil
+}
+
 func (c *Collector) submitHubbleFlowsTasks(_ context.Context, pods []*corev1.Pod, containerName string) error {
 	hubbleFlowsTimeout := strconv.FormatInt(int64(c.Options.HubbleFlowsTimeout/time.Second), 10)
 	for _, p := range pods {
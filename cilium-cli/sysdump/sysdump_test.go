@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/blang/semver/v4"
+	"github.com/cilium/workerpool"
 	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/assert"
 	appsv1 "k8s.io/api/apps/v1"
@@ -222,6 +223,84 @@ func TestKVStoreTask(t *testing.T) {
 	assert.Equal([]byte("{}"), data)
 }
 
+func TestCiliumIntrospectionTasks(t *testing.T) {
+	assert := assert.New(t)
+	client := &fakeClient{
+		nodeList: &corev1.NodeList{
+			Items: []corev1.Node{{ObjectMeta: metav1.ObjectMeta{Name: "node-a"}}},
+		},
+		execs: make(map[execRequest]execResult),
+	}
+	client.expectExec("ns0", "pod0", defaults.AgentContainerName,
+		[]string{"cilium", "endpoint", "gc", "--history", "-o", "json"},
+		[]byte("[]"), nil, nil)
+	client.expectExec("ns0", "pod0", defaults.AgentContainerName,
+		[]string{"cilium", "status", "--verbose", "-o", "json"},
+		[]byte("{}"), nil, nil)
+	client.expectExec("ns0", "pod0", defaults.AgentContainerName,
+		[]string{catCommand, "/run/cilium/status.json"},
+		[]byte(`{"overall":"ok"}`), nil, nil)
+	options := Options{
+		OutputFileName: "my-sysdump-<ts>",
+		Writer:         io.Discard,
+	}
+	collector, err := NewCollector(client, options, &nopHooks{}, time.Now())
+	assert.NoError(err)
+	collector.Pool = workerpool.New(1)
+	defer collector.Pool.Close()
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pod0",
+			Namespace: "ns0",
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name: defaults.AgentContainerName,
+				Args: []string{"--status-snapshot-file=/run/cilium/status.json"},
+			}},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	assert.NoError(collector.submitCiliumIntrospectionTasks([]*corev1.Pod{pod}))
+	results, err := collector.Pool.Drain()
+	assert.NoError(err)
+	assert.Len(results, 3)
+	for _, res := range results {
+		assert.NoError(res.Err())
+	}
+
+	for filename, expected := range map[string]string{
+		fmt.Sprintf(ciliumEndpointGCHistoryFileName, "pod0"): "[]",
+		fmt.Sprintf(ciliumStatusProbesFileName, "pod0"):      "{}",
+		fmt.Sprintf(ciliumStatusSnapshotFileName, "pod0"):    `{"overall":"ok"}`,
+	} {
+		fd, err := os.Open(path.Join(collector.sysdumpDir, collector.replaceTimestamp(filename)))
+		assert.NoError(err)
+		data, err := safeio.ReadAllLimit(fd, safeio.KB)
+		assert.NoError(err)
+		fd.Close()
+		assert.Equal(expected, string(data))
+	}
+}
+
+func TestStatusSnapshotPath(t *testing.T) {
+	assert := assert.New(t)
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name:    defaults.AgentContainerName,
+				Command: []string{"cilium-agent"},
+				Args:    []string{"--debug", "--status-snapshot-file=/run/cilium/status.json"},
+			}},
+		},
+	}
+	assert.Equal("/run/cilium/status.json", statusSnapshotPath(pod))
+
+	pod.Spec.Containers[0].Args = []string{"--debug"}
+	assert.Empty(statusSnapshotPath(pod))
+}
+
 func TestListCiliumEndpointSlices(t *testing.T) {
 	assert := assert.New(t)
 	client := &fakeClient{}
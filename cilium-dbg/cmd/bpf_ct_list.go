@@ -34,19 +34,28 @@ var (
 				return
 			}
 			common.RequireRootPrivilege("cilium bpf ct list")
-			dumpCt(getMaps(t, id), t)
+			switch ctListFormat {
+			case "":
+				dumpCt(getMaps(t, id), t)
+			case "pcap":
+				dumpCtPcap(getMaps(t, id), os.Stdout)
+			default:
+				cmd.PrintErrf("Invalid format %q: only \"pcap\" is supported", ctListFormat)
+			}
 		},
 	}
 
 	timeDiff                bool
 	timeDiffClockSourceMode string
 	timeDiffClockSourceHz   int64
+	ctListFormat            string
 )
 
 func init() {
 	bpfCtListCmd.Flags().BoolVarP(&timeDiff, "time-diff", "d", false, "print time difference for entries")
 	bpfCtListCmd.Flags().StringVar(&timeDiffClockSourceMode, "time-diff-clocksource-mode", "", "manually set clock source mode (instead of contacting the server)")
 	bpfCtListCmd.Flags().Int64Var(&timeDiffClockSourceHz, "time-diff-clocksource-hz", 250, "manually set clock source Hz")
+	bpfCtListCmd.Flags().StringVar(&ctListFormat, "format", "", "write entries as synthetic packets in the given format (pcap) to stdout")
 	BPFCtCmd.AddCommand(bpfCtListCmd)
 	command.AddOutputOption(bpfCtListCmd)
 }
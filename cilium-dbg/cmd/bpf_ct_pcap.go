@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+
+	"github.com/gopacket/gopacket"
+	"github.com/gopacket/gopacket/layers"
+	"github.com/gopacket/gopacket/pcapgo"
+
+	"github.com/cilium/cilium/pkg/bpf"
+	"github.com/cilium/cilium/pkg/maps/ctmap"
+	"github.com/cilium/cilium/pkg/maps/timestamp"
+	"github.com/cilium/cilium/pkg/time"
+	"github.com/cilium/cilium/pkg/u8proto"
+)
+
+// ctTupleAccessor is the subset of the tuple key accessors needed to
+// synthesize a packet from a CT entry. All CT key flavors implement it.
+type ctTupleAccessor interface {
+	GetDestAddr() netip.Addr
+	GetDestPort() uint16
+	GetSourceAddr() netip.Addr
+	GetSourcePort() uint16
+	GetNextHeader() u8proto.U8proto
+}
+
+// dumpCtPcap writes one synthetic pseudo-packet per CT entry to w in pcap
+// format, so that CT table snapshots can be imported into tools such as
+// Wireshark for analysis.
+func dumpCtPcap(maps []ctmap.CtMap, w io.Writer) {
+	clockSource, err := getClockSource()
+	if err != nil {
+		Fatalf("could not determine clocksource: %s", err)
+	}
+	converter, err := timestamp.NewCTTimeToSecConverter(clockSource)
+	if err != nil {
+		Fatalf("could not create timestamp converter: %s", err)
+	}
+	curTime, err := timestamp.GetCTCurTime(clockSource)
+	if err != nil {
+		Fatalf("could not determine current CT time: %s", err)
+	}
+	now := time.Now()
+
+	pcapWriter := pcapgo.NewWriter(w)
+	if err := pcapWriter.WriteFileHeader(65535, layers.LinkTypeRaw); err != nil {
+		Fatalf("Error while writing pcap file header: %s", err)
+	}
+
+	for _, m := range maps {
+		path, err := ctmap.OpenCTMap(m)
+		if err != nil {
+			if os.IsNotExist(err) {
+				fmt.Fprintf(os.Stderr, "Unable to open %s: %s. Skipping.\n", path, err)
+				continue
+			}
+			Fatalf("Unable to open %s: %s", path, err)
+		}
+		defer m.Close()
+
+		callback := func(key bpf.MapKey, value bpf.MapValue) {
+			entry := value.(*ctmap.CtEntry)
+			t, ok := key.(ctmap.CtKey).ToHost().GetTupleKey().(ctTupleAccessor)
+			if !ok {
+				fmt.Fprintf(os.Stderr, "Unsupported CT key type for entry %s. Skipping.\n", key)
+				return
+			}
+			data, err := ctEntryPacket(t)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Unable to serialize CT entry %s: %s. Skipping.\n", key, err)
+				return
+			}
+
+			// CT entries do not record their creation time, so use the
+			// oldest traffic report as the closest approximation and
+			// anchor it to the wallclock via the current CT time.
+			created := entry.LastTxReport
+			if entry.LastRxReport != 0 && (created == 0 || entry.LastRxReport < created) {
+				created = entry.LastRxReport
+			}
+			age := time.Duration(curTime-converter(uint64(created))) * time.Second
+
+			err = pcapWriter.WritePacket(gopacket.CaptureInfo{
+				Timestamp:     now.Add(-age),
+				CaptureLength: len(data),
+				Length:        len(data),
+			}, data)
+			if err != nil {
+				Fatalf("Error while writing pcap packet: %s", err)
+			}
+		}
+		if err := m.DumpWithCallback(callback); err != nil {
+			Fatalf("Error while collecting BPF map entries: %s", err)
+		}
+	}
+}
+
+// ctEntryPacket constructs a synthetic raw IP packet from the given CT
+// tuple. TCP and UDP entries carry the corresponding transport layer, any
+// other protocol is represented by a bare IP header.
+func ctEntryPacket(t ctTupleAccessor) ([]byte, error) {
+	srcAddr := t.GetSourceAddr()
+	dstAddr := t.GetDestAddr()
+
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+
+	var network gopacket.NetworkLayer
+	if srcAddr.Is4() {
+		network = &layers.IPv4{
+			Version:  4,
+			TTL:      64,
+			Protocol: layers.IPProtocol(t.GetNextHeader()),
+			SrcIP:    srcAddr.AsSlice(),
+			DstIP:    dstAddr.AsSlice(),
+		}
+	} else {
+		network = &layers.IPv6{
+			Version:    6,
+			HopLimit:   64,
+			NextHeader: layers.IPProtocol(t.GetNextHeader()),
+			SrcIP:      srcAddr.AsSlice(),
+			DstIP:      dstAddr.AsSlice(),
+		}
+	}
+
+	switch t.GetNextHeader() {
+	case u8proto.TCP:
+		transport := &layers.TCP{
+			SrcPort: layers.TCPPort(t.GetSourcePort()),
+			DstPort: layers.TCPPort(t.GetDestPort()),
+		}
+		if err := transport.SetNetworkLayerForChecksum(network); err != nil {
+			return nil, err
+		}
+		if err := gopacket.SerializeLayers(buf, opts, network.(gopacket.SerializableLayer), transport); err != nil {
+			return nil, err
+		}
+	case u8proto.UDP:
+		transport := &layers.UDP{
+			SrcPort: layers.UDPPort(t.GetSourcePort()),
+			DstPort: layers.UDPPort(t.GetDestPort()),
+		}
+		if err := transport.SetNetworkLayerForChecksum(network); err != nil {
+			return nil, err
+		}
+		if err := gopacket.SerializeLayers(buf, opts, network.(gopacket.SerializableLayer), transport); err != nil {
+			return nil, err
+		}
+	default:
+		if err := gopacket.SerializeLayers(buf, opts, network.(gopacket.SerializableLayer)); err != nil {
+			return nil, err
+		}
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,274 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"fmt"
+	"net/netip"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cilium/cilium/pkg/bpf"
+	"github.com/cilium/cilium/pkg/command"
+	"github.com/cilium/cilium/pkg/common"
+	linuxconfig "github.com/cilium/cilium/pkg/datapath/linux/config"
+	"github.com/cilium/cilium/pkg/maps/nat"
+	"github.com/cilium/cilium/pkg/maps/nat/stats"
+	"github.com/cilium/cilium/pkg/option"
+	"github.com/cilium/cilium/pkg/tuple"
+	"github.com/cilium/cilium/pkg/u8proto"
+)
+
+// natSummaryTopPairs caps the number of rows printed by --exhaustion.
+const natSummaryTopPairs = 20
+
+var natSummaryExhaustion bool
+
+// natPairUsage describes how much of the SNAT source port space a single
+// (srcIP,dstIP) pair has consumed. UsedPorts is the highest number of source
+// ports allocated towards a single remote port, which is the
+// exhaustion-relevant figure since allocated ports must only be unique per
+// connection tuple.
+type natPairUsage struct {
+	Family     string
+	EgressIP   string
+	RemoteIP   string
+	RemotePort uint16
+	Proto      string
+	UsedPorts  int
+	MaxPorts   int
+}
+
+// natFamilySummary is the per-family aggregate shown by "bpf nat summary".
+type natFamilySummary struct {
+	Family   string
+	Entries  int
+	Pairs    int
+	PeakUsed int
+	MaxPorts int
+}
+
+// bpfNatSummaryCmd represents the bpf_nat_summary command
+var bpfNatSummaryCmd = &cobra.Command{
+	Use:   "summary",
+	Short: "Summarize SNAT source port usage",
+	Run: func(cmd *cobra.Command, args []string) {
+		common.RequireRootPrivilege("cilium bpf nat summary")
+		if len(args) != 0 {
+			cmd.PrintErr("Invalid argument")
+			return
+		}
+
+		ipv4, ipv6 := getIpEnableStatuses()
+		maxPorts := natSummaryMaxPorts()
+		ipv4Map, ipv6Map := nat.GlobalMaps(ipv4, ipv6, true)
+
+		var usage []natPairUsage
+		var summaries []natFamilySummary
+		if ipv4Map != nil && openNatMapForSummary(ipv4Map) {
+			defer ipv4Map.Close()
+			buckets, entries := countNatBuckets4(ipv4Map)
+			fam := foldNatBuckets(nat.IPv4.String(), buckets, maxPorts)
+			usage = append(usage, fam...)
+			summaries = append(summaries, summarizeNatUsage(nat.IPv4.String(), entries, fam, maxPorts))
+		}
+		if ipv6Map != nil && openNatMapForSummary(ipv6Map) {
+			defer ipv6Map.Close()
+			buckets, entries := countNatBuckets6(ipv6Map)
+			fam := foldNatBuckets(nat.IPv6.String(), buckets, maxPorts)
+			usage = append(usage, fam...)
+			summaries = append(summaries, summarizeNatUsage(nat.IPv6.String(), entries, fam, maxPorts))
+		}
+
+		sortNatUsage(usage)
+		if natSummaryExhaustion {
+			if len(usage) > natSummaryTopPairs {
+				usage = usage[:natSummaryTopPairs]
+			}
+			printNatUsage(usage)
+		} else {
+			printNatSummaries(summaries)
+		}
+	},
+}
+
+func init() {
+	BPFNatCmd.AddCommand(bpfNatSummaryCmd)
+	command.AddOutputOption(bpfNatSummaryCmd)
+	bpfNatSummaryCmd.Flags().BoolVar(&natSummaryExhaustion, "exhaustion", false,
+		"List the (srcIP,dstIP) pairs closest to exhausting their source port space")
+}
+
+// natSummaryMaxPorts returns the number of source ports available to a single
+// connection tuple. SNAT allocates from above the NodePort range up to
+// NODEPORT_PORT_MAX_NAT; the NodePort range is taken from the agent runtime
+// configuration when available, falling back to the default.
+func natSummaryMaxPorts() int {
+	nodePortMax := option.Config.NodePortMax
+	if nodePortMax == 0 {
+		nodePortMax = option.NodePortMaxDefault
+	}
+	return linuxconfig.NodePortMaxNAT - (nodePortMax + 1)
+}
+
+func openNatMapForSummary(m nat.NatMap) bool {
+	path, err := m.Path()
+	if err == nil {
+		err = m.Open()
+	}
+	if err != nil {
+		if os.IsNotExist(err) {
+			fmt.Fprintf(os.Stderr, "Unable to open %s: %s. Skipping.\n", path, err)
+			return false
+		}
+		Fatalf("Unable to open %s: %s", path, err)
+	}
+	return true
+}
+
+// countNatBuckets4 walks the IPv4 NAT map and counts the reverse (SNAT)
+// entries per connection tuple, mirroring the aggregation performed by the
+// nat-stats module.
+func countNatBuckets4(m nat.NatMap) (map[stats.SNATTuple4]int, int) {
+	buckets := make(map[stats.SNATTuple4]int, 128)
+	entries := 0
+	callback := func(key bpf.MapKey, _ bpf.MapValue) {
+		k := key.(*nat.NatKey4).ToHost().(*nat.NatKey4).TupleKey4
+		if k.Flags&tuple.TUPLE_F_IN == 0 {
+			return
+		}
+		switch k.NextHeader {
+		case u8proto.TCP, u8proto.UDP, u8proto.ICMP:
+		default:
+			return
+		}
+		entries++
+		k.DestPort = 0
+		buckets[stats.SNATTuple4(k)]++
+	}
+	if err := m.DumpWithCallback(callback); err != nil {
+		Fatalf("Error while collecting BPF map entries: %s", err)
+	}
+	return buckets, entries
+}
+
+// countNatBuckets6 is the IPv6 counterpart of countNatBuckets4.
+func countNatBuckets6(m nat.NatMap) (map[stats.SNATTuple6]int, int) {
+	buckets := make(map[stats.SNATTuple6]int, 128)
+	entries := 0
+	callback := func(key bpf.MapKey, _ bpf.MapValue) {
+		k := key.(*nat.NatKey6).ToHost().(*nat.NatKey6).TupleKey6
+		if k.Flags&tuple.TUPLE_F_IN == 0 {
+			return
+		}
+		switch k.NextHeader {
+		case u8proto.TCP, u8proto.UDP, u8proto.ICMPv6:
+		default:
+			return
+		}
+		entries++
+		k.DestPort = 0
+		buckets[stats.SNATTuple6(k)]++
+	}
+	if err := m.DumpWithCallback(callback); err != nil {
+		Fatalf("Error while collecting BPF map entries: %s", err)
+	}
+	return buckets, entries
+}
+
+// foldNatBuckets reduces per-tuple counts to one entry per (srcIP,dstIP)
+// pair, keeping the most loaded tuple of each pair.
+func foldNatBuckets[T interface {
+	comparable
+	stats.SNATTupleAccessor
+}](family string, buckets map[T]int, maxPorts int) []natPairUsage {
+	type pairKey struct {
+		egress, remote netip.Addr
+	}
+	best := make(map[pairKey]natPairUsage, len(buckets))
+	for t, count := range buckets {
+		egressIP, _ := t.GetEgressAddr()
+		remoteIP, remotePort := t.GetEndpointAddr()
+		k := pairKey{egressIP, remoteIP}
+		if b, ok := best[k]; !ok || count > b.UsedPorts {
+			best[k] = natPairUsage{
+				Family:     family,
+				EgressIP:   egressIP.String(),
+				RemoteIP:   remoteIP.String(),
+				RemotePort: remotePort,
+				Proto:      t.GetProto().String(),
+				UsedPorts:  count,
+				MaxPorts:   maxPorts,
+			}
+		}
+	}
+	usage := make([]natPairUsage, 0, len(best))
+	for _, u := range best {
+		usage = append(usage, u)
+	}
+	return usage
+}
+
+func sortNatUsage(usage []natPairUsage) {
+	sort.Slice(usage, func(i, j int) bool {
+		if usage[i].UsedPorts != usage[j].UsedPorts {
+			return usage[i].UsedPorts > usage[j].UsedPorts
+		}
+		if usage[i].EgressIP != usage[j].EgressIP {
+			return usage[i].EgressIP < usage[j].EgressIP
+		}
+		return usage[i].RemoteIP < usage[j].RemoteIP
+	})
+}
+
+func summarizeNatUsage(family string, entries int, usage []natPairUsage, maxPorts int) natFamilySummary {
+	peak := 0
+	for _, u := range usage {
+		peak = max(peak, u.UsedPorts)
+	}
+	return natFamilySummary{
+		Family:   family,
+		Entries:  entries,
+		Pairs:    len(usage),
+		PeakUsed: peak,
+		MaxPorts: maxPorts,
+	}
+}
+
+func printNatUsage(usage []natPairUsage) {
+	if command.OutputOption() {
+		if err := command.PrintOutput(usage); err != nil {
+			os.Exit(1)
+		}
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 5, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "FAMILY\tSRC\tDST\tPROTO\tDPORT\tUSED\tMAX\tUSAGE")
+	for _, u := range usage {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%d\t%d\t%.2f%%\n",
+			u.Family, u.EgressIP, u.RemoteIP, u.Proto, u.RemotePort,
+			u.UsedPorts, u.MaxPorts, float64(u.UsedPorts)/float64(u.MaxPorts)*100)
+	}
+	w.Flush()
+}
+
+func printNatSummaries(summaries []natFamilySummary) {
+	if command.OutputOption() {
+		if err := command.PrintOutput(summaries); err != nil {
+			os.Exit(1)
+		}
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 5, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "FAMILY\tENTRIES\tPAIRS\tPEAK USED\tMAX\tPEAK USAGE")
+	for _, s := range summaries {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%.2f%%\n",
+			s.Family, s.Entries, s.Pairs, s.PeakUsed, s.MaxPorts,
+			float64(s.PeakUsed)/float64(s.MaxPorts)*100)
+	}
+	w.Flush()
+}
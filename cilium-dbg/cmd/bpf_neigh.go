@@ -0,0 +1,18 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// BPFNeighCmd represents the bpf neigh command
+var BPFNeighCmd = &cobra.Command{
+	Use:   "neigh",
+	Short: "Manage the neighbor entries for node next-hops",
+}
+
+func init() {
+	BPFCmd.AddCommand(BPFNeighCmd)
+}
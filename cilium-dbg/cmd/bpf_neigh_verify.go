@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cilium/cilium/pkg/command"
+)
+
+const neighVerifyUsage = "Verify that every remote node reachable through the " +
+	"neighbor discovery devices has a kernel neighbor entry for its next hop.\n"
+
+var bpfNeighVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Verify neighbor entries for node next-hops",
+	Long:  neighVerifyUsage,
+	Run: func(cmd *cobra.Command, args []string) {
+		resp, err := client.Daemon.GetHealthz(nil)
+		if err != nil {
+			Fatalf("%s", err)
+		}
+
+		nc := resp.Payload.NeighborConsistency
+		if nc == nil {
+			Fatalf("Neighbor consistency information is not available. Is node neighbor discovery enabled?")
+		}
+
+		if command.OutputOption() {
+			if err := command.PrintOutput(nc); err != nil {
+				os.Exit(1)
+			}
+			return
+		}
+
+		fmt.Printf("BPF neighbor map entries: %d\n", nc.BpfEntries)
+
+		total := len(nc.Missing) + len(nc.Stale)
+		if total == 0 {
+			fmt.Println("Neighbor entries for all node next-hops are present")
+			return
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 5, 0, 3, ' ', 0)
+		fmt.Fprintf(w, "Node\tEntry\n")
+		for _, name := range nc.Missing {
+			fmt.Fprintf(w, "%s\tmissing\n", name)
+		}
+		for _, name := range nc.Stale {
+			fmt.Fprintf(w, "%s\tstale\n", name)
+		}
+		w.Flush()
+
+		fmt.Fprintf(os.Stderr, "%d nodes with missing or stale neighbor entries\n", total)
+		os.Exit(1)
+	},
+}
+
+func init() {
+	BPFNeighCmd.AddCommand(bpfNeighVerifyCmd)
+	command.AddOutputOption(bpfNeighVerifyCmd)
+}
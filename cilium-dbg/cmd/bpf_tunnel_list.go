@@ -4,7 +4,11 @@
 package cmd
 
 import (
+	"fmt"
+	"maps"
 	"os"
+	"slices"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -18,6 +22,8 @@ const (
 	destinationTitle = "VALUE"
 )
 
+var bpfTunnelListByNode bool
+
 var bpfTunnelListCmd = &cobra.Command{
 	Use:     "list",
 	Aliases: []string{"ls"},
@@ -37,11 +43,71 @@ var bpfTunnelListCmd = &cobra.Command{
 			return
 		}
 
+		if bpfTunnelListByNode {
+			printTunnelsByNode(tunnelList)
+			return
+		}
+
 		TablePrinter(tunnelTitle, destinationTitle, tunnelList)
 	},
 }
 
+// nodeNamesByIP maps node IP addresses to node names using the node list
+// reported by the agent. Returns an empty map if the agent is unreachable.
+func nodeNamesByIP() map[string]string {
+	names := make(map[string]string)
+	resp, err := client.Daemon.GetClusterNodes(nil)
+	if err != nil || resp.Payload == nil {
+		return names
+	}
+	for _, node := range resp.Payload.NodesAdded {
+		if node.PrimaryAddress == nil {
+			continue
+		}
+		if ipv4 := node.PrimaryAddress.IPV4; ipv4 != nil && ipv4.IP != "" {
+			names[ipv4.IP] = node.Name
+		}
+		if ipv6 := node.PrimaryAddress.IPV6; ipv6 != nil && ipv6.IP != "" {
+			names[ipv6.IP] = node.Name
+		}
+	}
+	return names
+}
+
+// printTunnelsByNode prints the tunnel map grouped by overlay (node) address,
+// mirroring the layout of ip route show.
+func printTunnelsByNode(tunnelList map[string][]string) {
+	byNode := make(map[string][]string)
+	for prefix, values := range tunnelList {
+		for _, value := range values {
+			// The value is formatted as "<ip>:<key>"; strip the key
+			// suffix to obtain the node address.
+			nodeIP := value
+			if i := strings.LastIndex(value, ":"); i >= 0 {
+				nodeIP = value[:i]
+			}
+			byNode[nodeIP] = append(byNode[nodeIP], prefix)
+		}
+	}
+
+	names := nodeNamesByIP()
+
+	for _, nodeIP := range slices.Sorted(maps.Keys(byNode)) {
+		prefixes := byNode[nodeIP]
+		slices.Sort(prefixes)
+		header := nodeIP
+		if name, ok := names[nodeIP]; ok {
+			header = fmt.Sprintf("%s (%s)", nodeIP, name)
+		}
+		fmt.Printf("%s: %d route(s)\n", header, len(prefixes))
+		for _, prefix := range prefixes {
+			fmt.Printf("  %s\n", prefix)
+		}
+	}
+}
+
 func init() {
 	BPFTunnelCmd.AddCommand(bpfTunnelListCmd)
+	bpfTunnelListCmd.Flags().BoolVar(&bpfTunnelListByNode, "by-node", false, "Group tunnel entries by node address")
 	command.AddOutputOption(bpfTunnelListCmd)
 }
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"fmt"
+	"net/netip"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cilium/cilium/api/v1/models"
+	"github.com/cilium/cilium/pkg/common"
+	"github.com/cilium/cilium/pkg/maps/tunnel"
+)
+
+var bpfTunnelVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Verify tunnel endpoint entries against the known nodes",
+	Long: `Compare the tunnel map against the node list reported by the agent and
+print the missing, orphaned and mismatched entries.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		common.RequireRootPrivilege("cilium bpf tunnel verify")
+
+		actual, err := tunnel.TunnelMap().DumpEndpoints()
+		if err != nil {
+			Fatalf("Unable to dump tunnel map: %s", err)
+		}
+
+		resp, err := client.Daemon.GetClusterNodes(nil)
+		if err != nil {
+			Fatalf("Cannot get node list: %s", err)
+		}
+
+		d := tunnel.CompareEntries(expectedTunnelEndpoints(resp.Payload), actual)
+		if d.Count() == 0 {
+			fmt.Printf("Tunnel map is consistent with the %d known node(s)\n",
+				len(resp.Payload.NodesAdded))
+			return
+		}
+
+		printDiscrepancies("Missing entries (expected but absent from the tunnel map):", d.Missing)
+		printDiscrepancies("Orphaned entries (no corresponding known node):", d.Orphaned)
+		printDiscrepancies("Mismatched entries (unexpected tunnel endpoint):", d.Mismatched)
+		os.Exit(1)
+	},
+}
+
+// expectedTunnelEndpoints derives the expected tunnel map contents from the
+// node list reported by the agent, excluding the local node.
+func expectedTunnelEndpoints(nodes *models.ClusterNodeStatus) map[string]string {
+	expected := map[string]string{}
+	if nodes == nil {
+		return expected
+	}
+
+	for _, node := range nodes.NodesAdded {
+		if node.Name == nodes.Self || node.PrimaryAddress == nil {
+			continue
+		}
+
+		// The IPv4 node IP serves as the tunnel endpoint for both address
+		// families.
+		ipv4 := node.PrimaryAddress.IPV4
+		if ipv4 == nil || ipv4.IP == "" {
+			continue
+		}
+
+		for _, addressing := range []*models.NodeAddressingElement{ipv4, node.PrimaryAddress.IPV6} {
+			if addressing == nil || !addressing.Enabled || addressing.AllocRange == "" {
+				continue
+			}
+			prefix, err := netip.ParsePrefix(addressing.AllocRange)
+			if err != nil {
+				continue
+			}
+			expected[prefix.Masked().Addr().String()] = ipv4.IP
+		}
+	}
+
+	return expected
+}
+
+func printDiscrepancies(header string, entries []string) {
+	if len(entries) == 0 {
+		return
+	}
+	fmt.Println(header)
+	for _, entry := range entries {
+		fmt.Printf("  %s\n", entry)
+	}
+}
+
+func init() {
+	BPFTunnelCmd.AddCommand(bpfTunnelVerifyCmd)
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cilium/cilium/pkg/command"
+)
+
+var endpointGCHistory bool
+
+// endpointGCCmd represents the endpoint_gc command
+var endpointGCCmd = &cobra.Command{
+	Use:     "gc",
+	Short:   "View endpoint garbage collection results",
+	Example: "cilium endpoint gc --history",
+	Run: func(cmd *cobra.Command, args []string) {
+		getEndpointGC(cmd, args)
+	},
+}
+
+func init() {
+	EndpointCmd.AddCommand(endpointGCCmd)
+	endpointGCCmd.Flags().BoolVar(&endpointGCHistory, "history", false, "Show all retained garbage collection cycles")
+	command.AddOutputOption(endpointGCCmd)
+}
+
+func getEndpointGC(cmd *cobra.Command, args []string) {
+	history, err := client.EndpointGCHistory()
+	if err != nil {
+		Fatalf("Cannot get endpoint garbage collection history: %s\n", err)
+	}
+
+	if command.OutputOption() {
+		if err := command.PrintOutput(history); err != nil {
+			os.Exit(1)
+		}
+		return
+	}
+
+	if len(history) == 0 {
+		fmt.Println("No garbage collection cycles have completed yet")
+		return
+	}
+
+	if !endpointGCHistory {
+		// Only print the most recent cycle.
+		history = history[len(history)-1:]
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 2, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "TIMESTAMP\tEVALUATED\tMARKED\tSWEPT")
+	for _, cycle := range history {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\n",
+			time.Time(cycle.Timestamp).Format(time.RFC3339),
+			cycle.EndpointsEvaluated, cycle.EndpointsMarked, cycle.EndpointsSwept)
+	}
+	w.Flush()
+}
@@ -4,17 +4,31 @@
 package cmd
 
 import (
+	"errors"
+	"fmt"
+	"io"
 	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 
 	endpointApi "github.com/cilium/cilium/api/v1/client/endpoint"
+	policyApi "github.com/cilium/cilium/api/v1/client/policy"
 	"github.com/cilium/cilium/api/v1/models"
 	"github.com/cilium/cilium/pkg/api"
 	"github.com/cilium/cilium/pkg/command"
+	"github.com/cilium/cilium/pkg/identity"
+	"github.com/cilium/cilium/pkg/labels"
 )
 
-var lbls []string
+var (
+	lbls            []string
+	showBPFMaps     bool
+	showFQDNHistory bool
+	maxLabelLen     int
+)
 
 // endpointGetCmd represents the endpoint_get command
 var endpointGetCmd = &cobra.Command{
@@ -47,12 +61,41 @@ var endpointGetCmd = &cobra.Command{
 			endpointInst = append(endpointInst, result)
 		}
 
+		if showFQDNHistory {
+			printEndpointDNSHistory(endpointInst)
+			return
+		}
+
+		if !showBPFMaps {
+			for _, e := range endpointInst {
+				if e.Status != nil {
+					e.Status.BpfMaps = nil
+				}
+			}
+		}
+
 		if command.OutputOption() {
-			if err := command.PrintOutput(endpointInst); err != nil {
+			// endpointTable marshals identically to the plain endpoint
+			// slice, so json, yaml and jsonpath output is unaffected by
+			// the wrapping.
+			if err := command.PrintOutput(endpointTable(endpointInst)); err != nil {
 				os.Exit(1)
 			}
 			return
 		}
+		// Annotate endpoints whose security labels map to a reserved
+		// identity, so assignments like reserved:health are
+		// self-explanatory. Written to stderr to keep stdout valid JSON.
+		for _, e := range endpointInst {
+			if e.Status == nil || e.Status.Identity == nil {
+				continue
+			}
+			epLabels := labels.NewLabelsFromModel(e.Status.Identity.Labels)
+			if nid, ok := identity.MatchesReservedIdentity(epLabels); ok {
+				fmt.Fprintf(os.Stderr, "Endpoint %d: labels map to reserved identity %q (%d)\n",
+					e.ID, labels.LabelSourceReserved+":"+nid.String(), nid)
+			}
+		}
 		command.ForceJSON()
 		if err := command.PrintOutput(endpointInst); err != nil {
 			os.Exit(1)
@@ -60,8 +103,82 @@ var endpointGetCmd = &cobra.Command{
 	},
 }
 
+// endpointTable renders one or more endpoints as an aligned table for the
+// "table" output mode, one row per security relevant label.
+type endpointTable []*models.Endpoint
+
+func (eps endpointTable) PrintTable(w io.Writer) error {
+	fmt.Fprintf(w, "ENDPOINT\tSTATE\tIPv4\tIPv6\tIDENTITY\tPOLICY (ingress)\tPOLICY (egress)\tLABELS\t\n")
+	for _, ep := range eps {
+		ipv6, ipv4 := endpointAddressPair(ep)
+		policyIngress, policyEgress := endpointPolicyMode(ep)
+		for i, lbl := range endpointLabels(ep) {
+			if i == 0 {
+				fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t\n",
+					ep.ID, endpointState(ep), ipv4, ipv6, endpointID(ep),
+					policyIngress, policyEgress, truncateLabel(lbl))
+			} else {
+				fmt.Fprintf(w, "\t\t\t\t\t\t\t%s\t\n", truncateLabel(lbl))
+			}
+		}
+	}
+	return nil
+}
+
+// truncateLabel shortens the given label to at most maxLabelLen characters,
+// marking the cut with an ellipsis. A non-positive maxLabelLen disables
+// truncation.
+func truncateLabel(lbl string) string {
+	if maxLabelLen <= 3 || len(lbl) <= maxLabelLen {
+		return lbl
+	}
+	return lbl[:maxLabelLen-3] + "..."
+}
+
+// printEndpointDNSHistory prints the DNS lookups the FQDN proxy intercepted
+// from the given endpoints, with the resolved IPs and their TTLs, so that
+// operators can confirm a ToFQDN policy is seeing the resolutions it needs.
+func printEndpointDNSHistory(eps []*models.Endpoint) {
+	lookups := []*models.DNSLookup{}
+	for _, e := range eps {
+		params := policyApi.NewGetFqdnCacheIDParams().WithID(strconv.FormatInt(e.ID, 10)).WithTimeout(api.ClientTimeout)
+		result, err := client.Policy.GetFqdnCacheID(params)
+		if err != nil {
+			notFound := &policyApi.GetFqdnCacheIDNotFound{}
+			if !errors.As(err, &notFound) {
+				Fatalf("Cannot get DNS history for endpoint %d: %s\n", e.ID, err)
+			}
+			continue
+		}
+		lookups = append(lookups, result.Payload...)
+	}
+
+	if command.OutputOption() {
+		if err := command.PrintOutput(lookups); err != nil {
+			os.Exit(1)
+		}
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 5, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "Endpoint\tSource\tFQDN\tTTL\tExpirationTime\tIPs\t")
+	for _, lookup := range lookups {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%d\t%s\t%s\t\n",
+			lookup.EndpointID,
+			lookup.Source,
+			lookup.Fqdn,
+			lookup.TTL,
+			lookup.ExpirationTime.String(),
+			strings.Join(lookup.Ips, ","))
+	}
+	w.Flush()
+}
+
 func init() {
 	EndpointCmd.AddCommand(endpointGetCmd)
 	endpointGetCmd.Flags().StringSliceVarP(&lbls, "labels", "l", []string{}, "list of labels")
+	endpointGetCmd.Flags().BoolVar(&showBPFMaps, "show-bpf-maps", false, "Include the endpoint's BPF map IDs and pin paths in the output")
+	endpointGetCmd.Flags().BoolVar(&showFQDNHistory, "fqdn-history", false, "Print the endpoint's recent DNS lookups and resolved IPs instead of the endpoint model")
+	endpointGetCmd.Flags().IntVar(&maxLabelLen, "max-label-len", 80, "Truncate labels longer than this in table output (0 to disable)")
 	command.AddOutputOption(endpointGetCmd)
 }
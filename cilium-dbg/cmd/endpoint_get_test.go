@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"text/tabwriter"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/cilium/api/v1/models"
+)
+
+func TestTruncateLabel(t *testing.T) {
+	maxLabelLen = 10
+	require.Equal(t, "short", truncateLabel("short"))
+	require.Equal(t, "exactly10!", truncateLabel("exactly10!"))
+	require.Equal(t, "longert...", truncateLabel("longerthanthelimit"))
+
+	// A non-positive limit disables truncation.
+	maxLabelLen = 0
+	require.Equal(t, "longerthanthelimit", truncateLabel("longerthanthelimit"))
+}
+
+func TestEndpointTablePrint(t *testing.T) {
+	maxLabelLen = 80
+	state := models.EndpointState("ready")
+	eps := endpointTable{
+		{
+			ID: 42,
+			Status: &models.EndpointStatus{
+				State: &state,
+				Identity: &models.Identity{
+					ID: 1234,
+				},
+				Labels: &models.LabelConfigurationStatus{
+					SecurityRelevant: []string{"k8s:app=foo", "k8s:io.kubernetes.pod.namespace=default"},
+				},
+				Networking: &models.EndpointNetworking{
+					Addressing: []*models.AddressPair{
+						{IPV4: "10.0.0.42", IPV6: "f00d::42"},
+					},
+				},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 5, 0, 3, ' ', 0)
+	require.NoError(t, eps.PrintTable(w))
+	require.NoError(t, w.Flush())
+
+	out := buf.String()
+	require.Contains(t, out, "ENDPOINT")
+	require.Contains(t, out, "42")
+	require.Contains(t, out, "ready")
+	require.Contains(t, out, "10.0.0.42")
+	require.Contains(t, out, "f00d::42")
+	require.Contains(t, out, "1234")
+	require.Contains(t, out, "k8s:app=foo")
+	// One row per label, plus the header.
+	require.Len(t, strings.Split(strings.TrimRight(out, "\n"), "\n"), 3)
+}
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/cilium/cilium/api/v1/models"
+	endpointid "github.com/cilium/cilium/pkg/endpoint/id"
+	"github.com/cilium/cilium/pkg/labels/model"
+)
+
+var (
+	exportAllEndpoints bool
+	exportNamespace    string
+	exportOutputDir    string
+)
+
+// endpointLabelsDocument is the YAML document describing the identity label
+// assignment of a single endpoint. It doubles as the import format for label
+// assignments.
+type endpointLabelsDocument struct {
+	EndpointID   int64    `yaml:"endpoint-id"`
+	K8sNamespace string   `yaml:"k8s-namespace,omitempty"`
+	K8sPodName   string   `yaml:"k8s-pod-name,omitempty"`
+	Labels       []string `yaml:"labels"`
+}
+
+// endpointLabelsExportCmd represents the endpoint labels export command
+var endpointLabelsExportCmd = &cobra.Command{
+	Use:   "export ( <endpoint identifier> | --all-endpoints )",
+	Short: "Export identity labels of endpoints as YAML",
+	Long: `Export the identity labels of one endpoint, or of all local endpoints
+with --all-endpoints, as YAML with one document per endpoint. The output is
+written to stdout, or to one file per endpoint when --output-dir is given.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		var eps []*models.Endpoint
+
+		if exportAllEndpoints {
+			var err error
+			eps, err = client.EndpointList()
+			if err != nil {
+				Fatalf("Cannot get endpoint list: %s", err)
+			}
+		} else {
+			requireEndpointID(cmd, args)
+			_, id, _ := endpointid.Parse(args[0])
+			ep, err := client.EndpointGet(id)
+			if err != nil {
+				Fatalf("Cannot get endpoint %s: %s", id, err)
+			}
+			eps = []*models.Endpoint{ep}
+		}
+
+		docs := make([]endpointLabelsDocument, 0, len(eps))
+		for _, ep := range eps {
+			if ep == nil || ep.Status == nil {
+				continue
+			}
+			doc := endpointLabelsDocument{EndpointID: ep.ID}
+			if ids := ep.Status.ExternalIdentifiers; ids != nil {
+				doc.K8sNamespace = ids.K8sNamespace
+				doc.K8sPodName = ids.K8sPodName
+			}
+			if exportNamespace != "" && doc.K8sNamespace != exportNamespace {
+				continue
+			}
+			if ep.Status.Labels != nil {
+				doc.Labels = model.NewOplabelsFromModel(ep.Status.Labels).IdentityLabels().GetModel()
+				sort.Strings(doc.Labels)
+			}
+			docs = append(docs, doc)
+		}
+		slices.SortFunc(docs, func(a, b endpointLabelsDocument) int {
+			return int(a.EndpointID - b.EndpointID)
+		})
+
+		if exportOutputDir != "" {
+			if err := os.MkdirAll(exportOutputDir, 0755); err != nil {
+				Fatalf("Cannot create output directory %s: %s", exportOutputDir, err)
+			}
+		}
+
+		for _, doc := range docs {
+			out, err := yaml.Marshal(doc)
+			if err != nil {
+				Fatalf("Cannot marshal labels of endpoint %d: %s", doc.EndpointID, err)
+			}
+			if exportOutputDir != "" {
+				path := filepath.Join(exportOutputDir, fmt.Sprintf("endpoint-%d.yaml", doc.EndpointID))
+				if err := os.WriteFile(path, out, 0644); err != nil {
+					Fatalf("Cannot write %s: %s", path, err)
+				}
+			} else {
+				fmt.Printf("---\n%s", out)
+			}
+		}
+	},
+}
+
+func init() {
+	endpointLabelsCmd.AddCommand(endpointLabelsExportCmd)
+	endpointLabelsExportCmd.Flags().BoolVar(&exportAllEndpoints, "all-endpoints", false, "Export the labels of all local endpoints")
+	endpointLabelsExportCmd.Flags().StringVar(&exportNamespace, "namespace", "", "Only export endpoints of the given Kubernetes namespace")
+	endpointLabelsExportCmd.Flags().StringVar(&exportOutputDir, "output-dir", "", "Write one YAML file per endpoint into this directory instead of stdout")
+}
@@ -5,10 +5,13 @@ package cmd
 
 import (
 	"fmt"
+	"net/netip"
 	"os"
 	"slices"
 	"sort"
+	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -24,7 +27,11 @@ const (
 	UnknownState   = "Unknown"
 )
 
-var noHeaders bool
+var (
+	noHeaders bool
+	sortField string
+	sortDesc  bool
+)
 
 // endpointListCmd represents the endpoint_list command
 var endpointListCmd = &cobra.Command{
@@ -39,6 +46,8 @@ var endpointListCmd = &cobra.Command{
 func init() {
 	EndpointCmd.AddCommand(endpointListCmd)
 	endpointListCmd.Flags().BoolVar(&noHeaders, "no-headers", false, "Do not print headers")
+	endpointListCmd.Flags().StringVar(&sortField, "sort", "id", "Sort endpoints by field (id, state, created, labels, ip)")
+	endpointListCmd.Flags().BoolVar(&sortDesc, "sort-desc", false, "Sort in descending order")
 	command.AddOutputOption(endpointListCmd)
 }
 
@@ -106,6 +115,68 @@ func endpointID(ep *models.Endpoint) string {
 	return id
 }
 
+// endpointCreated returns the timestamp of the oldest status log entry of the
+// endpoint, which is the closest client-side approximation of its creation
+// time. Returns the zero time if no log entry is available.
+func endpointCreated(ep *models.Endpoint) time.Time {
+	created := time.Time{}
+	if ep.Status == nil {
+		return created
+	}
+	for _, s := range ep.Status.Log {
+		if s == nil {
+			continue
+		}
+		ts, err := time.Parse(time.RFC3339, s.Timestamp)
+		if err != nil {
+			continue
+		}
+		if created.IsZero() || ts.Before(created) {
+			created = ts
+		}
+	}
+	return created
+}
+
+// sortEndpoints sorts the endpoint list client-side so that repeated
+// invocations produce a stable order regardless of the iteration order on the
+// daemon side.
+func sortEndpoints(eps []*models.Endpoint) error {
+	var less func(a, b *models.Endpoint) bool
+	switch sortField {
+	case "id":
+		less = func(a, b *models.Endpoint) bool { return a.ID < b.ID }
+	case "state":
+		less = func(a, b *models.Endpoint) bool { return endpointState(a) < endpointState(b) }
+	case "created":
+		less = func(a, b *models.Endpoint) bool { return endpointCreated(a).Before(endpointCreated(b)) }
+	case "labels":
+		less = func(a, b *models.Endpoint) bool {
+			return strings.Join(endpointLabels(a), ",") < strings.Join(endpointLabels(b), ",")
+		}
+	case "ip":
+		less = func(a, b *models.Endpoint) bool {
+			_, ipv4A := endpointAddressPair(a)
+			_, ipv4B := endpointAddressPair(b)
+			addrA, errA := netip.ParseAddr(ipv4A)
+			addrB, errB := netip.ParseAddr(ipv4B)
+			if errA != nil || errB != nil {
+				return ipv4A < ipv4B
+			}
+			return addrA.Less(addrB)
+		}
+	default:
+		return fmt.Errorf("unknown sort field %q, must be one of id, state, created, labels, ip", sortField)
+	}
+	sort.SliceStable(eps, func(i, j int) bool {
+		if sortDesc {
+			i, j = j, i
+		}
+		return less(eps[i], eps[j])
+	})
+	return nil
+}
+
 func listEndpoint(w *tabwriter.Writer, ep *models.Endpoint, id string, label string) {
 	policyIngress, policyEgress := endpointPolicyMode(ep)
 	ipv6, ipv4 := endpointAddressPair(ep)
@@ -114,8 +185,13 @@ func listEndpoint(w *tabwriter.Writer, ep *models.Endpoint, id string, label str
 		policyIngress, policyEgress, id, label, ipv6, ipv4, endpointState(ep))
 }
 
+// endpointListPageSize is the number of endpoints fetched per request when
+// listing endpoints, keeping individual responses small on nodes with many
+// endpoints.
+const endpointListPageSize = 256
+
 func listEndpoints() {
-	eps, err := client.EndpointList()
+	eps, err := client.EndpointListPages(endpointListPageSize)
 	if err != nil {
 		Fatalf("cannot get endpoint list: %s\n", err)
 	}
@@ -124,7 +200,9 @@ func listEndpoints() {
 }
 
 func printEndpointList(w *tabwriter.Writer, eps []*models.Endpoint) {
-	sort.Slice(eps, func(i, j int) bool { return eps[i].ID < eps[j].ID })
+	if err := sortEndpoints(eps); err != nil {
+		Fatalf("%s\n", err)
+	}
 
 	const (
 		labelsIDTitle      = "IDENTITY"
@@ -55,7 +55,7 @@ func printMapListVerbose(mapList *models.BPFMapList) {
 
 func printMapList(mapList *models.BPFMapList) {
 	w := tabwriter.NewWriter(os.Stdout, 5, 0, 3, ' ', 0)
-	fmt.Fprintf(w, "Name\tNum entries\tNum errors\tCache enabled\n")
+	fmt.Fprintf(w, "Name\tID\tReused pin\tNum entries\tNum errors\tCache enabled\n")
 	for _, m := range mapList.Maps {
 		entries, errors := 0, 0
 		cacheEnabled := m.Cache != nil
@@ -68,8 +68,8 @@ func printMapList(mapList *models.BPFMapList) {
 				entries++
 			}
 		}
-		fmt.Fprintf(w, "%s\t%d\t%d\t%t\n",
-			path.Base(m.Path), entries, errors, cacheEnabled)
+		fmt.Fprintf(w, "%s\t%d\t%t\t%d\t%d\t%t\n",
+			path.Base(m.Path), m.ID, m.ReusedPin, entries, errors, cacheEnabled)
 	}
 	w.Flush()
 }
@@ -64,6 +64,8 @@ func init() {
 
 	PreflightCmd.AddCommand(validateCNPCmd())
 
+	PreflightCmd.AddCommand(validateConfigCmd())
+
 	RootCmd.AddCommand(PreflightCmd)
 }
 
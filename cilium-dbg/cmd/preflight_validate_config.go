@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	daemonCmd "github.com/cilium/cilium/daemon/cmd"
+	"github.com/cilium/cilium/pkg/hive"
+	"github.com/cilium/cilium/pkg/option"
+)
+
+var validateConfigDir string
+
+// validateConfigCmd returns the command to validate an agent configuration
+// snapshot without applying it.
+func validateConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-config --config-dir <dir>",
+		Short: "Validate an agent configuration snapshot without applying it",
+		Long: `Validate the agent configuration stored in a configuration directory
+(e.g. a mounted ConfigMap) by running the same option population and
+cross-field validation the agent performs at startup. The command works
+fully offline and does not require a running agent.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			validateConfig()
+		},
+	}
+	cmd.Flags().StringVar(&validateConfigDir, "config-dir", "", "Directory holding the agent configuration snapshot to validate")
+	cmd.MarkFlagRequired("config-dir")
+	return cmd
+}
+
+func validateConfig() {
+	// Register the agent's flag set, including the flags owned by the hive
+	// cells, into the hive's viper instance so that flag defaults and the
+	// per-key validation match the agent exactly.
+	h := hive.New(daemonCmd.Agent)
+	agentFlags := &cobra.Command{}
+	h.RegisterFlags(agentFlags.Flags())
+	vp := h.Viper()
+	daemonCmd.InitGlobalFlags(agentFlags, vp)
+
+	if err := option.MergeConfigDir(agentFlags, vp, validateConfigDir); err != nil {
+		Fatalf("Invalid configuration snapshot in %s: %s\n", validateConfigDir, err)
+	}
+
+	option.Config.Populate(vp)
+
+	var errs []error
+	if err := option.Config.Validate(vp); err != nil {
+		errs = append(errs, err)
+	}
+
+	for _, ve := range option.Config.ValidateKubeProxyReplacement(
+		vp.GetString("tunnel-protocol"), vp.GetString("underlay-protocol")) {
+		errs = append(errs, ve)
+	}
+
+	if len(errs) > 0 {
+		fmt.Fprintf(os.Stderr, "Configuration in %s is invalid:\n", validateConfigDir)
+		for _, err := range errs {
+			fmt.Fprintf(os.Stderr, "  %s\n", err)
+		}
+		os.Exit(1)
+	}
+	fmt.Printf("Configuration in %s is valid\n", validateConfigDir)
+}
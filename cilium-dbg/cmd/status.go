@@ -57,6 +57,20 @@ func init() {
 	command.AddOutputOption(statusCmd)
 }
 
+// printStatusCollectorStats prints the internal statistics of the agent's
+// status collector. Errors are ignored as the statistics are informational
+// only.
+func printStatusCollectorStats(w *tabwriter.Writer) {
+	resp, err := client.Daemon.GetStatusCollectorStats(nil)
+	if err != nil || resp.Payload == nil {
+		return
+	}
+	stats := resp.Payload
+	fmt.Fprintf(w, "Status Collector:\t%d/%d probes running, %d failing, %d leaked, %d cycles, last cycle took %s\n",
+		stats.RunningProbes, stats.TotalProbes, stats.FailedProbes,
+		stats.LeakedProbes, stats.CycleCount, stats.LastCycleDuration)
+}
+
 func statusDaemon() {
 	isUnhealthy := func(sr *models.StatusResponse) bool {
 		if sr.Cilium != nil {
@@ -83,6 +97,9 @@ func statusDaemon() {
 	params := daemon.NewGetHealthzParamsWithTimeout(timeout)
 	params.SetBrief(&brief)
 	params.SetRequireK8sConnectivity(&requireK8sConnectivity)
+	if verbose {
+		params.SetVerbose(&verbose)
+	}
 	if resp, err := client.Daemon.GetHealthz(params); err != nil {
 		if brief {
 			fmt.Fprintf(os.Stderr, "%s\n", "cilium: daemon unreachable")
@@ -105,6 +122,10 @@ func statusDaemon() {
 		w := tabwriter.NewWriter(os.Stdout, 2, 0, 3, ' ', 0)
 		pkg.FormatStatusResponse(w, sr, statusDetails)
 
+		if verbose {
+			printStatusCollectorStats(w)
+		}
+
 		if isUnhealthy(sr) {
 			w.Flush()
 			os.Exit(1)
@@ -0,0 +1,223 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cilium/cilium/api/v1/models"
+)
+
+// ctMapPressureThreshold is the fill ratio above which a conntrack map is
+// reported as under pressure.
+const ctMapPressureThreshold = 0.85
+
+const (
+	severityCritical = "critical"
+	severityWarning  = "warning"
+)
+
+// statusFinding is a single result produced by a status analysis rule.
+type statusFinding struct {
+	// Rule is the name of the rule that produced the finding.
+	Rule string
+	// Severity is one of severityCritical or severityWarning.
+	Severity string
+	// Message describes the detected problem.
+	Message string
+	// Remediation suggests a first step towards fixing the problem.
+	Remediation string
+}
+
+// statusRule inspects one aspect of a status payload. Rules are registered
+// in statusRules; adding a rule there is all that is needed to extend the
+// analysis, the command plumbing is rule-agnostic.
+type statusRule struct {
+	// Name identifies the rule in the findings output.
+	Name string
+	// Analyze returns any findings for the given status. It must tolerate
+	// partially populated payloads.
+	Analyze func(sr *models.StatusResponse) []statusFinding
+}
+
+var statusRules = []statusRule{
+	{Name: "kvstore-failure", Analyze: analyzeKvstore},
+	{Name: "stale-probes", Analyze: analyzeStaleProbes},
+	{Name: "ct-map-pressure", Analyze: analyzeCTMapPressure},
+}
+
+// analyzeStatus runs all registered rules against the given status and
+// returns their findings in rule registration order.
+func analyzeStatus(sr *models.StatusResponse) []statusFinding {
+	var findings []statusFinding
+	for _, rule := range statusRules {
+		for _, f := range rule.Analyze(sr) {
+			f.Rule = rule.Name
+			findings = append(findings, f)
+		}
+	}
+	return findings
+}
+
+// analyzeKvstore reports a failing kvstore connection. When the Kubernetes
+// connection is healthy, identity allocation is typically CRD-backed and
+// the operator is the more likely culprit than the agent.
+func analyzeKvstore(sr *models.StatusResponse) []statusFinding {
+	kv := sr.Kvstore
+	if kv == nil || kv.State == models.StatusStateOk || kv.State == models.StatusStateDisabled {
+		return nil
+	}
+	f := statusFinding{
+		Severity:    severityCritical,
+		Message:     fmt.Sprintf("kvstore is %s: %s", kv.State, kv.Msg),
+		Remediation: "Verify connectivity to the kvstore endpoints and the configured credentials.",
+	}
+	if sr.Kubernetes != nil && sr.Kubernetes.State == models.StatusStateOk {
+		f.Remediation = "Kubernetes is healthy, so identity allocation is likely CRD-backed; " +
+			"inspect cilium-operator logs and the kvstore configuration before suspecting the agent."
+	}
+	return []statusFinding{f}
+}
+
+// analyzeStaleProbes reports status probes that have not completed within
+// their deadline.
+func analyzeStaleProbes(sr *models.StatusResponse) []statusFinding {
+	if len(sr.Stale) == 0 {
+		return nil
+	}
+	probes := make([]string, 0, len(sr.Stale))
+	for probe := range sr.Stale {
+		probes = append(probes, probe)
+	}
+	sort.Strings(probes)
+	return []statusFinding{{
+		Severity: severityWarning,
+		Message:  fmt.Sprintf("stale status probes: %s", strings.Join(probes, ", ")),
+		Remediation: "Search the agent log for status collector watchdog messages to see " +
+			"where the probe goroutines are stuck, and check the goroutine dump in the sysdump.",
+	}}
+}
+
+// analyzeCTMapPressure reports conntrack maps that are close to full.
+func analyzeCTMapPressure(sr *models.StatusResponse) []statusFinding {
+	var findings []statusFinding
+	for _, entry := range sr.MapPressure {
+		if entry == nil || !strings.HasPrefix(entry.Name, "cilium_ct") {
+			continue
+		}
+		if entry.FillRatio < ctMapPressureThreshold {
+			continue
+		}
+		findings = append(findings, statusFinding{
+			Severity: severityWarning,
+			Message:  fmt.Sprintf("conntrack map %s is %.0f%% full", entry.Name, entry.FillRatio*100),
+			Remediation: "Increase bpf-ct-global-tcp-max/bpf-ct-global-any-max or set " +
+				"bpf-map-dynamic-size-ratio to size the conntrack maps for this node.",
+		})
+	}
+	return findings
+}
+
+// loadStatusResponse reads a StatusResponse from path. If path is a
+// directory, such as an unpacked sysdump, it is searched for the first file
+// that contains a status payload.
+func loadStatusResponse(path string) (*models.StatusResponse, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return decodeStatusFile(path)
+	}
+
+	var sr *models.StatusResponse
+	err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		if !strings.Contains(strings.ToLower(d.Name()), "status") {
+			return nil
+		}
+		if s, err := decodeStatusFile(p); err == nil {
+			sr = s
+			return fs.SkipAll
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if sr == nil {
+		return nil, fmt.Errorf("no cilium status payload found in %s", path)
+	}
+	return sr, nil
+}
+
+// decodeStatusFile decodes a single file as 'cilium status -o json' output.
+func decodeStatusFile(path string) (*models.StatusResponse, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var sr models.StatusResponse
+	if err := json.Unmarshal(data, &sr); err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", path, err)
+	}
+	// Guard against JSON documents that happen to decode into an empty
+	// StatusResponse, e.g. cilium-health output found in a sysdump.
+	if sr.Cilium == nil && sr.Controllers == nil && sr.Kvstore == nil {
+		return nil, fmt.Errorf("%s does not look like a cilium status payload", path)
+	}
+	return &sr, nil
+}
+
+// printFindings renders the findings with severity and remediation hints.
+func printFindings(w io.Writer, findings []statusFinding) {
+	if len(findings) == 0 {
+		fmt.Fprintln(w, "No issues found")
+		return
+	}
+	tab := tabwriter.NewWriter(w, 2, 0, 3, ' ', 0)
+	for _, f := range findings {
+		fmt.Fprintf(tab, "%s\t%s\t%s\n", strings.ToUpper(f.Severity), f.Rule, f.Message)
+		fmt.Fprintf(tab, "\t\t-> %s\n", f.Remediation)
+	}
+	tab.Flush()
+}
+
+var statusAnalyzeCmd = &cobra.Command{
+	Use:   "analyze <file-or-sysdump-dir>",
+	Short: "Analyze a status payload or sysdump offline",
+	Long: `Analyze runs a first-pass analysis over a saved 'cilium status -o json'
+payload, or over an unpacked sysdump directory containing one, without
+requiring access to a running agent.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		sr, err := loadStatusResponse(args[0])
+		if err != nil {
+			Fatalf("Unable to load status: %s", err)
+		}
+		findings := analyzeStatus(sr)
+		printFindings(os.Stdout, findings)
+		for _, f := range findings {
+			if f.Severity == severityCritical {
+				os.Exit(1)
+			}
+		}
+	},
+}
+
+func init() {
+	statusCmd.AddCommand(statusAnalyzeCmd)
+}
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/cilium/api/v1/models"
+)
+
+func TestAnalyzeKvstore(t *testing.T) {
+	// Healthy and disabled kvstores produce no findings.
+	require.Empty(t, analyzeKvstore(&models.StatusResponse{}))
+	require.Empty(t, analyzeKvstore(&models.StatusResponse{
+		Kvstore: &models.Status{State: models.StatusStateOk},
+	}))
+	require.Empty(t, analyzeKvstore(&models.StatusResponse{
+		Kvstore: &models.Status{State: models.StatusStateDisabled},
+	}))
+
+	findings := analyzeKvstore(&models.StatusResponse{
+		Kvstore: &models.Status{State: models.StatusStateFailure, Msg: "etcd: no connection"},
+	})
+	require.Len(t, findings, 1)
+	require.Equal(t, severityCritical, findings[0].Severity)
+	require.Contains(t, findings[0].Message, "etcd: no connection")
+	require.Contains(t, findings[0].Remediation, "kvstore")
+
+	// With a healthy Kubernetes connection the remediation points at the
+	// operator, as identity allocation is likely CRD-backed.
+	findings = analyzeKvstore(&models.StatusResponse{
+		Kubernetes: &models.K8sStatus{State: models.StatusStateOk},
+		Kvstore:    &models.Status{State: models.StatusStateFailure, Msg: "etcd: no connection"},
+	})
+	require.Len(t, findings, 1)
+	require.Contains(t, findings[0].Remediation, "cilium-operator")
+}
+
+func TestAnalyzeStaleProbes(t *testing.T) {
+	require.Empty(t, analyzeStaleProbes(&models.StatusResponse{}))
+
+	findings := analyzeStaleProbes(&models.StatusResponse{
+		Stale: map[string]strfmt.DateTime{
+			"kvstore":      strfmt.DateTime{},
+			"cni-chaining": strfmt.DateTime{},
+		},
+	})
+	require.Len(t, findings, 1)
+	require.Equal(t, severityWarning, findings[0].Severity)
+	require.Contains(t, findings[0].Message, "cni-chaining, kvstore")
+	require.Contains(t, findings[0].Remediation, "watchdog")
+}
+
+func TestAnalyzeCTMapPressure(t *testing.T) {
+	require.Empty(t, analyzeCTMapPressure(&models.StatusResponse{}))
+
+	findings := analyzeCTMapPressure(&models.StatusResponse{
+		MapPressure: []*models.MapPressureEntry{
+			{Name: "cilium_ct4_global", FillRatio: 0.95},
+			{Name: "cilium_ct_any4_global", FillRatio: 0.2},
+			{Name: "cilium_lb4_services_v2", FillRatio: 0.99},
+		},
+	})
+	require.Len(t, findings, 1)
+	require.Equal(t, severityWarning, findings[0].Severity)
+	require.Contains(t, findings[0].Message, "cilium_ct4_global")
+	require.Contains(t, findings[0].Message, "95%")
+	require.Contains(t, findings[0].Remediation, "bpf-ct-global-tcp-max")
+}
+
+func TestAnalyzeStatusTagsRuleNames(t *testing.T) {
+	findings := analyzeStatus(&models.StatusResponse{
+		Kvstore: &models.Status{State: models.StatusStateFailure},
+		Stale:   map[string]strfmt.DateTime{"kvstore": {}},
+	})
+	require.Len(t, findings, 2)
+	require.Equal(t, "kvstore-failure", findings[0].Rule)
+	require.Equal(t, "stale-probes", findings[1].Rule)
+}
+
+func TestLoadStatusResponse(t *testing.T) {
+	dir := t.TempDir()
+
+	// Files without a status payload must be skipped, even if their name
+	// contains "status".
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "cilium-health-status.json"),
+		[]byte(`{"nodes":[]}`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "logs.txt"),
+		[]byte("not json"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "cilium-status.json"),
+		[]byte(`{"cilium":{"state":"Ok"},"kvstore":{"state":"Failure","msg":"down"}}`), 0o644))
+
+	// Loading from the directory finds the status payload.
+	sr, err := loadStatusResponse(dir)
+	require.NoError(t, err)
+	require.NotNil(t, sr.Cilium)
+	require.Equal(t, models.StatusStateFailure, sr.Kvstore.State)
+
+	// Loading the file directly works as well.
+	sr, err = loadStatusResponse(filepath.Join(dir, "cilium-status.json"))
+	require.NoError(t, err)
+	require.NotNil(t, sr.Cilium)
+
+	// A directory without any payload is an error.
+	_, err = loadStatusResponse(t.TempDir())
+	require.Error(t, err)
+}
@@ -39,20 +39,26 @@ const (
 	statusProbeInterval  = 5 * time.Second
 )
 
-// Launch starts the cilium-health server and returns a handle to obtain its status
-func Launch(spec *healthApi.Spec, initialized <-chan struct{}) (*CiliumHealth, error) {
+// Launch starts the cilium-health server and returns a handle to obtain its
+// status. tunnelPort is the UDP port of the tunneling protocol in use, or 0
+// if the datapath does not use tunneling.
+func Launch(spec *healthApi.Spec, initialized <-chan struct{}, tunnelPort uint16) (*CiliumHealth, error) {
 	var (
 		err error
 		ch  = &CiliumHealth{}
 	)
 
 	config := server.Config{
-		CiliumURI:     ciliumPkg.DefaultSockPath(),
-		Debug:         option.Config.Opts.IsEnabled(option.Debug),
-		ICMPReqsCount: option.Config.HealthCheckICMPFailureThreshold,
-		ProbeDeadline: serverProbeDeadline,
-		HTTPPathPort:  option.Config.ClusterHealthPort,
-		HealthAPISpec: spec,
+		CiliumURI:             ciliumPkg.DefaultSockPath(),
+		Debug:                 option.Config.Opts.IsEnabled(option.Debug),
+		ICMPReqsCount:         option.Config.HealthCheckICMPFailureThreshold,
+		ProbeDeadline:         serverProbeDeadline,
+		HTTPPathPort:          option.Config.ClusterHealthPort,
+		HealthAPISpec:         spec,
+		TunnelProbeEnabled:    option.Config.EnableHealthCheckTunnelPort && tunnelPort != 0,
+		TunnelProbePort:       int(tunnelPort),
+		TunnelProbeSampleSize: option.Config.HealthCheckTunnelPortSampleSize,
+		TunnelProbeInterval:   option.Config.HealthCheckTunnelPortInterval,
 	}
 
 	ch.server, err = server.NewServer(config)
@@ -21,11 +21,15 @@ import (
 type handlersOut struct {
 	cell.Out
 
-	DaemonGetDebuginfoHandler daemon.GetDebuginfoHandler
-	DaemonGetHealthzHandler   daemon.GetHealthzHandler
+	DaemonGetDebuginfoHandler            daemon.GetDebuginfoHandler
+	DaemonGetHealthzHandler              daemon.GetHealthzHandler
+	DaemonGetStatusCollectorStatsHandler daemon.GetStatusCollectorStatsHandler
+	DaemonGetStatusWatchHandler          daemon.GetStatusWatchHandler
+	DaemonPutStatusProbesHandler         daemon.PutStatusProbesHandler
 
 	EndpointDeleteEndpointHandler        endpoint.DeleteEndpointHandler
 	EndpointDeleteEndpointIDHandler      endpoint.DeleteEndpointIDHandler
+	EndpointGetEndpointGcHandler         endpoint.GetEndpointGcHandler
 	EndpointGetEndpointHandler           endpoint.GetEndpointHandler
 	EndpointGetEndpointIDConfigHandler   endpoint.GetEndpointIDConfigHandler
 	EndpointGetEndpointIDHandler         endpoint.GetEndpointIDHandler
@@ -79,6 +83,15 @@ func ciliumAPIHandlers(dp promise.Promise[*Daemon], cfg *option.DaemonConfig, _
 	// /healthz/
 	out.DaemonGetHealthzHandler = wrapAPIHandler(dp, getHealthzHandler)
 
+	// /status/collector/stats
+	out.DaemonGetStatusCollectorStatsHandler = wrapAPIHandler(dp, getStatusCollectorStatsHandler)
+
+	// /status/watch
+	out.DaemonGetStatusWatchHandler = wrapAPIHandler(dp, watchHealthzHandler)
+
+	// /status/probes
+	out.DaemonPutStatusProbesHandler = wrapAPIHandler(dp, putStatusProbesHandler)
+
 	// /endpoint/
 	out.EndpointDeleteEndpointHandler = wrapAPIHandler(dp, deleteEndpointHandler)
 	out.EndpointGetEndpointHandler = wrapAPIHandler(dp, getEndpointHandler)
@@ -103,6 +116,9 @@ func ciliumAPIHandlers(dp promise.Promise[*Daemon], cfg *option.DaemonConfig, _
 	// /endpoint/{id}/healthz
 	out.EndpointGetEndpointIDHealthzHandler = wrapAPIHandler(dp, getEndpointIDHealthzHandler)
 
+	// /endpoint/gc
+	out.EndpointGetEndpointGcHandler = wrapAPIHandler(dp, getEndpointGcHandler)
+
 	// /debuginfo
 	out.DaemonGetDebuginfoHandler = wrapAPIHandler(dp, getDebugInfoHandler)
 
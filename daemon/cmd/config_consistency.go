@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cilium/cilium/api/v1/models"
+	"github.com/cilium/cilium/pkg/annotation"
+	"github.com/cilium/cilium/pkg/datapath/tunnel"
+	"github.com/cilium/cilium/pkg/option"
+)
+
+// configConsistencyMaxExamples caps the number of example node names reported
+// per divergent setting.
+const configConsistencyMaxExamples = 3
+
+// datapathFingerprint returns the key datapath settings this node advertises
+// to its peers through the feature fingerprint annotation. Mixed values for
+// any of these settings across the cluster typically result in partial
+// connectivity.
+func datapathFingerprint(tunnelConfig tunnel.Config) map[string]string {
+	return map[string]string{
+		"routing-mode":           option.Config.RoutingMode,
+		"tunnel-protocol":        tunnelConfig.EncapProtocol().String(),
+		"masquerade-ipv4":        strconv.FormatBool(option.Config.EnableIPv4Masquerade),
+		"masquerade-ipv6":        strconv.FormatBool(option.Config.EnableIPv6Masquerade),
+		"bpf-masquerade":         strconv.FormatBool(option.Config.EnableBPFMasquerade),
+		"encryption-ipsec":       strconv.FormatBool(option.Config.EnableIPSec),
+		"encryption-wireguard":   strconv.FormatBool(option.Config.EnableWireguard),
+		"kube-proxy-replacement": option.Config.KubeProxyReplacement,
+	}
+}
+
+// formatFingerprint serializes a fingerprint into the annotation value, as
+// sorted key=value pairs joined by commas.
+func formatFingerprint(fp map[string]string) string {
+	pairs := make([]string, 0, len(fp))
+	for key, value := range fp {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// parseFingerprint parses an annotation value produced by formatFingerprint.
+// Malformed pairs are skipped.
+func parseFingerprint(s string) map[string]string {
+	fp := make(map[string]string)
+	for _, pair := range strings.Split(s, ",") {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || key == "" {
+			continue
+		}
+		fp[key] = value
+	}
+	return fp
+}
+
+// getConfigConsistency compares the local datapath fingerprint against the
+// fingerprints advertised by the other nodes known to the node manager,
+// including nodes from remote clustermesh clusters. Peers not advertising a
+// fingerprint, for example nodes still running an older Cilium version, are
+// skipped.
+func (d *Daemon) getConfigConsistency() *models.ConfigConsistency {
+	local := datapathFingerprint(d.tunnelConfig)
+
+	checked := 0
+	divergent := map[string][]string{}
+	for _, n := range d.nodeManager.GetNodes() {
+		if n.IsLocal() {
+			continue
+		}
+		value, ok := n.Annotations[annotation.ConfigFeatureFingerprint]
+		if !ok {
+			continue
+		}
+		checked++
+		remote := parseFingerprint(value)
+		for setting, localValue := range local {
+			if remoteValue, ok := remote[setting]; ok && remoteValue != localValue {
+				divergent[setting] = append(divergent[setting], n.Fullname())
+			}
+		}
+	}
+
+	status := &models.ConfigConsistency{
+		CheckedNodes: int64(checked),
+	}
+	for setting, nodes := range divergent {
+		sort.Strings(nodes)
+		examples := nodes
+		if len(examples) > configConsistencyMaxExamples {
+			examples = examples[:configConsistencyMaxExamples]
+		}
+		status.Mismatches = append(status.Mismatches, &models.ConfigMismatch{
+			Setting:        setting,
+			LocalValue:     local[setting],
+			DivergentNodes: int64(len(nodes)),
+			ExampleNodes:   examples,
+		})
+	}
+	sort.Slice(status.Mismatches, func(i, j int) bool {
+		return status.Mismatches[i].Setting < status.Mismatches[j].Setting
+	})
+
+	return status
+}
@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/cilium/pkg/datapath/tunnel"
+)
+
+func TestFingerprintRoundTrip(t *testing.T) {
+	fp := datapathFingerprint(tunnel.Config{})
+	parsed := parseFingerprint(formatFingerprint(fp))
+	require.Equal(t, fp, parsed)
+}
+
+func TestParseFingerprintMalformed(t *testing.T) {
+	parsed := parseFingerprint("routing-mode=native,,garbage,=empty,tunnel-protocol=vxlan")
+	require.Equal(t, map[string]string{
+		"routing-mode":    "native",
+		"tunnel-protocol": "vxlan",
+	}, parsed)
+}
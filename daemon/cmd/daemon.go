@@ -11,11 +11,13 @@ import (
 	"net"
 	"net/netip"
 	"sync"
+	"sync/atomic"
 
 	"github.com/cilium/hive/job"
 	"github.com/cilium/statedb"
 	"github.com/sirupsen/logrus"
 	"github.com/vishvananda/netlink"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/cilium/cilium/api/v1/models"
 	health "github.com/cilium/cilium/cilium-health/launch"
@@ -30,6 +32,7 @@ import (
 	"github.com/cilium/cilium/pkg/datapath/linux/probes"
 	linuxrouting "github.com/cilium/cilium/pkg/datapath/linux/routing"
 	"github.com/cilium/cilium/pkg/datapath/linux/safenetlink"
+	"github.com/cilium/cilium/pkg/datapath/linux/sysctl"
 	datapathTables "github.com/cilium/cilium/pkg/datapath/tables"
 	"github.com/cilium/cilium/pkg/datapath/tunnel"
 	datapath "github.com/cilium/cilium/pkg/datapath/types"
@@ -38,7 +41,9 @@ import (
 	"github.com/cilium/cilium/pkg/endpoint"
 	"github.com/cilium/cilium/pkg/endpoint/regeneration"
 	"github.com/cilium/cilium/pkg/endpointmanager"
+	"github.com/cilium/cilium/pkg/fqdn/namemanager"
 	fqdnRules "github.com/cilium/cilium/pkg/fqdn/rules"
+	healthTypes "github.com/cilium/cilium/pkg/hive/health/types"
 	hubblecell "github.com/cilium/cilium/pkg/hubble/cell"
 	"github.com/cilium/cilium/pkg/identity"
 	identitycell "github.com/cilium/cilium/pkg/identity/cache/cell"
@@ -58,10 +63,12 @@ import (
 	"github.com/cilium/cilium/pkg/maps/ctmap"
 	"github.com/cilium/cilium/pkg/maps/lbmap"
 	"github.com/cilium/cilium/pkg/maps/policymap"
+	tunnelmap "github.com/cilium/cilium/pkg/maps/tunnel"
 	"github.com/cilium/cilium/pkg/metrics"
 	monitoragent "github.com/cilium/cilium/pkg/monitor/agent"
 	"github.com/cilium/cilium/pkg/mtu"
 	"github.com/cilium/cilium/pkg/node"
+	nodeManager "github.com/cilium/cilium/pkg/node/manager"
 	nodeTypes "github.com/cilium/cilium/pkg/node/types"
 	"github.com/cilium/cilium/pkg/nodediscovery"
 	"github.com/cilium/cilium/pkg/option"
@@ -97,15 +104,53 @@ type Daemon struct {
 
 	statusCollectMutex lock.RWMutex
 	statusResponse     models.StatusResponse
-	statusCollector    *status.Collector
+	statusCollector    status.StatusCollector
+
+	// statusWatchersMutex protects statusWatchers. It is separate from
+	// statusCollectMutex so that notifying watchers does not contend
+	// with status reads.
+	statusWatchersMutex lock.Mutex
+	statusWatchers      map[chan struct{}]struct{}
+
+	// probeStateMutex protects the state kept between runs of a status
+	// probe: rateLimiterRejections, policyMapInsertFailures and
+	// policyMapEventRecorder. Probe runs are normally serialized, but the
+	// status collector's watchdog may start a replacement invocation
+	// while an abandoned one is still blocked, so per-probe state must
+	// not be mutated without synchronization.
+	probeStateMutex lock.Mutex
+
+	// rateLimiterRejections holds the per-operation rejection counts seen
+	// by the previous rate-limiter status probe run. Protected by
+	// probeStateMutex.
+	rateLimiterRejections map[string]int64
+
+	// policyMapInsertFailures holds the per-endpoint policy map insert
+	// failure counts seen by the previous policy-map-pressure status probe
+	// run. Protected by probeStateMutex.
+	policyMapInsertFailures map[uint16]int64
+
+	// policyMapEventRecorder emits Kubernetes events on pods whose
+	// endpoint failed a policy map insert. Lazily initialized by the
+	// policy-map-pressure status probe. Protected by probeStateMutex.
+	policyMapEventRecorder record.EventRecorder
+
+	// startTime is the time at which the status collector was started,
+	// used to report the agent uptime in the status API.
+	startTime time.Time
+
+	// lastShutdownReason records why the previous agent run shut down, as
+	// derived from the last-status snapshot found at startup.
+	lastShutdownReason string
 
 	monitorAgent monitoragent.Agent
 	ciliumHealth *health.CiliumHealth
 
-	directRoutingDev datapathTables.DirectRoutingDevice
-	routes           statedb.Table[*datapathTables.Route]
-	devices          statedb.Table[*datapathTables.Device]
-	nodeAddrs        statedb.Table[datapathTables.NodeAddress]
+	directRoutingDev  datapathTables.DirectRoutingDevice
+	routes            statedb.Table[*datapathTables.Route]
+	devices           statedb.Table[*datapathTables.Device]
+	nodeAddrs         statedb.Table[datapathTables.NodeAddress]
+	healthStatusTable statedb.Table[healthTypes.Status]
 
 	// Used to synchronize generation of daemon's BPF programs and endpoint BPF
 	// programs.
@@ -123,6 +168,8 @@ type Daemon struct {
 	// nodeDiscovery defines the node discovery logic of the agent
 	nodeDiscovery  *nodediscovery.NodeDiscovery
 	nodeLocalStore *node.LocalNodeStore
+	nodeManager    nodeManager.NodeManager
+	nodeNeighbors  datapath.NodeNeighbors
 
 	// ipam is the IP address manager of the agent
 	ipam *ipam.IPAM
@@ -177,6 +224,25 @@ type Daemon struct {
 	tunnelConfig tunnel.Config
 	bwManager    datapath.BandwidthManager
 
+	// tunnelDiscrepancies holds the discrepancies found by the previous run
+	// of the tunnel map consistency controller, so that a discrepancy is
+	// only reported once it persisted over two consecutive runs.
+	tunnelDiscrepanciesMutex lock.Mutex
+	tunnelDiscrepancies      tunnelmap.Discrepancies
+
+	// statusSnapshotWriter maintains the on-host status snapshot file for
+	// external watchdogs, nil when --status-snapshot-file is not set.
+	statusSnapshotWriter *snapshotWriter
+
+	// k8sFamilyProber probes apiserver connectivity per address family on
+	// dual-stack clusters for the kubernetes status section.
+	k8sFamilyProber *k8sFamilyProber
+
+	// socketLBNoBackendPrev is the socket-LB no-backend counter observed
+	// by the previous status collection, used to flag a sustained
+	// no-backend rate in the status response.
+	socketLBNoBackendPrev atomic.Uint64
+
 	wireguardAgent  *wireguard.Agent
 	orchestrator    datapath.Orchestrator
 	iptablesManager datapath.IptablesManager
@@ -185,10 +251,13 @@ type Daemon struct {
 	lrpManager   *redirectpolicy.Manager
 	ctMapGC      ctmap.GCRunner
 	maglevConfig maglev.Config
+	sysctl       sysctl.Sysctl
 
 	explbConfig experimental.Config
 
 	dnsRulesAPI fqdnRules.DNSRulesService
+
+	dnsNameManager namemanager.NameManager
 }
 
 func (d *Daemon) init() error {
@@ -365,9 +434,13 @@ func newDaemon(ctx context.Context, cleaner *daemonCleanup, params *daemonParams
 		routes:            params.Routes,
 		devices:           params.Devices,
 		nodeAddrs:         params.NodeAddrs,
+		healthStatusTable: params.HealthStatusTable,
 		nodeDiscovery:     params.NodeDiscovery,
 		nodeLocalStore:    params.LocalNodeStore,
+		nodeManager:       params.NodeManager,
+		nodeNeighbors:     params.NodeNeighbors,
 		endpointCreations: newEndpointCreationManager(params.Clientset),
+		k8sFamilyProber:   newK8sFamilyProber(),
 		apiLimiterSet:     params.APILimiterSet,
 		controllers:       controller.NewManager(),
 		jobGroup:          params.JobGroup,
@@ -401,8 +474,10 @@ func newDaemon(ctx context.Context, cleaner *daemonCleanup, params *daemonParams
 		lrpManager:        params.LRPManager,
 		ctMapGC:           params.CTNATMapGC,
 		maglevConfig:      params.MaglevConfig,
+		sysctl:            params.Sysctl,
 		explbConfig:       params.ExpLBConfig,
 		dnsRulesAPI:       params.DNSRulesAPI,
+		dnsNameManager:    params.NameManager,
 	}
 
 	// initialize endpointRestoreComplete channel as soon as possible so that subsystems
@@ -820,6 +895,33 @@ func newDaemon(ctx context.Context, cleaner *daemonCleanup, params *daemonParams
 			})
 	}
 
+	if option.Config.TunnelingEnabled() {
+		// Start controller to periodically verify the tunnel map against
+		// the set of known remote nodes.
+		d.controllers.UpdateController(
+			tunnelConsistencyControllerGroup.Name,
+			controller.ControllerParams{
+				Group:       tunnelConsistencyControllerGroup,
+				DoFunc:      d.verifyTunnelConsistency,
+				RunInterval: tunnelConsistencyInterval,
+				Context:     d.ctx,
+			})
+	}
+
+	if d.nodeNeighbors.NodeNeighDiscoveryEnabled() {
+		// Start controller to periodically verify the kernel neighbor
+		// entries for node next-hops against the set of known remote
+		// nodes.
+		d.controllers.UpdateController(
+			neighborConsistencyControllerGroup.Name,
+			controller.ControllerParams{
+				Group:       neighborConsistencyControllerGroup,
+				DoFunc:      d.verifyNeighborConsistency,
+				RunInterval: neighborConsistencyInterval,
+				Context:     d.ctx,
+			})
+	}
+
 	// Start the host IP synchronization. Blocks until the initial synchronization
 	// has finished.
 	if err := params.SyncHostIPs.StartAndWaitFirst(ctx); err != nil {
@@ -59,8 +59,10 @@ import (
 	"github.com/cilium/cilium/pkg/envoy"
 	"github.com/cilium/cilium/pkg/flowdebug"
 	"github.com/cilium/cilium/pkg/fqdn/bootstrap"
+	"github.com/cilium/cilium/pkg/fqdn/namemanager"
 	fqdnRules "github.com/cilium/cilium/pkg/fqdn/rules"
 	"github.com/cilium/cilium/pkg/hive"
+	healthTypes "github.com/cilium/cilium/pkg/hive/health/types"
 	hubblecell "github.com/cilium/cilium/pkg/hubble/cell"
 	"github.com/cilium/cilium/pkg/identity"
 	identitycell "github.com/cilium/cilium/pkg/identity/cache/cell"
@@ -241,6 +243,9 @@ func InitGlobalFlags(cmd *cobra.Command, vp *viper.Viper) {
 	flags.Duration(option.ConntrackGCMaxInterval, time.Duration(0), "Set the maximum interval for the connection-tracking garbage collection")
 	option.BindEnv(vp, option.ConntrackGCMaxInterval)
 
+	flags.Duration(option.BPFGCMinEntryAge, time.Duration(0), "Minimum age of CT and NAT entries before the garbage collection may remove them (0 preserves the current behavior)")
+	option.BindEnv(vp, option.BPFGCMinEntryAge)
+
 	flags.BoolP(option.DebugArg, "D", false, "Enable debugging mode")
 	option.BindEnv(vp, option.DebugArg)
 
@@ -274,6 +279,15 @@ func InitGlobalFlags(cmd *cobra.Command, vp *viper.Viper) {
 	flags.Int(option.HealthCheckICMPFailureThreshold, defaults.HealthCheckICMPFailureThreshold, "Number of ICMP requests sent for each run of the health checker. If at least one ICMP response is received, the node or endpoint is marked as healthy.")
 	option.BindEnv(vp, option.HealthCheckICMPFailureThreshold)
 
+	flags.Bool(option.EnableHealthCheckTunnelPort, false, "Probe the tunnel port (UDP) of remote nodes and report peers whose port is unreachable in the cilium-health status")
+	option.BindEnv(vp, option.EnableHealthCheckTunnelPort)
+
+	flags.Int(option.HealthCheckTunnelPortSampleSize, defaults.HealthCheckTunnelPortSampleSize, "Number of remote nodes whose tunnel port is probed per probe round")
+	option.BindEnv(vp, option.HealthCheckTunnelPortSampleSize)
+
+	flags.Duration(option.HealthCheckTunnelPortInterval, defaults.HealthCheckTunnelPortInterval, "Interval between tunnel port probe rounds")
+	option.BindEnv(vp, option.HealthCheckTunnelPortInterval)
+
 	flags.Bool(option.EnableLocalNodeRoute, defaults.EnableLocalNodeRoute, "Enable installation of the route which points the allocation prefix of the local node")
 	option.BindEnv(vp, option.EnableLocalNodeRoute)
 
@@ -717,6 +731,9 @@ func InitGlobalFlags(cmd *cobra.Command, vp *viper.Viper) {
 	flags.String(option.StateDir, defaults.RuntimePath, "Directory path to store runtime state")
 	option.BindEnv(vp, option.StateDir)
 
+	flags.String(option.StatusSnapshotFile, "", "Host path to atomically write a compact JSON summary of the agent status for external watchdogs (empty to disable)")
+	option.BindEnv(vp, option.StatusSnapshotFile)
+
 	flags.Bool(option.ExternalEnvoyProxy, false, "whether the Envoy is deployed externally in form of a DaemonSet or not")
 	option.BindEnv(vp, option.ExternalEnvoyProxy)
 
@@ -1549,6 +1566,7 @@ type daemonParams struct {
 	Routes              statedb.Table[*datapathTables.Route]
 	Devices             statedb.Table[*datapathTables.Device]
 	NodeAddrs           statedb.Table[datapathTables.NodeAddress]
+	HealthStatusTable   statedb.Table[healthTypes.Status]
 	DirectRoutingDevice datapathTables.DirectRoutingDevice
 	// Grab the GC object so that we can start the CT/NAT map garbage collection.
 	// This is currently necessary because these maps have not yet been modularized,
@@ -1579,6 +1597,7 @@ type daemonParams struct {
 	ExpLBConfig         experimental.Config
 	DNSRulesAPI         fqdnRules.DNSRulesService
 	DNSProxy            bootstrap.FQDNProxyBootstrapper
+	NameManager         namemanager.NameManager
 }
 
 func newDaemonPromise(params daemonParams) promise.Promise[*Daemon] {
@@ -1811,6 +1830,8 @@ func startDaemon(d *Daemon, restoredEndpoints *endpointRestoreState, cleaner *da
 	}
 	bootstrapStats.healthCheck.End(true)
 
+	verifyBPFFSMount()
+
 	if err := d.startStatusCollector(d.ctx, cleaner); err != nil {
 		return fmt.Errorf("failed to start status collector: %w", err)
 	}
@@ -6,6 +6,7 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/cilium/hive/cell"
 	"github.com/go-openapi/runtime/middleware"
@@ -17,6 +18,7 @@ import (
 	"github.com/cilium/cilium/pkg/debug"
 	"github.com/cilium/cilium/pkg/option"
 	"github.com/cilium/cilium/pkg/service"
+	"github.com/cilium/cilium/pkg/time"
 	"github.com/cilium/cilium/pkg/version"
 )
 
@@ -38,6 +40,17 @@ func getDebugInfoHandler(d *Daemon, params restapi.GetDebuginfoParams) middlewar
 	dr.EndpointList = d.getEndpointList(p)
 	dr.Policy = d.policy.GetRulesList()
 	dr.Subsystem = debug.CollectSubsystemStatus()
+	if diags := getStartupDiagnostics(); len(diags) > 0 {
+		if dr.Subsystem == nil {
+			dr.Subsystem = map[string]string{}
+		}
+		lines := make([]string, 0, len(diags))
+		for _, diag := range diags {
+			lines = append(lines, fmt.Sprintf("%s [%s] %s: %s (suggestion: %s)",
+				diag.Timestamp.Format(time.RFC3339), diag.Severity, diag.Subsystem, diag.Message, diag.Suggestion))
+		}
+		dr.Subsystem["startup-diagnostics"] = strings.Join(lines, "\n")
+	}
 	dr.CiliumMemoryMap = memoryMap(os.Getpid())
 
 	dr.EnvironmentVariables = []string{}
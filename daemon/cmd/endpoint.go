@@ -7,13 +7,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"iter"
 	"maps"
 	"net"
 	"net/http"
 	"runtime"
+	"strconv"
 	"sync"
 
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
 	"github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -55,14 +58,31 @@ func getEndpointHandler(d *Daemon, params GetEndpointParams) middleware.Responde
 	}
 	defer r.Done()
 
-	resEPs := d.getEndpointList(params)
+	// Without pagination or field selection parameters, return the full
+	// list in a single response as before.
+	if params.Limit == nil && params.Continue == nil && params.Fields == nil {
+		resEPs := d.getEndpointList(params)
 
-	if params.Labels != nil && len(resEPs) == 0 {
+		if params.Labels != nil && len(resEPs) == 0 {
+			r.Error(errEndpointNotFound, GetEndpointNotFoundCode)
+			return NewGetEndpointNotFound()
+		}
+
+		return NewGetEndpointOK().WithPayload(resEPs)
+	}
+
+	resEPs, next, err := d.getEndpointPage(params)
+	if err != nil {
+		r.Error(err, GetEndpointInvalidCode)
+		return api.Error(GetEndpointInvalidCode, err)
+	}
+
+	if params.Labels != nil && params.Continue == nil && len(resEPs) == 0 && next == "" {
 		r.Error(errEndpointNotFound, GetEndpointNotFoundCode)
 		return NewGetEndpointNotFound()
 	}
 
-	return NewGetEndpointOK().WithPayload(resEPs)
+	return NewGetEndpointOK().WithXCiliumListContinue(next).WithPayload(resEPs)
 }
 
 func (d *Daemon) getEndpointList(params GetEndpointParams) []*models.Endpoint {
@@ -126,6 +146,66 @@ func (d *Daemon) getEndpointList(params GetEndpointParams) []*models.Endpoint {
 	return resEPs
 }
 
+// getEndpointPage returns a single page of the endpoint list along with the
+// continue token for the next page, using the endpoint manager's ordered
+// endpoint iterator so that at most limit endpoint models are built per
+// request.
+func (d *Daemon) getEndpointPage(params GetEndpointParams) ([]*models.Endpoint, string, error) {
+	var convertedLabels labels.Labels
+	if params.Labels != nil {
+		// Convert params.Labels to model that we can compare with the endpoint's labels.
+		convertedLabels = labels.NewLabelsFromModel(params.Labels)
+	}
+
+	var mask endpoint.ModelFieldMask
+	if params.Fields != nil {
+		var err error
+		if mask, err = endpoint.ParseModelFieldMask(*params.Fields); err != nil {
+			return nil, "", err
+		}
+	}
+
+	var fromID uint64
+	if params.Continue != nil {
+		var err error
+		if fromID, err = strconv.ParseUint(*params.Continue, 10, 64); err != nil {
+			return nil, "", fmt.Errorf("malformed continue token %q", *params.Continue)
+		}
+	}
+
+	var limit int
+	if params.Limit != nil {
+		if *params.Limit < 0 {
+			return nil, "", fmt.Errorf("invalid limit %d", *params.Limit)
+		}
+		limit = int(*params.Limit)
+	}
+
+	resEPs, next := buildEndpointPage(d.endpointManager.Endpoints(), convertedLabels, fromID, limit, mask)
+	return resEPs, next, nil
+}
+
+// buildEndpointPage walks the given ID-ordered endpoint iterator and returns
+// up to limit models of the endpoints with an ID greater than fromID that
+// match the given labels, along with the continue token for the next page. A
+// limit of zero returns all matching endpoints. The continue token is empty
+// when no further endpoints remain.
+func buildEndpointPage(eps iter.Seq[*endpoint.Endpoint], epLabels labels.Labels, fromID uint64, limit int, mask endpoint.ModelFieldMask) ([]*models.Endpoint, string) {
+	var resEPs []*models.Endpoint
+	var next string
+	for ep := range eps {
+		if uint64(ep.ID) <= fromID || !ep.HasLabels(epLabels) {
+			continue
+		}
+		if limit > 0 && len(resEPs) == limit {
+			next = strconv.FormatUint(uint64(resEPs[len(resEPs)-1].ID), 10)
+			break
+		}
+		resEPs = append(resEPs, ep.GetModelWithFieldMask(mask))
+	}
+	return resEPs, next
+}
+
 func deleteEndpointHandler(d *Daemon, params DeleteEndpointParams) middleware.Responder {
 	log.WithField(logfields.Params, logfields.Repr(params)).Debug("DELETE /endpoint/ request")
 
@@ -179,7 +259,11 @@ func getEndpointIDHandler(d *Daemon, params GetEndpointIDParams) middleware.Resp
 		r.Error(errEndpointNotFound, GetEndpointIDNotFoundCode)
 		return NewGetEndpointIDNotFound()
 	} else {
-		return NewGetEndpointIDOK().WithPayload(ep.GetModel())
+		mdl := ep.GetModel()
+		if mdl.Status != nil {
+			mdl.Status.BpfMaps = ep.GetBPFMapsModel()
+		}
+		return NewGetEndpointIDOK().WithPayload(mdl)
 	}
 }
 
@@ -1040,6 +1124,29 @@ func getEndpointIDHealthzHandler(d *Daemon, params GetEndpointIDHealthzParams) m
 	}
 }
 
+func getEndpointGcHandler(d *Daemon, params GetEndpointGcParams) middleware.Responder {
+	log.Debug("GET /endpoint/gc request")
+
+	r, err := d.apiLimiterSet.Wait(params.HTTPRequest.Context(), restapi.APIRequestEndpointGet)
+	if err != nil {
+		return api.Error(http.StatusTooManyRequests, err)
+	}
+	defer r.Done()
+
+	history := d.endpointManager.GCHistory()
+	payload := make([]*models.EndpointGCCycle, 0, len(history))
+	for _, cycle := range history {
+		payload = append(payload, &models.EndpointGCCycle{
+			Timestamp:          strfmt.DateTime(cycle.Timestamp),
+			EndpointsEvaluated: int64(cycle.Evaluated),
+			EndpointsMarked:    int64(cycle.Marked),
+			EndpointsSwept:     int64(cycle.Swept),
+		})
+	}
+
+	return NewGetEndpointGcOK().WithPayload(payload)
+}
+
 // modifyEndpointIdentityLabelsFromAPI adds and deletes the given labels on given endpoint ID.
 // Performs checks for whether the endpoint may be modified by an API call.
 // The received `add` and `del` labels will be filtered with the valid label prefixes.
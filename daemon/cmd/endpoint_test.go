@@ -8,9 +8,12 @@ import (
 	"fmt"
 	"net/netip"
 	"runtime"
+	"slices"
+	"strconv"
 	"testing"
 	"time"
 
+	"github.com/cilium/hive/hivetest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
@@ -22,13 +25,19 @@ import (
 	"github.com/cilium/cilium/pkg/endpoint"
 	endpointid "github.com/cilium/cilium/pkg/endpoint/id"
 	"github.com/cilium/cilium/pkg/identity"
+	"github.com/cilium/cilium/pkg/identity/identitymanager"
 	"github.com/cilium/cilium/pkg/ipam"
 	slim_corev1 "github.com/cilium/cilium/pkg/k8s/slim/k8s/api/core/v1"
 	slim_metav1 "github.com/cilium/cilium/pkg/k8s/slim/k8s/apis/meta/v1"
 	"github.com/cilium/cilium/pkg/labels"
 	"github.com/cilium/cilium/pkg/labelsfilter"
+	"github.com/cilium/cilium/pkg/maps/ctmap"
 	"github.com/cilium/cilium/pkg/metrics"
+	"github.com/cilium/cilium/pkg/policy"
+	policyapi "github.com/cilium/cilium/pkg/policy/api"
 	"github.com/cilium/cilium/pkg/testutils"
+	testidentity "github.com/cilium/cilium/pkg/testutils/identity"
+	testipcache "github.com/cilium/cilium/pkg/testutils/ipcache"
 )
 
 func getEPTemplate(t *testing.T, d *Daemon) *models.EndpointChangeRequest {
@@ -290,3 +299,66 @@ func TestHandleOutdatedPodInformer(t *testing.T) {
 		}
 	}
 }
+
+func newPageTestEndpoint(repo policy.PolicyRepository, id uint16) *endpoint.Endpoint {
+	return endpoint.NewTestEndpointWithState(nil, nil, nil, nil, nil, nil, nil, identitymanager.NewIDManager(), nil, repo, testipcache.NewMockIPCache(), &endpoint.FakeEndpointProxy{}, testidentity.NewMockIdentityAllocator(nil), ctmap.NewFakeGCRunner(), id, endpoint.StateReady)
+}
+
+func TestBuildEndpointPage(t *testing.T) {
+	repo := policy.NewPolicyRepository(hivetest.Logger(t), nil, nil, nil, nil, policyapi.NewPolicyMetricsNoop())
+	eps := make([]*endpoint.Endpoint, 0, 10)
+	for id := uint16(1); id <= 10; id++ {
+		eps = append(eps, newPageTestEndpoint(repo, id))
+	}
+
+	// Without a limit, a single page covers all endpoints.
+	page, next := buildEndpointPage(slices.Values(eps), nil, 0, 0, nil)
+	require.Len(t, page, 10)
+	require.Empty(t, next)
+
+	// Paging through with a limit, following the continue tokens, yields
+	// every endpoint exactly once, in ID order.
+	var ids []int64
+	var fromID uint64
+	for {
+		page, next = buildEndpointPage(slices.Values(eps), nil, fromID, 4, nil)
+		require.LessOrEqual(t, len(page), 4)
+		for _, ep := range page {
+			ids = append(ids, ep.ID)
+		}
+		if next == "" {
+			break
+		}
+		var err error
+		fromID, err = strconv.ParseUint(next, 10, 64)
+		require.NoError(t, err)
+	}
+	require.Equal(t, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, ids)
+}
+
+// BenchmarkGetEndpointPage compares the allocations a parameterless
+// GET /endpoint response has to hold in memory at once against those of a
+// single paginated request restricted to summary fields.
+func BenchmarkGetEndpointPage(b *testing.B) {
+	repo := policy.NewPolicyRepository(hivetest.Logger(b), nil, nil, nil, nil, policyapi.NewPolicyMetricsNoop())
+	eps := make([]*endpoint.Endpoint, 0, 512)
+	for id := uint16(1); id <= 512; id++ {
+		eps = append(eps, newPageTestEndpoint(repo, id))
+	}
+
+	mask, err := endpoint.ParseModelFieldMask("identity,networking,state")
+	require.NoError(b, err)
+
+	b.Run("full", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			buildEndpointPage(slices.Values(eps), nil, 0, 0, nil)
+		}
+	})
+	b.Run("page", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			buildEndpointPage(slices.Values(eps), nil, 0, 64, mask)
+		}
+	})
+}
@@ -30,7 +30,11 @@ const (
 func (d *Daemon) initHealth(spec *healthApi.Spec, cleaner *daemonCleanup, sysctl sysctl.Sysctl) {
 	// Launch cilium-health in the same process (and namespace) as cilium.
 	log.Info("Launching Cilium health daemon")
-	if ch, err := health.Launch(spec, d.loader.HostDatapathInitialized()); err != nil {
+	var tunnelPort uint16
+	if option.Config.TunnelingEnabled() {
+		tunnelPort = d.tunnelConfig.Port()
+	}
+	if ch, err := health.Launch(spec, d.loader.HostDatapathInitialized(), tunnelPort); err != nil {
 		log.WithError(err).Fatal("Failed to launch cilium-health")
 	} else {
 		d.ciliumHealth = ch
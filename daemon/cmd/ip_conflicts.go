@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/cilium/cilium/api/v1/models"
+	"github.com/cilium/cilium/pkg/cidr"
+	"github.com/cilium/cilium/pkg/identity"
+	"github.com/cilium/cilium/pkg/ipcache"
+	"github.com/cilium/cilium/pkg/metrics"
+	"github.com/cilium/cilium/pkg/node"
+)
+
+// detectIPConflicts cross-references the userspace ipcache against locally
+// owned IP addresses and reports any IP claimed by more than one owner. Two
+// classes of conflicts are detected: ipcache entries from other sources
+// claiming the IP of a local endpoint, and node IPs falling within the local
+// pod allocation CIDRs. Only the userspace ipcache metadata is consulted, the
+// BPF map is never touched.
+func (d *Daemon) detectIPConflicts() *models.IPConflicts {
+	s := &models.IPConflicts{
+		Status: &models.Status{State: models.StatusStateOk},
+	}
+
+	type endpointClaim struct {
+		owner    string
+		identity identity.NumericIdentity
+	}
+
+	localEndpointIPs := make(map[netip.Addr]endpointClaim)
+	for _, ep := range d.endpointManager.GetEndpoints() {
+		claim := endpointClaim{owner: "endpoint " + ep.StringID()}
+		if secID, err := ep.GetSecurityIdentity(); err == nil && secID != nil {
+			claim.identity = secID.ID
+		}
+		for _, ip := range []string{ep.GetIPv4Address(), ep.GetIPv6Address()} {
+			if addr, err := netip.ParseAddr(ip); err == nil {
+				localEndpointIPs[addr] = claim
+			}
+		}
+	}
+
+	ipv4AllocRange := node.GetIPv4AllocRange()
+	ipv6AllocRange := node.GetIPv6AllocRange()
+
+	d.ipcache.ForEachEntry(func(prefix string, id ipcache.Identity) {
+		// Entries from remote clusters are suffixed with "@<cluster>".
+		key, cluster, _ := strings.Cut(prefix, "@")
+		pfx, err := netip.ParsePrefix(key)
+		if err != nil || !pfx.IsSingleIP() {
+			return
+		}
+		addr := pfx.Addr()
+
+		if claim, ok := localEndpointIPs[addr]; ok {
+			// The endpoint's own entry carries the endpoint's identity. Any
+			// entry from a remote cluster or with a conflicting identity
+			// means another owner claims the same IP.
+			if cluster != "" || (claim.identity != 0 && id.ID != claim.identity) {
+				s.Conflicts = append(s.Conflicts, &models.IPConflict{
+					IP: addr.String(),
+					Claimants: []*models.IPConflictClaimant{
+						{Owner: claim.owner, Source: "endpoint"},
+						{Owner: fmt.Sprintf("ipcache entry %s (identity %d)", prefix, id.ID), Source: string(id.Source)},
+					},
+				})
+			}
+			return
+		}
+
+		// Node IPs must not fall within the local pod allocation range.
+		if id.ID == identity.ReservedIdentityHost || id.ID == identity.ReservedIdentityRemoteNode {
+			var allocRange *cidr.CIDR
+			if addr.Is4() {
+				allocRange = ipv4AllocRange
+			} else {
+				allocRange = ipv6AllocRange
+			}
+			if allocRange != nil && allocRange.Contains(addr.AsSlice()) {
+				s.Conflicts = append(s.Conflicts, &models.IPConflict{
+					IP: addr.String(),
+					Claimants: []*models.IPConflictClaimant{
+						{Owner: "node " + prefix, Source: string(id.Source)},
+						{Owner: "pod CIDR " + allocRange.String(), Source: "ipam"},
+					},
+				})
+			}
+		}
+	})
+
+	if len(s.Conflicts) > 0 {
+		metrics.IPCacheConflictsTotal.Add(float64(len(s.Conflicts)))
+		s.Status = &models.Status{
+			State: models.StatusStateFailure,
+			Msg:   fmt.Sprintf("%d IP address conflicts detected", len(s.Conflicts)),
+		}
+	}
+
+	return s
+}
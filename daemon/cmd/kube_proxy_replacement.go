@@ -39,11 +39,6 @@ import (
 // if this function cannot determine the strictness an error is returned and the boolean
 // is false. If an error is returned the boolean is of no meaning.
 func initKubeProxyReplacementOptions(sysctl sysctl.Sysctl, tunnelConfig tunnel.Config) error {
-	if option.Config.KubeProxyReplacement != option.KubeProxyReplacementTrue &&
-		option.Config.KubeProxyReplacement != option.KubeProxyReplacementFalse {
-		return fmt.Errorf("Invalid value for --%s: %s", option.KubeProxyReplacement, option.Config.KubeProxyReplacement)
-	}
-
 	if option.Config.KubeProxyReplacement == option.KubeProxyReplacementTrue {
 		log.Infof("Auto-enabling %q, %q, %q, %q, %q features",
 			option.EnableNodePort, option.EnableExternalIPs,
@@ -57,85 +52,29 @@ func initKubeProxyReplacementOptions(sysctl sysctl.Sysctl, tunnelConfig tunnel.C
 		option.Config.EnableSessionAffinity = true
 	}
 
-	if option.Config.EnableNodePort {
-		if option.Config.NodePortMode != option.NodePortModeSNAT &&
-			option.Config.NodePortMode != option.NodePortModeDSR &&
-			option.Config.NodePortMode != option.NodePortModeHybrid {
-			return fmt.Errorf("Invalid value for --%s: %s", option.NodePortMode, option.Config.NodePortMode)
-		}
-
-		if option.Config.LoadBalancerModeAnnotation &&
-			option.Config.NodePortMode == option.NodePortModeHybrid {
-			return fmt.Errorf("The value --%s=%s is not supported as default under annotation mode", option.NodePortMode, option.Config.NodePortMode)
-		}
-
-		if option.Config.NodePortMode == option.NodePortModeDSR &&
-			option.Config.LoadBalancerDSRDispatch != option.DSRDispatchOption &&
-			option.Config.LoadBalancerDSRDispatch != option.DSRDispatchIPIP &&
-			option.Config.LoadBalancerDSRDispatch != option.DSRDispatchGeneve {
-			return fmt.Errorf("Invalid value for --%s: %s", option.LoadBalancerDSRDispatch, option.Config.LoadBalancerDSRDispatch)
-		}
-
-		if option.Config.NodePortMode == option.NodePortModeHybrid &&
-			option.Config.LoadBalancerDSRDispatch != option.DSRDispatchOption &&
-			option.Config.LoadBalancerDSRDispatch != option.DSRDispatchGeneve {
-			return fmt.Errorf("Invalid value for --%s: %s", option.LoadBalancerDSRDispatch, option.Config.LoadBalancerDSRDispatch)
-		}
-
-		if option.Config.LoadBalancerModeAnnotation &&
-			option.Config.LoadBalancerDSRDispatch != option.DSRDispatchIPIP {
-			return fmt.Errorf("Invalid value for --%s: %s", option.LoadBalancerDSRDispatch, option.Config.LoadBalancerDSRDispatch)
-		}
+	// The cross-field validation of the kube-proxy replacement options is
+	// shared with the offline `cilium-dbg preflight validate-config`
+	// command. New checks belong there, not here.
+	if errs := option.Config.ValidateKubeProxyReplacement(
+		tunnelConfig.EncapProtocol().String(),
+		string(tunnelConfig.UnderlayProtocol()),
+	); len(errs) > 0 {
+		return errs[0]
+	}
 
+	if option.Config.EnableNodePort {
 		if option.Config.LoadBalancerRSSv4CIDR != "" {
-			ip, cidr, err := net.ParseCIDR(option.Config.LoadBalancerRSSv4CIDR)
-			if ip.To4() == nil {
-				err = fmt.Errorf("CIDR is not IPv4 based")
-			}
-			if err == nil {
-				if ones, _ := cidr.Mask.Size(); ones == 0 {
-					err = fmt.Errorf("CIDR length must be in (0,32]")
-				}
-			}
-			if err != nil {
-				return fmt.Errorf("Invalid value for --%s: %s",
-					option.LoadBalancerRSSv4CIDR, option.Config.LoadBalancerRSSv4CIDR)
-			}
+			// Already validated by ValidateKubeProxyReplacement above.
+			_, cidr, _ := net.ParseCIDR(option.Config.LoadBalancerRSSv4CIDR)
 			option.Config.LoadBalancerRSSv4 = *cidr
 		}
 
 		if option.Config.LoadBalancerRSSv6CIDR != "" {
-			ip, cidr, err := net.ParseCIDR(option.Config.LoadBalancerRSSv6CIDR)
-			if ip.To4() != nil {
-				err = fmt.Errorf("CIDR is not IPv6 based")
-			}
-			if err == nil {
-				if ones, _ := cidr.Mask.Size(); ones == 0 {
-					err = fmt.Errorf("CIDR length must be in (0,128]")
-				}
-			}
-			if err != nil {
-				return fmt.Errorf("Invalid value for --%s: %s",
-					option.LoadBalancerRSSv6CIDR, option.Config.LoadBalancerRSSv6CIDR)
-			}
+			// Already validated by ValidateKubeProxyReplacement above.
+			_, cidr, _ := net.ParseCIDR(option.Config.LoadBalancerRSSv6CIDR)
 			option.Config.LoadBalancerRSSv6 = *cidr
 		}
 
-		dsrIPIP := option.Config.LoadBalancerUsesDSR() && option.Config.LoadBalancerDSRDispatch == option.DSRDispatchIPIP
-		if dsrIPIP && option.Config.NodePortAcceleration == option.NodePortAccelerationDisabled {
-			return fmt.Errorf("DSR dispatch mode %s currently only available under XDP acceleration", option.Config.LoadBalancerDSRDispatch)
-		}
-
-		if (option.Config.LoadBalancerRSSv4CIDR != "" || option.Config.LoadBalancerRSSv6CIDR != "") && !dsrIPIP {
-			return fmt.Errorf("Invalid value for --%s/%s: currently only supported under %s dispatch for DSR",
-				option.LoadBalancerRSSv4CIDR, option.LoadBalancerRSSv6CIDR, option.DSRDispatchIPIP)
-		}
-
-		if option.Config.NodePortAlg != option.NodePortAlgRandom &&
-			option.Config.NodePortAlg != option.NodePortAlgMaglev {
-			return fmt.Errorf("Invalid value for --%s: %s", option.NodePortAlg, option.Config.NodePortAlg)
-		}
-
 		if option.Config.NodePortAcceleration != option.NodePortAccelerationDisabled &&
 			option.Config.EnableWireguard && option.Config.EncryptNode {
 			log.WithField(logfields.Hint,
@@ -149,51 +88,11 @@ func initKubeProxyReplacementOptions(sysctl sysctl.Sysctl, tunnelConfig tunnel.C
 			log.Warning("NodePort BPF configured without bind(2) protection against service ports")
 		}
 
-		if option.Config.TunnelingEnabled() && tunnelConfig.UnderlayProtocol() == tunnel.IPv6 {
-			return fmt.Errorf("BPF NodePort cannot be used over an IPv6 underlay")
-		}
-
-		if option.Config.TunnelingEnabled() && tunnelConfig.EncapProtocol() == tunnel.VXLAN &&
-			option.Config.LoadBalancerUsesDSR() {
-			return fmt.Errorf("Node Port %q mode cannot be used with %s tunneling.", option.Config.NodePortMode, tunnel.VXLAN)
-		}
-
-		if option.Config.TunnelingEnabled() && option.Config.LoadBalancerUsesDSR() &&
-			option.Config.LoadBalancerDSRDispatch != option.DSRDispatchGeneve {
-			return fmt.Errorf("Tunnel routing with Node Port %q mode requires %s dispatch.",
-				option.Config.NodePortMode, option.DSRDispatchGeneve)
-		}
-
-		if option.Config.LoadBalancerUsesDSR() &&
-			option.Config.LoadBalancerDSRDispatch == option.DSRDispatchGeneve &&
-			tunnelConfig.EncapProtocol() != tunnel.Geneve {
-			return fmt.Errorf("Node Port %q mode with %s dispatch requires %s tunnel protocol.",
-				option.Config.NodePortMode, option.Config.LoadBalancerDSRDispatch, tunnel.Geneve)
-		}
-
 		if option.Config.LoadBalancerIPIPSockMark {
-			if !dsrIPIP {
-				return fmt.Errorf("Node Port %q mode with IPIP socket mark logic requires %s dispatch.",
-					option.Config.NodePortMode, option.DSRDispatchIPIP)
-			}
 			option.Config.EnableHealthDatapath = true
 		}
 	}
 
-	if option.Config.InstallNoConntrackIptRules {
-		// InstallNoConntrackIptRules can only be enabled when Cilium is
-		// running in full KPR mode as otherwise conntrack would be
-		// required for NAT operations
-		if !option.Config.KubeProxyReplacementFullyEnabled() {
-			return fmt.Errorf("%s requires the agent to run with %s=%s.",
-				option.InstallNoConntrackIptRules, option.KubeProxyReplacement, option.KubeProxyReplacementTrue)
-		}
-
-		if option.Config.MasqueradingEnabled() && !option.Config.EnableBPFMasquerade {
-			return fmt.Errorf("%s requires the agent to run with %s.",
-				option.InstallNoConntrackIptRules, option.EnableBPFMasquerade)
-		}
-	}
 	if option.Config.BPFSocketLBHostnsOnly {
 		option.Config.EnableSocketLBTracing = false
 	}
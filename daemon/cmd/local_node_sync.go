@@ -14,6 +14,8 @@ import (
 	"github.com/sirupsen/logrus"
 
 	agentK8s "github.com/cilium/cilium/daemon/k8s"
+	"github.com/cilium/cilium/pkg/annotation"
+	"github.com/cilium/cilium/pkg/datapath/tunnel"
 	"github.com/cilium/cilium/pkg/identity"
 	"github.com/cilium/cilium/pkg/k8s"
 	v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
@@ -35,6 +37,7 @@ type localNodeSynchronizerParams struct {
 	Config             *option.DaemonConfig
 	K8sLocalNode       agentK8s.LocalNodeResource
 	K8sCiliumLocalNode agentK8s.LocalCiliumNodeResource
+	TunnelConfig       tunnel.Config
 
 	WireGuard *wg.Agent // nil if WireGuard is disabled
 }
@@ -104,6 +107,13 @@ func (ini *localNodeSynchronizer) initFromConfig(ctx context.Context, n *node.Lo
 	n.IPv4NativeRoutingCIDR = ini.Config.IPv4NativeRoutingCIDR
 	n.IPv6NativeRoutingCIDR = ini.Config.IPv6NativeRoutingCIDR
 
+	// Advertise the key datapath settings to the other nodes, so that they
+	// can flag asymmetric configuration across the cluster.
+	if n.Annotations == nil {
+		n.Annotations = map[string]string{}
+	}
+	n.Annotations[annotation.ConfigFeatureFingerprint] = formatFingerprint(datapathFingerprint(ini.TunnelConfig))
+
 	// Initialize node IP addresses from configuration.
 	if ini.Config.IPv6NodeAddr != "auto" {
 		if ip := net.ParseIP(ini.Config.IPv6NodeAddr); ip == nil {
@@ -17,6 +17,8 @@ import (
 	k8sRuntime "k8s.io/apimachinery/pkg/runtime"
 	k8stypes "k8s.io/apimachinery/pkg/types"
 
+	"github.com/cilium/cilium/pkg/annotation"
+	"github.com/cilium/cilium/pkg/datapath/tunnel"
 	v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
 	"github.com/cilium/cilium/pkg/k8s/resource"
 	slim_corev1 "github.com/cilium/cilium/pkg/k8s/slim/k8s/api/core/v1"
@@ -102,13 +104,18 @@ func TestLocalNodeSync(t *testing.T) {
 		})
 	)
 
+	// The fingerprint annotation is added unconditionally from the local
+	// configuration.
+	fingerprint := formatFingerprint(datapathFingerprint(tunnel.Config{}))
+
 	require.NoError(t, sync.InitLocalNode(context.TODO(), &local))
 	require.EqualValues(t, 1, fln.done)
 	require.Equal(t, "foo", local.Name)
 	require.Equal(t, "10.0.0.1", local.GetNodeInternalIPv4().String())
 	require.Equal(t, "fc00::11", local.GetNodeInternalIPv6().String())
 	require.Equal(t, map[string]string{"ex": "label", "foo": "bar"}, local.Labels)
-	require.Equal(t, map[string]string{"ex": "annot", "cilium.io/baz": "qux"}, local.Annotations)
+	require.Equal(t, map[string]string{"ex": "annot", "cilium.io/baz": "qux",
+		annotation.ConfigFeatureFingerprint: fingerprint}, local.Annotations)
 	require.Equal(t, k8stypes.UID("uid1"), local.UID)
 	require.Equal(t, "provider://foobar", local.ProviderID)
 
@@ -120,13 +127,16 @@ func TestLocalNodeSync(t *testing.T) {
 
 	update := <-updates
 	require.Equal(t, map[string]string{"ex": "label", "foo": "bar"}, update.Labels)
-	require.Equal(t, map[string]string{"ex": "annot", "cilium.io/baz": "qux"}, update.Annotations)
+	require.Equal(t, map[string]string{"ex": "annot", "cilium.io/baz": "qux",
+		annotation.ConfigFeatureFingerprint: fingerprint}, update.Annotations)
 	update = <-updates
 	require.Equal(t, map[string]string{"ex": "label", "foo": "bar", "qux": "baz"}, update.Labels)
-	require.Equal(t, map[string]string{"ex": "annot", "cilium.io/baz": "qux", "cilium.io/bar": "foo"}, update.Annotations)
+	require.Equal(t, map[string]string{"ex": "annot", "cilium.io/baz": "qux", "cilium.io/bar": "foo",
+		annotation.ConfigFeatureFingerprint: fingerprint}, update.Annotations)
 	update = <-updates
 	require.Equal(t, map[string]string{"ex": "label", "qux": "baz"}, update.Labels)
-	require.Equal(t, map[string]string{"ex": "annot", "cilium.io/bar": "foo"}, update.Annotations)
+	require.Equal(t, map[string]string{"ex": "annot", "cilium.io/bar": "foo",
+		annotation.ConfigFeatureFingerprint: fingerprint}, update.Annotations)
 	update = <-updates
 	require.Equal(t, k8stypes.UID("uid2"), update.UID)
 	require.Equal(t, "provider://foobaz", update.ProviderID)
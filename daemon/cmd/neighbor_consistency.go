@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/cilium/cilium/pkg/controller"
+	"github.com/cilium/cilium/pkg/metrics"
+	"github.com/cilium/cilium/pkg/status"
+)
+
+var neighborConsistencyControllerGroup = controller.NewGroup("neighbor-table-consistency")
+
+// neighborConsistencyInterval is the interval between two comparisons of the
+// kernel neighbor table against the set of known remote nodes.
+const neighborConsistencyInterval = time.Minute
+
+// verifyNeighborConsistency compares the kernel neighbor entries for node
+// next-hops against the nodes known to the agent and reports nodes with
+// missing or failed entries via the agent status and the
+// cilium_datapath_neighbor_table_inconsistencies metric. Missing entries on
+// the direct-routing device cause NodePort traffic towards the affected node
+// to be blackholed.
+func (d *Daemon) verifyNeighborConsistency(ctx context.Context) error {
+	nc := d.nodeNeighbors.NeighborConsistency()
+
+	inconsistent := len(nc.Missing) + len(nc.Stale)
+	metrics.NeighborTableInconsistencies.Set(float64(inconsistent))
+
+	ms := status.NewMultiStatus()
+	if len(nc.Missing) > 0 {
+		ms.AddWarning("missing-entries",
+			fmt.Sprintf("%d nodes without a neighbor entry for their next hop: %s",
+				len(nc.Missing), strings.Join(nc.Missing, ", ")))
+	} else {
+		ms.AddOK("missing-entries", "")
+	}
+	if len(nc.Stale) > 0 {
+		ms.AddWarning("stale-entries",
+			fmt.Sprintf("%d nodes whose next-hop entry failed resolution: %s",
+				len(nc.Stale), strings.Join(nc.Stale, ", ")))
+	} else {
+		ms.AddOK("stale-entries", "")
+	}
+	nc.Status = ms.StatusModel()
+
+	if inconsistent > 0 {
+		log.WithFields(logrus.Fields{
+			"missing": len(nc.Missing),
+			"stale":   len(nc.Stale),
+		}).Warn("Kernel neighbor table is inconsistent with the set of known nodes")
+	}
+
+	d.statusCollectMutex.Lock()
+	d.statusResponse.NeighborConsistency = nc
+	d.statusCollectMutex.Unlock()
+
+	return nil
+}
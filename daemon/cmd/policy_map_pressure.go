@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	typedv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/cilium/cilium/api/v1/models"
+	slim_corev1 "github.com/cilium/cilium/pkg/k8s/slim/k8s/api/core/v1"
+	slim_metav1 "github.com/cilium/cilium/pkg/k8s/slim/k8s/apis/meta/v1"
+	slimscheme "github.com/cilium/cilium/pkg/k8s/slim/k8s/client/clientset/versioned/scheme"
+	"github.com/cilium/cilium/pkg/maps/policymap"
+)
+
+// policyMapPressureTopN is the number of endpoints with the highest policy
+// map utilization reported in the status section. Endpoints with insert
+// failures are always reported, regardless of their utilization rank.
+const policyMapPressureTopN = 5
+
+// getPolicyMapPressure summarizes the utilization of the per-endpoint policy
+// maps as tracked by the policy map factory. Endpoints whose insert failure
+// count grew since the previous probe run additionally raise a Kubernetes
+// event on the affected pod, as a full policy map silently denies traffic
+// to or from the identities that did not fit.
+func (d *Daemon) getPolicyMapPressure() *models.PolicyMapPressure {
+	report := d.policyMapFactory.PressureReport()
+
+	d.probeStateMutex.Lock()
+	if d.policyMapInsertFailures == nil {
+		d.policyMapInsertFailures = make(map[uint16]int64)
+	}
+	d.notifyPolicyMapInsertFailures(report)
+	d.probeStateMutex.Unlock()
+
+	return policyMapPressureModel(report, d.policyMapFactory.PolicyMaxEntries(), policyMapPressureTopN)
+}
+
+// policyMapPressureModel converts the factory's utilization report, sorted by
+// entries in use in descending order, into the API model. It retains the
+// topN endpoints by utilization plus any endpoint that has experienced an
+// insert failure, and degrades the section to Warning when insert failures
+// are present.
+func policyMapPressureModel(report []policymap.EndpointPressure, maxEntries, topN int) *models.PolicyMapPressure {
+	var (
+		eps    []*models.PolicyMapPressureEndpoint
+		failed int
+	)
+
+	for i, p := range report {
+		if i >= topN && p.InsertFailures == 0 {
+			continue
+		}
+		if p.InsertFailures > 0 {
+			failed++
+		}
+
+		var utilization float64
+		if maxEntries > 0 {
+			utilization = float64(p.Used) / float64(maxEntries)
+		}
+		eps = append(eps, &models.PolicyMapPressureEndpoint{
+			EndpointID:     int64(p.EndpointID),
+			Used:           int64(p.Used),
+			Utilization:    utilization,
+			InsertFailures: p.InsertFailures,
+		})
+	}
+
+	state := &models.Status{State: models.StatusStateOk}
+	if failed > 0 {
+		state = &models.Status{
+			State: models.StatusStateWarning,
+			Msg:   fmt.Sprintf("%d endpoints with policy map insert failures", failed),
+		}
+	}
+
+	return &models.PolicyMapPressure{
+		Status:        state,
+		MapMaxEntries: int64(maxEntries),
+		Endpoints:     eps,
+	}
+}
+
+// notifyPolicyMapInsertFailures raises a Kubernetes event on the pod of every
+// endpoint whose insert failure count grew since the previous probe run.
+// Called with probeStateMutex held.
+func (d *Daemon) notifyPolicyMapInsertFailures(report []policymap.EndpointPressure) {
+	for _, p := range report {
+		if p.InsertFailures == 0 {
+			continue
+		}
+		prev := d.policyMapInsertFailures[p.EndpointID]
+		d.policyMapInsertFailures[p.EndpointID] = p.InsertFailures
+		if p.InsertFailures > prev {
+			d.emitPolicyMapFullEvent(p)
+		}
+	}
+}
+
+// emitPolicyMapFullEvent emits a warning event on the pod backing the given
+// endpoint. The event is a no-op for endpoints not backed by a pod or when
+// the agent runs without Kubernetes.
+func (d *Daemon) emitPolicyMapFullEvent(p policymap.EndpointPressure) {
+	if !d.clientset.IsEnabled() {
+		return
+	}
+
+	ep := d.endpointManager.LookupCiliumID(p.EndpointID)
+	if ep == nil || ep.GetK8sPodName() == "" {
+		return
+	}
+
+	if d.policyMapEventRecorder == nil {
+		broadcaster := record.NewBroadcaster()
+		broadcaster.StartRecordingToSink(&typedv1.EventSinkImpl{Interface: d.clientset.CoreV1().Events("")})
+		d.policyMapEventRecorder = broadcaster.NewRecorder(slimscheme.Scheme, v1.EventSource{Component: "cilium"})
+	}
+
+	d.policyMapEventRecorder.Eventf(&slim_corev1.Pod{
+		TypeMeta: slim_metav1.TypeMeta{
+			Kind:       "Pod",
+			APIVersion: "v1",
+		},
+		ObjectMeta: slim_metav1.ObjectMeta{
+			Name:      ep.GetK8sPodName(),
+			Namespace: ep.GetK8sNamespace(),
+		},
+	}, v1.EventTypeWarning, "CiliumPolicyMapFull",
+		"Policy map of endpoint %d is full (%d of %d entries in use); some policy entries could not be inserted and traffic of this pod may be dropped",
+		p.EndpointID, p.Used, d.policyMapFactory.PolicyMaxEntries())
+}
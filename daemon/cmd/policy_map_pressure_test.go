@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/cilium/api/v1/models"
+	"github.com/cilium/cilium/pkg/maps/policymap"
+)
+
+func TestPolicyMapPressureModel(t *testing.T) {
+	report := []policymap.EndpointPressure{
+		{EndpointID: 4, Used: 90},
+		{EndpointID: 1, Used: 50},
+		{EndpointID: 2, Used: 30},
+		{EndpointID: 3, Used: 10, InsertFailures: 2},
+	}
+
+	mdl := policyMapPressureModel(report, 100, 2)
+
+	// The top two endpoints by utilization plus the endpoint with insert
+	// failures are retained.
+	require.Len(t, mdl.Endpoints, 3)
+	require.Equal(t, int64(4), mdl.Endpoints[0].EndpointID)
+	require.InEpsilon(t, 0.9, mdl.Endpoints[0].Utilization, 0.0001)
+	require.Equal(t, int64(1), mdl.Endpoints[1].EndpointID)
+	require.Equal(t, int64(3), mdl.Endpoints[2].EndpointID)
+	require.Equal(t, int64(2), mdl.Endpoints[2].InsertFailures)
+
+	require.Equal(t, int64(100), mdl.MapMaxEntries)
+	require.Equal(t, models.StatusStateWarning, mdl.Status.State)
+	require.Equal(t, "1 endpoints with policy map insert failures", mdl.Status.Msg)
+}
+
+func TestPolicyMapPressureModelHealthy(t *testing.T) {
+	report := []policymap.EndpointPressure{
+		{EndpointID: 1, Used: 50},
+	}
+
+	mdl := policyMapPressureModel(report, 100, 5)
+
+	require.Len(t, mdl.Endpoints, 1)
+	require.Equal(t, models.StatusStateOk, mdl.Status.State)
+	require.Empty(t, mdl.Status.Msg)
+}
+
+func TestPolicyMapPressureModelEmpty(t *testing.T) {
+	mdl := policyMapPressureModel(nil, 100, 5)
+
+	require.Empty(t, mdl.Endpoints)
+	require.Equal(t, models.StatusStateOk, mdl.Status.State)
+}
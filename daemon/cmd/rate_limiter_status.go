@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/cilium/cilium/api/v1/models"
+	"github.com/cilium/cilium/pkg/maps/ratelimitmap"
+)
+
+// getRateLimiterStatus summarizes the saturation of the rate limiters. The
+// per-operation statistics of the API rate limiters are sourced from the
+// userspace limiter bookkeeping, the drop counters of the datapath rate
+// limiters from the ratelimit metrics map. The section degrades to Warning
+// whenever the rejection count of an operation grew since the previous probe
+// run, naming the affected operations.
+func (d *Daemon) getRateLimiterStatus() *models.RateLimiterStatus {
+	var ops []*models.RateLimiterOperation
+
+	if d.apiLimiterSet != nil {
+		for _, ls := range d.apiLimiterSet.Status() {
+			ops = append(ops, &models.RateLimiterOperation{
+				Name:             ls.Name,
+				Limit:            float64(ls.Limit),
+				Burst:            int64(ls.Burst),
+				MeanWaitDuration: strfmt.Duration(ls.MeanWaitDuration),
+				MaxWaitDuration:  strfmt.Duration(ls.MaxWaitDuration),
+				Rejected:         ls.RequestsRejected,
+			})
+		}
+	}
+
+	// The datapath rate limiters only track drops, there is no wait time
+	// bookkeeping. Dump errors are ignored as the metrics map may not have
+	// been created yet.
+	_ = ratelimitmap.Metrics().DumpWithCallback(func(k *ratelimitmap.MetricsKey, v *ratelimitmap.MetricsValue) {
+		ops = append(ops, &models.RateLimiterOperation{
+			Name:     "datapath/" + k.Usage.String(),
+			Rejected: int64(v.Dropped),
+		})
+	})
+
+	d.probeStateMutex.Lock()
+	if d.rateLimiterRejections == nil {
+		d.rateLimiterRejections = make(map[string]int64)
+	}
+	state := rateLimiterState(ops, d.rateLimiterRejections)
+	d.probeStateMutex.Unlock()
+
+	return &models.RateLimiterStatus{
+		Status:     state,
+		Operations: ops,
+	}
+}
+
+// rateLimiterState derives the state of the rate-limiter section from the
+// current operation statistics and the rejection counts seen by the previous
+// probe run. prev is updated in place with the current counts.
+func rateLimiterState(ops []*models.RateLimiterOperation, prev map[string]int64) *models.Status {
+	var saturated []string
+	for _, op := range ops {
+		if last, ok := prev[op.Name]; ok && op.Rejected > last {
+			saturated = append(saturated, op.Name)
+		}
+		prev[op.Name] = op.Rejected
+	}
+
+	if len(saturated) > 0 {
+		return &models.Status{
+			State: models.StatusStateWarning,
+			Msg:   fmt.Sprintf("Rate limiter rejecting requests: %s", strings.Join(saturated, ", ")),
+		}
+	}
+
+	return &models.Status{State: models.StatusStateOk}
+}
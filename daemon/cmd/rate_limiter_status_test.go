@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/cilium/api/v1/models"
+)
+
+func TestRateLimiterState(t *testing.T) {
+	prev := map[string]int64{}
+
+	ops := []*models.RateLimiterOperation{
+		{Name: "endpoint-create", Rejected: 5},
+		{Name: "endpoint-delete"},
+	}
+
+	// The first run only establishes the baseline, pre-existing rejections
+	// do not degrade the section.
+	s := rateLimiterState(ops, prev)
+	require.Equal(t, models.StatusStateOk, s.State)
+
+	// No growth between runs.
+	s = rateLimiterState(ops, prev)
+	require.Equal(t, models.StatusStateOk, s.State)
+
+	// Growth of a single operation degrades the section and names the
+	// operation.
+	ops[0].Rejected = 7
+	s = rateLimiterState(ops, prev)
+	require.Equal(t, models.StatusStateWarning, s.State)
+	require.Equal(t, "Rate limiter rejecting requests: endpoint-create", s.Msg)
+
+	// Once the count is steady again, the warning clears.
+	s = rateLimiterState(ops, prev)
+	require.Equal(t, models.StatusStateOk, s.State)
+
+	// Growth of multiple operations names all of them.
+	ops[0].Rejected = 8
+	ops[1].Rejected = 1
+	s = rateLimiterState(ops, prev)
+	require.Equal(t, models.StatusStateWarning, s.State)
+	require.Equal(t, "Rate limiter rejecting requests: endpoint-create, endpoint-delete", s.Msg)
+}
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/cilium/cilium/pkg/bpf"
+	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/mountinfo"
+	"github.com/cilium/cilium/pkg/time"
+)
+
+// StartupDiagnosticSeverity classifies how severe a startup diagnostic is.
+type StartupDiagnosticSeverity string
+
+const (
+	// StartupDiagnosticWarning indicates a condition which may degrade the
+	// agent but does not prevent it from operating.
+	StartupDiagnosticWarning StartupDiagnosticSeverity = "Warning"
+
+	// StartupDiagnosticCritical indicates a condition which is expected to
+	// cause the agent to fail or misbehave.
+	StartupDiagnosticCritical StartupDiagnosticSeverity = "Critical"
+)
+
+// StartupDiagnostic describes a problematic condition detected during daemon
+// bootstrap, together with a suggestion on how to resolve it.
+type StartupDiagnostic struct {
+	// Subsystem identifies the component which detected the condition.
+	Subsystem string
+
+	// Severity classifies the impact of the condition.
+	Severity StartupDiagnosticSeverity
+
+	// Message is a human-readable description of the condition.
+	Message string
+
+	// Suggestion is a human-readable hint on how to resolve the condition.
+	Suggestion string
+
+	// Timestamp is the time at which the condition was detected.
+	Timestamp time.Time
+}
+
+var (
+	startupDiagnosticsMutex lock.RWMutex
+	startupDiagnostics      []StartupDiagnostic
+)
+
+// addStartupDiagnostic records a diagnostic detected during daemon bootstrap
+// and logs it at a level matching its severity.
+func addStartupDiagnostic(diag StartupDiagnostic) {
+	if diag.Timestamp.IsZero() {
+		diag.Timestamp = time.Now()
+	}
+
+	startupDiagnosticsMutex.Lock()
+	startupDiagnostics = append(startupDiagnostics, diag)
+	startupDiagnosticsMutex.Unlock()
+
+	scopedLog := log.WithFields(logrus.Fields{
+		logfields.LogSubsys:   diag.Subsystem,
+		logfields.HelpMessage: diag.Suggestion,
+	})
+	if diag.Severity == StartupDiagnosticCritical {
+		scopedLog.Error(diag.Message)
+	} else {
+		scopedLog.Warning(diag.Message)
+	}
+}
+
+// getStartupDiagnostics returns a copy of the diagnostics recorded during
+// daemon bootstrap.
+func getStartupDiagnostics() []StartupDiagnostic {
+	startupDiagnosticsMutex.RLock()
+	defer startupDiagnosticsMutex.RUnlock()
+
+	diags := make([]StartupDiagnostic, len(startupDiagnostics))
+	copy(diags, startupDiagnostics)
+	return diags
+}
+
+// verifyBPFFSMount checks that the BPF filesystem is mounted at the configured
+// root before any BPF maps are created. A missing mount surfaces later as
+// cryptic map creation failures, so detect it up front and record a critical
+// startup diagnostic with a suggestion on how to mount it.
+func verifyBPFFSMount() {
+	root := bpf.BPFFSRoot()
+
+	mounted, bpffsInstance, err := mountinfo.IsMountFS(mountinfo.FilesystemTypeBPFFS, root)
+	if err != nil {
+		addStartupDiagnostic(StartupDiagnostic{
+			Subsystem:  "bpf",
+			Severity:   StartupDiagnosticWarning,
+			Message:    fmt.Sprintf("Unable to verify BPF filesystem mount at %s: %s", root, err),
+			Suggestion: fmt.Sprintf("Verify that %s is mounted as a BPF filesystem", root),
+		})
+		return
+	}
+
+	if !mounted || !bpffsInstance {
+		addStartupDiagnostic(StartupDiagnostic{
+			Subsystem:  "bpf",
+			Severity:   StartupDiagnosticCritical,
+			Message:    fmt.Sprintf("BPF filesystem is not mounted at %s, BPF map creation will fail", root),
+			Suggestion: fmt.Sprintf("Run \"mount -t bpf bpf %s\" on the host and restart the agent", root),
+		})
+	}
+}
@@ -10,12 +10,14 @@ import (
 	"net"
 	"os"
 	"slices"
+	"sort"
 	"sync"
 
 	"github.com/sirupsen/logrus"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 
+	"github.com/cilium/cilium/api/v1/models"
 	"github.com/cilium/cilium/pkg/controller"
 	"github.com/cilium/cilium/pkg/datapath/linux/safenetlink"
 	"github.com/cilium/cilium/pkg/endpoint"
@@ -30,6 +32,7 @@ import (
 	"github.com/cilium/cilium/pkg/maps/lxcmap"
 	nodeTypes "github.com/cilium/cilium/pkg/node/types"
 	"github.com/cilium/cilium/pkg/option"
+	"github.com/cilium/cilium/pkg/time"
 )
 
 var syncLBMapsControllerGroup = controller.NewGroup("sync-lb-maps-with-k8s-services")
@@ -65,6 +68,13 @@ type endpointRestoreState struct {
 	possible map[uint16]*endpoint.Endpoint
 	restored []*endpoint.Endpoint
 	toClean  []*endpoint.Endpoint
+
+	// started marks the beginning of the restore, for the duration reported
+	// in the status response.
+	started time.Time
+	// failureReasons counts the endpoints which could not be restored, per
+	// failure reason.
+	failureReasons map[string]int
 }
 
 // checkLink returns an error if a link with linkName does not exist.
@@ -163,9 +173,10 @@ func (d *Daemon) getPodForEndpoint(ep *endpoint.Endpoint) error {
 //   - recreate endpoint's policy, as well as bpf programs and maps
 func (d *Daemon) fetchOldEndpoints(dir string) (*endpointRestoreState, error) {
 	state := &endpointRestoreState{
-		possible: nil,
-		restored: []*endpoint.Endpoint{},
-		toClean:  []*endpoint.Endpoint{},
+		possible:       nil,
+		restored:       []*endpoint.Endpoint{},
+		toClean:        []*endpoint.Endpoint{},
+		failureReasons: map[string]int{},
 	}
 
 	if !option.Config.RestoreState {
@@ -208,6 +219,8 @@ func (d *Daemon) restoreOldEndpoints(state *endpointRestoreState) {
 	emf := &cachedEndpointMetadataFetcher{k8sWatcher: d.k8sWatcher}
 	d.endpointMetadataFetcher = emf
 
+	state.started = time.Now()
+
 	log.Info("Restoring endpoints...")
 
 	var (
@@ -247,6 +260,7 @@ func (d *Daemon) restoreOldEndpoints(state *endpointRestoreState) {
 				d.endpointManager.DeleteK8sCiliumEndpointSync(ep)
 				scopedLog.WithError(err).Warningf("Unable to restore endpoint, ignoring")
 				failed++
+				state.failureReasons[err.Error()]++
 			}
 		}
 		if !restore {
@@ -379,10 +393,43 @@ func (d *Daemon) regenerateRestoredEndpoints(state *endpointRestoreState, endpoi
 			"regenerated": regenerated,
 			"total":       total,
 		}).Info("Finished regenerating restored endpoints")
+		d.recordRestoreStatus(state)
 		close(d.endpointRestoreComplete)
 	}()
 }
 
+// recordRestoreStatus publishes the outcome of the endpoint restore in the
+// status response. It is called once, after the restored endpoints have been
+// regenerated.
+func (d *Daemon) recordRestoreStatus(state *endpointRestoreState) {
+	failed := 0
+	reasons := make([]*models.RestoreFailureReason, 0, len(state.failureReasons))
+	for reason, count := range state.failureReasons {
+		failed += count
+		reasons = append(reasons, &models.RestoreFailureReason{
+			Reason: reason,
+			Count:  int64(count),
+		})
+	}
+	sort.Slice(reasons, func(i, j int) bool {
+		if reasons[i].Count != reasons[j].Count {
+			return reasons[i].Count > reasons[j].Count
+		}
+		return reasons[i].Reason < reasons[j].Reason
+	})
+
+	d.statusCollectMutex.Lock()
+	defer d.statusCollectMutex.Unlock()
+	d.statusResponse.Restore = &models.RestoreStatus{
+		Restored:       int64(len(state.restored)),
+		Failed:         int64(failed),
+		Deleted:        int64(len(state.toClean)),
+		FailureReasons: reasons,
+		Duration:       time.Since(state.started).Nanoseconds(),
+		Timestamp:      time.Now().Format(time.RFC3339),
+	}
+}
+
 func (d *Daemon) allocateIPsLocked(ep *endpoint.Endpoint) (err error) {
 	if option.Config.EnableIPv6 && ep.IPv6.IsValid() {
 		ipv6Pool := ipam.PoolOrDefault(ep.IPv6IPAMPool)
@@ -4,11 +4,20 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
 	"sort"
 	"strings"
+	"sync"
 
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/sirupsen/logrus"
@@ -17,25 +26,37 @@ import (
 	"github.com/cilium/cilium/api/v1/models"
 	. "github.com/cilium/cilium/api/v1/server/restapi/daemon"
 	"github.com/cilium/cilium/pkg/annotation"
+	"github.com/cilium/cilium/pkg/api"
 	"github.com/cilium/cilium/pkg/backoff"
+	"github.com/cilium/cilium/pkg/bpf"
 	"github.com/cilium/cilium/pkg/controller"
+	linuxconfig "github.com/cilium/cilium/pkg/datapath/linux/config"
 	"github.com/cilium/cilium/pkg/datapath/linux/probes"
 	datapathOption "github.com/cilium/cilium/pkg/datapath/option"
 	datapathTables "github.com/cilium/cilium/pkg/datapath/tables"
 	datapath "github.com/cilium/cilium/pkg/datapath/types"
+	"github.com/cilium/cilium/pkg/datapath/xdp"
+	"github.com/cilium/cilium/pkg/defaults"
+	healthTypes "github.com/cilium/cilium/pkg/hive/health/types"
 	"github.com/cilium/cilium/pkg/identity"
 	k8smetrics "github.com/cilium/cilium/pkg/k8s/metrics"
 	"github.com/cilium/cilium/pkg/kvstore"
 	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/maps/authmap"
+	"github.com/cilium/cilium/pkg/maps/ctmap"
+	"github.com/cilium/cilium/pkg/maps/fragmap"
 	ipcachemap "github.com/cilium/cilium/pkg/maps/ipcache"
 	ipmasqmap "github.com/cilium/cilium/pkg/maps/ipmasq"
 	"github.com/cilium/cilium/pkg/maps/lbmap"
 	"github.com/cilium/cilium/pkg/maps/lxcmap"
 	"github.com/cilium/cilium/pkg/maps/metricsmap"
+	natmap "github.com/cilium/cilium/pkg/maps/nat"
+	"github.com/cilium/cilium/pkg/maps/policymap"
 	"github.com/cilium/cilium/pkg/maps/ratelimitmap"
 	"github.com/cilium/cilium/pkg/maps/timestamp"
 	tunnelmap "github.com/cilium/cilium/pkg/maps/tunnel"
+	"github.com/cilium/cilium/pkg/metrics"
 	nodeTypes "github.com/cilium/cilium/pkg/node/types"
 	"github.com/cilium/cilium/pkg/option"
 	"github.com/cilium/cilium/pkg/status"
@@ -52,8 +73,111 @@ const (
 	// event will be considered proof that the apiserver connectivity is
 	// healthy
 	k8sMinimumEventHeartbeat = time.Minute
+
+	// natTableUtilizationWarningThreshold is the NAT table utilization
+	// above which the overall agent status degrades to a warning.
+	natTableUtilizationWarningThreshold = 0.8
+
+	// mapPressureWarningThreshold and mapPressureFailureThreshold are the
+	// CT/NAT map fill ratios above which the overall agent status degrades
+	// to a warning respectively a failure.
+	mapPressureWarningThreshold = 0.8
+	mapPressureFailureThreshold = 0.95
+
+	// bpfVerifierWarningThreshold is the share of the verifier's
+	// instruction limit above which the complexity margin of the most
+	// complex loaded BPF program is considered thin.
+	bpfVerifierWarningThreshold = 0.9
+
+	// modulesHealthMaxDegraded caps the number of degraded modules listed
+	// in the status response to keep the payload small when a shared
+	// dependency degrades many modules at once.
+	modulesHealthMaxDegraded = 10
+
+	// statusCollectorDrainTimeout is the maximum time to wait for in-flight
+	// probes to complete when draining the status collector on shutdown
+	statusCollectorDrainTimeout = 10 * time.Second
+
+	// localSectionsRefreshInterval is the interval at which the status
+	// sections derived from local configuration and datapath state are
+	// recomputed
+	localSectionsRefreshInterval = 5 * time.Minute
+
+	// lastStatusFile is the name of the snapshot file in the state
+	// directory recording when and why the agent last shut down
+	lastStatusFile = "last-status.json"
+
+	// lastShutdownReasonUnknown is reported when no snapshot of the
+	// previous agent run is available
+	lastShutdownReasonUnknown = "unknown"
+
+	// lastShutdownReasonClean is recorded when the agent shuts down
+	// through its regular cleanup path
+	lastShutdownReasonClean = "clean shutdown"
+
+	// lastShutdownReasonUnclean is reported when the previous run left a
+	// startup marker behind but never flushed its final status, which
+	// typically indicates the agent was OOM-killed or crashed
+	lastShutdownReasonUnclean = "unclean shutdown (final status flush missing, possibly OOM-killed)"
+
+	// statusProbeTimeoutTight is the per-probe timeout for cheap probes
+	// that should report an unreachable backend quickly rather than
+	// waiting for the collector's default failure threshold.
+	statusProbeTimeoutTight = 10 * time.Second
+
+	// statusProbeTimeoutLong is the per-probe timeout for heavyweight
+	// probes such as the IPAM dump or the clustermesh status, which walk
+	// per-cluster or per-address state and may legitimately exceed the
+	// collector's default failure threshold on large deployments.
+	statusProbeTimeoutLong = 2 * time.Minute
+
+	// statusWatchHeartbeat is the maximum time between two status objects
+	// on a /status/watch stream. A heartbeat is emitted at this interval
+	// even when no probe has reported an update, so that clients can
+	// distinguish a quiet agent from a dead connection.
+	statusWatchHeartbeat = 30 * time.Second
 )
 
+// lastStatusSnapshot is the on-disk record of an agent run. It is written
+// without a reason at startup and rewritten with one by the final status
+// flush on shutdown.
+type lastStatusSnapshot struct {
+	StartTime    time.Time `json:"start-time"`
+	ShutdownTime time.Time `json:"shutdown-time,omitempty"`
+	Reason       string    `json:"reason,omitempty"`
+}
+
+func lastStatusPath() string {
+	return filepath.Join(option.Config.StateDir, lastStatusFile)
+}
+
+// readLastShutdownReason inspects the last-status snapshot left behind by the
+// previous agent run.
+func readLastShutdownReason() string {
+	data, err := os.ReadFile(lastStatusPath())
+	if err != nil {
+		return lastShutdownReasonUnknown
+	}
+	var snapshot lastStatusSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return lastShutdownReasonUnknown
+	}
+	if snapshot.Reason == "" {
+		return lastShutdownReasonUnclean
+	}
+	return snapshot.Reason
+}
+
+func writeLastStatusSnapshot(snapshot lastStatusSnapshot) {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return
+	}
+	if err := os.WriteFile(lastStatusPath(), data, 0o644); err != nil {
+		log.WithError(err).Warning("Failed to write last-status snapshot")
+	}
+}
+
 type k8sVersion struct {
 	version          string
 	lastVersionCheck time.Time
@@ -86,16 +210,52 @@ func (k *k8sVersion) update(version *versionapi.Info) string {
 
 var k8sVersionCache k8sVersion
 
+// k8sRequestRate derives the recent kube-apiserver request rate from the
+// monotonic request counter, sampled on every run of the kubernetes probe.
+type k8sRequestRate struct {
+	lock       lock.Mutex
+	lastCount  uint64
+	lastSample time.Time
+}
+
+func (r *k8sRequestRate) sample() float64 {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := time.Now()
+	count := k8smetrics.APIServerRequests.Count()
+	lastCount, lastSample := r.lastCount, r.lastSample
+	r.lastCount, r.lastSample = count, now
+
+	if lastSample.IsZero() || !now.After(lastSample) {
+		return 0
+	}
+	return float64(count-lastCount) / now.Sub(lastSample).Seconds()
+}
+
+var k8sRequestRateCache k8sRequestRate
+
 func (d *Daemon) getK8sStatus() *models.K8sStatus {
 	if !d.clientset.IsEnabled() {
 		return &models.K8sStatus{State: models.StatusStateDisabled}
 	}
 
+	var families []*models.K8sFamilyStatus
+	if option.Config.IsDualStack() {
+		families = d.k8sFamilyProber.probeFamilies(d.ctx, d.clientset.RestConfig().Host)
+	}
+
 	version, valid := k8sVersionCache.cachedVersion()
 	if !valid {
 		k8sVersion, err := d.clientset.Discovery().ServerVersion()
 		if err != nil {
-			return &models.K8sStatus{State: models.StatusStateFailure, Msg: err.Error()}
+			msg := err.Error()
+			// Attribute the failed discovery call to the address family
+			// the client connection uses.
+			if family := primaryFamily(families); family != "" {
+				msg = fmt.Sprintf("%s (over %s)", msg, family)
+			}
+			return &models.K8sStatus{State: models.StatusStateFailure, Msg: msg, Families: families}
 		}
 
 		version = k8sVersionCache.update(k8sVersion)
@@ -105,6 +265,19 @@ func (d *Daemon) getK8sStatus() *models.K8sStatus {
 		State:          models.StatusStateOk,
 		Msg:            version,
 		K8sAPIVersions: d.k8sWatcher.GetAPIGroups(),
+		RequestRate:    k8sRequestRateCache.sample(),
+		ThrottleEvents: int64(k8smetrics.APIServerThrottles.Count()),
+		Families:       families,
+	}
+
+	// A broken secondary family is only surfaced through the families list;
+	// the section state follows the family carrying the primary client
+	// connection.
+	for _, f := range families {
+		if f.Primary && f.State == models.StatusStateFailure {
+			k8sStatus.State = models.StatusStateWarning
+			k8sStatus.Msg = fmt.Sprintf("%s; %s connectivity to the apiserver failed: %s", version, f.Family, f.Msg)
+		}
 	}
 
 	return k8sStatus
@@ -120,6 +293,12 @@ func (d *Daemon) getMasqueradingStatus() *models.Masquerading {
 	}
 
 	if !option.Config.MasqueradingEnabled() {
+		switch {
+		case !option.Config.EnableIPv4 && !option.Config.EnableIPv6:
+			s.Reason = fmt.Sprintf("requires IPv4 or IPv6 (--%s, --%s)", option.EnableIPv4Name, option.EnableIPv6Name)
+		default:
+			s.Reason = fmt.Sprintf("disabled by --%s=false and --%s=false", option.EnableIPv4Masquerade, option.EnableIPv6Masquerade)
+		}
 		return s
 	}
 
@@ -128,32 +307,168 @@ func (d *Daemon) getMasqueradingStatus() *models.Masquerading {
 		return s
 	}
 
+	// The per-family exclusion CIDRs are derived from the node's allocation
+	// CIDRs, which are nil for a disabled address family. Guard against nil
+	// also for the enabled family, as the node may not have a CIDR yet.
 	if option.Config.EnableIPv4 {
-		// SnatExclusionCidr is the legacy field, continue to provide
-		// it for the time being
-		s.SnatExclusionCidr = datapath.RemoteSNATDstAddrExclusionCIDRv4(localNode).String()
-		s.SnatExclusionCidrV4 = datapath.RemoteSNATDstAddrExclusionCIDRv4(localNode).String()
+		if excl := datapath.RemoteSNATDstAddrExclusionCIDRv4(localNode); excl != nil {
+			// SnatExclusionCidr is the legacy field, continue to provide
+			// it for the time being
+			s.SnatExclusionCidr = excl.String()
+			s.SnatExclusionCidrV4 = excl.String()
+		}
 	}
 
 	if option.Config.EnableIPv6 {
-		s.SnatExclusionCidrV6 = datapath.RemoteSNATDstAddrExclusionCIDRv6(localNode).String()
+		if excl := datapath.RemoteSNATDstAddrExclusionCIDRv6(localNode); excl != nil {
+			s.SnatExclusionCidrV6 = excl.String()
+		}
 	}
 
 	if option.Config.EnableBPFMasquerade {
 		s.Mode = models.MasqueradingModeBPF
 		s.IPMasqAgent = option.Config.EnableIPMasqAgent
+		// BPF masquerading allocates source ports from the part of the
+		// ephemeral range above the NodePort range.
+		s.SnatPortRange = fmt.Sprintf("%d-%d", option.Config.NodePortMax+1, linuxconfig.NodePortMaxNAT)
+		if cur4, cur6, ok := readSNATAllocFailures(); ok {
+			delta4, delta6 := snatAllocFailureSamples.sample(cur4, cur6)
+			populateSNATAllocFailures(s, delta4, delta6)
+		}
 		return s
 	}
 
 	s.Mode = models.MasqueradingModeIptables
+	if option.Config.EnableIPMasqAgent {
+		s.Reason = fmt.Sprintf("ip-masq-agent requires BPF masquerading (--%s)", option.EnableBPFMasquerade)
+	}
 	return s
 }
 
+// readSNATAllocFailures reads the cumulative per-family SNAT port allocation
+// failure counters from the datapath's retries histograms. ok is false if
+// neither histogram could be read, e.g. because the maps do not exist.
+func readSNATAllocFailures() (cur4, cur6 uint64, ok bool) {
+	read := func(m natmap.RetriesMap) (uint64, bool) {
+		if m == nil {
+			return 0, false
+		}
+		if err := m.Open(); err != nil {
+			return 0, false
+		}
+		defer m.Close()
+		n, err := natmap.AllocFailures(m)
+		if err != nil {
+			return 0, false
+		}
+		return n, true
+	}
+
+	ipv4Map, ipv6Map := natmap.RetriesMaps(option.Config.EnableIPv4, option.Config.EnableIPv6, true)
+	var ok4, ok6 bool
+	cur4, ok4 = read(ipv4Map)
+	cur6, ok6 = read(ipv6Map)
+	return cur4, cur6, ok4 || ok6
+}
+
+// snatAllocFailureSampler reports the increase of the cumulative SNAT port
+// allocation failure counters between consecutive samples, so that each
+// status response covers roughly one probe interval.
+type snatAllocFailureSampler struct {
+	mutex        lock.Mutex
+	prev4, prev6 uint64
+	primed       bool
+}
+
+func (s *snatAllocFailureSampler) sample(cur4, cur6 uint64) (delta4, delta6 int64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.primed {
+		// The histograms can be cleared out-of-band via
+		// "cilium-dbg bpf nat retries flush"; treat a decrease as a
+		// restart of the counter.
+		if cur4 >= s.prev4 {
+			delta4 = int64(cur4 - s.prev4)
+		} else {
+			delta4 = int64(cur4)
+		}
+		if cur6 >= s.prev6 {
+			delta6 = int64(cur6 - s.prev6)
+		} else {
+			delta6 = int64(cur6)
+		}
+	}
+	s.prev4, s.prev6, s.primed = cur4, cur6, true
+	return delta4, delta6
+}
+
+var snatAllocFailureSamples snatAllocFailureSampler
+
+// populateSNATAllocFailures fills in the per-family SNAT port allocation
+// failure counters and derives the section status from them. Sustained
+// failures mean connections are being dropped due to port exhaustion on
+// some (srcIP,dstIP) pair, see "cilium-dbg bpf nat summary --exhaustion".
+func populateSNATAllocFailures(s *models.Masquerading, delta4, delta6 int64) {
+	s.AllocFailuresIPV4 = delta4
+	s.AllocFailuresIPV6 = delta6
+	if delta4 > 0 || delta6 > 0 {
+		s.Status = &models.Status{
+			State: models.StatusStateWarning,
+			Msg: fmt.Sprintf("%d IPv4, %d IPv6 SNAT port allocation failures over the last interval",
+				delta4, delta6),
+		}
+		return
+	}
+	s.Status = &models.Status{State: models.StatusStateOk}
+}
+
 func (d *Daemon) getSRv6Status() *models.Srv6 {
-	return &models.Srv6{
+	s := &models.Srv6{
 		Enabled:       option.Config.EnableSRv6,
 		Srv6EncapMode: option.Config.SRv6EncapMode,
 	}
+	if !s.Enabled {
+		s.Reason = fmt.Sprintf("disabled by --%s=false", option.EnableSRv6)
+	}
+	return s
+}
+
+func (d *Daemon) getSCTPStatus() *models.Sctp {
+	s := &models.Sctp{
+		Enabled: option.Config.EnableSCTP,
+	}
+
+	// The datapath only handles SCTP if it was compiled with ENABLE_SCTP,
+	// which is emitted into the node configuration header at load time.
+	if data, err := os.ReadFile(option.Config.GetNodeConfigPath()); err == nil {
+		s.DatapathEnabled = bytes.Contains(data, []byte("#define ENABLE_SCTP"))
+	}
+
+	if s.Enabled && !s.DatapathEnabled {
+		log.Warning("SCTP support is enabled but the datapath was not compiled with SCTP handling, SCTP traffic will be dropped")
+	}
+
+	return s
+}
+
+// getProxyRedirectInconsistencies cross-checks the proxy redirects required
+// by the realized policy of each local endpoint against the proxy's
+// bookkeeping and the endpoint's policy map. Only the number of affected
+// endpoints and a few of their IDs are reported here; the offending redirect
+// IDs are available per endpoint via the endpoint health API.
+func (d *Daemon) getProxyRedirectInconsistencies() *models.ProxyRedirectInconsistencies {
+	inconsistencies := &models.ProxyRedirectInconsistencies{}
+	for _, ep := range d.endpointManager.GetEndpoints() {
+		if len(ep.MissingProxyRedirects()) == 0 {
+			continue
+		}
+		inconsistencies.EndpointCount++
+		if len(inconsistencies.EndpointIds) < 5 {
+			inconsistencies.EndpointIds = append(inconsistencies.EndpointIds, int64(ep.GetID()))
+		}
+	}
+	return inconsistencies
 }
 
 func (d *Daemon) getIPV6BigTCPStatus() *models.IPV6BigTCP {
@@ -163,6 +478,14 @@ func (d *Daemon) getIPV6BigTCPStatus() *models.IPV6BigTCP {
 		MaxGSO:  int64(d.bigTCPConfig.GetGSOIPv6MaxSize()),
 	}
 
+	if !s.Enabled {
+		if !option.Config.EnableIPv6 {
+			s.Reason = fmt.Sprintf("requires IPv6 (--%s)", option.EnableIPv6Name)
+		} else {
+			s.Reason = fmt.Sprintf("disabled by --%s=false or unsupported by the kernel (requires 5.19 or newer)", datapath.EnableIPv6BIGTCPFlag)
+		}
+	}
+
 	return s
 }
 
@@ -173,6 +496,14 @@ func (d *Daemon) getIPV4BigTCPStatus() *models.IPV4BigTCP {
 		MaxGSO:  int64(d.bigTCPConfig.GetGSOIPv4MaxSize()),
 	}
 
+	if !s.Enabled {
+		if !option.Config.EnableIPv4 {
+			s.Reason = fmt.Sprintf("requires IPv4 (--%s)", option.EnableIPv4Name)
+		} else {
+			s.Reason = fmt.Sprintf("disabled by --%s=false or unsupported by the kernel (requires 6.3 or newer)", datapath.EnableIPv4BIGTCPFlag)
+		}
+	}
+
 	return s
 }
 
@@ -182,6 +513,11 @@ func (d *Daemon) getBandwidthManagerStatus() *models.BandwidthManager {
 	}
 
 	if !d.bwManager.Enabled() {
+		if option.Config.EnableIPSec {
+			s.Reason = "cannot be used with IPsec encryption"
+		} else {
+			s.Reason = fmt.Sprintf("disabled by --%s=false or system requirements not met", datapath.EnableBandwidthManagerFlag)
+		}
 		return s
 	}
 
@@ -206,22 +542,57 @@ func (d *Daemon) getRoutingStatus() *models.Routing {
 	}
 	if option.Config.RoutingMode == option.RoutingModeNative {
 		s.InterHostRoutingMode = models.RoutingInterHostRoutingModeNative
+		if drd, _ := d.directRoutingDev.Get(context.TODO(), d.db.ReadTxn()); drd != nil {
+			s.DirectRoutingDeviceMTU = int64(drd.MTU)
+		}
 	}
 	return s
 }
 
 func (d *Daemon) getHostFirewallStatus() *models.HostFirewall {
 	mode := models.HostFirewallModeDisabled
+	reason := ""
 	if option.Config.EnableHostFirewall {
 		mode = models.HostFirewallModeEnabled
+	} else {
+		reason = fmt.Sprintf("disabled by --%s=false", option.EnableHostFirewall)
 	}
 	devs, _ := datapathTables.SelectedDevices(d.devices, d.db.ReadTxn())
 	return &models.HostFirewall{
 		Mode:    mode,
 		Devices: datapathTables.DeviceNames(devs),
+		Reason:  reason,
 	}
 }
 
+// getSysctlStatus compares the sysctl parameters requested by Cilium against
+// their current values on the host, flagging parameters that have been
+// changed behind Cilium's back (e.g. by a node tuning daemon).
+func (d *Daemon) getSysctlStatus() []*models.SysctlStatus {
+	if d.sysctl == nil {
+		return nil
+	}
+	settings := d.sysctl.Settings()
+	out := make([]*models.SysctlStatus, 0, len(settings))
+	for _, setting := range settings {
+		s := &models.SysctlStatus{
+			Name:          strings.Join(setting.Name, "."),
+			ExpectedValue: setting.Val,
+		}
+		current, err := d.sysctl.Read(setting.Name)
+		if err != nil {
+			s.CurrentValue = "unreadable: " + err.Error()
+			s.Mismatch = !setting.IgnoreErr
+		} else {
+			s.CurrentValue = current
+			s.Mismatch = current != setting.Val
+		}
+		out = append(out, s)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
 func (d *Daemon) getClockSourceStatus() *models.ClockSource {
 	return timestamp.GetClockSourceFromOptions()
 }
@@ -265,17 +636,11 @@ func (d *Daemon) getKubeProxyReplacementStatus() *models.KubeProxyReplacement {
 	}
 
 	devices, _ := datapathTables.SelectedDevices(d.devices, d.db.ReadTxn())
-	devicesList := make([]*models.KubeProxyReplacementDeviceListItems0, len(devices))
-	for i, dev := range devices {
-		info := &models.KubeProxyReplacementDeviceListItems0{
-			Name: dev.Name,
-			IP:   make([]string, len(dev.Addrs)),
-		}
-		for _, addr := range dev.Addrs {
-			info.IP = append(info.IP, addr.Addr.String())
-		}
-		devicesList[i] = info
-	}
+	// Sort the devices by name so that the device lists in the response are
+	// stable between two captures of the same state.
+	devices = slices.Clone(devices)
+	sort.Slice(devices, func(i, j int) bool { return devices[i].Name < devices[j].Name })
+	devicesList := kubeProxyReplacementDeviceList(devices)
 
 	features := &models.KubeProxyReplacementFeatures{
 		NodePort:              &models.KubeProxyReplacementFeaturesNodePort{},
@@ -284,6 +649,7 @@ func (d *Daemon) getKubeProxyReplacementStatus() *models.KubeProxyReplacement {
 		SocketLB:              &models.KubeProxyReplacementFeaturesSocketLB{},
 		SocketLBTracing:       &models.KubeProxyReplacementFeaturesSocketLBTracing{},
 		SessionAffinity:       &models.KubeProxyReplacementFeaturesSessionAffinity{},
+		SourceRangeCheck:      &models.KubeProxyReplacementFeaturesSourceRangeCheck{},
 		Nat46X64:              &models.KubeProxyReplacementFeaturesNat46X64{},
 		BpfSocketLBHostnsOnly: option.Config.BPFSocketLBHostnsOnly,
 	}
@@ -315,6 +681,10 @@ func (d *Daemon) getKubeProxyReplacementStatus() *models.KubeProxyReplacement {
 		} else {
 			features.NodePort.Acceleration = strings.Title(option.Config.NodePortAcceleration)
 		}
+		if devStatus := d.loader.XDPDeviceStatus(); len(devStatus) > 0 {
+			features.NodePort.AccelerationDevices, features.NodePort.AccelerationStatus =
+				nodePortAccelerationModel(devStatus)
+		}
 		features.NodePort.PortMin = int64(option.Config.NodePortMin)
 		features.NodePort.PortMax = int64(option.Config.NodePortMax)
 	}
@@ -327,10 +697,19 @@ func (d *Daemon) getKubeProxyReplacementStatus() *models.KubeProxyReplacement {
 	if option.Config.EnableSocketLB {
 		features.SocketLB.Enabled = true
 		features.SocketLBTracing.Enabled = true
+		d.populateSocketLBStats(features.SocketLB)
 	}
 	if option.Config.EnableSessionAffinity {
 		features.SessionAffinity.Enabled = true
 	}
+	if option.Config.EnableSVCSourceRangeCheck {
+		features.SourceRangeCheck.Enabled = true
+		if count, err := lbmap.SourceRangeRestrictedServices(); err != nil {
+			log.WithError(err).Warning("Unable to count services with source range restrictions")
+		} else {
+			features.SourceRangeCheck.RestrictedServices = int64(count)
+		}
+	}
 	if option.Config.NodePortNat46X64 || option.Config.EnableNat46X64Gateway {
 		features.Nat46X64.Enabled = true
 		gw := &models.KubeProxyReplacementFeaturesNat46X64Gateway{
@@ -375,119 +754,749 @@ func (d *Daemon) getKubeProxyReplacementStatus() *models.KubeProxyReplacement {
 		Devices:             datapathTables.DeviceNames(devices),
 		DeviceList:          devicesList,
 		DirectRoutingDevice: directRoutingDevice,
+		NatTableUtilization: getNatTableUtilization(),
 		Features:            features,
 	}
 }
 
+// kubeProxyReplacementDeviceList renders the per-device address lists of the
+// KubeProxyReplacement status section. Primary and secondary addresses are
+// reported separately so that consumers do not need to know the kernel's
+// address ordering to tell them apart.
+func kubeProxyReplacementDeviceList(devices []*datapathTables.Device) []*models.KubeProxyReplacementDeviceListItems0 {
+	devicesList := make([]*models.KubeProxyReplacementDeviceListItems0, len(devices))
+	for i, dev := range devices {
+		info := &models.KubeProxyReplacementDeviceListItems0{
+			Name:        dev.Name,
+			IP:          make([]string, 0, len(dev.Addrs)),
+			SecondaryIP: []string{},
+		}
+		for _, addr := range dev.Addrs {
+			// Label each address with its family, so that the mixed list
+			// remains readable on dual-stack and IPv6-only clusters.
+			family := "IPv4"
+			if addr.Addr.Is6() && !addr.Addr.Is4In6() {
+				family = "IPv6"
+			}
+			rendered := fmt.Sprintf("%s (%s)", addr.Addr, family)
+			if addr.Secondary {
+				info.SecondaryIP = append(info.SecondaryIP, rendered)
+			} else {
+				info.IP = append(info.IP, rendered)
+			}
+		}
+		devicesList[i] = info
+	}
+	return devicesList
+}
+
+// nodePortAccelerationModel converts the loader's per-device XDP attachment
+// results into the NodePort feature model. The returned status is Warning if
+// any device fell back from the requested acceleration mode, since such a
+// fallback silently costs performance.
+func nodePortAccelerationModel(devStatus []datapath.XDPDeviceStatus) ([]*models.KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0, *models.Status) {
+	devices := make([]*models.KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0, 0, len(devStatus))
+	var degraded []string
+	for _, ds := range devStatus {
+		devices = append(devices, &models.KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0{
+			Device:         ds.Device,
+			RequestedMode:  string(ds.RequestedMode),
+			AttachedMode:   xdpAttachedModeName(ds.AttachedMode),
+			FallbackReason: ds.FallbackReason,
+		})
+		if ds.FallbackReason != "" {
+			degraded = append(degraded, ds.Device)
+		}
+	}
+	status := &models.Status{State: models.StatusStateOk}
+	if len(degraded) > 0 {
+		status = &models.Status{
+			State: models.StatusStateWarning,
+			Msg:   fmt.Sprintf("XDP acceleration degraded on %s", strings.Join(degraded, ", ")),
+		}
+	}
+	return devices, status
+}
+
+// xdpAttachedModeName maps the kernel-level XDP mode to the attached mode
+// name used in the API.
+func xdpAttachedModeName(mode xdp.Mode) string {
+	switch mode {
+	case xdp.ModeLinkDriver:
+		return models.KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0AttachedModeNative
+	case xdp.ModeLinkGeneric:
+		return models.KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0AttachedModeGeneric
+	}
+	return models.KubeProxyReplacementFeaturesNodePortAccelerationDevicesItems0AttachedModeNone
+}
+
+// getNatTableUtilization returns the fraction of the global NAT table
+// currently in use. With both IP families enabled, the fuller of the two
+// maps is reported as each family has its own table of
+// option.Config.NATMapEntriesGlobal entries.
+func getNatTableUtilization() float64 {
+	if option.Config.NATMapEntriesGlobal == 0 {
+		return 0
+	}
+	natMap4, natMap6 := natmap.GlobalMaps(option.Config.EnableIPv4,
+		option.Config.EnableIPv6, option.Config.EnableNodePort)
+	utilization := float64(0)
+	for _, m := range []*natmap.Map{natMap4, natMap6} {
+		if m == nil {
+			continue
+		}
+		count := 0
+		err := m.DumpWithCallbackIfExists(func(bpf.MapKey, bpf.MapValue) {
+			count++
+		})
+		m.Close()
+		if err != nil {
+			log.WithError(err).Warning("Unable to count NAT map entries")
+			continue
+		}
+		utilization = max(utilization, float64(count)/float64(option.Config.NATMapEntriesGlobal))
+	}
+	return utilization
+}
+
+func (d *Daemon) getLoadBalancerStatus() *models.LoadBalancerStatus {
+	s := &models.LoadBalancerStatus{}
+
+	// The Maglev maps are only initialized if at least one service may use
+	// the Maglev backend selection algorithm.
+	if option.Config.NodePortAlg != option.NodePortAlgMaglev &&
+		!option.Config.LoadBalancerAlgorithmAnnotation {
+		return s
+	}
+
+	maglevSvcs, randomSvcs := lbmap.MaglevServiceCounts()
+	m := &models.MaglevStatus{
+		TableSize:          int64(d.maglevConfig.MaglevTableSize),
+		ServicesMaglev:     int64(maglevSvcs),
+		ServicesRandom:     int64(randomSvcs),
+		InnerMapsAllocated: int64(lbmap.MaglevInnerMapsAllocated()),
+		InnerMapsMaximum:   int64(lbmap.MaglevMapMaxEntries),
+	}
+	for _, table := range lbmap.MaglevTableStatuses() {
+		m.Tables = append(m.Tables, &models.MaglevTableStatus{
+			ServiceID: int64(table.RevNatID),
+			IPV6:      table.IPv6,
+			Populated: table.Populated,
+			LastError: table.LastError,
+		})
+	}
+	s.Maglev = m
+
+	return s
+}
+
+// populateSocketLBStats fills the socket-LB connection statistics from the
+// BPF metrics map. The section is flagged with a warning when the no-backend
+// counter kept increasing over two consecutive status collections, pointing
+// at services without available backends.
+func (d *Daemon) populateSocketLBStats(slb *models.KubeProxyReplacementFeaturesSocketLB) {
+	if option.Config.DryMode {
+		return
+	}
+
+	stats, err := metricsmap.CollectSocketLBStats(metricsmap.Metrics)
+	if err != nil {
+		log.WithError(err).Debug("Unable to read socket-LB statistics from the metrics map")
+		return
+	}
+
+	slb.NoBackendConnections = int64(stats.NoBackend)
+	slb.HostnsOnlyRejectedConnections = int64(stats.HostnsOnlyRejected)
+	slb.TCPTranslations = int64(stats.XlateTCP)
+	slb.UDPTranslations = int64(stats.XlateUDP)
+
+	prev := d.socketLBNoBackendPrev.Swap(stats.NoBackend)
+	if prev > 0 && stats.NoBackend > prev {
+		slb.Warning = fmt.Sprintf("%d connection(s) without an available service backend since the last status collection",
+			stats.NoBackend-prev)
+	}
+}
+
+// getBPFVerifierStatus reports how close the most complex loaded BPF program
+// came to the verifier's instruction limit during its last successful load.
+// Returns nil if no Cilium program is loaded or the kernel does not report
+// verifier statistics.
+func (d *Daemon) getBPFVerifierStatus() *models.BPFVerifierStatus {
+	if option.Config.DryMode {
+		return nil
+	}
+
+	vc, err := bpf.MostComplexProgram()
+	if err != nil {
+		log.WithError(err).Debug("Unable to determine BPF program complexity")
+		return nil
+	}
+	if vc == nil {
+		return nil
+	}
+
+	if float64(vc.VerifiedInstructions) > bpfVerifierWarningThreshold*float64(vc.InstructionLimit) {
+		log.WithFields(logrus.Fields{
+			logfields.Name:         vc.ProgramName,
+			"verifiedInstructions": vc.VerifiedInstructions,
+			"instructionLimit":     vc.InstructionLimit,
+		}).Warn("BPF program complexity is approaching the verifier's instruction limit")
+	}
+
+	return &models.BPFVerifierStatus{
+		ProgramName:          vc.ProgramName,
+		VerifiedInstructions: int64(vc.VerifiedInstructions),
+		InstructionLimit:     int64(vc.InstructionLimit),
+	}
+}
+
+// getIptablesRulesStatus verifies that the iptables chains and feeder rules
+// installed by the agent are still in place.
+func (d *Daemon) getIptablesRulesStatus() (*models.IptablesRulesStatus, error) {
+	if option.Config.DryMode || d.iptablesManager == nil {
+		return nil, nil
+	}
+
+	missing, err := d.iptablesManager.VerifyInstalledRules()
+	if err != nil {
+		return nil, err
+	}
+	if len(missing) > 0 {
+		log.WithField(logfields.Count, len(missing)).
+			Warning("Expected iptables rules are missing, another entity may have flushed the agent's rules")
+	}
+	return &models.IptablesRulesStatus{MissingRules: missing}, nil
+}
+
+// getModulesHealth aggregates the per-component statuses reported through the
+// hive health provider into a per-module summary. A module is counted at the
+// worst level any of its components reports.
+func (d *Daemon) getModulesHealth() *models.ModulesHealth {
+	if d.healthStatusTable == nil {
+		return nil
+	}
+
+	rank := map[healthTypes.Level]int{
+		healthTypes.LevelOK:       0,
+		healthTypes.LevelStopped:  1,
+		healthTypes.LevelDegraded: 2,
+	}
+
+	// Reduce the component level statuses to the worst status per module.
+	modules := map[string]healthTypes.Status{}
+	for s := range d.healthStatusTable.All(d.db.ReadTxn()) {
+		mid := s.ID.Module.String()
+		if cur, ok := modules[mid]; !ok || rank[s.Level] > rank[cur.Level] {
+			modules[mid] = s
+		}
+	}
+
+	mh := &models.ModulesHealth{}
+	for mid, s := range modules {
+		switch s.Level {
+		case healthTypes.LevelDegraded:
+			mh.Degraded++
+			msg := s.Message
+			if s.Error != "" {
+				msg += ": " + s.Error
+			}
+			mh.DegradedModules = append(mh.DegradedModules, &models.ModuleHealth{
+				ModuleID: mid,
+				Message:  msg,
+				LastOk:   s.LastOK.Format(time.RFC3339),
+			})
+		case healthTypes.LevelStopped:
+			mh.Stopped++
+		default:
+			mh.Ok++
+		}
+	}
+
+	sort.Slice(mh.DegradedModules, func(i, j int) bool {
+		return mh.DegradedModules[i].ModuleID < mh.DegradedModules[j].ModuleID
+	})
+	if len(mh.DegradedModules) > modulesHealthMaxDegraded {
+		mh.DegradedModules = mh.DegradedModules[:modulesHealthMaxDegraded]
+	}
+	return mh
+}
+
+// bpfMapProps builds one entry of the BPF map status. When pinName names a
+// single open map, the entry is annotated with its pin path, kernel map ID
+// and whether the agent re-used an existing pin, so that duplicate pins left
+// behind by upgrades can be cross-referenced with "bpftool map show". The
+// entry count of the map is sampled here as well; this only runs on the
+// local-sections probe interval, never on the API request path, as walking
+// the keys of a large map is not free. pinName is empty for sections that
+// aggregate multiple maps.
+func bpfMapProps(name string, size int64, pinName string) *models.BPFMapProperties {
+	props := &models.BPFMapProperties{
+		Name: name,
+		Size: size,
+	}
+	if pinName != "" {
+		if info := bpf.GetMapInfo(pinName); info != nil {
+			props.PinPath = info.PinPath
+			props.ID = info.ID
+			props.ReusedPin = info.ReusedPin
+		}
+		if count, ok := bpf.CountMapEntries(pinName); ok {
+			props.Used = &count
+		}
+	}
+	return props
+}
+
+// withAlive annotates an aggregated BPF map status entry with the summed
+// garbage-collector-observed entry counts of the named per-family maps. The
+// entry is left unannotated when none of the maps have been observed yet.
+func withAlive(props *models.BPFMapProperties, alive map[string]int64, names ...string) *models.BPFMapProperties {
+	var total int64
+	found := false
+	for _, name := range names {
+		if count, ok := alive[name]; ok {
+			total += count
+			found = true
+		}
+	}
+	if found {
+		props.Used = &total
+	}
+	return props
+}
+
+// endpointPolicyMapProps builds the status entry for the per-endpoint policy
+// maps, with the entry count summed over all endpoints that have one.
+func endpointPolicyMapProps(size int64) *models.BPFMapProperties {
+	props := &models.BPFMapProperties{
+		Name: "Endpoint policy",
+		Size: size,
+	}
+	if used, counted := bpf.CountPrefixedMapEntries(policymap.MapName); counted > 0 {
+		props.Used = &used
+	}
+	return props
+}
+
 func (d *Daemon) getBPFMapStatus() *models.BPFMapStatus {
+	// The CT and NAT maps are too large to walk here; reuse the entry
+	// counts their garbage collector observed on its last pass instead.
+	alive := ctmap.GetAliveEntries()
+	maps := []*models.BPFMapProperties{
+		bpfMapProps("Auth", int64(option.Config.AuthMapEntries), authmap.MapName),
+		// Connection tracking and NAT are split into per-family maps.
+		withAlive(bpfMapProps("Non-TCP connection tracking", int64(option.Config.CTMapEntriesGlobalAny), ""),
+			alive, ctmap.MapNameAny4Global, ctmap.MapNameAny6Global),
+		withAlive(bpfMapProps("TCP connection tracking", int64(option.Config.CTMapEntriesGlobalTCP), ""),
+			alive, ctmap.MapNameTCP4Global, ctmap.MapNameTCP6Global),
+		bpfMapProps("Endpoints", int64(lxcmap.MaxEntries), lxcmap.MapName),
+		bpfMapProps("IP cache", int64(ipcachemap.MaxEntries), ipcachemap.Name),
+	}
+	// Only list the per-family maps of enabled address families; on an
+	// IPv6-only cluster the IPv4 maps do not exist.
+	if option.Config.EnableIPv4 {
+		maps = append(maps,
+			bpfMapProps("IPv4 masquerading agent", int64(ipmasqmap.MaxEntriesIPv4), ipmasqmap.MapNameIPv4),
+			bpfMapProps("IPv4 fragmentation", int64(option.Config.FragmentsMapEntries), fragmap.MapName),
+			bpfMapProps("IPv4 service", int64(lbmap.ServiceMapMaxEntries), lbmap.Service4MapV2Name),
+			bpfMapProps("IPv4 service backend", int64(lbmap.ServiceBackEndMapMaxEntries), lbmap.Backend4MapV3Name),
+			bpfMapProps("IPv4 service reverse NAT", int64(lbmap.RevNatMapMaxEntries), lbmap.RevNat4MapName),
+		)
+	}
+	if option.Config.EnableIPv6 {
+		maps = append(maps,
+			bpfMapProps("IPv6 masquerading agent", int64(ipmasqmap.MaxEntriesIPv6), ipmasqmap.MapNameIPv6),
+			bpfMapProps("IPv6 service", int64(lbmap.ServiceMapMaxEntries), lbmap.Service6MapV2Name),
+			bpfMapProps("IPv6 service backend", int64(lbmap.ServiceBackEndMapMaxEntries), lbmap.Backend6MapV3Name),
+			bpfMapProps("IPv6 service reverse NAT", int64(lbmap.RevNatMapMaxEntries), lbmap.RevNat6MapName),
+		)
+	}
+	maps = append(maps,
+		bpfMapProps("Metrics", int64(metricsmap.MaxEntries), metricsmap.MapName),
+		bpfMapProps("Ratelimit metrics", int64(ratelimitmap.MaxMetricsEntries), ratelimitmap.MetricsMapName),
+		withAlive(bpfMapProps("NAT", int64(option.Config.NATMapEntriesGlobal), ""),
+			alive, natmap.MapNameSnat4Global, natmap.MapNameSnat6Global),
+		bpfMapProps("Neighbor table", int64(option.Config.NeighMapEntriesGlobal), ""),
+		// Policy maps are created per endpoint; the reported entry count
+		// is the sum over all of them.
+		endpointPolicyMapProps(int64(d.policyMapFactory.PolicyMaxEntries())),
+		bpfMapProps("Policy stats", int64(d.policyMapFactory.StatsMaxEntries()), ""),
+		bpfMapProps("Session affinity", int64(lbmap.AffinityMapMaxEntries), lbmap.AffinityMatchMapName),
+		bpfMapProps("Sock reverse NAT", int64(option.Config.SockRevNatEntries), ""),
+		bpfMapProps("Tunnel", int64(tunnelmap.MaxEntries), tunnelmap.MapName),
+	)
 	return &models.BPFMapStatus{
 		DynamicSizeRatio: option.Config.BPFMapsDynamicSizeRatio,
-		Maps: []*models.BPFMapProperties{
-			{
-				Name: "Auth",
-				Size: int64(option.Config.AuthMapEntries),
-			},
-			{
-				Name: "Non-TCP connection tracking",
-				Size: int64(option.Config.CTMapEntriesGlobalAny),
-			},
-			{
-				Name: "TCP connection tracking",
-				Size: int64(option.Config.CTMapEntriesGlobalTCP),
-			},
-			{
-				Name: "Endpoints",
-				Size: int64(lxcmap.MaxEntries),
-			},
-			{
-				Name: "IP cache",
-				Size: int64(ipcachemap.MaxEntries),
-			},
-			{
-				Name: "IPv4 masquerading agent",
-				Size: int64(ipmasqmap.MaxEntriesIPv4),
-			},
-			{
-				Name: "IPv6 masquerading agent",
-				Size: int64(ipmasqmap.MaxEntriesIPv6),
-			},
-			{
-				Name: "IPv4 fragmentation",
-				Size: int64(option.Config.FragmentsMapEntries),
-			},
-			{
-				Name: "IPv4 service", // cilium_lb4_services_v2
-				Size: int64(lbmap.ServiceMapMaxEntries),
-			},
-			{
-				Name: "IPv6 service", // cilium_lb6_services_v2
-				Size: int64(lbmap.ServiceMapMaxEntries),
-			},
-			{
-				Name: "IPv4 service backend", // cilium_lb4_backends_v2
-				Size: int64(lbmap.ServiceBackEndMapMaxEntries),
-			},
-			{
-				Name: "IPv6 service backend", // cilium_lb6_backends_v2
-				Size: int64(lbmap.ServiceBackEndMapMaxEntries),
-			},
-			{
-				Name: "IPv4 service reverse NAT", // cilium_lb4_reverse_nat
-				Size: int64(lbmap.RevNatMapMaxEntries),
-			},
-			{
-				Name: "IPv6 service reverse NAT", // cilium_lb6_reverse_nat
-				Size: int64(lbmap.RevNatMapMaxEntries),
-			},
-			{
-				Name: "Metrics",
-				Size: int64(metricsmap.MaxEntries),
-			},
-			{
-				Name: "Ratelimit metrics",
-				Size: int64(ratelimitmap.MaxMetricsEntries),
-			},
-			{
-				Name: "NAT",
-				Size: int64(option.Config.NATMapEntriesGlobal),
-			},
-			{
-				Name: "Neighbor table",
-				Size: int64(option.Config.NeighMapEntriesGlobal),
-			},
-			{
-				Name: "Endpoint policy",
-				Size: int64(d.policyMapFactory.PolicyMaxEntries()),
-			},
-			{
-				Name: "Policy stats",
-				Size: int64(d.policyMapFactory.StatsMaxEntries()),
-			},
-			{
-				Name: "Session affinity",
-				Size: int64(lbmap.AffinityMapMaxEntries),
-			},
-			{
-				Name: "Sock reverse NAT",
-				Size: int64(option.Config.SockRevNatEntries),
-			},
-			{
-				Name: "Tunnel",
-				Size: int64(tunnelmap.MaxEntries),
-			},
-		},
+		Maps:             maps,
+	}
+}
+
+// statusComponentFields maps the component names accepted by the "components"
+// parameter of GET /healthz to the index of the corresponding field of
+// models.StatusResponse. The names are the JSON field names of the response,
+// e.g. "encryption" or "kube-proxy-replacement", so the valid names track the
+// model without requiring a hand-maintained list.
+var statusComponentFields = sync.OnceValue(func() map[string]int {
+	t := reflect.TypeOf(models.StatusResponse{})
+	fields := make(map[string]int, t.NumField())
+	for i := range t.NumField() {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		fields[name] = i
+	}
+	return fields
+})
+
+// validStatusComponents returns the sorted list of component names accepted
+// by the "components" parameter of GET /healthz, for error messages.
+func validStatusComponents() []string {
+	fields := statusComponentFields()
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// filterStatusComponents copies only the named components of the full status
+// response into a new response. The names must have been validated against
+// statusComponentFields beforehand. The copy is shallow; the caller is
+// responsible for deep copying if the result outlives the source.
+func filterStatusComponents(full *models.StatusResponse, components []string) models.StatusResponse {
+	var sr models.StatusResponse
+	fields := statusComponentFields()
+	in := reflect.ValueOf(full).Elem()
+	out := reflect.ValueOf(&sr).Elem()
+	for _, name := range components {
+		idx := fields[name]
+		out.Field(idx).Set(in.Field(idx))
+	}
+	return sr
+}
+
+func getHealthzHandler(d *Daemon, params GetHealthzParams) middleware.Responder {
+	brief := params.Brief != nil && *params.Brief
+	requireK8sConnectivity := params.RequireK8sConnectivity != nil && *params.RequireK8sConnectivity
+	verbose := params.Verbose != nil && *params.Verbose
+	// A component list takes precedence over brief; brief remains the
+	// hardcoded subset used by readiness checks when no components are
+	// given.
+	if params.Components != nil && *params.Components != "" {
+		components := strings.Split(*params.Components, ",")
+		for _, name := range components {
+			if _, ok := statusComponentFields()[name]; !ok {
+				return api.Error(GetHealthzInvalidCode,
+					fmt.Errorf("unknown status component %q, valid components are: %s",
+						name, strings.Join(validStatusComponents(), ", ")))
+			}
+		}
+		sr := d.getStatusComponents(components, requireK8sConnectivity)
+		return NewGetHealthzOK().WithPayload(&sr)
+	}
+	sr := d.getStatus(brief, requireK8sConnectivity)
+	if verbose && !brief && d.svc != nil {
+		sr.ServiceBackends = d.getServiceBackendsStatus()
+	}
+	return NewGetHealthzOK().WithPayload(&sr)
+}
+
+// subscribeStatusUpdates registers a watcher channel that is signalled
+// whenever a status probe reports an update. The channel has a buffer of
+// one so that updates arriving while the watcher is busy coalesce into a
+// single pending notification instead of being dropped.
+func (d *Daemon) subscribeStatusUpdates() chan struct{} {
+	ch := make(chan struct{}, 1)
+	d.statusWatchersMutex.Lock()
+	if d.statusWatchers == nil {
+		d.statusWatchers = map[chan struct{}]struct{}{}
+	}
+	d.statusWatchers[ch] = struct{}{}
+	d.statusWatchersMutex.Unlock()
+	return ch
+}
+
+// unsubscribeStatusUpdates removes a watcher channel registered with
+// subscribeStatusUpdates.
+func (d *Daemon) unsubscribeStatusUpdates(ch chan struct{}) {
+	d.statusWatchersMutex.Lock()
+	delete(d.statusWatchers, ch)
+	d.statusWatchersMutex.Unlock()
+}
+
+// notifyStatusWatchers signals all registered status watchers without
+// blocking; a watcher that already has a pending notification is skipped.
+func (d *Daemon) notifyStatusWatchers() {
+	d.statusWatchersMutex.Lock()
+	defer d.statusWatchersMutex.Unlock()
+	for ch := range d.statusWatchers {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// watchHealthzHandler streams the daemon status as newline-delimited JSON
+// until the client disconnects. A status object is written whenever a
+// probe reports an update and at least every statusWatchHeartbeat.
+func watchHealthzHandler(d *Daemon, params GetStatusWatchParams) middleware.Responder {
+	return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+		var flusher http.Flusher
+		if wrapper, ok := w.(*metrics.ResponderWrapper); ok {
+			flusher, _ = wrapper.ResponseWriter.(http.Flusher)
+		} else {
+			flusher, _ = w.(http.Flusher)
+		}
+
+		updates := d.subscribeStatusUpdates()
+		defer d.unsubscribeStatusUpdates(updates)
+
+		heartbeat := time.NewTicker(statusWatchHeartbeat)
+		defer heartbeat.Stop()
+
+		enc := json.NewEncoder(w)
+		ctx := params.HTTPRequest.Context()
+		for {
+			sr := d.getStatus(false, true)
+			if err := enc.Encode(&sr); err != nil {
+				return
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-updates:
+			case <-heartbeat.C:
+			}
+		}
+	})
+}
+
+// putStatusProbesHandler disables or re-enables scheduling of a named status
+// probe. Disabled probes are reported as disabled in the probes section of
+// the status response rather than going stale.
+func putStatusProbesHandler(d *Daemon, params PutStatusProbesParams) middleware.Responder {
+	if err := d.statusCollector.SetProbeEnabled(params.Config.Name, params.Config.Enabled); err != nil {
+		return NewPutStatusProbesNotFound()
 	}
+	return NewPutStatusProbesOK()
 }
 
-func getHealthzHandler(d *Daemon, params GetHealthzParams) middleware.Responder {
-	brief := params.Brief != nil && *params.Brief
-	requireK8sConnectivity := params.RequireK8sConnectivity != nil && *params.RequireK8sConnectivity
-	sr := d.getStatus(brief, requireK8sConnectivity)
-	return NewGetHealthzOK().WithPayload(&sr)
+func getStatusCollectorStatsHandler(d *Daemon, params GetStatusCollectorStatsParams) middleware.Responder {
+	stats := d.statusCollector.Stats()
+	return NewGetStatusCollectorStatsOK().WithPayload(&models.StatusCollectorStats{
+		TotalProbes:       int64(stats.TotalProbes),
+		RunningProbes:     int64(stats.RunningProbes),
+		FailedProbes:      int64(stats.FailedProbes),
+		LastCycleDuration: strfmt.Duration(stats.LastCycleDuration),
+		CycleCount:        int64(stats.CycleCount),
+		LeakedProbes:      int64(stats.LeakedProbes),
+	})
+}
+
+// getServiceBackendsStatus lists the backends of each service with their
+// programmed weights and states. It is only reported when verbose status
+// output is requested, to keep the default payload small.
+func (d *Daemon) getServiceBackendsStatus() []*models.ServiceBackendsStatus {
+	svcs := d.svc.GetDeepCopyServices()
+	list := make([]*models.ServiceBackendsStatus, 0, len(svcs))
+	for _, svc := range svcs {
+		s := &models.ServiceBackendsStatus{
+			FrontendAddress: svc.Frontend.String(),
+			Name:            svc.Name.String(),
+		}
+		for _, be := range svc.Backends {
+			state, _ := be.State.String()
+			s.Backends = append(s.Backends, &models.ServiceBackendStatus{
+				Address: be.L3n4Addr.String(),
+				Weight:  int64(be.Weight),
+				State:   state,
+			})
+		}
+		list = append(list, s)
+	}
+	return list
 }
 
 // getStatus returns the daemon status. If brief is provided a minimal version
 // of the StatusResponse is provided.
+// controllerFailureWarningThreshold is the number of consecutive failures
+// after which a controller degrades the overall status to a warning.
+const controllerFailureWarningThreshold = 5
+
+// getFQDNCacheStatus returns the bookkeeping statistics of the toFQDNs DNS
+// cache, or nil if the name manager is not available.
+func (d *Daemon) getFQDNCacheStatus() *models.FQDNCacheStatus {
+	if d.dnsNameManager == nil {
+		return nil
+	}
+	return d.dnsNameManager.CacheStatus()
+}
+
+// worstMapPressure returns the name and fill ratio of the fullest map in the
+// map pressure section.
+func worstMapPressure(entries []*models.MapPressureEntry) (name string, fill float64) {
+	for _, e := range entries {
+		if e.FillRatio > fill {
+			name, fill = e.Name, e.FillRatio
+		}
+	}
+	return name, fill
+}
+
+// notReadyClusters counts the remote clusters of the clustermesh that are not
+// ready.
+func notReadyClusters(cm *models.ClusterMeshStatus) int {
+	if cm == nil {
+		return 0
+	}
+	notReady := 0
+	for _, cluster := range cm.Clusters {
+		if !cluster.Ready {
+			notReady++
+		}
+	}
+	return notReady
+}
+
+// sortControllerStatuses sorts the controller statuses by name. The global
+// controller manager collects them from a map, so without sorting the order
+// shuffles between otherwise identical status captures.
+func sortControllerStatuses(controllers models.ControllerStatuses) {
+	sort.Slice(controllers, func(i, j int) bool {
+		return controllers[i].Name < controllers[j].Name
+	})
+}
+
+// longFailingControllers counts the controllers that have been failing for at
+// least controllerFailureWarningThreshold consecutive runs.
+func longFailingControllers(controllers models.ControllerStatuses) int {
+	failing := 0
+	for _, c := range controllers {
+		if c.Status != nil && c.Status.ConsecutiveFailureCount >= controllerFailureWarningThreshold {
+			failing++
+		}
+	}
+	return failing
+}
+
+// overallStatus computes the top-level Cilium status from the collected
+// status sections. It deliberately takes the full status response rather
+// than the Daemon so that every branch can be exercised in tests.
+func overallStatus(resp *models.StatusResponse, staleCount int, k8sEnabled, requireK8sConnectivity bool, ciliumVer string) *models.Status {
+	pressureMap, pressureFill := worstMapPressure(resp.MapPressure)
+
+	switch {
+	case staleCount > 0:
+		msg := "Stale status data"
+		return &models.Status{
+			State:      models.StatusStateWarning,
+			Msg:        fmt.Sprintf("%s    %s", ciliumVer, msg),
+			ReasonCode: models.StatusReasonCodeSTALEPROBES,
+		}
+	case resp.Kvstore != nil &&
+		resp.Kvstore.State != models.StatusStateOk &&
+		resp.Kvstore.State != models.StatusStateDisabled:
+		msg := "Kvstore service is not ready: " + resp.Kvstore.Msg
+		return &models.Status{
+			State:      resp.Kvstore.State,
+			Msg:        fmt.Sprintf("%s    %s", ciliumVer, msg),
+			ReasonCode: models.StatusReasonCodeKVSTOREUNAVAILABLE,
+		}
+	case resp.ContainerRuntime != nil && resp.ContainerRuntime.State != models.StatusStateOk:
+		msg := "Container runtime is not ready: " + resp.ContainerRuntime.Msg
+		if resp.ContainerRuntime.State == models.StatusStateDisabled {
+			msg = "Container runtime is disabled"
+		}
+		return &models.Status{
+			State:      resp.ContainerRuntime.State,
+			Msg:        fmt.Sprintf("%s    %s", ciliumVer, msg),
+			ReasonCode: models.StatusReasonCodeCONTAINERRUNTIMENOTREADY,
+		}
+	case k8sEnabled && resp.Kubernetes != nil && resp.Kubernetes.State != models.StatusStateOk && requireK8sConnectivity:
+		msg := "Kubernetes service is not ready: " + resp.Kubernetes.Msg
+		return &models.Status{
+			State:      resp.Kubernetes.State,
+			Msg:        fmt.Sprintf("%s    %s", ciliumVer, msg),
+			ReasonCode: models.StatusReasonCodeK8SUNREACHABLE,
+		}
+	case pressureFill > mapPressureFailureThreshold:
+		msg := fmt.Sprintf("BPF map %s is %.0f%% full", pressureMap, pressureFill*100)
+		return &models.Status{
+			State:      models.StatusStateFailure,
+			Msg:        fmt.Sprintf("%s    %s", ciliumVer, msg),
+			ReasonCode: models.StatusReasonCodeMAPPRESSURE,
+		}
+	case resp.KubeProxyReplacement != nil &&
+		resp.KubeProxyReplacement.NatTableUtilization > natTableUtilizationWarningThreshold:
+		msg := fmt.Sprintf("NAT table utilization %.0f%% exceeds %.0f%%",
+			resp.KubeProxyReplacement.NatTableUtilization*100,
+			natTableUtilizationWarningThreshold*100)
+		return &models.Status{
+			State:      models.StatusStateWarning,
+			Msg:        fmt.Sprintf("%s    %s", ciliumVer, msg),
+			ReasonCode: models.StatusReasonCodeNATTABLEPRESSURE,
+		}
+	case pressureFill > mapPressureWarningThreshold:
+		msg := fmt.Sprintf("BPF map %s is %.0f%% full", pressureMap, pressureFill*100)
+		return &models.Status{
+			State:      models.StatusStateWarning,
+			Msg:        fmt.Sprintf("%s    %s", ciliumVer, msg),
+			ReasonCode: models.StatusReasonCodeMAPPRESSURE,
+		}
+	case resp.CniFile != nil && resp.CniFile.State == models.StatusStateFailure:
+		msg := "Could not write CNI config file: " + resp.CniFile.Msg
+		return &models.Status{
+			State:      models.StatusStateFailure,
+			Msg:        fmt.Sprintf("%s    %s", ciliumVer, msg),
+			ReasonCode: models.StatusReasonCodeCNIWRITEFAILED,
+		}
+	case notReadyClusters(resp.ClusterMesh) > 0:
+		msg := fmt.Sprintf("ClusterMesh is degraded: %d/%d remote clusters not ready",
+			notReadyClusters(resp.ClusterMesh), len(resp.ClusterMesh.Clusters))
+		return &models.Status{
+			State:      models.StatusStateWarning,
+			Msg:        fmt.Sprintf("%s    %s", ciliumVer, msg),
+			ReasonCode: models.StatusReasonCodeCLUSTERMESHDEGRADED,
+		}
+	case resp.FqdnCache != nil && len(resp.FqdnCache.OverLimitEndpointIds) > 0:
+		msg := fmt.Sprintf("toFQDNs per-hostname IP limit exceeded on %d endpoints",
+			len(resp.FqdnCache.OverLimitEndpointIds))
+		return &models.Status{
+			State:      models.StatusStateWarning,
+			Msg:        fmt.Sprintf("%s    %s", ciliumVer, msg),
+			ReasonCode: models.StatusReasonCodeFQDNCACHELIMIT,
+		}
+	case resp.Modules != nil && resp.Modules.Degraded > 0:
+		msg := fmt.Sprintf("%d module(s) reporting degraded health", resp.Modules.Degraded)
+		return &models.Status{
+			State:      models.StatusStateWarning,
+			Msg:        fmt.Sprintf("%s    %s", ciliumVer, msg),
+			ReasonCode: models.StatusReasonCodeMODULESDEGRADED,
+		}
+	case longFailingControllers(resp.Controllers) > 0:
+		msg := fmt.Sprintf("%d controllers have been failing for at least %d consecutive runs",
+			longFailingControllers(resp.Controllers), controllerFailureWarningThreshold)
+		return &models.Status{
+			State:      models.StatusStateWarning,
+			Msg:        fmt.Sprintf("%s    %s", ciliumVer, msg),
+			ReasonCode: models.StatusReasonCodeCONTROLLERSFAILING,
+		}
+	default:
+		return &models.Status{
+			State:      models.StatusStateOk,
+			Msg:        ciliumVer,
+			ReasonCode: models.StatusReasonCodeOK,
+		}
+	}
+}
+
 func (d *Daemon) getStatus(brief bool, requireK8sConnectivity bool) models.StatusResponse {
 	staleProbes := d.statusCollector.GetStaleProbes()
 	stale := make(map[string]strfmt.DateTime, len(staleProbes))
@@ -495,89 +1504,78 @@ func (d *Daemon) getStatus(brief bool, requireK8sConnectivity bool) models.Statu
 		stale[probe] = strfmt.DateTime(startTime)
 	}
 
+	probeStats := d.statusCollector.ProbeStats()
+	probes := make([]*models.ProbeStatus, 0, len(probeStats))
+	for _, ps := range probeStats {
+		probes = append(probes, &models.ProbeStatus{
+			Name:                ps.Name,
+			LastDuration:        strfmt.Duration(ps.LastDuration),
+			ConsecutiveFailures: int64(ps.ConsecutiveFailures),
+			TotalFailures:       int64(ps.TotalFailures),
+			Disabled:            ps.Disabled,
+		})
+	}
+
 	d.statusCollectMutex.RLock()
 	defer d.statusCollectMutex.RUnlock()
 
 	var sr models.StatusResponse
 	if brief {
-		csCopy := new(models.ClusterStatus)
-		if d.statusResponse.Cluster != nil && d.statusResponse.Cluster.CiliumHealth != nil {
-			in, out := &d.statusResponse.Cluster.CiliumHealth, &csCopy.CiliumHealth
-			*out = new(models.Status)
-			**out = **in
-		}
-		var minimalControllers models.ControllerStatuses
-		if d.statusResponse.Controllers != nil {
-			for _, c := range d.statusResponse.Controllers {
-				if c.Status == nil {
-					continue
-				}
-				// With brief, the client should only care if a single controller
-				// is failing and its status so we don't need to continuing
-				// checking for failure messages for the remaining controllers.
-				if c.Status.LastFailureMsg != "" {
-					minimalControllers = append(minimalControllers, c.DeepCopy())
-					break
-				}
-			}
-		}
-		sr = models.StatusResponse{
-			Cluster:     csCopy,
-			Controllers: minimalControllers,
-		}
+		sr = *status.SummarizeStatus(&d.statusResponse, status.SummaryOptions{})
 	} else {
 		// d.statusResponse contains references, so we do a deep copy to be able to
 		// safely use sr after the method has returned
 		sr = *d.statusResponse.DeepCopy()
+
+		if d.endpointManager != nil {
+			sr.IdentityDistribution = d.getIdentityDistribution()
+			sr.PolicyRecalculation = d.getPolicyRecalculation()
+		}
+
+		if d.nodeNeighbors != nil && d.nodeNeighbors.NodeNeighDiscoveryEnabled() {
+			sr.NeighborTable = d.nodeNeighbors.NeighborTableHealth()
+		}
 	}
 
 	sr.Stale = stale
+	sr.Probes = probes
 
 	// CiliumVersion definition
 	ver := version.GetCiliumVersion()
 	ciliumVer := fmt.Sprintf("%s (v%s-%s)", ver.Version, ver.Version, ver.Revision)
 
-	switch {
-	case len(sr.Stale) > 0:
-		msg := "Stale status data"
-		sr.Cilium = &models.Status{
-			State: models.StatusStateWarning,
-			Msg:   fmt.Sprintf("%s    %s", ciliumVer, msg),
-		}
-	case d.statusResponse.Kvstore != nil &&
-		d.statusResponse.Kvstore.State != models.StatusStateOk &&
-		d.statusResponse.Kvstore.State != models.StatusStateDisabled:
-		msg := "Kvstore service is not ready: " + d.statusResponse.Kvstore.Msg
-		sr.Cilium = &models.Status{
-			State: d.statusResponse.Kvstore.State,
-			Msg:   fmt.Sprintf("%s    %s", ciliumVer, msg),
-		}
-	case d.statusResponse.ContainerRuntime != nil && d.statusResponse.ContainerRuntime.State != models.StatusStateOk:
-		msg := "Container runtime is not ready: " + d.statusResponse.ContainerRuntime.Msg
-		if d.statusResponse.ContainerRuntime.State == models.StatusStateDisabled {
-			msg = "Container runtime is disabled"
-		}
-		sr.Cilium = &models.Status{
-			State: d.statusResponse.ContainerRuntime.State,
-			Msg:   fmt.Sprintf("%s    %s", ciliumVer, msg),
-		}
-	case d.clientset.IsEnabled() && d.statusResponse.Kubernetes != nil && d.statusResponse.Kubernetes.State != models.StatusStateOk && requireK8sConnectivity:
-		msg := "Kubernetes service is not ready: " + d.statusResponse.Kubernetes.Msg
-		sr.Cilium = &models.Status{
-			State: d.statusResponse.Kubernetes.State,
-			Msg:   fmt.Sprintf("%s    %s", ciliumVer, msg),
-		}
-	case d.statusResponse.CniFile != nil && d.statusResponse.CniFile.State == models.StatusStateFailure:
-		msg := "Could not write CNI config file: " + d.statusResponse.CniFile.Msg
-		sr.Cilium = &models.Status{
-			State: models.StatusStateFailure,
-			Msg:   fmt.Sprintf("%s    %s", ciliumVer, msg),
-		}
-	default:
-		sr.Cilium = &models.Status{
-			State: models.StatusStateOk,
-			Msg:   ciliumVer,
-		}
+	sr.Cilium = overallStatus(&d.statusResponse, len(sr.Stale), d.clientset.IsEnabled(), requireK8sConnectivity, ciliumVer)
+
+	if !d.startTime.IsZero() {
+		sr.StartTime = d.startTime.Format(time.RFC3339)
+		sr.Uptime = time.Since(d.startTime).Round(time.Second).String()
+	}
+	sr.LastShutdownReason = d.lastShutdownReason
+
+	return sr
+}
+
+// getStatusComponents assembles a status response containing only the given
+// components, sparing the deep copy of the sections the caller did not ask
+// for. The component names must have been validated against
+// statusComponentFields. The overall Cilium status is computed only when the
+// "cilium" component is requested, as it is never collected into
+// d.statusResponse.
+func (d *Daemon) getStatusComponents(components []string, requireK8sConnectivity bool) models.StatusResponse {
+	staleProbes := d.statusCollector.GetStaleProbes()
+
+	d.statusCollectMutex.RLock()
+	defer d.statusCollectMutex.RUnlock()
+
+	filtered := filterStatusComponents(&d.statusResponse, components)
+	// The filtered sections still alias the collected response; deep copy
+	// so that the result can be used after the mutex has been released.
+	sr := *filtered.DeepCopy()
+
+	if slices.Contains(components, "cilium") {
+		ver := version.GetCiliumVersion()
+		ciliumVer := fmt.Sprintf("%s (v%s-%s)", ver.Version, ver.Version, ver.Revision)
+		sr.Cilium = overallStatus(&d.statusResponse, len(staleProbes), d.clientset.IsEnabled(), requireK8sConnectivity, ciliumVer)
 	}
 
 	return sr
@@ -592,16 +1590,265 @@ func (d *Daemon) getIdentityRange() *models.IdentityRange {
 	return s
 }
 
+// identityDistributionTopN limits how many identities are listed in the
+// identity distribution of the status response. The total number of distinct
+// identities is reported regardless.
+const identityDistributionTopN = 10
+
+// getIdentityDistribution summarizes how the local endpoints are spread
+// across security identities, so that an identity explosion or collapse
+// caused by a labeling problem is visible from the status API.
+func (d *Daemon) getIdentityDistribution() *models.IdentityDistribution {
+	counts := make(map[identity.NumericIdentity]int64)
+	idLabels := make(map[identity.NumericIdentity][]string)
+	for _, ep := range d.endpointManager.GetEndpoints() {
+		secID, err := ep.GetSecurityIdentity()
+		if err != nil || secID == nil {
+			continue
+		}
+		counts[secID.ID]++
+		if _, ok := idLabels[secID.ID]; !ok {
+			idLabels[secID.ID] = secID.Labels.GetPrintableModel()
+		}
+	}
+
+	ids := make([]identity.NumericIdentity, 0, len(counts))
+	for id := range counts {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if counts[ids[i]] != counts[ids[j]] {
+			return counts[ids[i]] > counts[ids[j]]
+		}
+		return ids[i] < ids[j]
+	})
+
+	dist := &models.IdentityDistribution{
+		TotalIdentities: int64(len(ids)),
+	}
+	for i, id := range ids {
+		if i >= identityDistributionTopN {
+			break
+		}
+		dist.Identities = append(dist.Identities, &models.IdentityEndpointCount{
+			Identity:      int64(id),
+			Labels:        idLabels[id],
+			EndpointCount: counts[id],
+		})
+	}
+
+	return dist
+}
+
+// getPolicyRecalculation reports the state of the most recent recalculation
+// of the policy of all endpoints, so that operators can tell whether policy
+// convergence is backed up or complete.
+func (d *Daemon) getPolicyRecalculation() *models.PolicyRecalculation {
+	status := d.endpointManager.RegenerationStatus()
+	if status.Started.IsZero() {
+		return nil
+	}
+
+	return &models.PolicyRecalculation{
+		InProgress: status.InProgress,
+		Timestamp:  status.Started.Format(time.RFC3339),
+		Duration:   status.Duration.Nanoseconds(),
+		Reason:     status.Reason,
+	}
+}
+
+// wireguardStatusProvider is the narrow surface of the wireguard agent used
+// by the encryption probe.
+type wireguardStatusProvider interface {
+	Status(withPeers bool) (*models.WireguardStatus, error)
+}
+
+// getEncryptionStatus returns the encryption section of the status response
+// based on the configured encryption mode.
+func getEncryptionStatus(wg wireguardStatusProvider, ipsecEnabled, wireguardEnabled bool) *models.EncryptionStatus {
+	switch {
+	case ipsecEnabled:
+		return &models.EncryptionStatus{
+			Mode: models.EncryptionStatusModeIPsec,
+		}
+	case wireguardEnabled:
+		var msg string
+		status, err := wg.Status(false)
+		if err != nil {
+			msg = err.Error()
+		}
+		return &models.EncryptionStatus{
+			Mode:      models.EncryptionStatusModeWireguard,
+			Msg:       msg,
+			Wireguard: status,
+		}
+	default:
+		return &models.EncryptionStatus{
+			Mode: models.EncryptionStatusModeDisabled,
+			Msg:  fmt.Sprintf("disabled by --%s=false and --%s=false", option.EnableIPSecName, option.EnableWireguard),
+		}
+	}
+}
+
+// kvstoreLeaseOperation is the name under which the kvstore client tracks
+// lease acquisition and keepalive setup operations.
+const kvstoreLeaseOperation = "AcquireLease"
+
+// kvstoreKeepaliveWarningFraction is the fraction of the lease TTL above
+// which the p95 lease keepalive latency degrades the kvstore status to a
+// warning.
+const kvstoreKeepaliveWarningFraction = 0.8
+
+// kvstoreStatusData is the payload of the kvstore probe, combining the
+// client's connectivity status with rolling operation statistics.
+type kvstoreStatusData struct {
+	status     *models.Status
+	operations *models.KVStoreOperations
+}
+
+func (d *Daemon) getKVStoreStatus() *kvstoreStatusData {
+	s := kvstore.Client().Status()
+
+	ops := &models.KVStoreOperations{
+		WindowSeconds: int64(kvstore.OperationStatsWindow.Seconds()),
+	}
+	var keepaliveLatency time.Duration
+	for _, op := range kvstore.CollectOperationStats() {
+		ops.Operations = append(ops.Operations, &models.KVStoreOperationStats{
+			Operation:         op.Operation,
+			Count:             op.Count,
+			Errors:            op.Errors,
+			P95LatencySeconds: op.P95Latency.Seconds(),
+		})
+		if op.Operation == kvstoreLeaseOperation {
+			keepaliveLatency = op.P95Latency
+		}
+	}
+
+	if s.State == models.StatusStateDisabled {
+		return &kvstoreStatusData{status: s, operations: ops}
+	}
+
+	ms := status.NewMultiStatus()
+	ms.Add("connectivity", s.State, s.Msg)
+
+	leaseTTL := option.Config.KVstoreLeaseTTL
+	if leaseTTL == 0 {
+		leaseTTL = defaults.KVstoreLeaseTTL
+	}
+	if keepaliveLatency > 0 &&
+		keepaliveLatency > time.Duration(float64(leaseTTL)*kvstoreKeepaliveWarningFraction) {
+		ms.AddWarning("lease-keepalive",
+			fmt.Sprintf("Lease keepalive latency %s approaching lease TTL %s", keepaliveLatency, leaseTTL))
+	} else {
+		ms.AddOK("lease-keepalive", "")
+	}
+
+	return &kvstoreStatusData{status: ms.StatusModel(), operations: ops}
+}
+
+// localSections holds the status sections that are derived from local
+// configuration and datapath state rather than from a remote dependency.
+type localSections struct {
+	masquerading     *models.Masquerading
+	ipv6BigTCP       *models.IPV6BigTCP
+	ipv4BigTCP       *models.IPV4BigTCP
+	bandwidthManager *models.BandwidthManager
+	hostFirewall     *models.HostFirewall
+	routing          *models.Routing
+	clockSource      *models.ClockSource
+	bpfMaps          *models.BPFMapStatus
+	bpfVerifier      *models.BPFVerifierStatus
+	cniChaining      *models.CNIChainingStatus
+	identityRange    *models.IdentityRange
+	srv6             *models.Srv6
+	sctp             *models.Sctp
+	attachMode       models.AttachMode
+	datapathMode     models.DatapathMode
+	sysctls          []*models.SysctlStatus
+	mapPressure      []*models.MapPressureEntry
+	fqdnCache        *models.FQDNCacheStatus
+}
+
+func (d *Daemon) collectLocalSections() *localSections {
+	return &localSections{
+		masquerading:     d.getMasqueradingStatus(),
+		ipv6BigTCP:       d.getIPV6BigTCPStatus(),
+		ipv4BigTCP:       d.getIPV4BigTCPStatus(),
+		bandwidthManager: d.getBandwidthManagerStatus(),
+		hostFirewall:     d.getHostFirewallStatus(),
+		routing:          d.getRoutingStatus(),
+		clockSource:      d.getClockSourceStatus(),
+		bpfMaps:          d.getBPFMapStatus(),
+		bpfVerifier:      d.getBPFVerifierStatus(),
+		cniChaining:      d.getCNIChainingStatus(),
+		identityRange:    d.getIdentityRange(),
+		srv6:             d.getSRv6Status(),
+		sctp:             d.getSCTPStatus(),
+		attachMode:       d.getAttachModeStatus(),
+		datapathMode:     d.getDatapathModeStatus(),
+		sysctls:          d.getSysctlStatus(),
+		mapPressure:      ctmap.GetMapPressure(),
+		fqdnCache:        d.getFQDNCacheStatus(),
+	}
+}
+
+// apply copies the sections into the status response. The caller must hold
+// d.statusCollectMutex.
+func (s *localSections) apply(sr *models.StatusResponse) {
+	sr.Masquerading = s.masquerading
+	sr.IPV6BigTCP = s.ipv6BigTCP
+	sr.IPV4BigTCP = s.ipv4BigTCP
+	sr.BandwidthManager = s.bandwidthManager
+	sr.HostFirewall = s.hostFirewall
+	sr.Routing = s.routing
+	sr.ClockSource = s.clockSource
+	sr.BpfMaps = s.bpfMaps
+	sr.BpfVerifier = s.bpfVerifier
+	sr.CniChaining = s.cniChaining
+	sr.IdentityRange = s.identityRange
+	sr.Srv6 = s.srv6
+	sr.Sctp = s.sctp
+	sr.AttachMode = s.attachMode
+	sr.DatapathMode = s.datapathMode
+	sr.Sysctls = s.sysctls
+	sr.MapPressure = s.mapPressure
+	sr.FqdnCache = s.fqdnCache
+}
+
 func (d *Daemon) startStatusCollector(ctx context.Context, cleaner *daemonCleanup) error {
+	d.startTime = time.Now()
+	d.lastShutdownReason = readLastShutdownReason()
+	// Leave a startup marker behind. It is rewritten with a reason by the
+	// final status flush; if the agent dies before that, the next run will
+	// find the reason-less marker and report an unclean shutdown.
+	writeLastStatusSnapshot(lastStatusSnapshot{StartTime: d.startTime})
+
+	encryptionMode := "disabled"
+	switch {
+	case option.Config.EnableIPSec:
+		encryptionMode = "ipsec"
+	case option.Config.EnableWireguard:
+		encryptionMode = "wireguard"
+	}
+	metrics.DatapathInfo.WithLabelValues(
+		string(d.getAttachModeStatus()),
+		string(d.getDatapathModeStatus()),
+		option.Config.RoutingMode,
+		encryptionMode,
+	).Set(1)
+
 	probes := []status.Probe{
 		{
-			Name: "kvstore",
+			Name:         "kvstore",
+			StaleTimeout: statusProbeTimeoutTight,
 			Probe: func(ctx context.Context) (interface{}, error) {
 				if option.Config.KVStore == "" {
-					return &models.Status{State: models.StatusStateDisabled}, nil
-				} else {
-					return kvstore.Client().Status(), nil
+					return &kvstoreStatusData{
+						status: &models.Status{State: models.StatusStateDisabled},
+					}, nil
 				}
+				return d.getKVStoreStatus(), nil
 			},
 			OnStatusUpdate: func(status status.Status) {
 				d.statusCollectMutex.Lock()
@@ -615,13 +1862,15 @@ func (d *Daemon) startStatusCollector(ctx context.Context, cleaner *daemonCleanu
 					return
 				}
 
-				if kvstore, ok := status.Data.(*models.Status); ok {
-					d.statusResponse.Kvstore = kvstore
+				if kv, ok := status.Data.(*kvstoreStatusData); ok {
+					d.statusResponse.Kvstore = kv.status
+					d.statusResponse.KvstoreOperations = kv.operations
 				}
 			},
 		},
 		{
-			Name: "kubernetes",
+			Name:         "kubernetes",
+			StaleTimeout: statusProbeTimeoutLong,
 			Interval: func(failures int) time.Duration {
 				if failures > 0 {
 					// While failing, we want an initial
@@ -669,7 +1918,8 @@ func (d *Daemon) startStatusCollector(ctx context.Context, cleaner *daemonCleanu
 			},
 		},
 		{
-			Name: "ipam",
+			Name:         "ipam",
+			StaleTimeout: statusProbeTimeoutLong,
 			Probe: func(ctx context.Context) (interface{}, error) {
 				return d.DumpIPAM(), nil
 			},
@@ -685,6 +1935,54 @@ func (d *Daemon) startStatusCollector(ctx context.Context, cleaner *daemonCleanu
 				}
 			},
 		},
+		{
+			Name: "ip-conflicts",
+			Probe: func(ctx context.Context) (interface{}, error) {
+				return d.detectIPConflicts(), nil
+			},
+			OnStatusUpdate: func(status status.Status) {
+				d.statusCollectMutex.Lock()
+				defer d.statusCollectMutex.Unlock()
+
+				if status.Err == nil {
+					if s, ok := status.Data.(*models.IPConflicts); ok {
+						d.statusResponse.IPConflicts = s
+					}
+				}
+			},
+		},
+		{
+			Name: "rate-limiter",
+			Probe: func(ctx context.Context) (interface{}, error) {
+				return d.getRateLimiterStatus(), nil
+			},
+			OnStatusUpdate: func(status status.Status) {
+				d.statusCollectMutex.Lock()
+				defer d.statusCollectMutex.Unlock()
+
+				if status.Err == nil {
+					if s, ok := status.Data.(*models.RateLimiterStatus); ok {
+						d.statusResponse.RateLimiter = s
+					}
+				}
+			},
+		},
+		{
+			Name: "policy-map-pressure",
+			Probe: func(ctx context.Context) (interface{}, error) {
+				return d.getPolicyMapPressure(), nil
+			},
+			OnStatusUpdate: func(status status.Status) {
+				d.statusCollectMutex.Lock()
+				defer d.statusCollectMutex.Unlock()
+
+				if status.Err == nil {
+					if s, ok := status.Data.(*models.PolicyMapPressure); ok {
+						d.statusResponse.PolicyMapPressure = s
+					}
+				}
+			},
+		},
 		{
 			Name: "node-monitor",
 			Probe: func(ctx context.Context) (interface{}, error) {
@@ -764,7 +2062,10 @@ func (d *Daemon) startStatusCollector(ctx context.Context, cleaner *daemonCleanu
 				if d.l7Proxy == nil {
 					return nil, nil
 				}
-				return d.l7Proxy.GetStatusModel(), nil
+				s := d.l7Proxy.GetStatusModel()
+				s.RedirectInconsistencies = d.getProxyRedirectInconsistencies()
+				s.EnvoyAdmin = d.l7Proxy.GetEnvoyAdminStatusModel(ctx)
+				return s, nil
 			},
 			OnStatusUpdate: func(status status.Status) {
 				d.statusCollectMutex.Lock()
@@ -790,13 +2091,66 @@ func (d *Daemon) startStatusCollector(ctx context.Context, cleaner *daemonCleanu
 				// ControllerStatuses has no way to report errors
 				if status.Err == nil {
 					if s, ok := status.Data.(models.ControllerStatuses); ok {
+						// GetGlobalStatus returns the controllers in map
+						// order; sort them so that two captures of the same
+						// state serialize identically and can be diffed.
+						sortControllerStatuses(s)
 						d.statusResponse.Controllers = s
 					}
 				}
 			},
 		},
 		{
-			Name: "clustermesh",
+			Name: "modules-health",
+			Probe: func(ctx context.Context) (interface{}, error) {
+				return d.getModulesHealth(), nil
+			},
+			OnStatusUpdate: func(status status.Status) {
+				d.statusCollectMutex.Lock()
+				defer d.statusCollectMutex.Unlock()
+
+				if status.Err == nil {
+					if s, ok := status.Data.(*models.ModulesHealth); ok {
+						d.statusResponse.Modules = s
+					}
+				}
+			},
+		},
+		{
+			Name: "iptables-rules",
+			Probe: func(ctx context.Context) (interface{}, error) {
+				return d.getIptablesRulesStatus()
+			},
+			OnStatusUpdate: func(status status.Status) {
+				d.statusCollectMutex.Lock()
+				defer d.statusCollectMutex.Unlock()
+
+				if status.Err == nil {
+					if s, ok := status.Data.(*models.IptablesRulesStatus); ok {
+						d.statusResponse.IptablesRules = s
+					}
+				}
+			},
+		},
+		{
+			Name: "config-consistency",
+			Probe: func(ctx context.Context) (interface{}, error) {
+				return d.getConfigConsistency(), nil
+			},
+			OnStatusUpdate: func(status status.Status) {
+				d.statusCollectMutex.Lock()
+				defer d.statusCollectMutex.Unlock()
+
+				if status.Err == nil {
+					if s, ok := status.Data.(*models.ConfigConsistency); ok {
+						d.statusResponse.ConfigConsistency = s
+					}
+				}
+			},
+		},
+		{
+			Name:         "clustermesh",
+			StaleTimeout: statusProbeTimeoutLong,
 			Probe: func(ctx context.Context) (interface{}, error) {
 				if d.clustermesh == nil {
 					return nil, nil
@@ -833,27 +2187,7 @@ func (d *Daemon) startStatusCollector(ctx context.Context, cleaner *daemonCleanu
 		{
 			Name: "encryption",
 			Probe: func(ctx context.Context) (interface{}, error) {
-				switch {
-				case option.Config.EnableIPSec:
-					return &models.EncryptionStatus{
-						Mode: models.EncryptionStatusModeIPsec,
-					}, nil
-				case option.Config.EnableWireguard:
-					var msg string
-					status, err := d.wireguardAgent.Status(false)
-					if err != nil {
-						msg = err.Error()
-					}
-					return &models.EncryptionStatus{
-						Mode:      models.EncryptionStatusModeWireguard,
-						Msg:       msg,
-						Wireguard: status,
-					}, nil
-				default:
-					return &models.EncryptionStatus{
-						Mode: models.EncryptionStatusModeDisabled,
-					}, nil
-				}
+				return getEncryptionStatus(d.wireguardAgent, option.Config.EnableIPSec, option.Config.EnableWireguard), nil
 			},
 			OnStatusUpdate: func(status status.Status) {
 				d.statusCollectMutex.Lock()
@@ -882,6 +2216,22 @@ func (d *Daemon) startStatusCollector(ctx context.Context, cleaner *daemonCleanu
 				}
 			},
 		},
+		{
+			Name: "load-balancer",
+			Probe: func(ctx context.Context) (interface{}, error) {
+				return d.getLoadBalancerStatus(), nil
+			},
+			OnStatusUpdate: func(status status.Status) {
+				d.statusCollectMutex.Lock()
+				defer d.statusCollectMutex.Unlock()
+
+				if status.Err == nil {
+					if s, ok := status.Data.(*models.LoadBalancerStatus); ok {
+						d.statusResponse.LoadBalancer = s
+					}
+				}
+			},
+		},
 		{
 			Name: "auth-cert-provider",
 			Probe: func(ctx context.Context) (interface{}, error) {
@@ -921,21 +2271,41 @@ func (d *Daemon) startStatusCollector(ctx context.Context, cleaner *daemonCleanu
 				}
 			},
 		},
+		{
+			Name: "local-sections",
+			// These sections are derived from local configuration and
+			// datapath state. They were historically computed once at
+			// startup, but the device-dependent ones can change at
+			// runtime, so they are refreshed at a low frequency.
+			Interval: func(failures int) time.Duration {
+				return localSectionsRefreshInterval
+			},
+			Probe: func(ctx context.Context) (interface{}, error) {
+				return d.collectLocalSections(), nil
+			},
+			OnStatusUpdate: func(status status.Status) {
+				d.statusCollectMutex.Lock()
+				defer d.statusCollectMutex.Unlock()
+
+				if status.Err == nil {
+					if s, ok := status.Data.(*localSections); ok {
+						s.apply(&d.statusResponse)
+					}
+				}
+			},
+		},
 	}
 
-	d.statusResponse.Masquerading = d.getMasqueradingStatus()
-	d.statusResponse.IPV6BigTCP = d.getIPV6BigTCPStatus()
-	d.statusResponse.IPV4BigTCP = d.getIPV4BigTCPStatus()
-	d.statusResponse.BandwidthManager = d.getBandwidthManagerStatus()
-	d.statusResponse.HostFirewall = d.getHostFirewallStatus()
-	d.statusResponse.Routing = d.getRoutingStatus()
-	d.statusResponse.ClockSource = d.getClockSourceStatus()
-	d.statusResponse.BpfMaps = d.getBPFMapStatus()
-	d.statusResponse.CniChaining = d.getCNIChainingStatus()
-	d.statusResponse.IdentityRange = d.getIdentityRange()
-	d.statusResponse.Srv6 = d.getSRv6Status()
-	d.statusResponse.AttachMode = d.getAttachModeStatus()
-	d.statusResponse.DatapathMode = d.getDatapathModeStatus()
+	// Fan out probe updates to /status/watch subscribers. Each probe's
+	// own OnStatusUpdate runs first so that watchers observe the already
+	// updated status response.
+	for i := range probes {
+		probeUpdate := probes[i].OnStatusUpdate
+		probes[i].OnStatusUpdate = func(status status.Status) {
+			probeUpdate(status)
+			d.notifyStatusWatchers()
+		}
+	}
 
 	d.statusCollector = status.NewCollector(probes, status.DefaultConfig)
 
@@ -945,6 +2315,8 @@ func (d *Daemon) startStatusCollector(ctx context.Context, cleaner *daemonCleanu
 		return fmt.Errorf("waiting for first run: %w", err)
 	}
 
+	d.startStatusSnapshotController()
+
 	// Set up a signal handler function which prints out logs related to daemon status.
 	cleaner.cleanupFuncs.Add(func() {
 		// If the KVstore state is not OK, print help for user.
@@ -961,7 +2333,17 @@ func (d *Daemon) startStatusCollector(ctx context.Context, cleaner *daemonCleanu
 
 		}
 
-		d.statusCollector.Close()
+		drainCtx, cancel := context.WithTimeout(context.Background(), statusCollectorDrainTimeout)
+		defer cancel()
+		if err := d.statusCollector.DrainAndClose(drainCtx); err != nil {
+			log.WithError(err).Warning("Timed out waiting for status probes to complete on shutdown")
+		}
+
+		writeLastStatusSnapshot(lastStatusSnapshot{
+			StartTime:    d.startTime,
+			ShutdownTime: time.Now(),
+			Reason:       lastShutdownReasonClean,
+		})
 	})
 
 	return nil
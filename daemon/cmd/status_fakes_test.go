@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/cilium/hive/hivetest"
+
+	"github.com/cilium/cilium/api/v1/models"
+	k8sClient "github.com/cilium/cilium/pkg/k8s/client"
+	"github.com/cilium/cilium/pkg/status"
+	"github.com/cilium/cilium/pkg/status/statustest"
+)
+
+// fakeWireguardAgent implements wireguardStatusProvider for tests.
+type fakeWireguardAgent struct {
+	status *models.WireguardStatus
+	err    error
+}
+
+func (f *fakeWireguardAgent) Status(bool) (*models.WireguardStatus, error) {
+	return f.status, f.err
+}
+
+// newStatusTestDaemon returns a Daemon with a fake clientset and status
+// collector wired in, just enough to call getStatus.
+func newStatusTestDaemon(t *testing.T) *Daemon {
+	t.Helper()
+	d := &Daemon{}
+	_, d.clientset = k8sClient.NewFakeClientset(hivetest.Logger(t))
+	d.statusCollector = statustest.NewFakeCollector(nil, status.DefaultConfig)
+	return d
+}
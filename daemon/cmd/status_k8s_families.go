@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"context"
+	"net"
+	"net/url"
+
+	"github.com/cilium/cilium/api/v1/models"
+	"github.com/cilium/cilium/pkg/time"
+)
+
+// k8sFamilyProbeTimeout bounds both the address resolution of the apiserver
+// host and the connection attempt for a single address family.
+const k8sFamilyProbeTimeout = 5 * time.Second
+
+// k8sFamilyProber probes TCP connectivity to the kube-apiserver separately
+// over IPv4 and IPv6 on dual-stack clusters. The dial and lookup functions
+// can be substituted in tests to fail one family.
+type k8sFamilyProber struct {
+	dial   func(ctx context.Context, network, addr string) (net.Conn, error)
+	lookup func(ctx context.Context, host string) ([]net.IP, error)
+}
+
+func newK8sFamilyProber() *k8sFamilyProber {
+	dialer := &net.Dialer{}
+	return &k8sFamilyProber{
+		dial: dialer.DialContext,
+		lookup: func(ctx context.Context, host string) ([]net.IP, error) {
+			addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+			if err != nil {
+				return nil, err
+			}
+			ips := make([]net.IP, 0, len(addrs))
+			for _, addr := range addrs {
+				ips = append(ips, addr.IP)
+			}
+			return ips, nil
+		},
+	}
+}
+
+func ipFamily(ip net.IP) string {
+	if ip.To4() != nil {
+		return models.K8sFamilyStatusFamilyIPV4
+	}
+	return models.K8sFamilyStatusFamilyIPV6
+}
+
+// probeFamilies resolves the apiserver host of the given URL and probes TCP
+// connectivity over each address family for which an address exists. The
+// family of the first resolved address is marked as primary, matching the
+// address the client's dialer prefers for the main apiserver connection.
+// Families without an address are omitted.
+func (p *k8sFamilyProber) probeFamilies(ctx context.Context, apiServerURL string) []*models.K8sFamilyStatus {
+	u, err := url.Parse(apiServerURL)
+	if err != nil || u.Hostname() == "" {
+		return nil
+	}
+	host := u.Hostname()
+	port := u.Port()
+	if port == "" {
+		port = "443"
+	}
+
+	var ips []net.IP
+	if ip := net.ParseIP(host); ip != nil {
+		ips = []net.IP{ip}
+	} else {
+		lookupCtx, cancel := context.WithTimeout(ctx, k8sFamilyProbeTimeout)
+		defer cancel()
+		ips, err = p.lookup(lookupCtx, host)
+		if err != nil {
+			return nil
+		}
+	}
+
+	byFamily := map[string]net.IP{}
+	primary := ""
+	for _, ip := range ips {
+		family := ipFamily(ip)
+		if primary == "" {
+			primary = family
+		}
+		if _, ok := byFamily[family]; !ok {
+			byFamily[family] = ip
+		}
+	}
+
+	var statuses []*models.K8sFamilyStatus
+	for _, family := range []string{models.K8sFamilyStatusFamilyIPV4, models.K8sFamilyStatusFamilyIPV6} {
+		ip, ok := byFamily[family]
+		if !ok {
+			continue
+		}
+		statuses = append(statuses, p.probeFamily(ctx, family, ip, port, family == primary))
+	}
+	return statuses
+}
+
+// probeFamily attempts a single TCP connection to the given apiserver address
+// over the network matching the address family and reports the outcome.
+func (p *k8sFamilyProber) probeFamily(ctx context.Context, family string, ip net.IP, port string, primary bool) *models.K8sFamilyStatus {
+	status := &models.K8sFamilyStatus{
+		Family:  family,
+		Primary: primary,
+	}
+
+	network := "tcp4"
+	if family == models.K8sFamilyStatusFamilyIPV6 {
+		network = "tcp6"
+	}
+
+	dialCtx, cancel := context.WithTimeout(ctx, k8sFamilyProbeTimeout)
+	defer cancel()
+
+	start := time.Now()
+	conn, err := p.dial(dialCtx, network, net.JoinHostPort(ip.String(), port))
+	if err != nil {
+		status.State = models.StatusStateFailure
+		status.Msg = err.Error()
+		return status
+	}
+	conn.Close()
+
+	status.State = models.StatusStateOk
+	status.Latency = time.Since(start).Nanoseconds()
+	return status
+}
+
+// primaryFamily returns the address family carrying the primary client
+// connection, or an empty string when no per-family probes ran.
+func primaryFamily(families []*models.K8sFamilyStatus) string {
+	for _, f := range families {
+		if f.Primary {
+			return f.Family
+		}
+	}
+	return ""
+}
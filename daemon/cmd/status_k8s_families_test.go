@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/cilium/api/v1/models"
+)
+
+// fakeDial returns a dial function that succeeds with a dummy connection for
+// all networks except the ones listed in failing.
+func fakeDial(failing ...string) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		for _, f := range failing {
+			if network == f {
+				return nil, errors.New("connection refused")
+			}
+		}
+		client, server := net.Pipe()
+		server.Close()
+		return client, nil
+	}
+}
+
+func TestProbeFamiliesOneFamilyFailing(t *testing.T) {
+	prober := &k8sFamilyProber{
+		dial: fakeDial("tcp6"),
+		lookup: func(ctx context.Context, host string) ([]net.IP, error) {
+			return []net.IP{net.ParseIP("192.0.2.1"), net.ParseIP("2001:db8::1")}, nil
+		},
+	}
+
+	families := prober.probeFamilies(context.Background(), "https://kubernetes.default.svc:6443")
+	require.Len(t, families, 2)
+
+	require.Equal(t, models.K8sFamilyStatusFamilyIPV4, families[0].Family)
+	require.Equal(t, models.StatusStateOk, families[0].State)
+	require.True(t, families[0].Primary)
+
+	require.Equal(t, models.K8sFamilyStatusFamilyIPV6, families[1].Family)
+	require.Equal(t, models.StatusStateFailure, families[1].State)
+	require.Contains(t, families[1].Msg, "connection refused")
+	require.False(t, families[1].Primary)
+
+	require.Equal(t, models.K8sFamilyStatusFamilyIPV4, primaryFamily(families))
+}
+
+func TestProbeFamiliesPrimaryFollowsFirstAddress(t *testing.T) {
+	prober := &k8sFamilyProber{
+		dial: fakeDial("tcp4"),
+		lookup: func(ctx context.Context, host string) ([]net.IP, error) {
+			return []net.IP{net.ParseIP("2001:db8::1"), net.ParseIP("192.0.2.1")}, nil
+		},
+	}
+
+	families := prober.probeFamilies(context.Background(), "https://kubernetes.default.svc")
+	require.Len(t, families, 2)
+
+	require.Equal(t, models.K8sFamilyStatusFamilyIPV4, families[0].Family)
+	require.Equal(t, models.StatusStateFailure, families[0].State)
+	require.False(t, families[0].Primary)
+
+	require.Equal(t, models.K8sFamilyStatusFamilyIPV6, families[1].Family)
+	require.Equal(t, models.StatusStateOk, families[1].State)
+	require.True(t, families[1].Primary)
+
+	require.Equal(t, models.K8sFamilyStatusFamilyIPV6, primaryFamily(families))
+}
+
+func TestProbeFamiliesSingleStackService(t *testing.T) {
+	prober := &k8sFamilyProber{
+		dial: fakeDial(),
+		lookup: func(ctx context.Context, host string) ([]net.IP, error) {
+			return []net.IP{net.ParseIP("192.0.2.1")}, nil
+		},
+	}
+
+	// A service resolving to a single family yields a single probe.
+	families := prober.probeFamilies(context.Background(), "https://kubernetes.default.svc:6443")
+	require.Len(t, families, 1)
+	require.Equal(t, models.K8sFamilyStatusFamilyIPV4, families[0].Family)
+	require.True(t, families[0].Primary)
+
+	// A literal apiserver address is probed without resolution.
+	prober.lookup = func(ctx context.Context, host string) ([]net.IP, error) {
+		return nil, errors.New("lookup must not be called for literal addresses")
+	}
+	families = prober.probeFamilies(context.Background(), "https://[2001:db8::1]:6443")
+	require.Len(t, families, 1)
+	require.Equal(t, models.K8sFamilyStatusFamilyIPV6, families[0].Family)
+}
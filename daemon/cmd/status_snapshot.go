@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"sort"
+
+	"github.com/google/renameio/v2"
+
+	"github.com/cilium/cilium/api/v1/models"
+	"github.com/cilium/cilium/pkg/controller"
+	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/option"
+	"github.com/cilium/cilium/pkg/time"
+)
+
+const (
+	// statusSnapshotHeartbeat is the minimum interval at which the status
+	// snapshot file is rewritten even when the summary is unchanged, so
+	// that external watchdogs can treat a stale file as an unhealthy agent.
+	statusSnapshotHeartbeat = time.Minute
+
+	// statusSnapshotCheckInterval is the interval at which the aggregated
+	// status is recomputed and compared against the last written snapshot.
+	statusSnapshotCheckInterval = 10 * time.Second
+)
+
+var statusSnapshotControllerGroup = controller.NewGroup("status-snapshot")
+
+// statusSnapshot is the compact summary of the aggregated agent status
+// written to the file given by --status-snapshot-file for consumption by
+// host-level monitoring agents which cannot reach the agent's unix socket.
+type statusSnapshot struct {
+	// State is the overall state of the agent, one of Ok, Warning,
+	// Failure or Disabled.
+	State string `json:"state"`
+
+	// ReasonCode is the machine readable cause of the state.
+	ReasonCode string `json:"reason-code,omitempty"`
+
+	// FailingSections lists the status sections in a warning or failure
+	// state, including stale probes.
+	FailingSections []string `json:"failing-sections,omitempty"`
+
+	// Timestamp records when the snapshot was written.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// equal reports whether two snapshots carry the same summary, ignoring the
+// timestamp.
+func (s statusSnapshot) equal(other statusSnapshot) bool {
+	return s.State == other.State &&
+		s.ReasonCode == other.ReasonCode &&
+		slices.Equal(s.FailingSections, other.FailingSections)
+}
+
+// statusSummary condenses a status response into a snapshot.
+func statusSummary(sr *models.StatusResponse) statusSnapshot {
+	s := statusSnapshot{State: models.StatusStateOk}
+	if sr.Cilium != nil {
+		s.State = sr.Cilium.State
+		s.ReasonCode = sr.Cilium.ReasonCode
+	}
+
+	failing := func(state string) bool {
+		return state != "" && state != models.StatusStateOk && state != models.StatusStateDisabled
+	}
+
+	sections := map[string]string{}
+	if sr.Kvstore != nil {
+		sections["kvstore"] = sr.Kvstore.State
+	}
+	if sr.ContainerRuntime != nil {
+		sections["container-runtime"] = sr.ContainerRuntime.State
+	}
+	if sr.Kubernetes != nil {
+		sections["kubernetes"] = sr.Kubernetes.State
+	}
+	if sr.CniFile != nil {
+		sections["cni-file"] = sr.CniFile.State
+	}
+	if sr.AuthCertificateProvider != nil {
+		sections["auth-certificate-provider"] = sr.AuthCertificateProvider.State
+	}
+	if sr.Cluster != nil && sr.Cluster.CiliumHealth != nil {
+		sections["cilium-health"] = sr.Cluster.CiliumHealth.State
+	}
+	if sr.Hubble != nil {
+		sections["hubble"] = sr.Hubble.State
+	}
+
+	for name, state := range sections {
+		if failing(state) {
+			s.FailingSections = append(s.FailingSections, name)
+		}
+	}
+	for name := range sr.Stale {
+		s.FailingSections = append(s.FailingSections, "stale:"+name)
+	}
+	sort.Strings(s.FailingSections)
+
+	return s
+}
+
+// snapshotWriter maintains the status snapshot file, rewriting it atomically
+// whenever the summary changes and at least once per heartbeat interval.
+type snapshotWriter struct {
+	mutex     lock.Mutex
+	path      string
+	heartbeat time.Duration
+	last      statusSnapshot
+	lastWrite time.Time
+}
+
+func newSnapshotWriter(path string, heartbeat time.Duration) *snapshotWriter {
+	return &snapshotWriter{
+		path:      path,
+		heartbeat: heartbeat,
+	}
+}
+
+// update writes the given snapshot if it differs from the last written one or
+// if the heartbeat interval has elapsed since the last write. The file is
+// replaced with a rename so that readers never observe partial contents.
+func (w *snapshotWriter) update(snapshot statusSnapshot) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if snapshot.equal(w.last) && time.Since(w.lastWrite) < w.heartbeat {
+		return nil
+	}
+
+	snapshot.Timestamp = time.Now()
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	if err := renameio.WriteFile(w.path, data, 0o644); err != nil {
+		return err
+	}
+
+	w.last = snapshot
+	w.lastWrite = time.Now()
+	return nil
+}
+
+// startStatusSnapshotController periodically condenses the aggregated status
+// into the snapshot file configured via --status-snapshot-file.
+func (d *Daemon) startStatusSnapshotController() {
+	if option.Config.StatusSnapshotFile == "" {
+		return
+	}
+
+	d.statusSnapshotWriter = newSnapshotWriter(option.Config.StatusSnapshotFile, statusSnapshotHeartbeat)
+	d.controllers.UpdateController(
+		statusSnapshotControllerGroup.Name,
+		controller.ControllerParams{
+			Group: statusSnapshotControllerGroup,
+			DoFunc: func(ctx context.Context) error {
+				sr := d.getStatus(false, true)
+				return d.statusSnapshotWriter.update(statusSummary(&sr))
+			},
+			RunInterval: statusSnapshotCheckInterval,
+			Context:     d.ctx,
+		},
+	)
+}
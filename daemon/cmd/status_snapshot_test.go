@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/cilium/api/v1/models"
+	"github.com/cilium/cilium/pkg/time"
+)
+
+func TestSnapshotWriterHeartbeat(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "status.json")
+	w := newSnapshotWriter(path, 100*time.Millisecond)
+
+	snap := statusSnapshot{State: models.StatusStateOk}
+	require.NoError(t, w.update(snap))
+	first, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	// An unchanged summary within the heartbeat interval is not rewritten.
+	require.NoError(t, w.update(snap))
+	second, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, first, second)
+
+	// Once the heartbeat interval has elapsed, the file is rewritten with
+	// a fresh timestamp even though the summary did not change.
+	time.Sleep(150 * time.Millisecond)
+	require.NoError(t, w.update(snap))
+	third, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NotEqual(t, first, third)
+
+	var s1, s3 statusSnapshot
+	require.NoError(t, json.Unmarshal(first, &s1))
+	require.NoError(t, json.Unmarshal(third, &s3))
+	require.True(t, s3.Timestamp.After(s1.Timestamp))
+
+	// A changed summary is written immediately.
+	require.NoError(t, w.update(statusSnapshot{State: models.StatusStateWarning}))
+	fourth, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var s4 statusSnapshot
+	require.NoError(t, json.Unmarshal(fourth, &s4))
+	require.Equal(t, models.StatusStateWarning, s4.State)
+}
+
+func TestSnapshotWriterConcurrent(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "status.json")
+	// A zero heartbeat makes every update rewrite the file, maximizing the
+	// chance for readers to catch a partial write.
+	w := newSnapshotWriter(path, 0)
+	require.NoError(t, w.update(statusSnapshot{State: models.StatusStateOk}))
+
+	stop := make(chan struct{})
+	readDone := make(chan error, 1)
+
+	// Continuously read the file while writers replace it. Every read must
+	// yield a complete, valid snapshot.
+	go func() {
+		for {
+			select {
+			case <-stop:
+				readDone <- nil
+				return
+			default:
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				readDone <- err
+				return
+			}
+			var s statusSnapshot
+			if err := json.Unmarshal(data, &s); err != nil {
+				readDone <- err
+				return
+			}
+		}
+	}()
+
+	states := []string{models.StatusStateOk, models.StatusStateWarning, models.StatusStateFailure}
+	var writeErr error
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 100 {
+				snap := statusSnapshot{
+					State:           states[i%len(states)],
+					FailingSections: []string{"kvstore", "kubernetes"},
+				}
+				if err := w.update(snap); err != nil {
+					mutex.Lock()
+					writeErr = err
+					mutex.Unlock()
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(stop)
+	require.NoError(t, writeErr)
+	require.NoError(t, <-readDone)
+}
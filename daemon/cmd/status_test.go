@@ -0,0 +1,656 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/netip"
+	"sort"
+	"testing"
+
+	"github.com/cilium/hive/hivetest"
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/cilium/api/v1/models"
+	"github.com/cilium/cilium/pkg/cidr"
+	datapathTables "github.com/cilium/cilium/pkg/datapath/tables"
+	datapath "github.com/cilium/cilium/pkg/datapath/types"
+	"github.com/cilium/cilium/pkg/datapath/xdp"
+	k8sClient "github.com/cilium/cilium/pkg/k8s/client"
+	"github.com/cilium/cilium/pkg/maps/policymap"
+	"github.com/cilium/cilium/pkg/node"
+	nodeTypes "github.com/cilium/cilium/pkg/node/types"
+	"github.com/cilium/cilium/pkg/option"
+	"github.com/cilium/cilium/pkg/status"
+	"github.com/cilium/cilium/pkg/status/statustest"
+)
+
+// TestGetStatusStaleProbes verifies that getStatus picks up probe results
+// and staleness from the injected status collector.
+func TestGetStatusStaleProbes(t *testing.T) {
+	d := Daemon{}
+	_, d.clientset = k8sClient.NewFakeClientset(hivetest.Logger(t))
+
+	probes := []status.Probe{
+		{
+			Name: "ipam",
+			Probe: func(ctx context.Context) (interface{}, error) {
+				t.Fatal("the fake collector must not invoke probes")
+				return nil, nil
+			},
+			OnStatusUpdate: func(st status.Status) {
+				d.statusCollectMutex.Lock()
+				defer d.statusCollectMutex.Unlock()
+
+				if st.Err == nil {
+					if s, ok := st.Data.(*models.IPAMStatus); ok {
+						d.statusResponse.Ipam = s
+					}
+				}
+			},
+		},
+	}
+
+	collector := statustest.NewFakeCollector(probes, status.DefaultConfig)
+	d.statusCollector = collector
+
+	collector.SetProbeResult("ipam", &models.IPAMStatus{Allocations: map[string]string{}}, nil)
+
+	sr := d.getStatus(false, true)
+	require.NotNil(t, sr.Ipam)
+	require.Empty(t, sr.Stale)
+	require.Equal(t, models.StatusStateOk, sr.Cilium.State)
+
+	// Once the probe exceeds the failure threshold, the status degrades to
+	// a warning and the probe is reported as stale.
+	collector.AdvanceClock(2 * status.DefaultConfig.FailureThreshold)
+
+	sr = d.getStatus(false, true)
+	require.Contains(t, sr.Stale, "ipam")
+	require.Equal(t, models.StatusStateWarning, sr.Cilium.State)
+	require.Equal(t, models.StatusReasonCodeSTALEPROBES, sr.Cilium.ReasonCode)
+
+	updates := collector.StatusUpdates("ipam")
+	require.Len(t, updates, 2)
+	require.NoError(t, updates[0].Err)
+	require.True(t, updates[1].StaleWarning)
+}
+
+// TestGetStatusReasonCodes exercises every branch of the overall-state switch
+// in getStatus and verifies the machine-readable reason code it assigns.
+func TestGetStatusReasonCodes(t *testing.T) {
+	d := Daemon{}
+	_, d.clientset = k8sClient.NewFakeClientset(hivetest.Logger(t))
+	d.statusCollector = statustest.NewFakeCollector(nil, status.DefaultConfig)
+
+	reasonCode := func(requireK8sConnectivity bool) string {
+		sr := d.getStatus(false, requireK8sConnectivity)
+		return sr.Cilium.ReasonCode
+	}
+
+	require.Equal(t, models.StatusReasonCodeOK, reasonCode(true))
+
+	// Conditions are set from the lowest priority branch upwards, so each
+	// newly degraded component takes over the reason code.
+	d.statusResponse.Controllers = models.ControllerStatuses{
+		{
+			Name:   "failing-controller",
+			Status: &models.ControllerStatusStatus{ConsecutiveFailureCount: controllerFailureWarningThreshold},
+		},
+	}
+	require.Equal(t, models.StatusReasonCodeCONTROLLERSFAILING, reasonCode(true))
+
+	d.statusResponse.FqdnCache = &models.FQDNCacheStatus{
+		OverLimitEndpointIds: []int64{42},
+	}
+	require.Equal(t, models.StatusReasonCodeFQDNCACHELIMIT, reasonCode(true))
+
+	d.statusResponse.ClusterMesh = &models.ClusterMeshStatus{
+		Clusters: []*models.RemoteCluster{{Name: "other", Ready: false}},
+	}
+	require.Equal(t, models.StatusReasonCodeCLUSTERMESHDEGRADED, reasonCode(true))
+
+	d.statusResponse.CniFile = &models.Status{State: models.StatusStateFailure}
+	require.Equal(t, models.StatusReasonCodeCNIWRITEFAILED, reasonCode(true))
+
+	d.statusResponse.MapPressure = []*models.MapPressureEntry{
+		{Name: "cilium_ct4_global", FillRatio: mapPressureWarningThreshold + 0.05},
+	}
+	require.Equal(t, models.StatusReasonCodeMAPPRESSURE, reasonCode(true))
+
+	d.statusResponse.KubeProxyReplacement = &models.KubeProxyReplacement{
+		NatTableUtilization: natTableUtilizationWarningThreshold + 0.1,
+	}
+	require.Equal(t, models.StatusReasonCodeNATTABLEPRESSURE, reasonCode(true))
+
+	// A map above the failure threshold outranks the NAT table warning.
+	d.statusResponse.MapPressure[0].FillRatio = mapPressureFailureThreshold + 0.01
+	require.Equal(t, models.StatusReasonCodeMAPPRESSURE, reasonCode(true))
+
+	d.statusResponse.Kubernetes = &models.K8sStatus{State: models.StatusStateFailure}
+	require.Equal(t, models.StatusReasonCodeK8SUNREACHABLE, reasonCode(true))
+	// Without requiring k8s connectivity the next degraded component wins.
+	require.Equal(t, models.StatusReasonCodeMAPPRESSURE, reasonCode(false))
+
+	d.statusResponse.ContainerRuntime = &models.Status{State: models.StatusStateFailure}
+	require.Equal(t, models.StatusReasonCodeCONTAINERRUNTIMENOTREADY, reasonCode(true))
+
+	d.statusResponse.Kvstore = &models.Status{State: models.StatusStateFailure}
+	require.Equal(t, models.StatusReasonCodeKVSTOREUNAVAILABLE, reasonCode(true))
+}
+
+// TestOverallStatus drives every branch of the overall-state switch directly,
+// without a Daemon.
+func TestOverallStatus(t *testing.T) {
+	const ver = "1.18.0 (v1.18.0-dev)"
+
+	tests := []struct {
+		name       string
+		resp       models.StatusResponse
+		staleCount int
+		k8sEnabled bool
+		requireK8s bool
+		state      string
+		reasonCode string
+	}{
+		{
+			name:       "ok",
+			state:      models.StatusStateOk,
+			reasonCode: models.StatusReasonCodeOK,
+		},
+		{
+			name:       "stale probes",
+			staleCount: 1,
+			state:      models.StatusStateWarning,
+			reasonCode: models.StatusReasonCodeSTALEPROBES,
+		},
+		{
+			name: "kvstore failure",
+			resp: models.StatusResponse{
+				Kvstore: &models.Status{State: models.StatusStateFailure, Msg: "etcd down"},
+			},
+			state:      models.StatusStateFailure,
+			reasonCode: models.StatusReasonCodeKVSTOREUNAVAILABLE,
+		},
+		{
+			name: "kvstore disabled is ok",
+			resp: models.StatusResponse{
+				Kvstore: &models.Status{State: models.StatusStateDisabled},
+			},
+			state:      models.StatusStateOk,
+			reasonCode: models.StatusReasonCodeOK,
+		},
+		{
+			name: "container runtime failure",
+			resp: models.StatusResponse{
+				ContainerRuntime: &models.Status{State: models.StatusStateFailure},
+			},
+			state:      models.StatusStateFailure,
+			reasonCode: models.StatusReasonCodeCONTAINERRUNTIMENOTREADY,
+		},
+		{
+			name: "container runtime disabled",
+			resp: models.StatusResponse{
+				ContainerRuntime: &models.Status{State: models.StatusStateDisabled},
+			},
+			state:      models.StatusStateDisabled,
+			reasonCode: models.StatusReasonCodeCONTAINERRUNTIMENOTREADY,
+		},
+		{
+			name: "k8s unreachable",
+			resp: models.StatusResponse{
+				Kubernetes: &models.K8sStatus{State: models.StatusStateFailure},
+			},
+			k8sEnabled: true,
+			requireK8s: true,
+			state:      models.StatusStateFailure,
+			reasonCode: models.StatusReasonCodeK8SUNREACHABLE,
+		},
+		{
+			name: "k8s unreachable but not required",
+			resp: models.StatusResponse{
+				Kubernetes: &models.K8sStatus{State: models.StatusStateFailure},
+			},
+			k8sEnabled: true,
+			state:      models.StatusStateOk,
+			reasonCode: models.StatusReasonCodeOK,
+		},
+		{
+			name: "k8s unreachable but disabled",
+			resp: models.StatusResponse{
+				Kubernetes: &models.K8sStatus{State: models.StatusStateFailure},
+			},
+			requireK8s: true,
+			state:      models.StatusStateOk,
+			reasonCode: models.StatusReasonCodeOK,
+		},
+		{
+			name: "map pressure failure",
+			resp: models.StatusResponse{
+				MapPressure: []*models.MapPressureEntry{
+					{Name: "cilium_ct4_global", FillRatio: mapPressureFailureThreshold + 0.01},
+				},
+			},
+			state:      models.StatusStateFailure,
+			reasonCode: models.StatusReasonCodeMAPPRESSURE,
+		},
+		{
+			name: "nat table pressure",
+			resp: models.StatusResponse{
+				KubeProxyReplacement: &models.KubeProxyReplacement{
+					NatTableUtilization: natTableUtilizationWarningThreshold + 0.1,
+				},
+			},
+			state:      models.StatusStateWarning,
+			reasonCode: models.StatusReasonCodeNATTABLEPRESSURE,
+		},
+		{
+			name: "map pressure warning",
+			resp: models.StatusResponse{
+				MapPressure: []*models.MapPressureEntry{
+					{Name: "cilium_ct4_global", FillRatio: mapPressureWarningThreshold + 0.05},
+				},
+			},
+			state:      models.StatusStateWarning,
+			reasonCode: models.StatusReasonCodeMAPPRESSURE,
+		},
+		{
+			name: "cni write failed",
+			resp: models.StatusResponse{
+				CniFile: &models.Status{State: models.StatusStateFailure},
+			},
+			state:      models.StatusStateFailure,
+			reasonCode: models.StatusReasonCodeCNIWRITEFAILED,
+		},
+		{
+			name: "clustermesh degraded",
+			resp: models.StatusResponse{
+				ClusterMesh: &models.ClusterMeshStatus{
+					Clusters: []*models.RemoteCluster{{Name: "other", Ready: false}},
+				},
+			},
+			state:      models.StatusStateWarning,
+			reasonCode: models.StatusReasonCodeCLUSTERMESHDEGRADED,
+		},
+		{
+			name: "fqdn cache limit",
+			resp: models.StatusResponse{
+				FqdnCache: &models.FQDNCacheStatus{OverLimitEndpointIds: []int64{42}},
+			},
+			state:      models.StatusStateWarning,
+			reasonCode: models.StatusReasonCodeFQDNCACHELIMIT,
+		},
+		{
+			name: "modules degraded",
+			resp: models.StatusResponse{
+				Modules: &models.ModulesHealth{Degraded: 2},
+			},
+			state:      models.StatusStateWarning,
+			reasonCode: models.StatusReasonCodeMODULESDEGRADED,
+		},
+		{
+			name: "controllers failing",
+			resp: models.StatusResponse{
+				Controllers: models.ControllerStatuses{
+					{
+						Name:   "failing-controller",
+						Status: &models.ControllerStatusStatus{ConsecutiveFailureCount: controllerFailureWarningThreshold},
+					},
+				},
+			},
+			state:      models.StatusStateWarning,
+			reasonCode: models.StatusReasonCodeCONTROLLERSFAILING,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := overallStatus(&tt.resp, tt.staleCount, tt.k8sEnabled, tt.requireK8s, ver)
+			require.Equal(t, tt.state, s.State)
+			require.Equal(t, tt.reasonCode, s.ReasonCode)
+			require.Contains(t, s.Msg, ver)
+		})
+	}
+}
+
+// TestGetStatusDeepCopy verifies that the full status response is deep
+// copied, so mutating the returned value cannot corrupt the collected state.
+func TestGetStatusDeepCopy(t *testing.T) {
+	d := newStatusTestDaemon(t)
+	d.statusResponse = models.StatusResponse{
+		Ipam: &models.IPAMStatus{Allocations: map[string]string{"10.0.0.1": "pod-a"}},
+	}
+
+	sr := d.getStatus(false, true)
+	require.Equal(t, models.StatusStateOk, sr.Cilium.State)
+	sr.Ipam.Allocations["10.0.0.2"] = "pod-b"
+	require.Len(t, d.statusResponse.Ipam.Allocations, 1)
+
+	brief := d.getStatus(true, true)
+	require.Nil(t, brief.Ipam)
+	require.NotNil(t, brief.Cilium)
+}
+
+// TestGetEncryptionStatus exercises the encryption probe body with a fake
+// wireguard agent.
+func TestGetEncryptionStatus(t *testing.T) {
+	s := getEncryptionStatus(nil, false, false)
+	require.Equal(t, models.EncryptionStatusModeDisabled, s.Mode)
+	require.Contains(t, s.Msg, "disabled by")
+
+	s = getEncryptionStatus(nil, true, false)
+	require.Equal(t, models.EncryptionStatusModeIPsec, s.Mode)
+
+	wg := &fakeWireguardAgent{status: &models.WireguardStatus{NodeEncryption: "Disabled"}}
+	s = getEncryptionStatus(wg, false, true)
+	require.Equal(t, models.EncryptionStatusModeWireguard, s.Mode)
+	require.Empty(t, s.Msg)
+	require.Equal(t, wg.status, s.Wireguard)
+
+	wg.err = errors.New("wgtypes: device not found")
+	s = getEncryptionStatus(wg, false, true)
+	require.Equal(t, "wgtypes: device not found", s.Msg)
+}
+
+// TestNodePortAccelerationModel verifies the conversion of the loader's
+// per-device XDP attachment results into the NodePort feature model,
+// including the Warning raised when a device fell back from the requested
+// acceleration mode.
+func TestNodePortAccelerationModel(t *testing.T) {
+	devices, status := nodePortAccelerationModel([]datapath.XDPDeviceStatus{
+		{
+			Device:        "eth0",
+			RequestedMode: xdp.AccelerationModeBestEffort,
+			AttachedMode:  xdp.ModeLinkDriver,
+		},
+		{
+			Device:         "eth1",
+			RequestedMode:  xdp.AccelerationModeBestEffort,
+			AttachedMode:   xdp.ModeLinkGeneric,
+			FallbackReason: "driver does not support native XDP",
+		},
+	})
+
+	require.Len(t, devices, 2)
+	require.Equal(t, "eth0", devices[0].Device)
+	require.Equal(t, "Native", devices[0].AttachedMode)
+	require.Empty(t, devices[0].FallbackReason)
+	require.Equal(t, "eth1", devices[1].Device)
+	require.Equal(t, "Generic", devices[1].AttachedMode)
+	require.Equal(t, "driver does not support native XDP", devices[1].FallbackReason)
+
+	require.Equal(t, models.StatusStateWarning, status.State)
+	require.Contains(t, status.Msg, "eth1")
+	require.NotContains(t, status.Msg, "eth0")
+}
+
+// TestNodePortAccelerationModelHealthy verifies that no Warning is raised
+// when all devices attached in the requested mode.
+func TestNodePortAccelerationModelHealthy(t *testing.T) {
+	devices, status := nodePortAccelerationModel([]datapath.XDPDeviceStatus{
+		{
+			Device:        "eth0",
+			RequestedMode: xdp.AccelerationModeNative,
+			AttachedMode:  xdp.ModeLinkDriver,
+		},
+	})
+
+	require.Len(t, devices, 1)
+	require.Equal(t, models.StatusStateOk, status.State)
+	require.Empty(t, status.Msg)
+}
+
+// TestKubeProxyReplacementDeviceList verifies that the device list renders
+// every address exactly once, labeled with its family, with primary and
+// secondary addresses kept apart.
+func TestKubeProxyReplacementDeviceList(t *testing.T) {
+	list := kubeProxyReplacementDeviceList([]*datapathTables.Device{
+		{
+			Name: "eth0",
+			Addrs: []datapathTables.DeviceAddress{
+				{Addr: netip.MustParseAddr("192.0.2.10")},
+				{Addr: netip.MustParseAddr("192.0.2.11"), Secondary: true},
+				{Addr: netip.MustParseAddr("2001:db8::10")},
+			},
+		},
+		{
+			Name: "eth1",
+			Addrs: []datapathTables.DeviceAddress{
+				{Addr: netip.MustParseAddr("2001:db8::20")},
+				{Addr: netip.MustParseAddr("2001:db8::21"), Secondary: true},
+			},
+		},
+		{
+			Name: "eth2",
+		},
+	})
+
+	require.Len(t, list, 3)
+
+	require.Equal(t, "eth0", list[0].Name)
+	require.Equal(t, []string{"192.0.2.10 (IPv4)", "2001:db8::10 (IPv6)"}, list[0].IP)
+	require.Equal(t, []string{"192.0.2.11 (IPv4)"}, list[0].SecondaryIP)
+
+	require.Equal(t, "eth1", list[1].Name)
+	require.Equal(t, []string{"2001:db8::20 (IPv6)"}, list[1].IP)
+	require.Equal(t, []string{"2001:db8::21 (IPv6)"}, list[1].SecondaryIP)
+
+	// A device without addresses reports empty lists, not null, so that
+	// JSON consumers can iterate without a nil check.
+	require.Equal(t, "eth2", list[2].Name)
+	require.Empty(t, list[2].IP)
+	require.NotNil(t, list[2].IP)
+	require.Empty(t, list[2].SecondaryIP)
+	require.NotNil(t, list[2].SecondaryIP)
+}
+
+func TestSNATAllocFailureSampler(t *testing.T) {
+	var sampler snatAllocFailureSampler
+
+	// The first sample only primes the counters.
+	delta4, delta6 := sampler.sample(10, 2)
+	require.Zero(t, delta4)
+	require.Zero(t, delta6)
+
+	delta4, delta6 = sampler.sample(15, 2)
+	require.EqualValues(t, 5, delta4)
+	require.EqualValues(t, 0, delta6)
+
+	// A decreasing counter means the histogram was flushed out-of-band.
+	delta4, delta6 = sampler.sample(3, 4)
+	require.EqualValues(t, 3, delta4)
+	require.EqualValues(t, 2, delta6)
+}
+
+func TestStatusComponentFields(t *testing.T) {
+	fields := statusComponentFields()
+
+	// Spot-check a few component names against the JSON field names of
+	// the model.
+	for _, name := range []string{"encryption", "kube-proxy-replacement", "kvstore", "cilium"} {
+		require.Contains(t, fields, name)
+	}
+	require.NotContains(t, fields, "")
+	require.NotContains(t, fields, "-")
+
+	// The valid-name list used in error messages is sorted and complete.
+	names := validStatusComponents()
+	require.Len(t, names, len(fields))
+	require.True(t, sort.StringsAreSorted(names))
+}
+
+func TestFilterStatusComponents(t *testing.T) {
+	full := &models.StatusResponse{
+		Encryption: &models.EncryptionStatus{Mode: models.EncryptionStatusModeWireguard},
+		Kvstore:    &models.Status{State: models.StatusStateOk},
+		Kubernetes: &models.K8sStatus{K8sAPIVersions: []string{"v1"}},
+	}
+
+	sr := filterStatusComponents(full, []string{"encryption", "kvstore"})
+	require.Equal(t, full.Encryption, sr.Encryption)
+	require.Equal(t, full.Kvstore, sr.Kvstore)
+
+	// Everything that was not asked for is left out, including sections
+	// that are set in the full response.
+	require.Nil(t, sr.Kubernetes)
+	require.Nil(t, sr.Cilium)
+
+	// Requesting a section that has not been collected yet simply yields
+	// an empty response rather than an error.
+	sr = filterStatusComponents(full, []string{"hubble"})
+	require.Equal(t, models.StatusResponse{}, sr)
+}
+
+func TestWithAlive(t *testing.T) {
+	alive := map[string]int64{
+		"cilium_ct4_global": 100,
+		"cilium_ct6_global": 50,
+	}
+
+	// Counts of the named maps are summed; maps without a sample yet are
+	// simply left out.
+	props := withAlive(&models.BPFMapProperties{Name: "CT"}, alive,
+		"cilium_ct4_global", "cilium_ct6_global")
+	require.NotNil(t, props.Used)
+	require.EqualValues(t, 150, *props.Used)
+
+	props = withAlive(&models.BPFMapProperties{Name: "CT"}, alive,
+		"cilium_ct4_global", "cilium_ct_any4_global")
+	require.NotNil(t, props.Used)
+	require.EqualValues(t, 100, *props.Used)
+
+	// No observed map at all leaves the entry count unknown.
+	props = withAlive(&models.BPFMapProperties{Name: "NAT"}, alive,
+		"cilium_snat_v4_external")
+	require.Nil(t, props.Used)
+}
+
+func TestPopulateSNATAllocFailures(t *testing.T) {
+	s := &models.Masquerading{}
+	populateSNATAllocFailures(s, 0, 0)
+	require.Equal(t, models.StatusStateOk, s.Status.State)
+
+	s = &models.Masquerading{}
+	populateSNATAllocFailures(s, 7, 1)
+	require.EqualValues(t, 7, s.AllocFailuresIPV4)
+	require.EqualValues(t, 1, s.AllocFailuresIPV6)
+	require.Equal(t, models.StatusStateWarning, s.Status.State)
+	require.Contains(t, s.Status.Msg, "7 IPv4")
+	require.Contains(t, s.Status.Msg, "1 IPv6")
+}
+
+// TestStatusResponseByteStable builds a status response twice from the same
+// fixtures, with the controller statuses delivered in different orders, and
+// verifies that the serialized JSON is identical so captures can be diffed.
+func TestStatusResponseByteStable(t *testing.T) {
+	controllers := func() models.ControllerStatuses {
+		return models.ControllerStatuses{
+			{Name: "sync-endpoints"},
+			{Name: "ipcache-gc"},
+			{Name: "dns-poller"},
+		}
+	}
+	shuffled := func() models.ControllerStatuses {
+		return models.ControllerStatuses{
+			{Name: "dns-poller"},
+			{Name: "sync-endpoints"},
+			{Name: "ipcache-gc"},
+		}
+	}
+
+	build := func(ctrls models.ControllerStatuses) []byte {
+		sortControllerStatuses(ctrls)
+		sr := models.StatusResponse{
+			Controllers: ctrls,
+			Stale: map[string]strfmt.DateTime{
+				"kvstore":     {},
+				"controllers": {},
+			},
+		}
+		data, err := json.Marshal(&sr)
+		require.NoError(t, err)
+		return data
+	}
+
+	require.Equal(t, build(controllers()), build(shuffled()))
+}
+
+// fakePolicyMapFactory implements policymap.Factory for tests that only
+// need the map size getters.
+type fakePolicyMapFactory struct{}
+
+func (fakePolicyMapFactory) OpenEndpoint(id uint16) (*policymap.PolicyMap, error) {
+	panic("implement me")
+}
+func (fakePolicyMapFactory) CreateEndpoint(id uint16) error               { panic("implement me") }
+func (fakePolicyMapFactory) RemoveEndpoint(id uint16) error               { panic("implement me") }
+func (fakePolicyMapFactory) PolicyMaxEntries() int                        { return 16384 }
+func (fakePolicyMapFactory) StatsMaxEntries() int                         { return 16384 }
+func (fakePolicyMapFactory) UpdatePressure(id uint16, used int)           {}
+func (fakePolicyMapFactory) RecordInsertFailure(id uint16)                {}
+func (fakePolicyMapFactory) PressureReport() []policymap.EndpointPressure { return nil }
+
+// TestGetBPFMapStatusIPv6Only verifies that the BPF map listing only contains
+// the maps of enabled address families.
+func TestGetBPFMapStatusIPv6Only(t *testing.T) {
+	oldV4, oldV6 := option.Config.EnableIPv4, option.Config.EnableIPv6
+	t.Cleanup(func() {
+		option.Config.EnableIPv4, option.Config.EnableIPv6 = oldV4, oldV6
+	})
+
+	d := Daemon{policyMapFactory: fakePolicyMapFactory{}}
+
+	names := func() []string {
+		var names []string
+		for _, m := range d.getBPFMapStatus().Maps {
+			names = append(names, m.Name)
+		}
+		return names
+	}
+
+	option.Config.EnableIPv4, option.Config.EnableIPv6 = false, true
+	for _, name := range names() {
+		require.NotContains(t, name, "IPv4", "IPv4 map %q listed on an IPv6-only cluster", name)
+	}
+	require.Contains(t, names(), "IPv6 service")
+
+	option.Config.EnableIPv4, option.Config.EnableIPv6 = true, true
+	require.Contains(t, names(), "IPv4 service")
+	require.Contains(t, names(), "IPv6 service")
+}
+
+// TestGetMasqueradingStatusIPv6Only verifies that the masquerading getter
+// neither emits the legacy IPv4 exclusion CIDR nor dereferences IPv4-only
+// node fields when IPv4 is disabled.
+func TestGetMasqueradingStatusIPv6Only(t *testing.T) {
+	oldV4, oldV6 := option.Config.EnableIPv4, option.Config.EnableIPv6
+	oldV4Masq, oldV6Masq := option.Config.EnableIPv4Masquerade, option.Config.EnableIPv6Masquerade
+	t.Cleanup(func() {
+		option.Config.EnableIPv4, option.Config.EnableIPv6 = oldV4, oldV6
+		option.Config.EnableIPv4Masquerade, option.Config.EnableIPv6Masquerade = oldV4Masq, oldV6Masq
+	})
+	option.Config.EnableIPv4, option.Config.EnableIPv6 = false, true
+	option.Config.EnableIPv4Masquerade, option.Config.EnableIPv6Masquerade = false, true
+
+	v6CIDR := cidr.MustParseCIDR("fd00::/64")
+	d := Daemon{
+		nodeLocalStore: node.NewTestLocalNodeStore(node.LocalNode{
+			Node: nodeTypes.Node{IPv6AllocCIDR: v6CIDR},
+		}),
+	}
+
+	s := d.getMasqueradingStatus()
+	require.True(t, s.Enabled)
+	require.Empty(t, s.SnatExclusionCidr)
+	require.Empty(t, s.SnatExclusionCidrV4)
+	require.Equal(t, v6CIDR.String(), s.SnatExclusionCidrV6)
+
+	// A node without an allocation CIDR yet must not panic either.
+	d.nodeLocalStore = node.NewTestLocalNodeStore(node.LocalNode{})
+	s = d.getMasqueradingStatus()
+	require.Empty(t, s.SnatExclusionCidrV6)
+}
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cilium/cilium/api/v1/models"
+	cmtypes "github.com/cilium/cilium/pkg/clustermesh/types"
+	"github.com/cilium/cilium/pkg/controller"
+	tunnelmap "github.com/cilium/cilium/pkg/maps/tunnel"
+	"github.com/cilium/cilium/pkg/metrics"
+	"github.com/cilium/cilium/pkg/option"
+	"github.com/cilium/cilium/pkg/status"
+)
+
+var tunnelConsistencyControllerGroup = controller.NewGroup("tunnel-map-consistency")
+
+// tunnelConsistencyInterval is the interval between two comparisons of the
+// tunnel map against the set of known remote nodes.
+const tunnelConsistencyInterval = time.Minute
+
+// expectedTunnelEndpoints derives the expected tunnel map contents from the
+// remote nodes known to the node manager, keyed by the pod CIDR prefix
+// address with the tunnel endpoint address as value.
+func (d *Daemon) expectedTunnelEndpoints() map[string]string {
+	expected := map[string]string{}
+
+	for _, n := range d.nodeManager.GetNodes() {
+		if n.IsLocal() {
+			continue
+		}
+
+		// The IPv4 node IP serves as the tunnel endpoint for both address
+		// families, mirroring the tunnel map updates performed on node
+		// events by the datapath.
+		nodeIP := n.GetNodeIP(false)
+		if nodeIP == nil {
+			continue
+		}
+
+		if option.Config.EnableIPv4 && n.IPv4AllocCIDR != nil {
+			prefix := cmtypes.PrefixClusterFromCIDR(n.IPv4AllocCIDR)
+			expected[prefix.AddrCluster().String()] = nodeIP.String()
+		}
+		if option.Config.EnableIPv6 && n.IPv6AllocCIDR != nil {
+			prefix := cmtypes.PrefixClusterFromCIDR(n.IPv6AllocCIDR)
+			expected[prefix.AddrCluster().String()] = nodeIP.String()
+		}
+	}
+
+	return expected
+}
+
+// verifyTunnelConsistency compares the tunnel map against the node manager's
+// view of the cluster and reports the confirmed discrepancies via the agent
+// status and the cilium_datapath_tunnel_map_discrepancies metric. Only
+// discrepancies observed by two consecutive runs are reported, to tolerate
+// the transient windows while a node addition or removal propagates.
+func (d *Daemon) verifyTunnelConsistency(ctx context.Context) error {
+	actual, err := tunnelmap.TunnelMap().DumpEndpoints()
+	if err != nil {
+		return fmt.Errorf("unable to dump tunnel map: %w", err)
+	}
+
+	current := tunnelmap.CompareEntries(d.expectedTunnelEndpoints(), actual)
+
+	d.tunnelDiscrepanciesMutex.Lock()
+	confirmed := current.Intersect(d.tunnelDiscrepancies)
+	d.tunnelDiscrepancies = current
+	d.tunnelDiscrepanciesMutex.Unlock()
+
+	metrics.TunnelMapDiscrepancies.Set(float64(confirmed.Count()))
+
+	var tcs *models.TunnelConsistencyStatus
+	if confirmed.Count() > 0 {
+		log.WithField("discrepancies", confirmed.Count()).
+			Warn("Tunnel map is inconsistent with the set of known nodes")
+
+		ms := status.NewMultiStatus()
+		for _, class := range []struct {
+			name    string
+			entries []string
+		}{
+			{"missing", confirmed.Missing},
+			{"orphaned", confirmed.Orphaned},
+			{"mismatched", confirmed.Mismatched},
+		} {
+			if len(class.entries) > 0 {
+				ms.AddWarning(class.name, fmt.Sprintf("%d entries", len(class.entries)))
+			} else {
+				ms.AddOK(class.name, "")
+			}
+		}
+
+		tcs = &models.TunnelConsistencyStatus{
+			Status:     ms.StatusModel(),
+			Missing:    confirmed.Missing,
+			Orphaned:   confirmed.Orphaned,
+			Mismatched: confirmed.Mismatched,
+		}
+	}
+
+	d.statusCollectMutex.Lock()
+	d.statusResponse.TunnelConsistency = tcs
+	d.statusCollectMutex.Unlock()
+
+	return nil
+}
@@ -180,6 +180,11 @@ const (
 	WireguardPubKey      = NetworkPrefix + "/wg-pub-key"
 	WireguardPubKeyAlias = Prefix + ".network.wg-pub-key"
 
+	// ConfigFeatureFingerprint is the annotation through which each node
+	// advertises its key datapath settings, so that peers can detect
+	// asymmetric configuration across the cluster.
+	ConfigFeatureFingerprint = ConfigPrefix + "/feature-fingerprint"
+
 	// BGPVRouterAnnoPrefix is the prefix used for all Virtual Router annotations
 	// Its just a prefix, because the ASN of the Router is part of the annotation itself
 	BGPVRouterAnnoPrefix = "cilium.io/bgp-virtual-router."
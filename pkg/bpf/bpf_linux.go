@@ -106,18 +106,26 @@ func objCheck(m *ebpf.Map, path string, mapType ebpf.MapType, keySize, valueSize
 // removed and recreated.
 //
 // If spec.Pinned is 0, a new Map is always created.
-func OpenOrCreateMap(spec *ebpf.MapSpec, pinDir string) (*ebpf.Map, error) {
+//
+// The second return value is true if a new map was created, and false if an
+// existing pinned map was re-used.
+func OpenOrCreateMap(spec *ebpf.MapSpec, pinDir string) (*ebpf.Map, bool, error) {
 	var opts ebpf.MapOptions
+	pinExists := false
 	if spec.Pinning != 0 {
 		if pinDir == "" {
-			return nil, errors.New("cannot pin map to empty pinDir")
+			return nil, false, errors.New("cannot pin map to empty pinDir")
 		}
 		if spec.Name == "" {
-			return nil, errors.New("cannot load unnamed map from pin")
+			return nil, false, errors.New("cannot load unnamed map from pin")
 		}
 
 		if err := MkdirBPF(pinDir); err != nil {
-			return nil, fmt.Errorf("creating map base pinning directory: %w", err)
+			return nil, false, fmt.Errorf("creating map base pinning directory: %w", err)
+		}
+
+		if _, err := os.Stat(path.Join(pinDir, spec.Name)); err == nil {
+			pinExists = true
 		}
 
 		opts.PinPath = pinDir
@@ -128,7 +136,7 @@ func OpenOrCreateMap(spec *ebpf.MapSpec, pinDir string) (*ebpf.Map, error) {
 		// Found incompatible map. Open the pin again to find out why.
 		m, err := ebpf.LoadPinnedMap(path.Join(pinDir, spec.Name), nil)
 		if err != nil {
-			return nil, fmt.Errorf("open pin of incompatible map: %w", err)
+			return nil, false, fmt.Errorf("open pin of incompatible map: %w", err)
 		}
 		defer m.Close()
 
@@ -142,13 +150,14 @@ func OpenOrCreateMap(spec *ebpf.MapSpec, pinDir string) (*ebpf.Map, error) {
 
 		// Existing map incompatible with spec. Unpin so it can be recreated.
 		if err := m.Unpin(); err != nil {
-			return nil, err
+			return nil, false, err
 		}
 
-		return createMap(spec, &opts)
+		m2, err := createMap(spec, &opts)
+		return m2, err == nil, err
 	}
 
-	return m, err
+	return m, err == nil && !pinExists, err
 }
 
 // GetMtime returns monotonic time that can be used to compare
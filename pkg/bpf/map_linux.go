@@ -87,6 +87,10 @@ type Map struct {
 	// endpoint ID
 	cachedCommonName string
 
+	// reusedPin is true when the map was opened from an existing pin
+	// rather than created by this agent
+	reusedPin bool
+
 	// enableSync is true when synchronization retries have been enabled.
 	enableSync bool
 
@@ -364,6 +368,20 @@ func (m *Map) FD() int {
 	return m.m.FD()
 }
 
+// ID returns the kernel ID of this map, or 0 if the map is not open or the
+// ID cannot be retrieved.
+func (m *Map) ID() uint32 {
+	if m.m == nil {
+		return 0
+	}
+	info, err := m.m.Info()
+	if err != nil {
+		return 0
+	}
+	id, _ := info.ID()
+	return uint32(id)
+}
+
 // Name returns the basename of this map.
 func (m *Map) Name() string {
 	return m.name
@@ -418,11 +436,12 @@ func OpenMap(pinPath string, key MapKey, value MapValue) (*Map, error) {
 	}
 
 	m := &Map{
-		m:     em,
-		name:  path.Base(pinPath),
-		path:  pinPath,
-		key:   key,
-		value: value,
+		m:         em,
+		name:      path.Base(pinPath),
+		path:      pinPath,
+		key:       key,
+		value:     value,
+		reusedPin: true,
 	}
 
 	m.updateMetrics()
@@ -528,11 +547,12 @@ func (m *Map) openOrCreate(pin bool) error {
 		m.spec.Pinning = ebpf.PinByName
 	}
 
-	em, err := OpenOrCreateMap(m.spec, path.Dir(m.path))
+	em, created, err := OpenOrCreateMap(m.spec, path.Dir(m.path))
 	if err != nil {
 		return err
 	}
 
+	m.reusedPin = pin && !created
 	m.updateMetrics()
 	registerMap(m.path, m)
 
@@ -571,6 +591,7 @@ func (m *Map) open() error {
 		return fmt.Errorf("loading pinned map %s: %w", m.path, err)
 	}
 
+	m.reusedPin = true
 	m.updateMetrics()
 	registerMap(m.path, m)
 
@@ -612,6 +633,33 @@ func (m *Map) NextKey(key, nextKeyOut interface{}) error {
 	return err
 }
 
+// CountEntries walks the keys of the map and returns the number of entries it
+// currently holds. Counting costs one getnextkey syscall per entry, so callers
+// should sample at a low frequency rather than count on every request. The
+// result is approximate when the map is modified concurrently.
+func (m *Map) CountEntries() (int64, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	if m.m == nil {
+		return 0, fmt.Errorf("map %s is not open", m.name)
+	}
+
+	// Bound the walk so that a hash map under heavy churn, which can yield
+	// keys more than once, cannot stall the caller indefinitely.
+	limit := int64(m.m.MaxEntries()) * 2
+
+	var count int64
+	key, err := m.m.NextKeyBytes(nil)
+	for ; key != nil && err == nil && count < limit; key, err = m.m.NextKeyBytes(key) {
+		count++
+	}
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
 type DumpCallback func(key MapKey, value MapValue)
 
 // DumpWithCallback iterates over the Map and calls the given DumpCallback for
@@ -1373,11 +1421,30 @@ func (m *Map) ClearAll() error {
 	return i.Err()
 }
 
+// kernelID returns the ID of the map as reported by the kernel, or 0 if the
+// map is not open or the kernel does not expose map IDs.
+func (m *Map) kernelID() int64 {
+	if m.m == nil {
+		return 0
+	}
+	info, err := m.m.Info()
+	if err != nil {
+		return 0
+	}
+	id, ok := info.ID()
+	if !ok {
+		return 0
+	}
+	return int64(id)
+}
+
 // GetModel returns a BPF map in the representation served via the API
 func (m *Map) GetModel() *models.BPFMap {
 
 	mapModel := &models.BPFMap{
-		Path: m.path,
+		Path:      m.path,
+		ID:        m.kernelID(),
+		ReusedPin: m.reusedPin,
 	}
 
 	mapModel.Cache = make([]*models.BPFMapEntry, 0, len(m.cache))
@@ -7,6 +7,7 @@ package bpf
 
 import (
 	"path"
+	"strings"
 
 	"github.com/cilium/cilium/api/v1/models"
 	"github.com/cilium/cilium/pkg/lock"
@@ -45,6 +46,76 @@ func GetMap(name string) *Map {
 	return mapRegister[name]
 }
 
+// MapInfo describes the kernel identity and pin origin of an open map.
+type MapInfo struct {
+	// PinPath is the path the map is pinned to in the BPF filesystem.
+	PinPath string
+	// ID is the ID of the map as reported by the kernel.
+	ID int64
+	// ReusedPin is true when the map was opened from an existing pin
+	// rather than created by this agent.
+	ReusedPin bool
+}
+
+// GetMapInfo returns the pin path, kernel map ID and pin origin of the
+// registered map with the given name, or nil if no such map is open.
+func GetMapInfo(name string) *MapInfo {
+	m := GetMap(name)
+	if m == nil {
+		return nil
+	}
+
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return &MapInfo{
+		PinPath:   m.path,
+		ID:        m.kernelID(),
+		ReusedPin: m.reusedPin,
+	}
+}
+
+// CountMapEntries returns the number of entries currently in the registered
+// map with the given name. The second return value is false when no such map
+// is open or walking its keys failed.
+func CountMapEntries(name string) (int64, bool) {
+	m := GetMap(name)
+	if m == nil {
+		return 0, false
+	}
+
+	count, err := m.CountEntries()
+	if err != nil {
+		return 0, false
+	}
+	return count, true
+}
+
+// CountPrefixedMapEntries sums the entry counts of all registered maps whose
+// base name starts with the given prefix, such as the per-endpoint policy
+// maps. The second return value is the number of maps that were counted.
+func CountPrefixedMapEntries(prefix string) (int64, int) {
+	mutex.RLock()
+	maps := make([]*Map, 0, len(mapRegister))
+	for _, m := range mapRegister {
+		if strings.HasPrefix(m.Name(), prefix) {
+			maps = append(maps, m)
+		}
+	}
+	mutex.RUnlock()
+
+	var total int64
+	counted := 0
+	for _, m := range maps {
+		count, err := m.CountEntries()
+		if err != nil {
+			continue
+		}
+		total += count
+		counted++
+	}
+	return total, counted
+}
+
 // GetOpenMaps returns a slice of all open BPF maps. This is identical to
 // calling GetMap() on all open maps.
 func GetOpenMaps() []*models.BPFMap {
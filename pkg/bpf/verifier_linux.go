@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+//go:build linux
+
+package bpf
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cilium/ebpf"
+)
+
+// VerifierInstructionLimit is the kernel verifier's complexity limit for
+// programs loaded by privileged users (BPF_COMPLEXITY_LIMIT_INSNS).
+const VerifierInstructionLimit = 1_000_000
+
+// ciliumProgramPrefix is the name prefix shared by all BPF programs loaded
+// by the agent.
+const ciliumProgramPrefix = "cil_"
+
+// VerifierComplexity describes how close a loaded BPF program is to the
+// kernel verifier's instruction limit, based on the verifier statistics of
+// the last successful load.
+type VerifierComplexity struct {
+	// ProgramName is the name of the program.
+	ProgramName string
+
+	// VerifiedInstructions is the number of instructions processed by the
+	// verifier when the program was loaded.
+	VerifiedInstructions uint32
+
+	// InstructionLimit is the verifier's complexity limit.
+	InstructionLimit uint32
+}
+
+// MostComplexProgram returns the verifier complexity of the loaded Cilium
+// BPF program with the highest number of verified instructions. Returns nil
+// without an error if no Cilium program is loaded, or if the kernel does not
+// report verified instruction counts (< 5.16).
+func MostComplexProgram() (*VerifierComplexity, error) {
+	var (
+		result *VerifierComplexity
+		id     ebpf.ProgramID
+		err    error
+	)
+
+	for {
+		id, err = ebpf.ProgramGetNextID(id)
+		if errors.Is(err, os.ErrNotExist) {
+			return result, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to iterate BPF programs: %w", err)
+		}
+
+		prog, err := ebpf.NewProgramFromID(id)
+		if err != nil {
+			// The program may have been unloaded since the ID was
+			// obtained.
+			continue
+		}
+		info, err := prog.Info()
+		prog.Close()
+		if err != nil {
+			continue
+		}
+
+		if !strings.HasPrefix(info.Name, ciliumProgramPrefix) {
+			continue
+		}
+
+		insns, ok := info.VerifiedInstructions()
+		if !ok {
+			continue
+		}
+		if result == nil || insns > result.VerifiedInstructions {
+			result = &VerifierComplexity{
+				ProgramName:          info.Name,
+				VerifiedInstructions: insns,
+				InstructionLimit:     VerifierInstructionLimit,
+			}
+		}
+	}
+}
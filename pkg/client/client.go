@@ -13,6 +13,7 @@ import (
 	"net/url"
 	"os"
 	"slices"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 	"time"
@@ -222,6 +223,18 @@ func statusUnhealthy(s *models.Status) bool {
 	return false
 }
 
+// formatSubChecks renders the individual checks of a multi-check section
+// indented beneath the section line, one check per line with its own state.
+// Nothing is printed while the section as a whole is healthy.
+func formatSubChecks(w io.Writer, s *models.Status) {
+	if s == nil || !stateUnhealthy(s.State) {
+		return
+	}
+	for _, sc := range s.SubChecks {
+		fmt.Fprintf(w, "  %s:\t%s\t%s\n", sc.Name, sc.State, sc.Msg)
+	}
+}
+
 // FormatStatusResponseBrief writes a one-line status to the writer. If
 // everything ok, this is "ok", otherwise a message of the form "error in ..."
 func FormatStatusResponseBrief(w io.Writer, sr *models.StatusResponse) {
@@ -268,6 +281,18 @@ func clusterReadiness(cluster *models.RemoteCluster) string {
 	return "ready"
 }
 
+// sysctlMismatches returns the sysctl parameters whose current value deviates
+// from the one Cilium expects.
+func sysctlMismatches(sysctls []*models.SysctlStatus) []*models.SysctlStatus {
+	var mismatches []*models.SysctlStatus
+	for _, s := range sysctls {
+		if s.Mismatch {
+			mismatches = append(mismatches, s)
+		}
+	}
+	return mismatches
+}
+
 func NumReadyClusters(clusters []*models.RemoteCluster) int {
 	numReady := 0
 	for _, cluster := range clusters {
@@ -278,6 +303,19 @@ func NumReadyClusters(clusters []*models.RemoteCluster) int {
 	return numReady
 }
 
+const (
+	// minRecommendedMTU is the IPv6 minimum link MTU. If the MTU usable by
+	// pods after subtracting encapsulation overhead falls below it,
+	// fragmentation or connectivity problems are likely.
+	minRecommendedMTU = 1280
+
+	// ipsecOverhead and wireguardOverhead approximate the encapsulation
+	// overhead of the respective encryption modes. They mirror the values
+	// in pkg/mtu, which this package cannot import.
+	ipsecOverhead     = 77
+	wireguardOverhead = 80
+)
+
 type StatusDetails struct {
 	// AllAddress causes all addresses to be printed by FormatStatusResponse.
 	AllAddresses bool
@@ -295,6 +333,15 @@ type StatusDetails struct {
 	KubeProxyReplacementDetails bool
 	// ClockSourceDetails causes BPF time-keeping internals to be printed by FormatStatusResponse.
 	ClockSourceDetails bool
+	// RoutingDetails causes routing internals such as the direct routing
+	// device MTU to be printed by FormatStatusResponse.
+	RoutingDetails bool
+	// RestoreDetails causes the outcome of the endpoint restore performed
+	// at startup to be printed by FormatStatusResponse.
+	RestoreDetails bool
+	// SectionReasons causes the reason why a feature is disabled to be printed
+	// by FormatStatusResponse.
+	SectionReasons bool
 }
 
 var (
@@ -311,6 +358,9 @@ var (
 		BPFMapDetails:               true,
 		KubeProxyReplacementDetails: true,
 		ClockSourceDetails:          true,
+		RoutingDetails:              true,
+		RestoreDetails:              true,
+		SectionReasons:              true,
 	}
 )
 
@@ -318,8 +368,18 @@ var (
 // whether a additional details are printed about a certain aspect of the status. In case there are
 // errors, some details may be printed regardless of the value of sd.
 func FormatStatusResponse(w io.Writer, sr *models.StatusResponse, sd StatusDetails) {
+	// reason annotates a disabled feature with the reason reported by the
+	// agent, but only when section reasons were requested.
+	reason := func(r string) string {
+		if sd.SectionReasons && r != "" {
+			return "\t(" + r + ")"
+		}
+		return ""
+	}
+
 	if sr.Kvstore != nil {
 		fmt.Fprintf(w, "KVStore:\t%s\t%s\n", sr.Kvstore.State, sr.Kvstore.Msg)
+		formatSubChecks(w, sr.Kvstore)
 	}
 	if sr.ContainerRuntime != nil {
 		fmt.Fprintf(w, "ContainerRuntime:\t%s\t%s\n",
@@ -333,6 +393,17 @@ func FormatStatusResponse(w io.Writer, sr *models.StatusResponse, sd StatusDetai
 			slices.Sort(sr.Kubernetes.K8sAPIVersions)
 			fmt.Fprintf(w, "Kubernetes APIs:\t[\"%s\"]\n", strings.Join(sr.Kubernetes.K8sAPIVersions, "\", \""))
 		}
+		for _, f := range sr.Kubernetes.Families {
+			primary := ""
+			if f.Primary {
+				primary = " (primary)"
+			}
+			if f.State == models.StatusStateOk {
+				fmt.Fprintf(w, "Kubernetes %s%s:\t%s\t%s\n", f.Family, primary, f.State, time.Duration(f.Latency))
+			} else {
+				fmt.Fprintf(w, "Kubernetes %s%s:\t%s\t%s\n", f.Family, primary, f.State, f.Msg)
+			}
+		}
 
 	}
 	if sr.KubeProxyReplacement != nil {
@@ -358,6 +429,8 @@ func FormatStatusResponse(w io.Writer, sr *models.StatusResponse, sd StatusDetai
 		fmt.Fprintf(w, "Host firewall:\t%s", sr.HostFirewall.Mode)
 		if sr.HostFirewall.Mode != models.HostFirewallModeDisabled {
 			fmt.Fprintf(w, "\t[%s]", strings.Join(sr.HostFirewall.Devices, ", "))
+		} else {
+			fmt.Fprint(w, reason(sr.HostFirewall.Reason))
 		}
 		fmt.Fprintf(w, "\n")
 	}
@@ -371,11 +444,55 @@ func FormatStatusResponse(w io.Writer, sr *models.StatusResponse, sd StatusDetai
 		if sr.Srv6.Enabled {
 			fields[0] = "Enabled"
 			fields = append(fields, fmt.Sprintf("[encap-mode: %s]", sr.Srv6.Srv6EncapMode))
+		} else {
+			fields[0] += reason(sr.Srv6.Reason)
 		}
 
 		fmt.Fprintf(w, "SRv6:\t%s\n", strings.Join(fields, "\t"))
 	}
 
+	if sr.Sctp != nil {
+		status := "Disabled"
+		if sr.Sctp.Enabled {
+			status = "Enabled"
+			if !sr.Sctp.DatapathEnabled {
+				status = "Enabled\t[warning: not enabled in datapath]"
+			}
+		}
+		fmt.Fprintf(w, "SCTP:\t%s\n", status)
+	}
+
+	if sr.IPConflicts != nil && sr.IPConflicts.Status != nil && sr.IPConflicts.Status.State != models.StatusStateOk {
+		fmt.Fprintf(w, "IP Conflicts:\t%s\n", sr.IPConflicts.Status.Msg)
+		for _, c := range sr.IPConflicts.Conflicts {
+			claimants := make([]string, 0, len(c.Claimants))
+			for _, cl := range c.Claimants {
+				claimants = append(claimants, fmt.Sprintf("%s (%s)", cl.Owner, cl.Source))
+			}
+			fmt.Fprintf(w, "  %s:\t%s\n", c.IP, strings.Join(claimants, ", "))
+		}
+	}
+
+	if rl := sr.RateLimiter; rl != nil && rl.Status != nil && rl.Status.State != models.StatusStateOk {
+		fmt.Fprintf(w, "Rate Limiter:\t%s\n", rl.Status.Msg)
+		for _, op := range rl.Operations {
+			if op.Rejected > 0 {
+				fmt.Fprintf(w, "  %s:\t%d rejected (limit %.2f/s, mean wait %s, max wait %s)\n",
+					op.Name, op.Rejected, op.Limit, op.MeanWaitDuration, op.MaxWaitDuration)
+			}
+		}
+	}
+
+	if pmp := sr.PolicyMapPressure; pmp != nil && pmp.Status != nil && pmp.Status.State != models.StatusStateOk {
+		fmt.Fprintf(w, "Policy Map Pressure:\t%s\n", pmp.Status.Msg)
+		for _, ep := range pmp.Endpoints {
+			if ep.InsertFailures > 0 {
+				fmt.Fprintf(w, "  %d:\t%d/%d entries in use, %d insert failures\n",
+					ep.EndpointID, ep.Used, pmp.MapMaxEntries, ep.InsertFailures)
+			}
+		}
+	}
+
 	if sr.CniChaining != nil {
 		fmt.Fprintf(w, "CNI Chaining:\t%s\n", sr.CniChaining.Mode)
 	}
@@ -385,7 +502,11 @@ func FormatStatusResponse(w io.Writer, sr *models.StatusResponse, sd StatusDetai
 	}
 
 	if sr.Cilium != nil {
-		fmt.Fprintf(w, "Cilium:\t%s   %s\n", sr.Cilium.State, sr.Cilium.Msg)
+		uptime := ""
+		if sr.Uptime != "" {
+			uptime = fmt.Sprintf("   (up %s)", sr.Uptime)
+		}
+		fmt.Fprintf(w, "Cilium:\t%s   %s%s\n", sr.Cilium.State, sr.Cilium.Msg, uptime)
 	}
 
 	if sr.Stale != nil {
@@ -436,8 +557,9 @@ func FormatStatusResponse(w io.Writer, sr *models.StatusResponse, sd StatusDetai
 	}
 
 	if sr.ClusterMesh != nil {
-		fmt.Fprintf(w, "ClusterMesh:\t%d/%d remote clusters ready, %d global-services\n",
-			NumReadyClusters(sr.ClusterMesh.Clusters), len(sr.ClusterMesh.Clusters), sr.ClusterMesh.NumGlobalServices)
+		fmt.Fprintf(w, "ClusterMesh:\t%d/%d remote clusters ready, %d global-services from %d clusters\n",
+			NumReadyClusters(sr.ClusterMesh.Clusters), len(sr.ClusterMesh.Clusters),
+			sr.ClusterMesh.NumGlobalServices, sr.ClusterMesh.NumContributingClusters)
 
 		verbosity := RemoteClustersStatusNotReadyOnly
 		if sd.AllClusters {
@@ -448,7 +570,7 @@ func FormatStatusResponse(w io.Writer, sr *models.StatusResponse, sd StatusDetai
 	}
 
 	if sr.IPV4BigTCP != nil {
-		status := "Disabled"
+		status := "Disabled" + reason(sr.IPV4BigTCP.Reason)
 		if sr.IPV4BigTCP.Enabled {
 			max := fmt.Sprintf("[%d]", sr.IPV4BigTCP.MaxGSO)
 			if sr.IPV4BigTCP.MaxGRO != sr.IPV4BigTCP.MaxGSO {
@@ -460,7 +582,7 @@ func FormatStatusResponse(w io.Writer, sr *models.StatusResponse, sd StatusDetai
 	}
 
 	if sr.IPV6BigTCP != nil {
-		status := "Disabled"
+		status := "Disabled" + reason(sr.IPV6BigTCP.Reason)
 		if sr.IPV6BigTCP.Enabled {
 			max := fmt.Sprintf("[%d]", sr.IPV6BigTCP.MaxGSO)
 			if sr.IPV6BigTCP.MaxGRO != sr.IPV6BigTCP.MaxGSO {
@@ -474,7 +596,7 @@ func FormatStatusResponse(w io.Writer, sr *models.StatusResponse, sd StatusDetai
 	if sr.BandwidthManager != nil {
 		var status string
 		if !sr.BandwidthManager.Enabled {
-			status = "Disabled"
+			status = "Disabled" + reason(sr.BandwidthManager.Reason)
 		} else {
 			status = fmt.Sprintf("EDT with BPF [%s] [%s]",
 				strings.ToUpper(sr.BandwidthManager.CongestionControl),
@@ -490,9 +612,45 @@ func FormatStatusResponse(w io.Writer, sr *models.StatusResponse, sd StatusDetai
 		}
 		status = status + "\tHost: " + sr.Routing.IntraHostRoutingMode
 
+		if sd.RoutingDetails && sr.Routing.DirectRoutingDeviceMTU > 0 {
+			status = status + fmt.Sprintf("\tDirectRoutingDeviceMTU: %d", sr.Routing.DirectRoutingDeviceMTU)
+			overhead := int64(0)
+			if sr.Encryption != nil {
+				switch sr.Encryption.Mode {
+				case models.EncryptionStatusModeIPsec:
+					overhead = ipsecOverhead
+				case models.EncryptionStatusModeWireguard:
+					overhead = wireguardOverhead
+				}
+			}
+			if sr.Routing.DirectRoutingDeviceMTU-overhead < minRecommendedMTU {
+				status = status + fmt.Sprintf(" (warning: below the recommended minimum of %d)",
+					minRecommendedMTU+overhead)
+			}
+		}
+
 		fmt.Fprintf(w, "Routing:\t%s\n", status)
 	}
 
+	if bv := sr.BpfVerifier; bv != nil && bv.InstructionLimit > 0 {
+		utilization := float64(bv.VerifiedInstructions) / float64(bv.InstructionLimit)
+		status := fmt.Sprintf("%d/%d instructions (%.1f%%) [%s]",
+			bv.VerifiedInstructions, bv.InstructionLimit, utilization*100, bv.ProgramName)
+		if utilization > 0.9 {
+			status += " (warning: approaching verifier limit)"
+		}
+		fmt.Fprintf(w, "BPF Complexity:\t%s\n", status)
+	}
+
+	if tc := sr.TunnelConsistency; tc != nil {
+		total := len(tc.Missing) + len(tc.Orphaned) + len(tc.Mismatched)
+		if total > 0 {
+			fmt.Fprintf(w, "Tunnel Map:\t%d inconsistent entries (%d missing, %d orphaned, %d mismatched)\n",
+				total, len(tc.Missing), len(tc.Orphaned), len(tc.Mismatched))
+			formatSubChecks(w, tc.Status)
+		}
+	}
+
 	if sr.AttachMode != "" {
 		status := "Legacy TC"
 		if sr.AttachMode == models.AttachModeTcx {
@@ -557,7 +715,31 @@ func FormatStatusResponse(w io.Writer, sr *models.StatusResponse, sd StatusDetai
 			status = fmt.Sprintf("%s [IPv4: %s, IPv6: %s]", status,
 				enabled(sr.Masquerading.EnabledProtocols.IPV4), enabled(sr.Masquerading.EnabledProtocols.IPV6))
 		}
-		fmt.Fprintf(w, "Masquerading:\t%s\n", status)
+		fmt.Fprintf(w, "Masquerading:\t%s%s\n", status, reason(sr.Masquerading.Reason))
+		if sr.Masquerading.SnatPortRange != "" {
+			fmt.Fprintf(w, "  SNAT Port Range:\t%s\n", sr.Masquerading.SnatPortRange)
+		}
+		if st := sr.Masquerading.Status; st != nil && st.State == models.StatusStateWarning {
+			fmt.Fprintf(w, "  Warning:\t%s\n", st.Msg)
+		}
+	}
+
+	if sr.IptablesRules != nil {
+		if n := len(sr.IptablesRules.MissingRules); n > 0 {
+			fmt.Fprintf(w, "Iptables Rules:\t%d expected chains or rules missing\n", n)
+			for _, r := range sr.IptablesRules.MissingRules {
+				fmt.Fprintf(w, "  %s\n", r)
+			}
+		} else {
+			fmt.Fprintf(w, "Iptables Rules:\tOK\n")
+		}
+	}
+
+	if mismatches := sysctlMismatches(sr.Sysctls); len(mismatches) > 0 {
+		fmt.Fprintf(w, "Sysctl Mismatches:\t%d parameters deviate from the expected values\n", len(mismatches))
+		for _, s := range mismatches {
+			fmt.Fprintf(w, "  %s:\tcurrent %q, expected %q\n", s.Name, s.CurrentValue, s.ExpectedValue)
+		}
 	}
 
 	if sd.ClockSourceDetails && sr.ClockSource != nil {
@@ -608,9 +790,113 @@ func FormatStatusResponse(w io.Writer, sr *models.StatusResponse, sd StatusDetai
 
 	}
 
+	if sr.Modules != nil {
+		fmt.Fprintf(w, "Modules Health:\tStopped(%d) Degraded(%d) OK(%d)\n",
+			sr.Modules.Stopped, sr.Modules.Degraded, sr.Modules.Ok)
+		if len(sr.Modules.DegradedModules) > 0 {
+			tab := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+			fmt.Fprint(tab, "  Module\tLast OK\tMessage\n")
+			for _, m := range sr.Modules.DegradedModules {
+				fmt.Fprintf(tab, "  %s\t%s\t%s\n", m.ModuleID, m.LastOk, m.Message)
+			}
+			tab.Flush()
+		}
+	}
+
+	if cc := sr.ConfigConsistency; cc != nil {
+		if len(cc.Mismatches) == 0 {
+			fmt.Fprintf(w, "Config Consistency:\tOK (%d peers checked)\n", cc.CheckedNodes)
+		} else {
+			fmt.Fprintf(w, "Config Consistency:\t%d setting(s) diverge across the cluster\n", len(cc.Mismatches))
+			tab := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+			fmt.Fprint(tab, "  Setting\tLocal Value\tDivergent Nodes\tExamples\n")
+			for _, m := range cc.Mismatches {
+				fmt.Fprintf(tab, "  %s\t%s\t%d\t%s\n",
+					m.Setting, m.LocalValue, m.DivergentNodes, strings.Join(m.ExampleNodes, ", "))
+			}
+			tab.Flush()
+		}
+	}
+
+	if sr.Restore != nil {
+		if sd.RestoreDetails {
+			fmt.Fprintf(w, "Endpoint Restore:\t%d restored, %d failed, %d deleted, took %s (completed %s)\n",
+				sr.Restore.Restored, sr.Restore.Failed, sr.Restore.Deleted,
+				time.Duration(sr.Restore.Duration), sr.Restore.Timestamp)
+		}
+		if sr.Restore.Failed > 0 && len(sr.Restore.FailureReasons) > 0 {
+			top := sr.Restore.FailureReasons[0]
+			fmt.Fprintf(w, "Endpoint Restore Warning:\t%d endpoint(s) failed to restore, most common reason: %s (%d)\n",
+				sr.Restore.Failed, top.Reason, top.Count)
+		}
+	}
+
+	if pr := sr.PolicyRecalculation; pr != nil {
+		if pr.InProgress {
+			fmt.Fprintf(w, "Policy Recalculation:\tIn progress (started %s, reason: %s)\n",
+				pr.Timestamp, pr.Reason)
+		} else {
+			fmt.Fprintf(w, "Policy Recalculation:\tComplete, last run %s took %s (reason: %s)\n",
+				pr.Timestamp, time.Duration(pr.Duration), pr.Reason)
+		}
+	}
+
+	if nt := sr.NeighborTable; nt != nil {
+		line := fmt.Sprintf("Neighbor Table:\t%d entries", nt.Entries)
+		if nt.Failed > 0 || nt.Incomplete > 0 {
+			line += fmt.Sprintf(", %d failed, %d incomplete", nt.Failed, nt.Incomplete)
+		}
+		if nt.LastRefresh != "" {
+			line += fmt.Sprintf(", last refresh %s", nt.LastRefresh)
+		}
+		fmt.Fprintln(w, line)
+	}
+
+	if nc := sr.NeighborConsistency; nc != nil {
+		if total := len(nc.Missing) + len(nc.Stale); total > 0 {
+			fmt.Fprintf(w, "Neighbor Consistency:\t%d nodes with missing or stale entries (%d missing, %d stale)\n",
+				total, len(nc.Missing), len(nc.Stale))
+			formatSubChecks(w, nc.Status)
+		}
+	}
+
+	if len(sr.ServiceBackends) > 0 {
+		fmt.Fprintf(w, "Service Backends:\t%d services\n", len(sr.ServiceBackends))
+		for _, svc := range sr.ServiceBackends {
+			name := svc.Name
+			if name != "" {
+				name = " (" + name + ")"
+			}
+			fmt.Fprintf(w, "  %s%s:\n", svc.FrontendAddress, name)
+			for _, be := range svc.Backends {
+				fmt.Fprintf(w, "    %s\tweight %d\t%s\n", be.Address, be.Weight, be.State)
+			}
+		}
+	}
+
 	if sr.Proxy != nil {
-		fmt.Fprintf(w, "Proxy Status:\tOK, ip %s, %d redirects active on ports %s, Envoy: %s\n",
-			sr.Proxy.IP, sr.Proxy.TotalRedirects, sr.Proxy.PortRange, sr.Proxy.EnvoyDeploymentMode)
+		proxyState := "OK"
+		if ea := sr.Proxy.EnvoyAdmin; ea != nil && ea.Status != nil && ea.Status.State == models.StatusStateFailure {
+			proxyState = "Failure"
+		}
+		fmt.Fprintf(w, "Proxy Status:\t%s, ip %s, %d redirects active on ports %s, Envoy: %s\n",
+			proxyState, sr.Proxy.IP, sr.Proxy.TotalRedirects, sr.Proxy.PortRange, sr.Proxy.EnvoyDeploymentMode)
+		if ea := sr.Proxy.EnvoyAdmin; ea != nil {
+			if ea.Status != nil && ea.Status.State == models.StatusStateFailure {
+				fmt.Fprintf(w, "  Envoy Admin:\t%s\n", ea.Status.Msg)
+			} else if ea.Version != "" {
+				fmt.Fprintf(w, "  Envoy Admin:\tOK, version %s, uptime %s\n",
+					ea.Version, (time.Duration(ea.UptimeSeconds) * time.Second).String())
+			}
+		}
+		if ri := sr.Proxy.RedirectInconsistencies; ri != nil && ri.EndpointCount > 0 {
+			ids := make([]string, 0, len(ri.EndpointIds))
+			for _, id := range ri.EndpointIds {
+				ids = append(ids, strconv.FormatInt(id, 10))
+			}
+			fmt.Fprintf(w, "  Inconsistent Redirects:\t%d endpoints (%s)\n",
+				ri.EndpointCount, strings.Join(ids, ", "))
+		}
 		if sd.AllRedirects && sr.Proxy.TotalRedirects > 0 {
 			out := make([]string, 0, len(sr.Proxy.Redirects)+1)
 			for _, r := range sr.Proxy.Redirects {
@@ -635,6 +921,14 @@ func FormatStatusResponse(w io.Writer, sr *models.StatusResponse, sd StatusDetai
 		fmt.Fprintf(w, "Global Identity Range:\tUnknown\n")
 	}
 
+	if dist := sr.IdentityDistribution; dist != nil && dist.TotalIdentities > 0 {
+		fmt.Fprintf(w, "Local Identity Usage:\t%d identities in use by endpoints\n", dist.TotalIdentities)
+		for _, id := range dist.Identities {
+			fmt.Fprintf(w, "  %d:\t%d endpoints [%s]\n",
+				id.Identity, id.EndpointCount, strings.Join(id.Labels, " "))
+		}
+	}
+
 	if sr.Hubble != nil {
 		var fields []string
 
@@ -692,6 +986,11 @@ func FormatStatusResponse(w io.Writer, sr *models.StatusResponse, sd StatusDetai
 			affinity = "Enabled"
 		}
 
+		srcRange := "Disabled"
+		if src := sr.KubeProxyReplacement.Features.SourceRangeCheck; src != nil && src.Enabled {
+			srcRange = fmt.Sprintf("Enabled (%d restricted services)", src.RestrictedServices)
+		}
+
 		hPort := "Disabled"
 		if sr.KubeProxyReplacement.Features.HostPort.Enabled {
 			hPort = "Enabled"
@@ -705,6 +1004,15 @@ func FormatStatusResponse(w io.Writer, sr *models.StatusResponse, sd StatusDetai
 		socketLB := "Disabled"
 		if slb := sr.KubeProxyReplacement.Features.SocketLB; slb.Enabled {
 			socketLB = "Enabled"
+			if slb.TCPTranslations > 0 || slb.UDPTranslations > 0 || slb.NoBackendConnections > 0 ||
+				slb.HostnsOnlyRejectedConnections > 0 {
+				socketLB += fmt.Sprintf(" [%d TCP, %d UDP translations, %d no backend, %d hostns-only rejected]",
+					slb.TCPTranslations, slb.UDPTranslations,
+					slb.NoBackendConnections, slb.HostnsOnlyRejectedConnections)
+			}
+			if slb.Warning != "" {
+				socketLB += fmt.Sprintf(" (warning: %s)", slb.Warning)
+			}
 		}
 
 		socketLBTracing := "Disabled"
@@ -751,6 +1059,10 @@ func FormatStatusResponse(w io.Writer, sr *models.StatusResponse, sd StatusDetai
 			fmt.Fprintf(tab, "  Backend Selection:\t%s\n", selection)
 		}
 		fmt.Fprintf(tab, "  Session Affinity:\t%s\n", affinity)
+		fmt.Fprintf(tab, "  Source Range Check:\t%s\n", srcRange)
+		if nt := sr.KubeProxyReplacement.NatTableUtilization; nt > 0 {
+			fmt.Fprintf(tab, "  NAT Table Utilization:\t%.1f%%\n", nt*100)
+		}
 		if nat46X64 == "Disabled" {
 			fmt.Fprintf(tab, "  NAT46/64 Support:\t%s\n", nat46X64)
 		} else {
@@ -763,6 +1075,18 @@ func FormatStatusResponse(w io.Writer, sr *models.StatusResponse, sd StatusDetai
 		}
 		if xdp != "" {
 			fmt.Fprintf(tab, "  XDP Acceleration:\t%s\n", xdp)
+			if np := sr.KubeProxyReplacement.Features.NodePort; np != nil {
+				if as := np.AccelerationStatus; as != nil && as.State == models.StatusStateWarning {
+					fmt.Fprintf(tab, "    Warning:\t%s\n", as.Msg)
+				}
+				for _, dev := range np.AccelerationDevices {
+					line := fmt.Sprintf("    %s:\t%s", dev.Device, dev.AttachedMode)
+					if dev.FallbackReason != "" {
+						line += fmt.Sprintf(" (%s requested, %s)", dev.RequestedMode, dev.FallbackReason)
+					}
+					fmt.Fprintf(tab, "%s\n", line)
+				}
+			}
 		}
 		fmt.Fprintf(tab, "  Services:\n")
 		fmt.Fprintf(tab, "  - ClusterIP:\t%s\n", cIP)
@@ -789,17 +1113,46 @@ func FormatStatusResponse(w io.Writer, sr *models.StatusResponse, sd StatusDetai
 		}
 		fmt.Fprintf(w, "BPF Maps:\tdynamic sizing: %s\n", dynamicSizingStatus)
 		tab := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
-		fmt.Fprintf(tab, "  Name\tSize\n")
+		fmt.Fprintf(tab, "  Name\tUsed\tSize\n")
 		for _, m := range sr.BpfMaps.Maps {
-			fmt.Fprintf(tab, "  %s\t%d\n", m.Name, m.Size)
+			used := "-"
+			if m.Used != nil {
+				used = strconv.FormatInt(*m.Used, 10)
+			}
+			fmt.Fprintf(tab, "  %s\t%s\t%d\n", m.Name, used, m.Size)
 		}
 		tab.Flush()
 	}
 
+	if len(sr.MapPressure) > 0 && sd.BPFMapDetails {
+		fmt.Fprintf(w, "Map Pressure:\n")
+		for _, e := range sr.MapPressure {
+			trend := "not trending to full"
+			if e.TrendingToFull {
+				trend = fmt.Sprintf("full in ~%s", time.Duration(e.TimeToFullSeconds)*time.Second)
+			}
+			fmt.Fprintf(w, "  %s:\t%.2f%% (GC deleting %.1f entries/s, %s)\n",
+				e.Name, e.FillRatio*100, e.DeletionRate, trend)
+		}
+	}
+
+	if fc := sr.FqdnCache; fc != nil && (fc.Selectors > 0 || fc.Names > 0) {
+		fmt.Fprintf(w, "FQDN Cache:\t%d selectors, %d names, %d IPs, zombies %d alive/%d deleted\n",
+			fc.Selectors, fc.Names, fc.Ips, fc.AliveZombies, fc.DeletedZombies)
+		if len(fc.OverLimitEndpointIds) > 0 {
+			fmt.Fprintf(w, "  Warning:\t%d endpoints exceed the per-hostname IP limit\n",
+				len(fc.OverLimitEndpointIds))
+		}
+	}
+
 	if sr.Encryption != nil {
 		var fields []string
 
-		if sr.Encryption.Msg != "" {
+		if sr.Encryption.Mode == models.EncryptionStatusModeDisabled {
+			if sd.SectionReasons && sr.Encryption.Msg != "" {
+				fields = append(fields, "("+sr.Encryption.Msg+")")
+			}
+		} else if sr.Encryption.Msg != "" {
 			fields = append(fields, sr.Encryption.Msg)
 		} else if wg := sr.Encryption.Wireguard; wg != nil {
 			fields = append(fields, fmt.Sprintf("[NodeEncryption: %s", wg.NodeEncryption))
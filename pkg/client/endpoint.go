@@ -20,6 +20,27 @@ func (c *Client) EndpointList() ([]*models.Endpoint, error) {
 	return resp.Payload, nil
 }
 
+// EndpointListPages returns the complete endpoint list, fetching it from the
+// daemon in pages of the given size so that the daemon never has to assemble
+// the full list in a single response.
+func (c *Client) EndpointListPages(pageSize int64) ([]*models.Endpoint, error) {
+	params := endpoint.NewGetEndpointParams().WithLimit(&pageSize).WithTimeout(api.ClientTimeout)
+
+	var eps []*models.Endpoint
+	for {
+		resp, err := c.Endpoint.GetEndpoint(params)
+		if err != nil {
+			return nil, Hint(err)
+		}
+		eps = append(eps, resp.Payload...)
+		if resp.XCiliumListContinue == "" {
+			return eps, nil
+		}
+		continueToken := resp.XCiliumListContinue
+		params.SetContinue(&continueToken)
+	}
+}
+
 // EndpointDeleteMany deletes multiple endpoints
 func (c *Client) EndpointDeleteMany(req *models.EndpointBatchDeleteRequest) error {
 	params := endpoint.NewDeleteEndpointParams().WithEndpoint(req).WithTimeout(api.ClientTimeout)
@@ -75,6 +96,17 @@ func (c *Client) EndpointLogGet(id string) (models.EndpointStatusLog, error) {
 	return resp.Payload, nil
 }
 
+// EndpointGCHistory returns the results of the most recent endpoint garbage
+// collection cycles
+func (c *Client) EndpointGCHistory() ([]*models.EndpointGCCycle, error) {
+	params := endpoint.NewGetEndpointGcParams().WithTimeout(api.ClientTimeout)
+	resp, err := c.Endpoint.GetEndpointGc(params)
+	if err != nil {
+		return nil, Hint(err)
+	}
+	return resp.Payload, nil
+}
+
 // EndpointHealthGet returns endpoint healthz
 func (c *Client) EndpointHealthGet(id string) (*models.EndpointHealth, error) {
 	params := endpoint.NewGetEndpointIDHealthzParams().WithID(id).WithTimeout(api.ClientTimeout)
@@ -269,7 +269,8 @@ func (cm *ClusterMesh) synced(ctx context.Context, toWaitFn func(*remoteCluster)
 // Status returns the status of the ClusterMesh subsystem
 func (cm *ClusterMesh) Status() (status *models.ClusterMeshStatus) {
 	status = &models.ClusterMeshStatus{
-		NumGlobalServices: int64(cm.globalServices.Size()),
+		NumGlobalServices:       int64(cm.globalServices.Size()),
+		NumContributingClusters: int64(cm.globalServices.ContributingClusters()),
 	}
 
 	cm.common.ForEachRemoteCluster(func(rci common.RemoteCluster) error {
@@ -165,6 +165,21 @@ func (c *GlobalServiceCache) Size() (num int) {
 	return
 }
 
+// ContributingClusters returns the number of distinct clusters contributing
+// backends to at least one global service in the cache.
+func (c *GlobalServiceCache) ContributingClusters() int {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	clusters := make(map[string]struct{})
+	for _, globalSvc := range c.byName {
+		for cluster := range globalSvc.ClusterServices {
+			clusters[cluster] = struct{}{}
+		}
+	}
+	return len(clusters)
+}
+
 type remoteServiceObserver struct {
 	log *slog.Logger
 
@@ -76,3 +76,22 @@ func TestRemoteServiceObserver(t *testing.T) {
 	require.Equal(t, 1, upstream.deleted[svc1.String()])
 	require.Equal(t, 0, cache.Size())
 }
+
+func TestGlobalServiceCacheContributingClusters(t *testing.T) {
+	cache := NewGlobalServiceCache(hivetest.Logger(t), metrics.NoOpGauge)
+	require.Equal(t, 0, cache.ContributingClusters())
+
+	// Two services shared by the same cluster count as one contributor.
+	cache.OnUpdate(&serviceStore.ClusterService{Cluster: "cluster1", Namespace: "namespace", Name: "name1"})
+	cache.OnUpdate(&serviceStore.ClusterService{Cluster: "cluster1", Namespace: "namespace", Name: "name2"})
+	require.Equal(t, 2, cache.Size())
+	require.Equal(t, 1, cache.ContributingClusters())
+
+	// A second cluster contributing to an existing global service.
+	cache.OnUpdate(&serviceStore.ClusterService{Cluster: "cluster2", Namespace: "namespace", Name: "name1"})
+	require.Equal(t, 2, cache.Size())
+	require.Equal(t, 2, cache.ContributingClusters())
+
+	cache.OnDelete(&serviceStore.ClusterService{Cluster: "cluster2", Namespace: "namespace", Name: "name1"})
+	require.Equal(t, 1, cache.ContributingClusters())
+}
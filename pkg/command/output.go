@@ -6,9 +6,11 @@ package command
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"regexp"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
@@ -110,7 +112,7 @@ func recursiveMerge(i1, i2 interface{}) interface{} {
 }
 
 // PrintOutputWithType receives an interface and dump the data using the --output flag.
-// ATM only json, yaml, or jsonpath.
+// ATM only json, yaml, jsonpath, or table.
 func PrintOutputWithType(data interface{}, outputType string) error {
 	if outputType == "json" {
 		return dumpJSON(data, "")
@@ -120,6 +122,10 @@ func PrintOutputWithType(data interface{}, outputType string) error {
 		return dumpYAML(data)
 	}
 
+	if outputType == "table" {
+		return dumpTable(data)
+	}
+
 	if re.MatchString(outputType) {
 		return dumpJSON(data, re.ReplaceAllString(outputType, "$1"))
 	}
@@ -169,6 +175,29 @@ func dumpJSON(data interface{}, jsonPath string) error {
 	return nil
 }
 
+// TablePrinter is implemented by data types that can render themselves as an
+// aligned table for the "table" output mode. The rows are written to a
+// tabwriter, so columns are separated with tab characters and alignment is
+// taken care of by the caller.
+type TablePrinter interface {
+	PrintTable(w io.Writer) error
+}
+
+// dumpTable dumps the data variable to the stdout as an aligned table. It
+// returns an error if the data type does not implement TablePrinter.
+func dumpTable(data interface{}) error {
+	tp, ok := data.(TablePrinter)
+	if !ok {
+		return fmt.Errorf("table output is not supported for this command")
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 5, 0, 3, ' ', 0)
+	if err := tp.PrintTable(w); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
 // dumpYAML dumps the data variable to the stdout as yaml.
 // If something fails, it returns an error
 func dumpYAML(data interface{}) error {
@@ -32,6 +32,10 @@ func (sysctl *Sysctl) ApplySettings(sysSettings []tables.Sysctl) error {
 	return nil
 }
 
+func (sysctl *Sysctl) Settings() []tables.Sysctl {
+	return nil
+}
+
 func (sysctl *Sysctl) Read(name []string) (string, error) {
 	return "", nil
 }
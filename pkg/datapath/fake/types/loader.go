@@ -27,6 +27,10 @@ func (f *FakeLoader) ReinitializeXDP(ctx context.Context, lnc *datapath.LocalNod
 	panic("implement me")
 }
 
+func (f *FakeLoader) XDPDeviceStatus() []datapath.XDPDeviceStatus {
+	return nil
+}
+
 func (f *FakeLoader) EndpointHash(cfg datapath.EndpointConfiguration, _ *datapath.LocalNodeConfiguration) (string, error) {
 	panic("implement me")
 }
@@ -81,6 +81,14 @@ func (n *FakeNodeHandler) InsertMiscNeighbor(newNode *nodeTypes.Node) {
 func (n *FakeNodeHandler) DeleteMiscNeighbor(oldNode *nodeTypes.Node) {
 }
 
+func (n *FakeNodeHandler) NeighborTableHealth() *models.NeighborTable {
+	return &models.NeighborTable{}
+}
+
+func (n *FakeNodeHandler) NeighborConsistency() *models.NeighborConsistency {
+	return &models.NeighborConsistency{}
+}
+
 func (n *FakeNodeHandler) GetNodeIP(_ uint16) string {
 	return ""
 }
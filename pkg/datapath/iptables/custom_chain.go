@@ -216,6 +216,17 @@ func (c *customChain) remove(ipv4, ipv6 bool) error {
 	return nil
 }
 
+// feederExists returns whether the feeder rule hooking the custom chain into
+// its built-in chain is present. Errors from the check command are treated as
+// the rule being absent, as iptables reports a missing rule through a non-zero
+// exit status.
+func (c *customChain) feederExists(prog runnable) bool {
+	feedRule := []string{"-m", "comment", "--comment", feederDescription + " " + c.name, "-j", c.name}
+	args := append([]string{"-t", c.table, "-C", c.hook}, feedRule...)
+
+	return prog.runProg(args) == nil
+}
+
 func (c *customChain) doInstallFeeder(prog iptablesInterface, prepend bool) error {
 	installMode := "-A"
 	if prepend {
@@ -1471,6 +1471,50 @@ func (m *Manager) doInstallRules(state desiredState, firstInit bool) error {
 
 // installRules installs iptables rules for Cilium in specific use-cases
 // (most specifically, interaction with kube-proxy).
+// VerifyInstalledRules checks that the custom chains and feeder rules
+// installed by Cilium are still in place, returning a description of every
+// expected entry that is missing. This allows another entity flushing or
+// deleting Cilium-owned iptables rules to surface explicitly instead of as
+// intermittent connectivity loss.
+func (m *Manager) VerifyInstalledRules() ([]string, error) {
+	if !m.sharedCfg.InstallIptRules {
+		return nil, nil
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	var missing []string
+	for _, c := range ciliumChains {
+		var progs []iptablesInterface
+		if m.sharedCfg.EnableIPv4 {
+			progs = append(progs, ip4tables)
+		}
+		if m.sharedCfg.EnableIPv6 && m.haveIp6tables && c.ipv6 {
+			progs = append(progs, ip6tables)
+		}
+
+		for _, prog := range progs {
+			exists, err := c.exists(prog)
+			if err != nil {
+				return nil, err
+			}
+			if !exists {
+				missing = append(missing, fmt.Sprintf("%s -t %s chain %s", prog.getProg(), c.table, c.name))
+				continue
+			}
+			if isDisabledChain(m.cfg.DisableIptablesFeederRules, c.hook) {
+				continue
+			}
+			if !c.feederExists(prog) {
+				missing = append(missing, fmt.Sprintf("%s -t %s feeder rule %s -> %s", prog.getProg(), c.table, c.hook, c.name))
+			}
+		}
+	}
+
+	return missing, nil
+}
+
 func (m *Manager) installRules(state desiredState) error {
 	// Install new rules
 	for _, c := range ciliumChains {
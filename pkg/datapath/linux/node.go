@@ -23,6 +23,7 @@ import (
 	"golang.org/x/sys/unix"
 	"k8s.io/apimachinery/pkg/util/sets"
 
+	"github.com/cilium/cilium/api/v1/models"
 	"github.com/cilium/cilium/pkg/cidr"
 	cmtypes "github.com/cilium/cilium/pkg/clustermesh/types"
 	"github.com/cilium/cilium/pkg/counter"
@@ -38,6 +39,7 @@ import (
 	ipamOption "github.com/cilium/cilium/pkg/ipam/option"
 	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/maps/neighborsmap"
 	"github.com/cilium/cilium/pkg/maps/nodemap"
 	"github.com/cilium/cilium/pkg/maps/tunnel"
 	"github.com/cilium/cilium/pkg/node"
@@ -1422,6 +1424,147 @@ func (n *linuxNodeHandler) NodeNeighDiscoveryEnabled() bool {
 	return n.enableNeighDiscovery
 }
 
+// NeighborTableHealth reports the health of the kernel neighbor entries
+// managed for node next-hops, so that failing neighbor resolution behind
+// unreachable nodes can be diagnosed from the agent status.
+func (n *linuxNodeHandler) NeighborTableHealth() *models.NeighborTable {
+	n.neighLock.Lock()
+	defer n.neighLock.Unlock()
+
+	health := &models.NeighborTable{
+		Entries: int64(len(n.neighByNextHop)),
+	}
+
+	var lastRefresh time.Time
+	for _, pinged := range n.neighLastPingByNextHop {
+		if pinged.After(lastRefresh) {
+			lastRefresh = pinged
+		}
+	}
+	if !lastRefresh.IsZero() {
+		health.LastRefresh = lastRefresh.Format(time.RFC3339)
+	}
+
+	// The cached entries reflect the state at insertion time, so query the
+	// kernel for the current resolution state of each next hop.
+	states := make(map[string]int, len(n.neighByNextHop))
+	for _, link := range n.neighDiscoveryLinks {
+		for _, family := range []int{netlink.FAMILY_V4, netlink.FAMILY_V6} {
+			neighList, err := safenetlink.NeighList(link.Attrs().Index, family)
+			if err != nil {
+				n.log.Debug("Unable to list neighbor entries",
+					logfields.Device, link.Attrs().Name,
+					logfields.Error, err)
+				continue
+			}
+			for _, neigh := range neighList {
+				states[neigh.IP.String()] = neigh.State
+			}
+		}
+	}
+
+	for nextHop := range n.neighByNextHop {
+		switch states[nextHop] {
+		case netlink.NUD_FAILED:
+			health.Failed++
+		case netlink.NUD_INCOMPLETE:
+			health.Incomplete++
+		}
+	}
+
+	return health
+}
+
+// NeighborConsistency compares the kernel neighbor table against the set of
+// known remote nodes and reports the nodes whose next hop is missing a
+// neighbor entry or failed address resolution. The next hop of every node is
+// re-derived from the current routing decision, so nodes that are not
+// routable via any neighbor discovery link are not expected to have an entry
+// and do not count as missing.
+func (n *linuxNodeHandler) NeighborConsistency() *models.NeighborConsistency {
+	status := &models.NeighborConsistency{
+		BpfEntries: int64(neighborsmap.Count()),
+	}
+
+	n.neighLock.Lock()
+	links := slices.Clone(n.neighDiscoveryLinks)
+	n.neighLock.Unlock()
+
+	if len(links) == 0 {
+		return status
+	}
+
+	n.mutex.RLock()
+	nodes := make([]nodeTypes.Node, 0, len(n.nodes))
+	for _, node := range n.nodes {
+		nodes = append(nodes, *node)
+	}
+	n.mutex.RUnlock()
+
+	// Snapshot the resolution state of the kernel neighbor table on the
+	// discovery links.
+	states := make(map[string]int)
+	for _, link := range links {
+		for _, family := range []int{netlink.FAMILY_V4, netlink.FAMILY_V6} {
+			neighList, err := safenetlink.NeighList(link.Attrs().Index, family)
+			if err != nil {
+				n.log.Debug("Unable to list neighbor entries",
+					logfields.Device, link.Attrs().Name,
+					logfields.Error, err)
+				continue
+			}
+			for _, neigh := range neighList {
+				states[neigh.IP.String()] |= neigh.State
+			}
+		}
+	}
+
+	for _, node := range nodes {
+		if node.IsLocal() {
+			continue
+		}
+
+		var nodeIPs []net.IP
+		if ip := node.GetNodeIP(false); ip.To4() != nil {
+			nodeIPs = append(nodeIPs, ip)
+		}
+		if ip := node.GetNodeIP(true); ip.To4() == nil && ip.To16() != nil {
+			nodeIPs = append(nodeIPs, ip)
+		}
+
+		var missing, stale bool
+		for _, nodeIP := range nodeIPs {
+			for _, link := range links {
+				nextHop, err := getNextHopIP(nodeIP, link)
+				if err != nil {
+					// The node is not reachable through this link, so no
+					// neighbor entry is expected.
+					continue
+				}
+				state, found := states[nextHop.String()]
+				switch {
+				case !found:
+					missing = true
+				case state&(netlink.NUD_FAILED|netlink.NUD_INCOMPLETE) != 0:
+					stale = true
+				}
+			}
+		}
+
+		switch {
+		case missing:
+			status.Missing = append(status.Missing, node.Fullname())
+		case stale:
+			status.Stale = append(status.Stale, node.Fullname())
+		}
+	}
+
+	slices.Sort(status.Missing)
+	slices.Sort(status.Stale)
+
+	return status
+}
+
 // NodeNeighborRefresh is called to refresh node neighbor table.
 // This is currently triggered by controller neighbor-table-refresh
 func (n *linuxNodeHandler) NodeNeighborRefresh(ctx context.Context, nodeToRefresh nodeTypes.Node) error {
@@ -53,6 +53,11 @@ type Sysctl interface {
 	// reconciled, or timeouts after reconciliationTimeout.
 	ApplySettings(sysSettings []tables.Sysctl) error
 
+	// Settings returns the sysctl parameters that have been requested
+	// through this interface so far, together with their desired values.
+	// Implementations that do not track settings return nil.
+	Settings() []tables.Sysctl
+
 	// Read reads the given sysctl parameter.
 	Read(name []string) (string, error)
 
@@ -159,6 +164,14 @@ func (sysctl *reconcilingSysctl) Read(name []string) (string, error) {
 	return val, nil
 }
 
+func (sysctl *reconcilingSysctl) Settings() []tables.Sysctl {
+	var settings []tables.Sysctl
+	for s := range sysctl.settings.All(sysctl.db.ReadTxn()) {
+		settings = append(settings, *s)
+	}
+	return settings
+}
+
 func (sysctl *reconcilingSysctl) ReadInt(name []string) (int64, error) {
 	val, err := sysctl.Read(name)
 	if err != nil {
@@ -235,6 +248,11 @@ func (ay *directSysctl) ApplySettings(sysSettings []tables.Sysctl) error {
 	return nil
 }
 
+func (ay *directSysctl) Settings() []tables.Sysctl {
+	// The direct variant writes through without bookkeeping.
+	return nil
+}
+
 func (ay *directSysctl) Read(name []string) (string, error) {
 	path, err := parameterPath(ay.procFs, name)
 	if err != nil {
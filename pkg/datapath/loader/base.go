@@ -11,6 +11,7 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/cilium/ebpf"
@@ -26,6 +27,7 @@ import (
 	"github.com/cilium/cilium/pkg/datapath/tables"
 	"github.com/cilium/cilium/pkg/datapath/tunnel"
 	datapath "github.com/cilium/cilium/pkg/datapath/types"
+	"github.com/cilium/cilium/pkg/datapath/xdp"
 	"github.com/cilium/cilium/pkg/defaults"
 	ipamOption "github.com/cilium/cilium/pkg/ipam/option"
 	"github.com/cilium/cilium/pkg/logging/logfields"
@@ -291,12 +293,14 @@ func reinitializeWireguard(ctx context.Context, lnc *datapath.LocalNodeConfigura
 	return
 }
 
-func reinitializeXDPLocked(ctx context.Context, lnc *datapath.LocalNodeConfiguration, extraCArgs []string, devices []string) error {
+func (l *loader) reinitializeXDPLocked(ctx context.Context, lnc *datapath.LocalNodeConfiguration, extraCArgs []string, devices []string) error {
 	xdpConfig := lnc.XDPConfig
 	maybeUnloadObsoleteXDPPrograms(devices, xdpConfig.Mode(), bpf.CiliumPath())
 	if xdpConfig.Disabled() {
+		l.setXDPDeviceStatus(nil)
 		return nil
 	}
+	status := make([]datapath.XDPDeviceStatus, 0, len(devices))
 	for _, dev := range devices {
 		// When WG & encrypt-node are on, the devices include cilium_wg0 to attach bpf_host
 		// so that NodePort's rev-{S,D}NAT translations happens for a reply from the remote node.
@@ -306,18 +310,55 @@ func reinitializeXDPLocked(ctx context.Context, lnc *datapath.LocalNodeConfigura
 			continue
 		}
 
-		if err := compileAndLoadXDPProg(ctx, lnc, dev, xdpConfig.Mode(), extraCArgs); err != nil {
+		entry := datapath.XDPDeviceStatus{
+			Device:        dev,
+			RequestedMode: xdpConfig.AccelerationMode(),
+			AttachedMode:  xdpConfig.Mode(),
+		}
+		err := compileAndLoadXDPProg(ctx, lnc, dev, xdpConfig.Mode(), extraCArgs)
+		if err != nil && option.Config.NodePortAcceleration == option.XDPModeBestEffort &&
+			xdpConfig.Mode() == xdp.ModeLinkDriver {
+			// In best-effort mode a device whose driver cannot run the
+			// program natively should still get it, just without the
+			// speedup. Retry in generic mode before giving up on the device.
+			entry.FallbackReason = xdpFallbackReason(err)
+			entry.AttachedMode = xdp.ModeLinkGeneric
+			log.WithError(err).WithField(logfields.Device, dev).
+				Info("Failed to attach XDP program natively, retrying in generic mode due to best-effort")
+			err = compileAndLoadXDPProg(ctx, lnc, dev, xdp.ModeLinkGeneric, extraCArgs)
+		}
+		if err != nil {
 			if option.Config.NodePortAcceleration == option.XDPModeBestEffort {
+				entry.AttachedMode = xdp.ModeLinkNone
+				if entry.FallbackReason == "" {
+					entry.FallbackReason = xdpFallbackReason(err)
+				}
 				log.WithError(err).WithField(logfields.Device, dev).Info("Failed to attach XDP program, ignoring due to best-effort mode")
 			} else {
 				return fmt.Errorf("attaching XDP program to interface %s: %w", dev, err)
 			}
 		}
+		status = append(status, entry)
 	}
+	l.setXDPDeviceStatus(status)
 
 	return nil
 }
 
+func (l *loader) setXDPDeviceStatus(status []datapath.XDPDeviceStatus) {
+	l.xdpStatusMu.Lock()
+	l.xdpStatus = status
+	l.xdpStatusMu.Unlock()
+}
+
+// XDPDeviceStatus returns the per-device outcome of the most recent XDP
+// attachment pass, or nil if XDP is disabled or no pass has run yet.
+func (l *loader) XDPDeviceStatus() []datapath.XDPDeviceStatus {
+	l.xdpStatusMu.Lock()
+	defer l.xdpStatusMu.Unlock()
+	return slices.Clone(l.xdpStatus)
+}
+
 // ReinitializeXDP (re-)configures the XDP datapath only. This includes recompilation
 // and reinsertion of the object into the kernel as well as an atomic program replacement
 // at the XDP hook. extraCArgs can be passed-in in order to alter BPF code defines.
@@ -326,7 +367,7 @@ func (l *loader) ReinitializeXDP(ctx context.Context, lnc *datapath.LocalNodeCon
 	defer l.compilationLock.Unlock()
 	devices := lnc.DeviceNames()
 
-	return reinitializeXDPLocked(ctx, lnc, extraCArgs, devices)
+	return l.reinitializeXDPLocked(ctx, lnc, extraCArgs, devices)
 }
 
 func (l *loader) ReinitializeHostDev(ctx context.Context, mtu int) error {
@@ -461,7 +502,7 @@ func (l *loader) Reinitialize(ctx context.Context, lnc *datapath.LocalNodeConfig
 	}
 
 	extraArgs := []string{"-Dcapture_enabled=0"}
-	if err := reinitializeXDPLocked(ctx, lnc, extraArgs, devices); err != nil {
+	if err := l.reinitializeXDPLocked(ctx, lnc, extraArgs, devices); err != nil {
 		log.WithError(err).Fatal("Failed to compile XDP program")
 	}
 
@@ -84,6 +84,11 @@ type loader struct {
 	hostDpInitializedOnce sync.Once
 	hostDpInitialized     chan struct{}
 
+	// xdpStatusMu guards xdpStatus, which is written by the XDP attachment
+	// pass and read by the status API.
+	xdpStatusMu lock.Mutex
+	xdpStatus   []datapath.XDPDeviceStatus
+
 	sysctl          sysctl.Sysctl
 	prefilter       datapath.PreFilter
 	compilationLock datapath.CompilationLock
@@ -56,6 +56,22 @@ func xdpAttachedModeToFlag(mode uint32) link.XDPAttachFlags {
 	return 0
 }
 
+// xdpFallbackReason maps a failed native XDP attachment to a short,
+// user-facing explanation for the status API. The raw error text is used
+// verbatim if no known driver limitation matches.
+func xdpFallbackReason(err error) string {
+	msg := err.Error()
+	switch {
+	case errors.Is(err, unix.EOPNOTSUPP):
+		return "driver does not support native XDP"
+	case strings.Contains(msg, "frags"):
+		return "driver lacks the multi-buffer support required for this MTU"
+	case strings.Contains(msg, "MTU"):
+		return "device MTU exceeds the driver's XDP limit"
+	}
+	return msg
+}
+
 // maybeUnloadObsoleteXDPPrograms removes bpf_xdp.o from previously used
 // devices.
 //
@@ -80,13 +96,23 @@ func maybeUnloadObsoleteXDPPrograms(xdpDevs []string, xdpMode xdp.Mode, bpffsBas
 
 		used := false
 		for _, xdpDev := range xdpDevs {
-			if link.Attrs().Name == xdpDev &&
-				xdpAttachedModeToFlag(linkxdp.AttachMode) == xdpConfigModeToFlag(xdpMode) {
+			if link.Attrs().Name != xdpDev {
+				continue
+			}
+			if xdpAttachedModeToFlag(linkxdp.AttachMode) == xdpConfigModeToFlag(xdpMode) {
 				// XDP mode matches; don't unload, otherwise we might introduce
 				// intermittent connectivity problems
 				used = true
 				break
 			}
+			if option.Config.NodePortAcceleration == option.XDPModeBestEffort &&
+				xdpAttachedModeToFlag(linkxdp.AttachMode) == xdpConfigModeToFlag(xdp.ModeLinkGeneric) {
+				// In best-effort mode devices without native XDP support
+				// carry the program in generic mode; don't flap it on
+				// reinitialization.
+				used = true
+				break
+			}
 		}
 		if !used {
 			if err := DetachXDP(link.Attrs().Name, bpffsBase, symbolFromHostNetdevXDP); err != nil {
@@ -5,6 +5,7 @@ package loader
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/cilium/ebpf/link"
 	"github.com/stretchr/testify/require"
 	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
 
 	"github.com/cilium/cilium/pkg/bpf"
 	"github.com/cilium/cilium/pkg/option"
@@ -236,3 +238,14 @@ func getLink(tb testing.TB, link netlink.Link) netlink.Link {
 
 	return req
 }
+
+func TestXDPFallbackReason(t *testing.T) {
+	require.Equal(t, "driver does not support native XDP",
+		xdpFallbackReason(fmt.Errorf("attaching program: %w", unix.EOPNOTSUPP)))
+	require.Equal(t, "driver lacks the multi-buffer support required for this MTU",
+		xdpFallbackReason(errors.New("veth: Too many frags requested")))
+	require.Equal(t, "device MTU exceeds the driver's XDP limit",
+		xdpFallbackReason(errors.New("MTU too large for XDP")))
+	require.Equal(t, "some other error",
+		xdpFallbackReason(errors.New("some other error")))
+}
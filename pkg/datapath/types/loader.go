@@ -11,8 +11,28 @@ import (
 
 	"github.com/cilium/cilium/pkg/datapath/loader/metrics"
 	"github.com/cilium/cilium/pkg/datapath/tunnel"
+	"github.com/cilium/cilium/pkg/datapath/xdp"
 )
 
+// XDPDeviceStatus describes the outcome of the most recent XDP attachment
+// attempt on a single network device.
+type XDPDeviceStatus struct {
+	// Device is the network device name.
+	Device string
+
+	// RequestedMode is the acceleration mode that was configured for the
+	// device.
+	RequestedMode xdp.AccelerationMode
+
+	// AttachedMode is the mode the XDP program ended up attached in, or
+	// ModeLinkNone if no program could be attached.
+	AttachedMode xdp.Mode
+
+	// FallbackReason explains why AttachedMode differs from the mode implied
+	// by RequestedMode. Empty if the program attached in the requested mode.
+	FallbackReason string
+}
+
 // Loader is an interface to abstract out loading of datapath programs.
 type Loader interface {
 	CallsMapPath(id uint16) string
@@ -22,6 +42,9 @@ type Loader interface {
 
 	ReloadDatapath(ctx context.Context, ep Endpoint, cfg *LocalNodeConfiguration, stats *metrics.SpanStat) (string, error)
 	ReinitializeXDP(ctx context.Context, cfg *LocalNodeConfiguration, extraCArgs []string) error
+	// XDPDeviceStatus returns the per-device outcome of the most recent XDP
+	// attachment pass, or nil if XDP is disabled or no pass has run yet.
+	XDPDeviceStatus() []XDPDeviceStatus
 	EndpointHash(cfg EndpointConfiguration, lnCfg *LocalNodeConfiguration) (string, error)
 	ReinitializeHostDev(ctx context.Context, mtu int) error
 	Reinitialize(ctx context.Context, cfg *LocalNodeConfiguration, tunnelConfig tunnel.Config, iptMgr IptablesManager, p Proxy) error
@@ -71,6 +94,10 @@ type IptablesManager interface {
 
 	// See comments for InstallNoTrackRules.
 	RemoveNoTrackRules(ip netip.Addr, port uint16)
+
+	// VerifyInstalledRules returns a description of every Cilium-owned
+	// iptables chain or feeder rule that is expected but no longer present.
+	VerifyInstalledRules() ([]string, error)
 }
 
 // CompilationLock is a interface over a mutex, it is used by both the loader, daemon
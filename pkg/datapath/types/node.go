@@ -250,6 +250,15 @@ type NodeNeighbors interface {
 	// This is needed to delete the entries which have been inserted at an earlier
 	// point in time through InsertMiscNeighbor.
 	DeleteMiscNeighbor(oldNode *nodeTypes.Node)
+
+	// NeighborTableHealth reports the health of the kernel neighbor
+	// entries managed for node next-hops.
+	NeighborTableHealth() *models.NeighborTable
+
+	// NeighborConsistency compares the kernel neighbor entries for node
+	// next-hops against the set of known remote nodes and reports nodes
+	// with missing or failed entries.
+	NeighborConsistency() *models.NeighborConsistency
 }
 
 type NodeIDHandler interface {
@@ -188,6 +188,11 @@ const (
 	// is declared as stale
 	StatusCollectorWarningThreshold = 15 * time.Second
 
+	// StatusCollectorLeakThreshold is the number of consecutive failure
+	// threshold intervals after which a probe goroutine that has not
+	// returned is abandoned and the probe is restarted
+	StatusCollectorLeakThreshold = 3
+
 	// StatusCollectorFailureThreshold is the duration after which a probe
 	// is considered failed
 	StatusCollectorFailureThreshold = 1 * time.Minute
@@ -300,6 +305,14 @@ const (
 	// HealthCheckICMPFailureThreshold is the default value for HealthCheckICMPFailureThreshold
 	HealthCheckICMPFailureThreshold = 3
 
+	// HealthCheckTunnelPortSampleSize is the default number of remote nodes
+	// whose tunnel port is probed per probe round
+	HealthCheckTunnelPortSampleSize = 10
+
+	// HealthCheckTunnelPortInterval is the default interval between tunnel
+	// port probe rounds
+	HealthCheckTunnelPortInterval = 60 * time.Second
+
 	// AlignCheckerName is the BPF object name for the alignchecker.
 	AlignCheckerName = "bpf_alignchecker.o"
 
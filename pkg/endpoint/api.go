@@ -12,11 +12,13 @@ import (
 	"slices"
 	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 	"go4.org/netipx"
 
 	"github.com/cilium/cilium/api/v1/models"
+	"github.com/cilium/cilium/pkg/bpf"
 	datapath "github.com/cilium/cilium/pkg/datapath/types"
 	"github.com/cilium/cilium/pkg/endpoint/regeneration"
 	"github.com/cilium/cilium/pkg/identity/cache"
@@ -209,62 +211,149 @@ func (e *Endpoint) getModelCurrentStateRLocked() models.EndpointState {
 	return currentState
 }
 
+// modelFieldNames is the set of field names that can be selected through a
+// ModelFieldMask, matching the JSON field names of the Endpoint model. The
+// endpoint ID and state are always included.
+var modelFieldNames = map[string]struct{}{
+	"spec":                 {},
+	"realized":             {},
+	"identity":             {},
+	"labels":               {},
+	"networking":           {},
+	"external-identifiers": {},
+	"policy":               {},
+	"log":                  {},
+	"controllers":          {},
+	"health":               {},
+	"namedPorts":           {},
+	// The state is always included; accepted here so that callers may name
+	// it explicitly.
+	"state": {},
+}
+
+// ModelFieldMask restricts which fields of the Endpoint model are built. An
+// empty mask selects all fields.
+type ModelFieldMask map[string]struct{}
+
+// ParseModelFieldMask parses a comma-separated list of Endpoint model field
+// names into a ModelFieldMask. It returns an error for unknown field names.
+func ParseModelFieldMask(fields string) (ModelFieldMask, error) {
+	mask := ModelFieldMask{}
+	for field := range strings.SplitSeq(fields, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		if _, ok := modelFieldNames[field]; !ok {
+			return nil, fmt.Errorf("unknown endpoint field %q", field)
+		}
+		mask[field] = struct{}{}
+	}
+	return mask, nil
+}
+
+// has returns whether the given field should be included in the model.
+func (m ModelFieldMask) has(field string) bool {
+	if len(m) == 0 {
+		return true
+	}
+	_, ok := m[field]
+	return ok
+}
+
 // GetModelRLocked returns the API model of endpoint e.
 // e.mutex must be RLocked.
 func (e *Endpoint) GetModelRLocked() *models.Endpoint {
+	return e.getModelMaskedRLocked(nil)
+}
+
+// getModelMaskedRLocked returns the API model of endpoint e, restricted to
+// the fields selected by the given mask. Fields outside the mask are left nil
+// and their computation is skipped entirely, keeping masked model generation
+// cheap on nodes with many endpoints.
+// e.mutex must be RLocked.
+func (e *Endpoint) getModelMaskedRLocked(mask ModelFieldMask) *models.Endpoint {
 	if e == nil {
 		return nil
 	}
 
-	// This returns the most recent log entry for this endpoint. It is backwards
-	// compatible with the json from before we added `cilium endpoint log` but it
-	// only returns 1 entry.
-	statusLog := e.status.GetModel()
-	if len(statusLog) > 0 {
-		statusLog = statusLog[:1]
+	var statusLog models.EndpointStatusLog
+	if mask.has("log") {
+		// This returns the most recent log entry for this endpoint. It is backwards
+		// compatible with the json from before we added `cilium endpoint log` but it
+		// only returns 1 entry.
+		statusLog = e.status.GetModel()
+		if len(statusLog) > 0 {
+			statusLog = statusLog[:1]
+		}
 	}
 
-	lblMdl := model.NewModel(&e.OpLabels)
+	var lblMdl *models.LabelConfigurationStatus
+	var spec *models.EndpointConfigurationSpec
+	if mask.has("labels") || mask.has("spec") || mask.has("realized") {
+		lblMdl = model.NewModel(&e.OpLabels)
 
-	// Sort these slices since they come out in random orders. This allows
-	// reflect.DeepEqual to succeed.
-	sort.StringSlice(lblMdl.Realized.User).Sort()
-	sort.StringSlice(lblMdl.Disabled).Sort()
-	sort.StringSlice(lblMdl.SecurityRelevant).Sort()
-	sort.StringSlice(lblMdl.Derived).Sort()
+		// Sort these slices since they come out in random orders. This allows
+		// reflect.DeepEqual to succeed.
+		sort.StringSlice(lblMdl.Realized.User).Sort()
+		sort.StringSlice(lblMdl.Disabled).Sort()
+		sort.StringSlice(lblMdl.SecurityRelevant).Sort()
+		sort.StringSlice(lblMdl.Derived).Sort()
 
-	controllerMdl := e.controllers.GetStatusModel()
-	sort.Slice(controllerMdl, func(i, j int) bool { return controllerMdl[i].Name < controllerMdl[j].Name })
+		spec = &models.EndpointConfigurationSpec{
+			LabelConfiguration: lblMdl.Realized,
+		}
 
-	spec := &models.EndpointConfigurationSpec{
-		LabelConfiguration: lblMdl.Realized,
+		if e.Options != nil {
+			spec.Options = *e.Options.GetMutableModel()
+		}
 	}
 
-	if e.Options != nil {
-		spec.Options = *e.Options.GetMutableModel()
+	var controllerMdl models.ControllerStatuses
+	if mask.has("controllers") {
+		controllerMdl = e.controllers.GetStatusModel()
+		sort.Slice(controllerMdl, func(i, j int) bool { return controllerMdl[i].Name < controllerMdl[j].Name })
 	}
 
 	mdl := &models.Endpoint{
-		ID:   int64(e.ID),
-		Spec: spec,
+		ID: int64(e.ID),
 		Status: &models.EndpointStatus{
-			// FIXME GH-3280 When we begin implementing revision numbers this will
-			// diverge from models.Endpoint.Spec to reflect the in-datapath config
-			Realized:            spec,
-			Identity:            identitymodel.CreateModel(e.SecurityIdentity),
-			Labels:              lblMdl,
-			Networking:          e.getModelNetworkingRLocked(),
-			ExternalIdentifiers: e.getModelEndpointIdentitiersRLocked(),
-			// FIXME GH-3280 When we begin returning endpoint revisions this should
-			// change to return the configured and in-datapath policies.
-			Policy:      e.GetPolicyModel(),
 			Log:         statusLog,
 			Controllers: controllerMdl,
 			State:       e.getModelCurrentStateRLocked().Pointer(), // TODO: Validate
-			Health:      e.getHealthModel(),
-			NamedPorts:  e.getNamedPortsModel(),
 		},
 	}
+	if mask.has("spec") {
+		mdl.Spec = spec
+	}
+	if mask.has("realized") {
+		// FIXME GH-3280 When we begin implementing revision numbers this will
+		// diverge from models.Endpoint.Spec to reflect the in-datapath config
+		mdl.Status.Realized = spec
+	}
+	if mask.has("identity") {
+		mdl.Status.Identity = identitymodel.CreateModel(e.SecurityIdentity)
+	}
+	if mask.has("labels") {
+		mdl.Status.Labels = lblMdl
+	}
+	if mask.has("networking") {
+		mdl.Status.Networking = e.getModelNetworkingRLocked()
+	}
+	if mask.has("external-identifiers") {
+		mdl.Status.ExternalIdentifiers = e.getModelEndpointIdentitiersRLocked()
+	}
+	if mask.has("policy") {
+		// FIXME GH-3280 When we begin returning endpoint revisions this should
+		// change to return the configured and in-datapath policies.
+		mdl.Status.Policy = e.GetPolicyModel()
+	}
+	if mask.has("health") {
+		mdl.Status.Health = e.getHealthModel()
+	}
+	if mask.has("namedPorts") {
+		mdl.Status.NamedPorts = e.getNamedPortsModel()
+	}
 
 	return mdl
 }
@@ -322,6 +411,7 @@ func (e *Endpoint) getHealthModel() *models.EndpointHealth {
 			OverallHealth: models.EndpointHealthStatusOK,
 		}
 	}
+	h.MissingProxyRedirects = e.missingProxyRedirectsRLocked()
 
 	return &h
 }
@@ -372,14 +462,58 @@ func (e *Endpoint) GetNamedPortsModel() models.NamedPorts {
 
 // GetModel returns the API model of endpoint e.
 func (e *Endpoint) GetModel() *models.Endpoint {
+	return e.GetModelWithFieldMask(nil)
+}
+
+// GetModelWithFieldMask returns the API model of endpoint e, restricted to
+// the fields selected by the given mask. An empty mask selects all fields.
+func (e *Endpoint) GetModelWithFieldMask(mask ModelFieldMask) *models.Endpoint {
+	if e == nil {
+		return nil
+	}
+	// NOTE: Using rlock on mutex directly because getModelMaskedRLocked handles removed endpoint properly
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+
+	return e.getModelMaskedRLocked(mask)
+}
+
+// GetBPFMapsModel returns the BPF maps dedicated to endpoint e, along with
+// their bpffs pin paths and kernel map IDs, so that they can be inspected
+// directly with external tooling such as bpftool.
+func (e *Endpoint) GetBPFMapsModel() []*models.EndpointBPFMap {
 	if e == nil {
 		return nil
 	}
-	// NOTE: Using rlock on mutex directly because GetModelRLocked handles removed endpoint properly
 	e.mutex.RLock()
 	defer e.mutex.RUnlock()
 
-	return e.GetModelRLocked()
+	policyMap := &models.EndpointBPFMap{
+		Name: bpf.LocalMapName(policymap.MapName, e.ID),
+		Path: bpf.LocalMapPath(policymap.MapName, e.ID),
+	}
+	if e.policyMap != nil {
+		policyMap.MapID = int64(e.policyMap.ID())
+	}
+	maps := []*models.EndpointBPFMap{policyMap}
+
+	if e.ConntrackLocalLocked() {
+		for _, m := range ctmap.LocalMaps(e, option.Config.EnableIPv4, option.Config.EnableIPv6) {
+			entry := &models.EndpointBPFMap{
+				Name: m.Name(),
+			}
+			if path, err := m.Path(); err == nil {
+				entry.Path = path
+			}
+			if err := m.Open(); err == nil {
+				entry.MapID = int64(m.ID())
+				m.Close()
+			}
+			maps = append(maps, entry)
+		}
+	}
+
+	return maps
 }
 
 // getIdentities returns the ingress and egress identities stored in the
@@ -450,6 +584,11 @@ func (e *Endpoint) GetPolicyModel() *models.EndpointPolicyStatus {
 		realizedL4Policy = &e.realizedPolicy.L4Policy
 	}
 
+	var policyMapPressure float64
+	if e.policyMap != nil && e.realizedPolicy != nil {
+		policyMapPressure = float64(e.realizedPolicy.Len()) / float64(e.policyMap.MaxEntries())
+	}
+
 	mdl := &models.EndpointPolicy{
 		ID: int64(e.SecurityIdentity.ID),
 		// This field should be removed.
@@ -487,6 +626,7 @@ func (e *Endpoint) GetPolicyModel() *models.EndpointPolicyStatus {
 		Realized:            mdl,
 		ProxyPolicyRevision: int64(e.proxyPolicyRevision),
 		ProxyStatistics:     proxyStats,
+		PolicyMapPressure:   policyMapPressure,
 	}
 }
 
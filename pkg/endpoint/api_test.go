@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package endpoint
+
+import (
+	"testing"
+
+	"github.com/cilium/hive/hivetest"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/cilium/pkg/identity/identitymanager"
+	"github.com/cilium/cilium/pkg/maps/ctmap"
+	"github.com/cilium/cilium/pkg/policy"
+	policyapi "github.com/cilium/cilium/pkg/policy/api"
+	testidentity "github.com/cilium/cilium/pkg/testutils/identity"
+	testipcache "github.com/cilium/cilium/pkg/testutils/ipcache"
+)
+
+func TestParseModelFieldMask(t *testing.T) {
+	// An empty fields parameter selects all fields.
+	mask, err := ParseModelFieldMask("")
+	require.NoError(t, err)
+	require.Empty(t, mask)
+	require.True(t, mask.has("policy"))
+
+	mask, err = ParseModelFieldMask("identity, networking,state")
+	require.NoError(t, err)
+	require.Len(t, mask, 3)
+	require.True(t, mask.has("identity"))
+	require.True(t, mask.has("networking"))
+	require.False(t, mask.has("policy"))
+	require.False(t, mask.has("labels"))
+
+	_, err = ParseModelFieldMask("identity,bogus")
+	require.ErrorContains(t, err, "unknown endpoint field \"bogus\"")
+}
+
+func TestGetModelWithFieldMask(t *testing.T) {
+	repo := policy.NewPolicyRepository(hivetest.Logger(t), nil, nil, nil, nil, policyapi.NewPolicyMetricsNoop())
+	e := NewTestEndpointWithState(nil, nil, nil, nil, nil, nil, nil, identitymanager.NewIDManager(), nil, repo, testipcache.NewMockIPCache(), &FakeEndpointProxy{}, testidentity.NewMockIdentityAllocator(nil), ctmap.NewFakeGCRunner(), 42, StateReady)
+
+	mask, err := ParseModelFieldMask("networking,state")
+	require.NoError(t, err)
+
+	mdl := e.GetModelWithFieldMask(mask)
+	require.EqualValues(t, 42, mdl.ID)
+	require.NotNil(t, mdl.Status.State)
+	require.NotNil(t, mdl.Status.Networking)
+	require.Nil(t, mdl.Spec)
+	require.Nil(t, mdl.Status.Realized)
+	require.Nil(t, mdl.Status.Labels)
+	require.Nil(t, mdl.Status.Health)
+	require.Empty(t, mdl.Status.Log)
+	require.Empty(t, mdl.Status.Controllers)
+
+	// An empty mask is equivalent to GetModel.
+	full := e.GetModelWithFieldMask(nil)
+	require.NotNil(t, full.Spec)
+	require.NotNil(t, full.Status.Realized)
+	require.NotNil(t, full.Status.Labels)
+	require.NotNil(t, full.Status.Health)
+}
@@ -978,6 +978,9 @@ func (e *Endpoint) updatePolicyMapPressureMetric(add float64) {
 		Value:      value,
 		EndpointID: e.ID,
 	})
+	if e.policyMapFactory != nil {
+		e.policyMapFactory.UpdatePressure(e.ID, int(policyLen))
+	}
 }
 
 func (e *Endpoint) deletePolicyKeys(deletes, adds policy.Keys) int {
@@ -1044,6 +1047,9 @@ func (e *Endpoint) addPolicyKey(keyToAdd policy.Key, entry policy.MapStateEntry)
 
 	err := e.policyMap.Update(&policymapKey, &policymapEntry)
 	if err != nil {
+		if e.policyMapFactory != nil {
+			e.policyMapFactory.RecordInsertFailure(e.ID)
+		}
 		e.getLogger().WithError(err).WithFields(logrus.Fields{
 			logfields.BPFMapKey: policymapKey,
 			logfields.Port:      entry.ProxyPort,
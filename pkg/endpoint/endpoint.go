@@ -1202,21 +1202,11 @@ func (e *Endpoint) HasLabels(l labels.Labels) bool {
 // return 'false' if any label in l is not in the endpoint's labels.
 // e.mutex must be RLock()ed.
 func (e *Endpoint) hasLabelsRLocked(l labels.Labels) bool {
-	allEpLabels := e.OpLabels.AllLabels()
-
-	for _, v := range l {
-		found := false
-		for _, j := range allEpLabels {
-			if j.Equals(&v) {
-				found = true
-				break
-			}
-		}
-		if !found {
+	for _, lbl := range l {
+		if !e.OpLabels.HasLabel(lbl) {
 			return false
 		}
 	}
-
 	return true
 }
 
@@ -2075,8 +2065,8 @@ func (e *Endpoint) UpdateLabels(ctx context.Context, sourceFilter string, identi
 		logfields.ContainerID:    e.GetShortContainerID(),
 		logfields.EndpointID:     e.StringID(),
 		logfields.SourceFilter:   sourceFilter,
-		logfields.IdentityLabels: identityLabels.String(),
-		logfields.InfoLabels:     infoLabels.String(),
+		logfields.IdentityLabels: identityLabels.CanonicalString(),
+		logfields.InfoLabels:     infoLabels.CanonicalString(),
 	}).Debug("Refreshing labels of endpoint")
 
 	if err := e.lockAlive(); err != nil {
@@ -2157,7 +2147,7 @@ func (e *Endpoint) runIdentityResolver(ctx context.Context, blocking bool) (rege
 	}
 	newLabels := e.OpLabels.IdentityLabels()
 	e.runlock()
-	scopedLog := e.getLogger().WithField(logfields.IdentityLabels, newLabels)
+	scopedLog := e.getLogger().WithField(logfields.IdentityLabels, newLabels.CanonicalString())
 
 	// If we are certain we can resolve the identity without accessing the KV
 	// store, do it first synchronously right now. This can reduce the number
@@ -2206,7 +2196,7 @@ func (e *Endpoint) identityLabelsChanged(ctx context.Context) (regenTriggered bo
 	myChangeRev := e.identityRevision
 	elog := e.getLogger().WithFields(logrus.Fields{
 		logfields.EndpointID:     e.ID,
-		logfields.IdentityLabels: newLabels,
+		logfields.IdentityLabels: newLabels.CanonicalString(),
 	})
 
 	// Since we unlocked the endpoint and re-locked, the label update may already be obsolete
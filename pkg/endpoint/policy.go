@@ -1046,7 +1046,7 @@ func (e *Endpoint) SetIdentity(identity *identityPkg.Identity, newEndpoint bool)
 		e.getLogger().WithFields(logrus.Fields{
 			logfields.Identity:       identity.StringID(),
 			logfields.OldIdentity:    oldIdentity,
-			logfields.IdentityLabels: identity.Labels.String(),
+			logfields.IdentityLabels: identity.Labels.CanonicalString(),
 		}).Info("Identity of endpoint changed")
 	}
 	e.UpdateLogger(map[string]interface{}{
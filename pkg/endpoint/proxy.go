@@ -6,6 +6,7 @@ package endpoint
 import (
 	"context"
 	"reflect"
+	"slices"
 
 	"github.com/cilium/cilium/pkg/completion"
 	"github.com/cilium/cilium/pkg/policy"
@@ -17,6 +18,7 @@ import (
 type EndpointProxy interface {
 	CreateOrUpdateRedirect(ctx context.Context, l4 policy.ProxyPolicy, id string, epID uint16, wg *completion.WaitGroup) (proxyPort uint16, err error, revertFunc revert.RevertFunc)
 	RemoveRedirect(id string)
+	HasRedirect(id string) bool
 	UpdateNetworkPolicy(ep endpoint.EndpointUpdater, policy *policy.L4Policy, ingressPolicyEnforced, egressPolicyEnforced bool, wg *completion.WaitGroup) (error, func() error)
 	UseCurrentNetworkPolicy(ep endpoint.EndpointUpdater, policy *policy.L4Policy, wg *completion.WaitGroup)
 	RemoveNetworkPolicy(ep endpoint.EndpointInfoSource)
@@ -52,6 +54,11 @@ func (f *FakeEndpointProxy) CreateOrUpdateRedirect(ctx context.Context, l4 polic
 func (f *FakeEndpointProxy) RemoveRedirect(id string) {
 }
 
+// HasRedirect returns false.
+func (f *FakeEndpointProxy) HasRedirect(id string) bool {
+	return false
+}
+
 // UseCurrentNetworkPolicy does nothing.
 func (f *FakeEndpointProxy) UseCurrentNetworkPolicy(ep endpoint.EndpointUpdater, policy *policy.L4Policy, wg *completion.WaitGroup) {
 }
@@ -63,3 +70,52 @@ func (f *FakeEndpointProxy) UpdateNetworkPolicy(ep endpoint.EndpointUpdater, pol
 
 // RemoveNetworkPolicy does nothing.
 func (f *FakeEndpointProxy) RemoveNetworkPolicy(ep endpoint.EndpointInfoSource) {}
+
+// missingProxyRedirectsRLocked returns the proxy redirect IDs required by the
+// endpoint's realized policy which are either unknown to the proxy or have no
+// redirect entry in the endpoint's policy map.
+//
+// Must be called with e.mutex RLock()ed.
+func (e *Endpoint) missingProxyRedirectsRLocked() []string {
+	pol := e.realizedPolicy
+	if pol == nil || len(pol.Redirects) == 0 || e.IsProxyDisabled() {
+		return nil
+	}
+
+	// Proxy ports for which the policy map contains a redirect entry.
+	mapPorts := make(map[uint16]struct{})
+	for _, entry := range pol.Entries() {
+		if entry.ProxyPort != 0 {
+			mapPorts[entry.ProxyPort] = struct{}{}
+		}
+	}
+
+	var missing []string
+	for id, port := range pol.Redirects {
+		if port == 0 {
+			// No datapath redirection expected.
+			continue
+		}
+		if !e.proxy.HasRedirect(id) {
+			missing = append(missing, id)
+			continue
+		}
+		if _, ok := mapPorts[port]; !ok {
+			missing = append(missing, id)
+		}
+	}
+	slices.Sort(missing)
+	return missing
+}
+
+// MissingProxyRedirects returns the proxy redirect IDs required by the
+// endpoint's realized policy which are either unknown to the proxy or have no
+// redirect entry in the endpoint's policy map. An empty result means the
+// endpoint's proxy redirects are consistent.
+func (e *Endpoint) MissingProxyRedirects() []string {
+	if err := e.rlockAlive(); err != nil {
+		return nil
+	}
+	defer e.runlock()
+	return e.missingProxyRedirectsRLocked()
+}
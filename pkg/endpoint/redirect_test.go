@@ -107,6 +107,12 @@ func (r *RedirectSuiteProxy) RemoveRedirect(id string) {
 	delete(r.redirects, id)
 }
 
+// HasRedirect returns true if a redirect with the given id has been created.
+func (r *RedirectSuiteProxy) HasRedirect(id string) bool {
+	_, ok := r.redirects[id]
+	return ok
+}
+
 // UseCurrentNetworkPolicy does nothing.
 func (f *RedirectSuiteProxy) UseCurrentNetworkPolicy(ep endpoint.EndpointUpdater, policy *policy.L4Policy, wg *completion.WaitGroup) {
 }
@@ -5,6 +5,7 @@ package endpointmanager
 
 import (
 	"context"
+	"iter"
 	"net/netip"
 	"sync"
 
@@ -74,6 +75,10 @@ type EndpointsLookup interface {
 	// GetEndpoints returns a slice of all endpoints present in endpoint manager.
 	GetEndpoints() []*endpoint.Endpoint
 
+	// Endpoints returns an iterator over a snapshot of all endpoints present
+	// in the endpoint manager, ordered by endpoint ID.
+	Endpoints() iter.Seq[*endpoint.Endpoint]
+
 	// EndpointExists returns whether the endpoint with id exists.
 	EndpointExists(id uint16) bool
 
@@ -210,6 +215,14 @@ type EndpointManager interface {
 	// Endpoints with security IDs in provided set will be regenerated. Otherwise, the endpoint's
 	// policy revision will be bumped to toRev.
 	UpdatePolicy(idsToRegen *set.Set[identity.NumericIdentity], fromRev, toRev uint64)
+
+	// GCHistory returns the results of the most recent garbage collection
+	// cycles, ordered from oldest to newest.
+	GCHistory() []GCCycleResult
+
+	// RegenerationStatus returns information about the most recent
+	// regeneration of all endpoints.
+	RegenerationStatus() RegenerationStatus
 }
 
 // EndpointResourceSynchronizer is an interface which synchronizes CiliumEndpoint
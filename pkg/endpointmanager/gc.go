@@ -10,11 +10,36 @@ import (
 
 	"github.com/cilium/cilium/pkg/endpoint"
 	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/time"
 )
 
 // EndpointCheckerFunc can verify whether an endpoint is currently healthy.
 type EndpointCheckerFunc func(*endpoint.Endpoint) error
 
+// gcHistorySize is the maximum number of garbage collection cycle results
+// retained by the endpoint manager. It covers a full day of history at the
+// default five minute GC interval.
+const gcHistorySize = 288
+
+// GCCycleResult describes the outcome of a single endpoint garbage
+// collection cycle.
+type GCCycleResult struct {
+	// Timestamp is the time at which the cycle completed.
+	Timestamp time.Time
+
+	// Evaluated is the number of endpoints run against the configured
+	// EndpointChecker during the mark phase.
+	Evaluated int
+
+	// Marked is the number of endpoints newly marked for removal during
+	// this cycle.
+	Marked int
+
+	// Swept is the number of endpoints removed during the sweep phase of
+	// this cycle.
+	Swept int
+}
+
 // markAndSweep performs a two-phase garbage collection of endpoints using the
 // configured EndpointChecker.
 //
@@ -30,14 +55,45 @@ func (mgr *endpointManager) markAndSweep(ctx context.Context) error {
 	mgr.mutex.Lock()
 	toSweep := mgr.markedEndpoints
 	mgr.markedEndpoints = marked
+	evaluated := len(mgr.endpoints)
 	mgr.mutex.Unlock()
 
 	// Avoid returning an error which would cause the calling controller to
 	// re-run the garbage collection more frequently than the RunInterval.
-	mgr.sweepEndpoints(toSweep)
+	swept := mgr.sweepEndpoints(toSweep)
+	mgr.recordGCCycle(GCCycleResult{
+		Timestamp: time.Now(),
+		Evaluated: evaluated,
+		Marked:    len(marked),
+		Swept:     swept,
+	})
 	return nil
 }
 
+// recordGCCycle appends the result of a garbage collection cycle to the
+// bounded history, discarding the oldest entry once gcHistorySize results
+// have accumulated.
+func (mgr *endpointManager) recordGCCycle(result GCCycleResult) {
+	mgr.mutex.Lock()
+	defer mgr.mutex.Unlock()
+
+	mgr.gcHistory = append(mgr.gcHistory, result)
+	if len(mgr.gcHistory) > gcHistorySize {
+		mgr.gcHistory = mgr.gcHistory[1:]
+	}
+}
+
+// GCHistory returns the results of the most recent garbage collection
+// cycles, ordered from oldest to newest.
+func (mgr *endpointManager) GCHistory() []GCCycleResult {
+	mgr.mutex.RLock()
+	defer mgr.mutex.RUnlock()
+
+	history := make([]GCCycleResult, len(mgr.gcHistory))
+	copy(history, mgr.gcHistory)
+	return history
+}
+
 // markEndpoints runs all endpoints in the manager against the configured
 // EndpointChecker and returns a slice of endpoint ids that require garbage
 // collection.
@@ -55,8 +111,9 @@ func (mgr *endpointManager) markEndpoints() []uint16 {
 }
 
 // sweepEndpoints iterates through the specified list of endpoints marked for
-// deletion and attempts to garbage-collect them if they still exist.
-func (mgr *endpointManager) sweepEndpoints(markedEndpoints []uint16) {
+// deletion and attempts to garbage-collect them if they still exist. It
+// returns the number of endpoints for which removal was attempted.
+func (mgr *endpointManager) sweepEndpoints(markedEndpoints []uint16) int {
 	toSweep := make([]*endpoint.Endpoint, 0, len(markedEndpoints))
 
 	// 'markedEndpoints' were marked during the previous mark round, so
@@ -89,4 +146,6 @@ func (mgr *endpointManager) sweepEndpoints(markedEndpoints []uint16) {
 			}
 		}
 	}
+
+	return len(toSweep)
 }
@@ -59,4 +59,12 @@ func TestMarkAndSweep(t *testing.T) {
 	require.False(t, mgr.EndpointExists(endpointIDToDelete))
 	require.NoError(t, err)
 	require.Equal(t, len(healthyEndpointIDs), len(mgr.GetEndpoints()))
+
+	// Both cycles should have been recorded in the GC history.
+	history := mgr.GCHistory()
+	require.Len(t, history, 2)
+	require.Equal(t, len(allEndpointIDs), history[0].Evaluated)
+	require.Equal(t, 1, history[0].Marked)
+	require.Equal(t, 0, history[0].Swept)
+	require.Equal(t, 1, history[1].Swept)
 }
@@ -7,8 +7,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"iter"
 	"maps"
 	"net/netip"
+	"sort"
 	"sync"
 
 	"github.com/cilium/hive/cell"
@@ -90,6 +92,17 @@ type endpointManager struct {
 	// subsequent controller run will remove the endpoints.
 	markedEndpoints []uint16
 
+	// gcHistory is a bounded history of the most recent garbage collection
+	// cycle results, ordered from oldest to newest. mutex must be held to
+	// read and write.
+	gcHistory []GCCycleResult
+
+	// regenInProgress is the number of regenerations of all endpoints
+	// currently in flight, and lastRegen describes the most recent one.
+	// mutex must be held to read and write.
+	regenInProgress int
+	lastRegen       RegenerationStatus
+
 	// controllers associated with the endpoint manager.
 	controllers *controller.Manager
 
@@ -601,6 +614,8 @@ func (mgr *endpointManager) RegenerateAllEndpoints(regenMetadata *regeneration.E
 		}(ep)
 	}
 
+	mgr.trackRegeneration(reason, &wg)
+
 	return &wg
 }
 
@@ -643,6 +658,21 @@ func (mgr *endpointManager) GetEndpoints() []*endpoint.Endpoint {
 	return eps
 }
 
+// Endpoints returns an iterator over a snapshot of all endpoints present in
+// the endpoint manager, ordered by endpoint ID. The snapshot is taken when
+// iteration starts; endpoints added or removed afterwards are not reflected.
+func (mgr *endpointManager) Endpoints() iter.Seq[*endpoint.Endpoint] {
+	return func(yield func(*endpoint.Endpoint) bool) {
+		eps := mgr.GetEndpoints()
+		sort.Slice(eps, func(i, j int) bool { return eps[i].ID < eps[j].ID })
+		for _, ep := range eps {
+			if !yield(ep) {
+				return
+			}
+		}
+	}
+}
+
 // GetPolicyEndpoints returns a map of all endpoints present in endpoint
 // manager as policy.Endpoint interface set for the map key.
 func (mgr *endpointManager) GetPolicyEndpoints() map[policy.Endpoint]struct{} {
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package endpointmanager
+
+import (
+	"sync"
+
+	"github.com/cilium/cilium/pkg/time"
+)
+
+// RegenerationStatus describes the most recent regeneration of all endpoints,
+// as triggered by a full policy recalculation.
+type RegenerationStatus struct {
+	// InProgress is true while a regeneration of all endpoints is running.
+	InProgress bool
+
+	// Started is the time at which the most recent regeneration started.
+	// The zero value indicates that no regeneration has run yet.
+	Started time.Time
+
+	// Duration is the time the most recent completed regeneration took.
+	Duration time.Duration
+
+	// Reason is the reason the most recent regeneration was triggered.
+	Reason string
+}
+
+// trackRegeneration records a regeneration of all endpoints for reporting
+// through RegenerationStatus, marking it as completed once wg is signaled.
+func (mgr *endpointManager) trackRegeneration(reason string, wg *sync.WaitGroup) {
+	started := time.Now()
+
+	mgr.mutex.Lock()
+	mgr.regenInProgress++
+	mgr.lastRegen.Started = started
+	mgr.lastRegen.Reason = reason
+	mgr.mutex.Unlock()
+
+	go func() {
+		wg.Wait()
+
+		mgr.mutex.Lock()
+		mgr.regenInProgress--
+		mgr.lastRegen.Duration = time.Since(started)
+		mgr.mutex.Unlock()
+	}()
+}
+
+// RegenerationStatus returns information about the most recent regeneration
+// of all endpoints.
+func (mgr *endpointManager) RegenerationStatus() RegenerationStatus {
+	mgr.mutex.RLock()
+	defer mgr.mutex.RUnlock()
+
+	status := mgr.lastRegen
+	status.InProgress = mgr.regenInProgress > 0
+	return status
+}
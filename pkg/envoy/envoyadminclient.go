@@ -69,6 +69,110 @@ func (a *EnvoyAdminClient) transact(query string) error {
 	return nil
 }
 
+// adminGet issues a GET request against the admin endpoint and returns the
+// response body, honoring the deadline of the passed context.
+func (a *EnvoyAdminClient) adminGet(ctx context.Context, query string, limit safeio.ByteSize) ([]byte, error) {
+	// Use a custom dialer to use a Unix domain socket for an HTTP connection.
+	var conn net.Conn
+	var err error
+	client := &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				d := &net.Dialer{}
+				conn, err = d.DialContext(ctx, "unix", a.unixPath)
+				return conn, err
+			},
+		},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, a.adminURL+query, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("admin endpoint returned status %s", resp.Status)
+	}
+	return safeio.ReadAllLimit(resp.Body, limit)
+}
+
+// ServerInfo is the subset of the Envoy server_info admin response consumed
+// by the agent's status probe.
+type ServerInfo struct {
+	Version            string `json:"version"`
+	State              string `json:"state"`
+	UptimeCurrentEpoch string `json:"uptime_current_epoch"`
+}
+
+// GetServerInfo queries the server_info admin endpoint. It serves as the
+// liveness check of the embedded Envoy: an error means that the admin
+// endpoint did not respond within the deadline of the passed context.
+func (a *EnvoyAdminClient) GetServerInfo(ctx context.Context) (*ServerInfo, error) {
+	body, err := a.adminGet(ctx, "server_info", safeio.MB)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call ServerInfo endpoint: %w", err)
+	}
+
+	info := &ServerInfo{}
+	if err := json.Unmarshal(body, info); err != nil {
+		return nil, fmt.Errorf("failed to parse ServerInfo: %w", err)
+	}
+	return info, nil
+}
+
+// GetXDSConfigVersions returns the last accepted xDS configuration version
+// per config type, derived from the config_dump admin endpoint. Note that
+// config_dump responses can be large; callers are expected to invoke this
+// less frequently than GetServerInfo.
+func (a *EnvoyAdminClient) GetXDSConfigVersions(ctx context.Context) (map[string]string, error) {
+	body, err := a.adminGet(ctx, "config_dump", 16*safeio.MB)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call ConfigDump endpoint: %w", err)
+	}
+
+	versions, err := parseXDSConfigVersions(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ConfigDump: %w", err)
+	}
+	return versions, nil
+}
+
+// parseXDSConfigVersions extracts the per-type version_info fields from a
+// config_dump response. Config sections without a version, such as the
+// bootstrap dump, are skipped.
+func parseXDSConfigVersions(body []byte) (map[string]string, error) {
+	var dump struct {
+		Configs []struct {
+			Type        string `json:"@type"`
+			VersionInfo string `json:"version_info"`
+		} `json:"configs"`
+	}
+	if err := json.Unmarshal(body, &dump); err != nil {
+		return nil, err
+	}
+
+	versions := map[string]string{}
+	for _, config := range dump.Configs {
+		if config.VersionInfo == "" {
+			continue
+		}
+		// Reduce "type.googleapis.com/envoy.admin.v3.ListenersConfigDump"
+		// to "ListenersConfigDump".
+		name := config.Type
+		if i := strings.LastIndex(name, "."); i >= 0 {
+			name = name[i+1:]
+		}
+		versions[name] = config.VersionInfo
+	}
+	return versions, nil
+}
+
 // ChangeLogLevel changes Envoy log level to correspond to the logrus log level 'level'.
 func (a *EnvoyAdminClient) ChangeLogLevel(agentLogLevel logrus.Level) error {
 	envoyLevel := mapLogLevel(agentLogLevel, a.defaultLogLevel)
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package envoy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseXDSConfigVersions(t *testing.T) {
+	body := []byte(`{
+		"configs": [
+			{
+				"@type": "type.googleapis.com/envoy.admin.v3.BootstrapConfigDump"
+			},
+			{
+				"@type": "type.googleapis.com/envoy.admin.v3.ListenersConfigDump",
+				"version_info": "42"
+			},
+			{
+				"@type": "type.googleapis.com/envoy.admin.v3.ClustersConfigDump",
+				"version_info": "17"
+			}
+		]
+	}`)
+
+	versions, err := parseXDSConfigVersions(body)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"ListenersConfigDump": "42",
+		"ClustersConfigDump":  "17",
+	}, versions)
+
+	// The bootstrap dump carries no version and must not show up.
+	require.NotContains(t, versions, "BootstrapConfigDump")
+
+	_, err = parseXDSConfigVersions([]byte("not json"))
+	require.Error(t, err)
+}
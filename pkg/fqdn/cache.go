@@ -712,6 +712,20 @@ func (c *DNSCache) Count() (uint64, uint64) {
 	return uint64(len(c.forward)), ips
 }
 
+// MaxIPsPerName returns the DNS name with the most cached IPs and its IP
+// count. Returns an empty name and zero for an empty cache.
+func (c *DNSCache) MaxIPsPerName() (name string, count int) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for n, entries := range c.forward {
+		if len(entries) > count {
+			name, count = n, len(entries)
+		}
+	}
+	return name, count
+}
+
 // MarshalJSON serialises the set of DNS lookup cacheEntries needed to
 // reconstruct this cache instance.
 // Note: Expiration times are honored and the reconstructed cache instance is
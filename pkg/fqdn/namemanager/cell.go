@@ -8,6 +8,7 @@ import (
 
 	"github.com/cilium/hive/cell"
 
+	"github.com/cilium/cilium/api/v1/models"
 	"github.com/cilium/cilium/api/v1/server/restapi/policy"
 	"github.com/cilium/cilium/pkg/endpoint"
 	"github.com/cilium/cilium/pkg/endpointmanager"
@@ -107,6 +108,10 @@ type NameManager interface {
 	// UnlockName releases a lock previously acquired by LockName()
 	UnlockName(name string)
 
+	// CacheStatus reports bookkeeping statistics of the DNS cache and the
+	// registered toFQDNs selectors.
+	CacheStatus() *models.FQDNCacheStatus
+
 	StartGC(context.Context)
 	// RestoreCache loads cache state from the restored system:
 	// - adds any pre-cached DNS entries
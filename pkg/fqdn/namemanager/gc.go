@@ -51,6 +51,23 @@ type serializedSelector struct {
 	Selector api.FQDNSelector `json:"sel"`
 }
 
+// gcStats summarizes the most recent garbage collection pass over the
+// per-endpoint DNS caches and zombie mappings.
+type gcStats struct {
+	// aliveZombies and deadZombies count the zombie entries kept alive
+	// respectively deleted across all endpoints.
+	aliveZombies int
+	deadZombies  int
+
+	// maxIPsPerHostname is the highest number of IPs cached for a single
+	// DNS name in any endpoint cache.
+	maxIPsPerHostname int
+
+	// overLimitEndpoints are the endpoints with at least one DNS name at
+	// or above the per-hostname IP limit.
+	overLimitEndpoints []uint16
+}
+
 // This implements some garbage collection and cleanup functions for the NameManager
 
 // doGC cleans up TTL expired entries from the DNS policies. It removes stale or
@@ -77,6 +94,7 @@ func (n *manager) doGC(ctx context.Context) error {
 		activeConnections    = fqdn.NewDNSCache(activeConnectionsTTL)
 	)
 	namesToClean := make(sets.Set[string])
+	var stats gcStats
 
 	// Take a snapshot of the *entire* reverse cache, so we can compute the set of
 	// IPs that have been completely removed and safely delete their metadata.
@@ -99,6 +117,16 @@ func (n *manager) doGC(ctx context.Context) error {
 		namesToClean = namesToClean.Union(affectedNames)
 
 		alive, dead := ep.DNSZombies.GC()
+		stats.aliveZombies += len(alive)
+		stats.deadZombies += len(dead)
+		if limit := option.Config.ToFQDNsMaxIPsPerHost; limit > 0 {
+			if _, count := ep.DNSHistory.MaxIPsPerName(); count > 0 {
+				stats.maxIPsPerHostname = max(stats.maxIPsPerHostname, count)
+				if count >= limit {
+					stats.overLimitEndpoints = append(stats.overLimitEndpoints, ep.ID)
+				}
+			}
+		}
 		if metrics.FQDNAliveZombieConnections.IsEnabled() {
 			metrics.FQDNAliveZombieConnections.WithLabelValues(epID).Set(float64(len(alive)))
 		}
@@ -131,6 +159,10 @@ func (n *manager) doGC(ctx context.Context) error {
 		}
 	}
 
+	n.Lock()
+	n.lastGCStats = stats
+	n.Unlock()
+
 	if namesToClean.Len() == 0 {
 		return nil
 	}
@@ -5,18 +5,22 @@ package namemanager
 
 import (
 	"context"
+	"fmt"
 	"hash/fnv"
 	"net/netip"
 	"os"
 	"path/filepath"
 	"regexp"
 	"slices"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/util/sets"
 
+	"github.com/cilium/cilium/api/v1/models"
 	cmtypes "github.com/cilium/cilium/pkg/clustermesh/types"
 	"github.com/cilium/cilium/pkg/controller"
+	"github.com/cilium/cilium/pkg/debug"
 	"github.com/cilium/cilium/pkg/fqdn"
 	"github.com/cilium/cilium/pkg/fqdn/dns"
 	"github.com/cilium/cilium/pkg/fqdn/matchpattern"
@@ -28,6 +32,7 @@ import (
 	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/logging/logfields"
 	"github.com/cilium/cilium/pkg/metrics"
+	"github.com/cilium/cilium/pkg/option"
 	"github.com/cilium/cilium/pkg/policy/api"
 	"github.com/cilium/cilium/pkg/source"
 	"github.com/cilium/cilium/pkg/time"
@@ -56,6 +61,9 @@ type manager struct {
 
 	manager *controller.Manager
 
+	// lastGCStats summarizes the most recent garbage collection pass.
+	lastGCStats gcStats
+
 	// list of locks used as coordination points for name updates
 	// see LockName() for details.
 	nameLocks []*lock.Mutex
@@ -81,9 +89,59 @@ func New(params ManagerParams) *manager {
 		n.nameLocks[i] = &lock.Mutex{}
 	}
 
+	// A second registration only occurs when multiple managers are created
+	// in tests, in which case losing the debug status is acceptable.
+	_ = debug.RegisterStatusObject("fqdn-name-manager", n)
+
 	return n
 }
 
+// CacheStatus reports bookkeeping statistics of the DNS cache and the
+// registered toFQDNs selectors.
+func (n *manager) CacheStatus() *models.FQDNCacheStatus {
+	n.RLock()
+	defer n.RUnlock()
+
+	names, ips := n.cache.Count()
+	status := &models.FQDNCacheStatus{
+		Selectors:      int64(len(n.allSelectors)),
+		Names:          int64(names),
+		Ips:            int64(ips),
+		AliveZombies:   int64(n.lastGCStats.aliveZombies),
+		DeletedZombies: int64(n.lastGCStats.deadZombies),
+	}
+	if limit := option.Config.ToFQDNsMaxIPsPerHost; limit > 0 {
+		status.MaxIpsPerHostnameUtilization = float64(n.lastGCStats.maxIPsPerHostname) / float64(limit)
+	}
+	for _, id := range n.lastGCStats.overLimitEndpoints {
+		status.OverLimitEndpointIds = append(status.OverLimitEndpointIds, int64(id))
+	}
+	return status
+}
+
+// DebugStatus implements debug.StatusObject to provide DNS cache internals in
+// debuginfo output, including the endpoints exceeding the per-hostname IP
+// limit.
+func (n *manager) DebugStatus() string {
+	n.RLock()
+	defer n.RUnlock()
+
+	names, ips := n.cache.Count()
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Selectors: %d\n", len(n.allSelectors))
+	fmt.Fprintf(&sb, "Cached DNS names: %d\n", names)
+	fmt.Fprintf(&sb, "Cached IPs: %d\n", ips)
+	fmt.Fprintf(&sb, "Zombies in last GC pass: %d alive, %d deleted\n",
+		n.lastGCStats.aliveZombies, n.lastGCStats.deadZombies)
+	fmt.Fprintf(&sb, "Max IPs cached for a single hostname: %d (limit %d)\n",
+		n.lastGCStats.maxIPsPerHostname, option.Config.ToFQDNsMaxIPsPerHost)
+	if len(n.lastGCStats.overLimitEndpoints) > 0 {
+		fmt.Fprintf(&sb, "Endpoints over the per-hostname IP limit: %v\n",
+			n.lastGCStats.overLimitEndpoints)
+	}
+	return sb.String()
+}
+
 // RegisterFQDNSelector exposes this FQDNSelector so that the identity labels
 // of IPs contained in a DNS response that matches said selector can be
 // associated with that selector.
@@ -218,6 +218,9 @@ func formatPathStatus(w io.Writer, name string, cp *models.PathStatus, indent st
 	if cp.HTTP != nil {
 		formatConnectivityStatus(w, cp.HTTP, "HTTP to agent", indent)
 	}
+	if cp.Tunnel != nil {
+		formatConnectivityStatus(w, cp.Tunnel, "UDP to tunnel port", indent)
+	}
 }
 
 // allPathsAreHealthyOrUnknown checks whether ICMP and TCP(HTTP) connectivity
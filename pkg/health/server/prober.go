@@ -59,6 +59,12 @@ type prober struct {
 	probeRateLimiter *rate.Limiter
 	probeInterval    time.Duration
 	probeIpCount     int
+
+	// tunnelCursor and lastTunnelProbe track the rotating sample of nodes
+	// whose tunnel port is probed, see tunnel.go. Both are protected by the
+	// embedded lock.
+	tunnelCursor    int
+	lastTunnelProbe time.Time
 }
 
 // copyResultRLocked makes a copy of the path status for the specified IP.
@@ -72,8 +78,9 @@ func (p *prober) copyResultRLocked(ip string) *models.PathStatus {
 		IP: ip,
 	}
 	paths := map[**models.ConnectivityStatus]*models.ConnectivityStatus{
-		&result.Icmp: status.Icmp,
-		&result.HTTP: status.HTTP,
+		&result.Icmp:   status.Icmp,
+		&result.HTTP:   status.HTTP,
+		&result.Tunnel: status.Tunnel,
 	}
 	for res, value := range paths {
 		if value != nil {
@@ -373,6 +380,7 @@ func httpProbe(node string, ip string, ctx context.Context, resChan chan<- conne
 func (p *prober) runProbe(nodeIps map[string][]*net.IPAddr) {
 	httpResChan := make(chan connectivityResult)
 	icmpResChan := make(chan connectivityResult)
+	tunnelResChan := make(chan connectivityResult)
 	wg := sync.WaitGroup{}
 	resultsWg := sync.WaitGroup{}
 
@@ -389,7 +397,7 @@ func (p *prober) runProbe(nodeIps map[string][]*net.IPAddr) {
 	p.probeRateLimiter = rate.NewLimiter(per(p.probeIpCount, p.probeInterval), 1)
 
 	// update results as probes complete
-	resultsWg.Add(2)
+	resultsWg.Add(3)
 	go func() {
 		defer resultsWg.Done()
 		for resp := range httpResChan {
@@ -418,6 +426,20 @@ func (p *prober) runProbe(nodeIps map[string][]*net.IPAddr) {
 		}
 	}()
 
+	go func() {
+		defer resultsWg.Done()
+		for resp := range tunnelResChan {
+			peer := ipString(resp.ip)
+			p.Lock()
+			if _, ok := p.results[peer]; ok {
+				p.results[peer].Tunnel = resp.status
+			} else {
+				scopedLog.Debug("Node disappeared before result written")
+			}
+			p.Unlock()
+		}
+	}()
+
 	for name, ips := range nodeIps {
 		if debugLogsEnabled {
 			scopedLog = log.WithField(logfields.NodeName, name)
@@ -445,11 +467,19 @@ func (p *prober) runProbe(nodeIps map[string][]*net.IPAddr) {
 		}
 	}
 
+	// The tunnel port is only probed for a small rotating sample of nodes
+	// per round, so the probe stays cheap on large clusters.
+	for name, ip := range p.tunnelTargets(false) {
+		wg.Add(1)
+		go tunnelProbe(name, ip, tunnelResChan, &wg, p.server.Config.TunnelProbePort, p.server.Config.ProbeDeadline)
+	}
+
 	// owner closes result channels only once all probe results have been written
 	go func() {
 		wg.Wait()
 		close(httpResChan)
 		close(icmpResChan)
+		close(tunnelResChan)
 	}()
 
 	// block until all results are written
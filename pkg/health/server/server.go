@@ -40,6 +40,17 @@ type Config struct {
 	ProbeDeadline time.Duration
 	HTTPPathPort  int
 	HealthAPISpec *healthApi.Spec
+
+	// TunnelProbeEnabled enables probing the tunnel port of remote nodes
+	// to detect firewalls blocking tunnel traffic between nodes.
+	TunnelProbeEnabled bool
+	// TunnelProbePort is the UDP port of the tunneling protocol in use.
+	TunnelProbePort int
+	// TunnelProbeSampleSize is the number of nodes whose tunnel port is
+	// probed per round.
+	TunnelProbeSampleSize int
+	// TunnelProbeInterval is the interval between tunnel probe rounds.
+	TunnelProbeInterval time.Duration
 }
 
 // ipString is an IP address used as a more descriptive type name in maps.
@@ -69,6 +80,7 @@ type Server struct {
 	lock.RWMutex
 	connectivity *healthReport
 	localStatus  *healthModels.SelfStatus
+	prober       *prober
 
 	nodesSeen map[string]struct{}
 }
@@ -368,8 +380,20 @@ func (s *Server) GetStatusResponse() *healthModels.HealthStatusResponse {
 	}
 }
 
-// FetchStatusResponse returns the results of the most recent probe.
+// FetchStatusResponse returns the results of the most recent probe. If the
+// tunnel port probe is enabled, the tunnel port of every known node is swept
+// first, so that a forced probe yields a full reachability picture without
+// waiting for the rotating sample to cover the cluster.
 func (s *Server) FetchStatusResponse() (*healthModels.HealthStatusResponse, error) {
+	s.RLock()
+	prober := s.prober
+	s.RUnlock()
+
+	if prober != nil && s.TunnelProbeEnabled {
+		prober.runTunnelSweep()
+		s.updateCluster(prober.getResults())
+	}
+
 	return s.GetStatusResponse(), nil
 }
 
@@ -385,6 +409,9 @@ func (s *Server) runActiveServices() error {
 	// fetching the nodes from the server.
 	nodesAdded, _, _ := s.getNodes()
 	prober := newProber(s, nodesAdded)
+	s.Lock()
+	s.prober = prober
+	s.Unlock()
 	prober.RunLoop()
 	defer prober.Stop()
 
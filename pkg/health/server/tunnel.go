@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"os"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cilium/cilium/api/v1/health/models"
+)
+
+// tunnelTargets returns the node name to primary IP mapping of the nodes
+// whose tunnel port should be probed now. With full set, all known nodes are
+// returned; otherwise a rotating sample of up to TunnelProbeSampleSize nodes
+// is returned once per TunnelProbeInterval, and nil in between, so that the
+// probe's cost stays bounded on large clusters while still covering every
+// node eventually.
+func (p *prober) tunnelTargets(full bool) map[string]string {
+	if !p.server.Config.TunnelProbeEnabled {
+		return nil
+	}
+
+	p.Lock()
+	defer p.Unlock()
+
+	if !full {
+		if !p.lastTunnelProbe.IsZero() && time.Since(p.lastTunnelProbe) < p.server.Config.TunnelProbeInterval {
+			return nil
+		}
+		p.lastTunnelProbe = time.Now()
+	}
+
+	// Tunnel traffic is addressed to the node IP, so only the primary IP of
+	// each node is probed.
+	byName := map[string]string{}
+	for _, n := range p.nodes {
+		if _, ok := byName[n.Name]; ok {
+			continue
+		}
+		if ip := n.PrimaryIP(); ip != "" {
+			byName[n.Name] = ip
+		}
+	}
+
+	if full {
+		return byName
+	}
+
+	names := slices.Sorted(maps.Keys(byName))
+	sample, next := rotatingSample(names, p.tunnelCursor, p.server.Config.TunnelProbeSampleSize)
+	p.tunnelCursor = next
+
+	targets := make(map[string]string, len(sample))
+	for _, name := range sample {
+		targets[name] = byName[name]
+	}
+	return targets
+}
+
+// rotatingSample returns a window of up to size names starting at cursor,
+// wrapping around the end of the slice, along with the cursor for the next
+// round.
+func rotatingSample(names []string, cursor, size int) ([]string, int) {
+	if len(names) == 0 || size <= 0 {
+		return nil, 0
+	}
+	if size >= len(names) {
+		return names, 0
+	}
+	cursor %= len(names)
+	sample := make([]string, 0, size)
+	for i := range size {
+		sample = append(sample, names[(cursor+i)%len(names)])
+	}
+	return sample, (cursor + size) % len(names)
+}
+
+// tunnelProbe checks whether the given node's tunnel port accepts UDP
+// datagrams. The probe datagram is not a valid tunnel frame, so a reachable
+// tunnel stack silently discards it; the absence of an ICMP error within the
+// deadline counts as reachable. A firewall REJECTing the port, or a node not
+// listening on it, answers with an ICMP destination-unreachable which
+// surfaces as an error on the connected socket and is reported together with
+// the blocked port. A firewall that silently drops the datagram is
+// indistinguishable from successful delivery with this method.
+func tunnelProbe(node, ip string, resChan chan<- connectivityResult, wg *sync.WaitGroup, port int, deadline time.Duration) {
+	defer wg.Done()
+
+	result := &models.ConnectivityStatus{}
+
+	addr := net.JoinHostPort(ip, strconv.Itoa(port))
+	conn, err := net.DialTimeout("udp", addr, deadline)
+	if err == nil {
+		defer conn.Close()
+		if err = conn.SetDeadline(time.Now().Add(deadline)); err == nil {
+			if _, err = conn.Write([]byte("cilium-health tunnel probe")); err == nil {
+				_, err = conn.Read(make([]byte, 64))
+			}
+		}
+	}
+
+	switch {
+	case err == nil:
+		// Unexpectedly got a payload back; the port is reachable either way.
+	case errors.Is(err, os.ErrDeadlineExceeded):
+		// No ICMP error came back, assume the datagram was delivered.
+		err = nil
+	}
+
+	if err != nil {
+		log.WithField("node", node).WithField("addr", addr).
+			WithError(err).Debug("Tunnel port unreachable")
+		result.Status = fmt.Sprintf("tunnel port %d unreachable: %s", port, err)
+	}
+	result.LastProbed = time.Now().Format(time.RFC3339)
+
+	resChan <- connectivityResult{ip: ip, status: result}
+}
+
+// runTunnelSweep probes the tunnel port of every known node and blocks until
+// all results have been recorded.
+func (p *prober) runTunnelSweep() {
+	targets := p.tunnelTargets(true)
+	if len(targets) == 0 {
+		return
+	}
+
+	resChan := make(chan connectivityResult)
+	wg := sync.WaitGroup{}
+	done := make(chan struct{})
+
+	go func() {
+		for resp := range resChan {
+			peer := ipString(resp.ip)
+			p.Lock()
+			if _, ok := p.results[peer]; ok {
+				p.results[peer].Tunnel = resp.status
+			}
+			p.Unlock()
+		}
+		close(done)
+	}()
+
+	for name, ip := range targets {
+		wg.Add(1)
+		go tunnelProbe(name, ip, resChan, &wg, p.server.Config.TunnelProbePort, p.server.Config.ProbeDeadline)
+	}
+
+	wg.Wait()
+	close(resChan)
+	<-done
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotatingSample(t *testing.T) {
+	names := []string{"a", "b", "c", "d", "e"}
+
+	// Empty input or non-positive size yields no sample.
+	sample, next := rotatingSample(nil, 0, 3)
+	require.Nil(t, sample)
+	require.Equal(t, 0, next)
+
+	sample, next = rotatingSample(names, 2, 0)
+	require.Nil(t, sample)
+	require.Equal(t, 0, next)
+
+	// A sample size covering all names returns them all and resets the
+	// cursor.
+	sample, next = rotatingSample(names, 3, 5)
+	require.Equal(t, names, sample)
+	require.Equal(t, 0, next)
+
+	// Successive rounds walk through the names, wrapping around the end.
+	sample, next = rotatingSample(names, 0, 2)
+	require.Equal(t, []string{"a", "b"}, sample)
+	require.Equal(t, 2, next)
+
+	sample, next = rotatingSample(names, next, 2)
+	require.Equal(t, []string{"c", "d"}, sample)
+	require.Equal(t, 4, next)
+
+	sample, next = rotatingSample(names, next, 2)
+	require.Equal(t, []string{"e", "a"}, sample)
+	require.Equal(t, 1, next)
+
+	// An out-of-range cursor, e.g. after nodes were removed, wraps around.
+	sample, next = rotatingSample(names, 7, 2)
+	require.Equal(t, []string{"c", "d"}, sample)
+	require.Equal(t, 4, next)
+}
+
+func TestTunnelTargets(t *testing.T) {
+	nodes := make(nodeMap)
+	for i := 1; i <= 4; i++ {
+		n, _, _ := makeHealthNode(i, i)
+		nodes[ipString(n.PrimaryIP())] = n
+	}
+
+	newTunnelProber := func(sampleSize int) *prober {
+		return &prober{
+			server: &Server{
+				Config: Config{
+					TunnelProbeEnabled:    true,
+					TunnelProbePort:       8472,
+					TunnelProbeSampleSize: sampleSize,
+					TunnelProbeInterval:   time.Minute,
+				},
+			},
+			nodes: nodes,
+		}
+	}
+
+	// Disabled probe yields no targets.
+	p := newTunnelProber(2)
+	p.server.Config.TunnelProbeEnabled = false
+	require.Nil(t, p.tunnelTargets(true))
+
+	// A full sweep returns every node keyed by name.
+	p = newTunnelProber(2)
+	targets := p.tunnelTargets(true)
+	require.Len(t, targets, 4)
+	require.Equal(t, "192.0.2.1", targets["node-1"])
+
+	// Sampled rounds cover all nodes across successive rounds.
+	seen := make(map[string]struct{})
+	for range 2 {
+		for name := range p.tunnelTargets(false) {
+			seen[name] = struct{}{}
+		}
+		// Reset the interval gate to allow the next round immediately.
+		p.lastTunnelProbe = time.Time{}
+	}
+	require.Len(t, seen, 4)
+
+	// A second round within the probe interval is skipped.
+	require.NotNil(t, p.tunnelTargets(false))
+	require.Nil(t, p.tunnelTargets(false))
+}
@@ -417,7 +417,7 @@ func (m *CachingIdentityAllocator) AllocateLocalIdentity(lbls labels.Labels, not
 		if option.Config.Debug {
 			log.WithFields(logrus.Fields{
 				logfields.Identity:       reservedIdentity.ID,
-				logfields.IdentityLabels: lbls.String(),
+				logfields.IdentityLabels: lbls.CanonicalString(),
 				"isNew":                  false,
 			}).Debug("Resolving reserved identity")
 		}
@@ -426,7 +426,7 @@ func (m *CachingIdentityAllocator) AllocateLocalIdentity(lbls labels.Labels, not
 
 	if option.Config.Debug {
 		log.WithFields(logrus.Fields{
-			logfields.IdentityLabels: lbls.String(),
+			logfields.IdentityLabels: lbls.CanonicalString(),
 		}).Debug("Resolving local identity")
 	}
 
@@ -497,7 +497,7 @@ func (m *CachingIdentityAllocator) AllocateIdentity(ctx context.Context, lbls la
 
 	if option.Config.Debug {
 		log.WithFields(logrus.Fields{
-			logfields.IdentityLabels: lbls.String(),
+			logfields.IdentityLabels: lbls.CanonicalString(),
 		}).Debug("Resolving global identity")
 	}
 
@@ -524,7 +524,7 @@ func (m *CachingIdentityAllocator) AllocateIdentity(ctx context.Context, lbls la
 	if option.Config.Debug {
 		log.WithFields(logrus.Fields{
 			logfields.Identity:       idp,
-			logfields.IdentityLabels: lbls.String(),
+			logfields.IdentityLabels: lbls.CanonicalString(),
 			"isNew":                  allocated,
 			"isNewLocally":           isNewLocally,
 		}).Debug("Resolved identity")
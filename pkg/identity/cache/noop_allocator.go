@@ -37,7 +37,7 @@ func (n *NoopIdentityAllocator) WaitForInitialGlobalIdentities(context.Context)
 func (n *NoopIdentityAllocator) AllocateIdentity(ctx context.Context, lbls labels.Labels, notifyOwner bool, oldNID identity.NumericIdentity) (*identity.Identity, bool, error) {
 	if option.Config.Debug {
 		log.WithFields(logrus.Fields{
-			logfields.IdentityLabels: lbls.String(),
+			logfields.IdentityLabels: lbls.CanonicalString(),
 			logfields.Identity:       identity.ReservedIdentityInit,
 		}).Debug("Assigning a fixed identity that is not based on labels, because network policies are disabled")
 	}
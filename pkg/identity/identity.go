@@ -306,6 +306,17 @@ func LookupReservedIdentityByLabels(lbls labels.Labels) *Identity {
 	return nil
 }
 
+// MatchesReservedIdentity returns the reserved or well-known identity
+// matching the given label set, if any. It lives here rather than as a method
+// on labels.Labels to avoid an import cycle, as the lookup depends on the
+// well-known identity tables of this package.
+func MatchesReservedIdentity(lbls labels.Labels) (NumericIdentity, bool) {
+	if identity := LookupReservedIdentityByLabels(lbls); identity != nil {
+		return identity.ID, true
+	}
+	return IdentityUnknown, false
+}
+
 // IdentityAllocationIsLocal returns true if a call to AllocateIdentity with
 // the given labels would not require accessing the KV store to allocate the
 // identity.
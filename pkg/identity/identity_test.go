@@ -336,6 +336,20 @@ func TestLookupReservedIdentityByLabels(t *testing.T) {
 	}
 }
 
+func TestMatchesReservedIdentity(t *testing.T) {
+	nid, ok := MatchesReservedIdentity(labels.LabelHealth)
+	require.True(t, ok)
+	require.Equal(t, ReservedIdentityHealth, nid)
+
+	nid, ok = MatchesReservedIdentity(labels.LabelHost)
+	require.True(t, ok)
+	require.Equal(t, ReservedIdentityHost, nid)
+
+	nid, ok = MatchesReservedIdentity(labels.NewLabelsFromModel([]string{"foo"}))
+	require.False(t, ok)
+	require.Equal(t, IdentityUnknown, nid)
+}
+
 func TestIPIdentityPair_PrefixString(t *testing.T) {
 	ipv6Mask := make(net.IPMask, net.IPv6len)
 	for i := range ipv6Mask {
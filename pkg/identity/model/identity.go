@@ -4,6 +4,8 @@
 package model
 
 import (
+	"slices"
+
 	"github.com/cilium/cilium/api/v1/models"
 	"github.com/cilium/cilium/pkg/identity"
 	"github.com/cilium/cilium/pkg/labels"
@@ -40,5 +42,8 @@ func CreateModel(id *identity.Identity) *models.Identity {
 	for _, v := range id.LabelArray {
 		ret.Labels = append(ret.Labels, v.String())
 	}
+	// Canonical ordering, so that two equal identities always render the
+	// same label list.
+	slices.Sort(ret.Labels)
 	return ret
 }
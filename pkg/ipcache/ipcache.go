@@ -783,6 +783,20 @@ func (ipc *IPCache) Delete(IP string, source source.Source) (namedPortsChanged b
 	return ipc.deleteLocked(IP, source)
 }
 
+// ForEachEntry invokes f for each IP to security identity mapping in the
+// cache while holding the read lock. Shadowed entries are skipped. f must
+// not attempt to modify the IPCache.
+func (ipc *IPCache) ForEachEntry(f func(prefix string, id Identity)) {
+	ipc.mutex.RLock()
+	defer ipc.mutex.RUnlock()
+	for ip, identity := range ipc.ipToIdentityCache {
+		if identity.shadowed {
+			continue
+		}
+		f(ip, identity)
+	}
+}
+
 // LookupByIP returns the corresponding security identity that endpoint IP maps
 // to within the provided IPCache, as well as if the corresponding entry exists
 // in the IPCache.
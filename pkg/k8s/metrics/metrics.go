@@ -4,6 +4,8 @@
 package metrics
 
 import (
+	"sync/atomic"
+
 	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/time"
 )
@@ -15,8 +17,29 @@ var (
 	// LastSuccessInteraction is the time at which we have received a successful
 	// k8s apiserver reply (i.e. a response code 2xx or 4xx).
 	LastSuccessInteraction eventTimestamper
+
+	// APIServerRequests counts the requests made to the kube-apiserver
+	APIServerRequests eventCounter
+	// APIServerThrottles counts the requests delayed by the client-side
+	// rate limiter before being sent to the kube-apiserver
+	APIServerThrottles eventCounter
 )
 
+// eventCounter is a monotonically increasing event count
+type eventCounter struct {
+	count atomic.Uint64
+}
+
+// Inc increments the counter by one
+func (e *eventCounter) Inc() {
+	e.count.Add(1)
+}
+
+// Count returns the current counter value
+func (e *eventCounter) Count() uint64 {
+	return e.count.Load()
+}
+
 type eventTimestamper struct {
 	timestamp time.Time
 	lock      lock.RWMutex
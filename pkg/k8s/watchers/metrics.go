@@ -81,11 +81,20 @@ func (*requestLatencyAdapter) Observe(_ context.Context, verb string, u url.URL,
 	metrics.KubernetesAPIInteractions.WithLabelValues(u.Path, verb).Observe(latency.Seconds())
 }
 
+// rateLimiterThrottleThreshold is the rate limiter latency above which a
+// request is considered to have been throttled by the client-side rate
+// limiter. Requests passing an uncontended rate limiter complete well below
+// this threshold.
+const rateLimiterThrottleThreshold = time.Millisecond
+
 // rateLimiterLatencyAdapter implements the LatencyMetric interface from k8s client-go package
 type rateLimiterLatencyAdapter struct{}
 
 func (c *rateLimiterLatencyAdapter) Observe(_ context.Context, verb string, u url.URL, latency time.Duration) {
 	metrics.KubernetesAPIRateLimiterLatency.WithLabelValues(u.Path, verb).Observe(latency.Seconds())
+	if latency > rateLimiterThrottleThreshold {
+		k8smetrics.APIServerThrottles.Inc()
+	}
 }
 
 // resultAdapter implements the ResultMetric interface from k8s client-go package
@@ -103,4 +112,5 @@ func (r *resultAdapter) Increment(_ context.Context, code, method, host string)
 		}
 	}
 	k8smetrics.LastInteraction.Reset()
+	k8smetrics.APIServerRequests.Inc()
 }
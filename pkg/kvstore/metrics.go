@@ -29,6 +29,7 @@ func GetScopeFromKey(key string) string {
 }
 
 func increaseMetric(key, kind, action string, duration time.Duration, err error) {
+	opTracker.record(action, duration, err)
 	if !metrics.KVStoreOperationsDuration.IsEnabled() {
 		return
 	}
@@ -38,7 +39,12 @@ func increaseMetric(key, kind, action string, duration time.Duration, err error)
 		WithLabelValues(namespace, kind, action, outcome).Observe(duration.Seconds())
 }
 
+// watchEventQueueOperation is the operation name under which the queueing
+// delay of watcher events is tracked in the rolling operation statistics.
+const watchEventQueueOperation = "WatchEventQueue"
+
 func trackEventQueued(scope string, typ EventType, duration time.Duration) {
+	opTracker.record(watchEventQueueOperation, duration, nil)
 	if !metrics.KVStoreEventsQueueDuration.IsEnabled() {
 		return
 	}
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package kvstore
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/time"
+)
+
+// OperationStatsWindow is the period over which rolling statistics of
+// kvstore client operations are aggregated.
+const OperationStatsWindow = 5 * time.Minute
+
+// OperationStats summarizes the operations of one type observed within the
+// rolling window.
+type OperationStats struct {
+	// Operation is the name of the operation, e.g. Get or Update.
+	Operation string
+
+	// Count is the number of operations observed within the window.
+	Count int64
+
+	// Errors is the number of failed operations within the window.
+	Errors int64
+
+	// P95Latency is the 95th percentile latency of the operations
+	// observed within the window.
+	P95Latency time.Duration
+}
+
+type operationSample struct {
+	when    time.Time
+	latency time.Duration
+	failed  bool
+}
+
+// operationTracker keeps the samples of each operation type recorded within
+// the rolling window to derive statistics for the status API. It is fed from
+// the same instrumentation points as the Prometheus metrics, so both views
+// report the same data.
+type operationTracker struct {
+	mu      lock.Mutex
+	samples map[string][]operationSample
+}
+
+var opTracker = operationTracker{samples: make(map[string][]operationSample)}
+
+func (t *operationTracker) record(operation string, latency time.Duration, err error) {
+	now := time.Now()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.samples[operation] = append(pruneSamples(t.samples[operation], now), operationSample{
+		when:    now,
+		latency: latency,
+		failed:  err != nil,
+	})
+}
+
+// pruneSamples drops the samples which have fallen out of the rolling
+// window. Samples are appended in order, so the remainder is a suffix.
+func pruneSamples(samples []operationSample, now time.Time) []operationSample {
+	cutoff := now.Add(-OperationStatsWindow)
+	i := 0
+	for i < len(samples) && samples[i].when.Before(cutoff) {
+		i++
+	}
+	return samples[i:]
+}
+
+// CollectOperationStats returns rolling statistics for each operation type
+// observed within the last OperationStatsWindow, sorted by operation name.
+func CollectOperationStats() []OperationStats {
+	now := time.Now()
+	opTracker.mu.Lock()
+	defer opTracker.mu.Unlock()
+
+	stats := make([]OperationStats, 0, len(opTracker.samples))
+	for op, samples := range opTracker.samples {
+		samples = pruneSamples(samples, now)
+		if len(samples) == 0 {
+			delete(opTracker.samples, op)
+			continue
+		}
+		opTracker.samples[op] = samples
+
+		latencies := make([]time.Duration, len(samples))
+		var errs int64
+		for i, s := range samples {
+			latencies[i] = s.latency
+			if s.failed {
+				errs++
+			}
+		}
+		slices.Sort(latencies)
+		// Index of the 95th percentile, rounding up as is conventional
+		// for percentile estimation on small sample counts.
+		idx := (len(latencies)*95 + 99) / 100
+		if idx > 0 {
+			idx--
+		}
+		stats = append(stats, OperationStats{
+			Operation:  op,
+			Count:      int64(len(samples)),
+			Errors:     errs,
+			P95Latency: latencies[idx],
+		})
+	}
+
+	slices.SortFunc(stats, func(a, b OperationStats) int {
+		return strings.Compare(a.Operation, b.Operation)
+	})
+	return stats
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package kvstore
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/cilium/pkg/time"
+)
+
+func TestCollectOperationStats(t *testing.T) {
+	defer func(prev map[string][]operationSample) { opTracker.samples = prev }(opTracker.samples)
+	opTracker.samples = make(map[string][]operationSample)
+
+	for i := range 19 {
+		opTracker.record("Get", time.Duration(i+1)*time.Millisecond, nil)
+	}
+	opTracker.record("Get", 100*time.Millisecond, errors.New("deadline exceeded"))
+	opTracker.record("Update", 5*time.Millisecond, nil)
+
+	stats := CollectOperationStats()
+	require.Len(t, stats, 2)
+
+	require.Equal(t, "Get", stats[0].Operation)
+	require.EqualValues(t, 20, stats[0].Count)
+	require.EqualValues(t, 1, stats[0].Errors)
+	require.Equal(t, 19*time.Millisecond, stats[0].P95Latency)
+
+	require.Equal(t, "Update", stats[1].Operation)
+	require.EqualValues(t, 1, stats[1].Count)
+	require.EqualValues(t, 0, stats[1].Errors)
+	require.Equal(t, 5*time.Millisecond, stats[1].P95Latency)
+}
+
+func TestPruneSamples(t *testing.T) {
+	now := time.Now()
+	samples := []operationSample{
+		{when: now.Add(-2 * OperationStatsWindow)},
+		{when: now.Add(-OperationStatsWindow - time.Second)},
+		{when: now.Add(-time.Minute)},
+		{when: now},
+	}
+	require.Len(t, pruneSamples(samples, now), 2)
+	require.Empty(t, pruneSamples(samples, now.Add(2*OperationStatsWindow)))
+}
@@ -265,6 +265,30 @@ func (l Labels) String() string {
 	return strings.Join(l.GetPrintableModel(), ",")
 }
 
+// CanonicalString returns the canonical serialization of the label set: the
+// source:key=value form of every label, sorted lexicographically and joined
+// with commas. Two Labels containing the same set of labels always render
+// identically, regardless of how they were constructed. Unlike String, CIDR
+// labels are not prettified, so the output can be parsed back with
+// NewLabelsFromCanonicalString.
+func (l Labels) CanonicalString() string {
+	terms := make([]string, 0, len(l))
+	for _, v := range l {
+		terms = append(terms, v.String())
+	}
+	slices.Sort(terms)
+	return strings.Join(terms, ",")
+}
+
+// NewLabelsFromCanonicalString parses the output of CanonicalString back
+// into a label set.
+func NewLabelsFromCanonicalString(s string) Labels {
+	if s == "" {
+		return Labels{}
+	}
+	return NewLabelsFromModel(strings.Split(s, ","))
+}
+
 // Equals returns true if the two Labels contain the same set of labels.
 func (l Labels) Equals(other Labels) bool {
 	if len(l) != len(other) {
@@ -300,6 +324,27 @@ func (l Labels) RemoveFromSource(source string) {
 	})
 }
 
+// LabelFilter reports whether the given label is relevant for identity
+// determination. Labels for which it returns false are retained for
+// informational purposes only.
+type LabelFilter func(lbl Label) bool
+
+// PartitionByIdentityRelevance applies filter once to every label in all and
+// splits them into the labels relevant for identity determination and the
+// remaining informational labels.
+func PartitionByIdentityRelevance(all Labels, filter LabelFilter) (identity, info Labels) {
+	identity = Labels{}
+	info = Labels{}
+	for k, lbl := range all {
+		if filter(lbl) {
+			identity[k] = lbl
+		} else {
+			info[k] = lbl
+		}
+	}
+	return identity, info
+}
+
 // NewLabel returns a new label from the given key, value and source. If source is empty,
 // the default value will be LabelSourceUnspec. If key starts with '$', the source
 // will be overwritten with LabelSourceReserved. If key contains ':', the value
@@ -603,12 +648,14 @@ func NewFrom(l Labels) Labels {
 	return nl
 }
 
-// GetModel returns model with all the values of the labels.
+// GetModel returns model with all the values of the labels. The returned
+// list is sorted, so that the model of two equal label sets is identical.
 func (l Labels) GetModel() []string {
 	res := make([]string, 0, len(l))
 	for _, v := range l {
 		res = append(res, v.String())
 	}
+	slices.Sort(res)
 	return res
 }
 
@@ -748,6 +795,32 @@ func (l Labels) Has(label Label) bool {
 	return false
 }
 
+// ContainsAll returns true if every label in 'subset' has an identical entry
+// (same key, value, and source) in l. An empty subset is contained in any
+// label set.
+func (l Labels) ContainsAll(subset Labels) bool {
+	for _, lbl := range subset {
+		other, ok := l[lbl.Key]
+		if !ok || !other.Equals(&lbl) {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsKey returns true if l contains a label with the given key,
+// regardless of its value and source.
+func (l Labels) ContainsKey(key string) bool {
+	return l.HasLabelWithKey(key)
+}
+
+// ContainsKeyValue returns true if l contains a label with the given key and
+// value, regardless of its source.
+func (l Labels) ContainsKeyValue(key, value string) bool {
+	lbl, ok := l[key]
+	return ok && lbl.Value == value
+}
+
 // HasSource returns true if l contains the given label source.
 func (l Labels) HasSource(source string) bool {
 	for _, lbl := range l {
@@ -80,6 +80,38 @@ func TestLabelArraySorted(t *testing.T) {
 	require.EqualValues(t, lblsString, str)
 }
 
+func TestCanonicalString(t *testing.T) {
+	// Two differently-constructed but equal label sets must render
+	// identically.
+	a := NewLabelsFromModel([]string{"k8s:app=bar", "k8s:io.kubernetes.pod.namespace=default", "cidr:10.0.0.0/8"})
+	b := Labels{}
+	for _, s := range []string{"cidr:10.0.0.0/8", "k8s:io.kubernetes.pod.namespace=default", "k8s:app=bar"} {
+		lbl := ParseLabel(s)
+		b[lbl.Key] = lbl
+	}
+
+	require.True(t, a.Equals(b))
+	require.Equal(t, a.CanonicalString(), b.CanonicalString())
+	require.Equal(t, "cidr:10.0.0.0/8,k8s:app=bar,k8s:io.kubernetes.pod.namespace=default",
+		a.CanonicalString())
+}
+
+func TestNewLabelsFromCanonicalString(t *testing.T) {
+	a := NewLabelsFromModel([]string{"k8s:app=bar", "reserved:host", "cidr:10.0.0.0/8"})
+
+	// The canonical form round-trips.
+	parsed := NewLabelsFromCanonicalString(a.CanonicalString())
+	require.EqualValues(t, a, parsed)
+	require.Equal(t, a.CanonicalString(), parsed.CanonicalString())
+
+	require.Empty(t, NewLabelsFromCanonicalString(""))
+}
+
+func TestGetModelSorted(t *testing.T) {
+	a := NewLabelsFromModel([]string{"k8s:zz=top", "k8s:app=bar", "reserved:host"})
+	require.Equal(t, []string{"k8s:app=bar", "k8s:zz=top", "reserved:host"}, a.GetModel())
+}
+
 func TestMap2Labels(t *testing.T) {
 	m := Map2Labels(map[string]string{
 		"k8s:foo":  "bar",
@@ -613,3 +645,49 @@ func TestNewLabelCIDR(t *testing.T) {
 		assert.Nil(t, lbl.cidr)
 	}
 }
+
+func TestLabelsContainsAll(t *testing.T) {
+	lbls := NewLabelsFromModel([]string{"k8s:foo=bar", "k8s:baz=qux", "reserved:host"})
+
+	require.True(t, lbls.ContainsAll(nil))
+	require.True(t, lbls.ContainsAll(Labels{}))
+	require.True(t, lbls.ContainsAll(NewLabelsFromModel([]string{"k8s:foo=bar"})))
+	require.True(t, lbls.ContainsAll(NewLabelsFromModel([]string{"k8s:foo=bar", "reserved:host"})))
+	require.False(t, lbls.ContainsAll(NewLabelsFromModel([]string{"k8s:foo=other"})))
+	require.False(t, lbls.ContainsAll(NewLabelsFromModel([]string{"container:foo=bar"})))
+	require.False(t, lbls.ContainsAll(NewLabelsFromModel([]string{"k8s:foo=bar", "k8s:missing"})))
+
+	require.True(t, lbls.ContainsKey("foo"))
+	require.False(t, lbls.ContainsKey("missing"))
+
+	require.True(t, lbls.ContainsKeyValue("foo", "bar"))
+	require.False(t, lbls.ContainsKeyValue("foo", "other"))
+	require.False(t, lbls.ContainsKeyValue("missing", "bar"))
+}
+
+func TestPartitionByIdentityRelevance(t *testing.T) {
+	all := NewLabelsFromModel([]string{"k8s:app=foo", "k8s:pod-template-hash=1234", "reserved:host"})
+
+	identity, info := PartitionByIdentityRelevance(all, func(lbl Label) bool {
+		return lbl.Key != "pod-template-hash"
+	})
+	require.Equal(t, NewLabelsFromModel([]string{"k8s:app=foo", "reserved:host"}), identity)
+	require.Equal(t, NewLabelsFromModel([]string{"k8s:pod-template-hash=1234"}), info)
+
+	// A filter accepting everything leaves the informational set empty.
+	identity, info = PartitionByIdentityRelevance(all, func(Label) bool { return true })
+	require.Equal(t, all, identity)
+	require.Empty(t, info)
+
+	// A filter rejecting everything leaves the identity set empty.
+	identity, info = PartitionByIdentityRelevance(all, func(Label) bool { return false })
+	require.Empty(t, identity)
+	require.Equal(t, all, info)
+
+	// Both buckets are always non-nil, even for empty or nil input.
+	identity, info = PartitionByIdentityRelevance(nil, func(Label) bool { return true })
+	require.NotNil(t, identity)
+	require.NotNil(t, info)
+	require.Empty(t, identity)
+	require.Empty(t, info)
+}
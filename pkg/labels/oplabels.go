@@ -6,6 +6,7 @@ package labels
 import (
 	"fmt"
 	"maps"
+	"path"
 
 	"github.com/sirupsen/logrus"
 
@@ -82,6 +83,48 @@ func (o *OpLabels) IdentityLabels() Labels {
 	return enabled
 }
 
+// ForEachIdentityLabel iterates over the labels that are used when
+// determining a security identity, calling fn for each label and stopping
+// early if fn returns false. Unlike IdentityLabels it does not allocate a
+// combined map; labels in OrchestrationIdentity shadow Custom labels with
+// the same key.
+func (o *OpLabels) ForEachIdentityLabel(fn func(Label) bool) {
+	for _, lbl := range o.OrchestrationIdentity {
+		if !fn(lbl) {
+			return
+		}
+	}
+	for key, lbl := range o.Custom {
+		if _, shadowed := o.OrchestrationIdentity[key]; shadowed {
+			continue
+		}
+		if !fn(lbl) {
+			return
+		}
+	}
+}
+
+// HasLabel returns true if the provided label is present in any of the label
+// sets tracked by the OpLabels, without allocating the combined map that
+// AllLabels returns.
+func (o *OpLabels) HasLabel(lbl Label) bool {
+	found := false
+	o.ForEachIdentityLabel(func(l Label) bool {
+		found = l.Equals(&lbl)
+		return !found
+	})
+	if found {
+		return true
+	}
+	if l, ok := o.Disabled[lbl.Key]; ok && l.Equals(&lbl) {
+		return true
+	}
+	if l, ok := o.OrchestrationInfo[lbl.Key]; ok && l.Equals(&lbl) {
+		return true
+	}
+	return false
+}
+
 // GetIdentityLabel returns the value of the given Key from all IdentityLabels.
 func (o *OpLabels) GetIdentityLabel(key string) (l Label, found bool) {
 	l, found = o.OrchestrationIdentity[key]
@@ -120,9 +163,19 @@ func (o *OpLabels) ReplaceInformationLabels(sourceFilter string, l Labels, logge
 	return changed
 }
 
-func (o *OpLabels) ReplaceIdentityLabels(sourceFilter string, l Labels, logger *logrus.Entry) bool {
+// IdentityLabelSet is the set of labels accepted by ReplaceIdentityLabels. It
+// is implemented by both Labels and TTLLabels.
+type IdentityLabelSet interface {
+	// identityLabels returns the labels relevant for identity
+	// determination, removing expired labels beforehand where supported.
+	identityLabels() Labels
+}
+
+func (o *OpLabels) ReplaceIdentityLabels(sourceFilter string, lbls IdentityLabelSet, logger *logrus.Entry) bool {
 	changed := false
 
+	l := lbls.identityLabels()
+
 	keepers := make(keepMarks)
 	disabledKeepers := make(keepMarks)
 
@@ -187,6 +240,70 @@ func (o *OpLabels) ModifyIdentityLabels(addLabels, delLabels Labels) (changed bo
 	return changed, nil
 }
 
+// ModifyIdentityLabelsGlob behaves like ModifyIdentityLabels, but deletes by
+// glob pattern instead of by exact key. Each pattern is expanded against the
+// current Custom, OrchestrationIdentity and Disabled label sets, so that a
+// whole family of labels like "k8s:app.kubernetes.io/*" can be deleted
+// without enumerating each key. Patterns that match no label are returned in
+// unmatched rather than causing an error, so that callers can tell a
+// mistyped pattern apart from a no-op. An error is only returned for
+// malformed patterns.
+func (o *OpLabels) ModifyIdentityLabelsGlob(addLabels Labels, delPatterns []string) (changed bool, unmatched []string, err error) {
+	delLabels := Labels{}
+	for _, pattern := range delPatterns {
+		found := false
+		for _, lbls := range []Labels{o.Custom, o.OrchestrationIdentity, o.Disabled} {
+			for k, v := range lbls {
+				matched, err := matchLabelPattern(pattern, v)
+				if err != nil {
+					return false, nil, fmt.Errorf("invalid label pattern %s: %w", pattern, err)
+				}
+				if matched {
+					delLabels[k] = v
+					found = true
+				}
+			}
+		}
+		if !found {
+			unmatched = append(unmatched, pattern)
+		}
+	}
+
+	changed, err = o.ModifyIdentityLabels(addLabels, delLabels)
+	return changed, unmatched, err
+}
+
+// matchLabelPattern returns whether the glob pattern matches the label. The
+// pattern is matched against both the plain key and the source-qualified
+// "source:key" form, so that both "app.kubernetes.io/*" and
+// "k8s:app.kubernetes.io/*" match a k8s label with key
+// "app.kubernetes.io/name". As in path.Match, a "*" does not cross a "/" in
+// the key.
+func matchLabelPattern(pattern string, lbl Label) (bool, error) {
+	matched, err := path.Match(pattern, lbl.Key)
+	if err != nil || matched {
+		return matched, err
+	}
+	return path.Match(pattern, lbl.Source+":"+lbl.Key)
+}
+
+// StripKeys removes the given label keys from the Custom,
+// OrchestrationIdentity and OrchestrationInfo label sets, returning whether
+// any label was removed. It allows a dynamic ignore-list to be enforced on an
+// existing OpLabels without reconstructing the whole object. Disabled labels
+// are left untouched, as they do not contribute to the security identity.
+func (o *OpLabels) StripKeys(keys []string) (changed bool) {
+	for _, k := range keys {
+		for _, lbls := range []Labels{o.Custom, o.OrchestrationIdentity, o.OrchestrationInfo} {
+			if _, found := lbls[k]; found {
+				delete(lbls, k)
+				changed = true
+			}
+		}
+	}
+	return changed
+}
+
 // upsertLabel updates or inserts 'label' in 'l', but only if exactly the same label
 // was not already in 'l'. Returns 'true' if a label was added, or an old label was
 // updated, 'false' otherwise.
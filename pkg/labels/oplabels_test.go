@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package labels
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestForEachIdentityLabel(t *testing.T) {
+	custom := NewLabel("custom", "a", LabelSourceUnspec)
+	shadowed := NewLabel("shadowed", "custom", LabelSourceUnspec)
+	orch := NewLabel("shadowed", "k8s", LabelSourceK8s)
+	info := NewLabel("info", "b", LabelSourceK8s)
+
+	o := NewOpLabels()
+	o.Custom = Labels{custom.Key: custom, shadowed.Key: shadowed}
+	o.OrchestrationIdentity = Labels{orch.Key: orch}
+	o.OrchestrationInfo = Labels{info.Key: info}
+
+	seen := Labels{}
+	o.ForEachIdentityLabel(func(l Label) bool {
+		seen[l.Key] = l
+		return true
+	})
+	require.Equal(t, o.IdentityLabels(), seen)
+
+	// Early termination after the first label.
+	count := 0
+	o.ForEachIdentityLabel(func(Label) bool {
+		count++
+		return false
+	})
+	require.Equal(t, 1, count)
+}
+
+func TestOpLabelsHasLabel(t *testing.T) {
+	custom := NewLabel("custom", "a", LabelSourceUnspec)
+	disabled := NewLabel("disabled", "b", LabelSourceK8s)
+	info := NewLabel("info", "c", LabelSourceK8s)
+
+	o := NewOpLabels()
+	o.Custom = Labels{custom.Key: custom}
+	o.Disabled = Labels{disabled.Key: disabled}
+	o.OrchestrationInfo = Labels{info.Key: info}
+
+	require.True(t, o.HasLabel(custom))
+	require.True(t, o.HasLabel(disabled))
+	require.True(t, o.HasLabel(info))
+	require.False(t, o.HasLabel(NewLabel("custom", "other", LabelSourceUnspec)))
+	require.False(t, o.HasLabel(NewLabel("missing", "a", LabelSourceUnspec)))
+}
+
+func TestModifyIdentityLabelsGlob(t *testing.T) {
+	name := NewLabel("app.kubernetes.io/name", "frontend", LabelSourceK8s)
+	part := NewLabel("app.kubernetes.io/part-of", "shop", LabelSourceK8s)
+	custom := NewLabel("custom", "a", LabelSourceUnspec)
+	disabled := NewLabel("app.kubernetes.io/version", "v1", LabelSourceK8s)
+
+	mkOpLabels := func() OpLabels {
+		o := NewOpLabels()
+		o.Custom = Labels{custom.Key: custom}
+		o.OrchestrationIdentity = Labels{name.Key: name, part.Key: part}
+		o.Disabled = Labels{disabled.Key: disabled}
+		return o
+	}
+
+	// A glob deletes the whole label family; orchestration labels move to
+	// the disabled set, and the already disabled one stays there.
+	o := mkOpLabels()
+	changed, unmatched, err := o.ModifyIdentityLabelsGlob(nil, []string{"app.kubernetes.io/*"})
+	require.NoError(t, err)
+	require.Empty(t, unmatched)
+	require.True(t, changed)
+	require.Empty(t, o.OrchestrationIdentity)
+	require.Contains(t, o.Custom, custom.Key)
+	require.Contains(t, o.Disabled, name.Key)
+	require.Contains(t, o.Disabled, part.Key)
+	require.Contains(t, o.Disabled, disabled.Key)
+
+	// The source-qualified form of the pattern matches as well.
+	o = mkOpLabels()
+	_, unmatched, err = o.ModifyIdentityLabelsGlob(nil, []string{"k8s:app.kubernetes.io/*"})
+	require.NoError(t, err)
+	require.Empty(t, unmatched)
+	require.Empty(t, o.OrchestrationIdentity)
+
+	// Patterns matching no label are reported, but do not fail the call.
+	o = mkOpLabels()
+	changed, unmatched, err = o.ModifyIdentityLabelsGlob(nil, []string{"custom", "app.example.com/*"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"app.example.com/*"}, unmatched)
+	require.True(t, changed)
+	require.NotContains(t, o.Custom, custom.Key)
+
+	// Malformed patterns are rejected without modifying anything.
+	o = mkOpLabels()
+	changed, _, err = o.ModifyIdentityLabelsGlob(nil, []string{"app.kubernetes.io/["})
+	require.Error(t, err)
+	require.False(t, changed)
+	require.Contains(t, o.OrchestrationIdentity, name.Key)
+}
+
+func TestOpLabelsStripKeys(t *testing.T) {
+	custom := NewLabel("custom", "a", LabelSourceUnspec)
+	ignored := NewLabel("pod-template-hash", "1234", LabelSourceK8s)
+	disabled := NewLabel("pod-template-hash", "5678", LabelSourceK8s)
+	info := NewLabel("info", "c", LabelSourceK8s)
+
+	o := NewOpLabels()
+	o.Custom = Labels{custom.Key: custom}
+	o.OrchestrationIdentity = Labels{ignored.Key: ignored}
+	o.OrchestrationInfo = Labels{info.Key: info}
+	o.Disabled = Labels{disabled.Key: disabled}
+
+	// Unknown keys do not report a change.
+	require.False(t, o.StripKeys([]string{"missing"}))
+
+	// The key is removed from all identity-relevant label sets, but the
+	// disabled set is left alone.
+	require.True(t, o.StripKeys([]string{"pod-template-hash", "info"}))
+	require.NotContains(t, o.OrchestrationIdentity, ignored.Key)
+	require.NotContains(t, o.OrchestrationInfo, info.Key)
+	require.Contains(t, o.Disabled, disabled.Key)
+	require.Contains(t, o.Custom, custom.Key)
+
+	// Stripping the same keys again is a no-op.
+	require.False(t, o.StripKeys([]string{"pod-template-hash", "info"}))
+
+	require.True(t, o.StripKeys([]string{custom.Key}))
+	require.Empty(t, o.Custom)
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package labels
+
+import (
+	"github.com/cilium/cilium/pkg/time"
+)
+
+// TTLLabels is a set of labels with optional per-label expiration. It is
+// intended for label sources whose labels are only valid for a limited
+// duration, such as temporary maintenance labels.
+//
+// TTLLabels is not safe for concurrent use.
+type TTLLabels struct {
+	Labels
+
+	// expiries maps label keys to the time at which the label expires.
+	// Labels without an entry never expire.
+	expiries map[string]time.Time
+}
+
+// NewTTLLabels returns an empty TTLLabels set.
+func NewTTLLabels() *TTLLabels {
+	return &TTLLabels{
+		Labels:   Labels{},
+		expiries: map[string]time.Time{},
+	}
+}
+
+// SetWithTTL adds the given label to the set, to be removed by ExpireOld once
+// ttl has elapsed. A non-positive ttl adds the label without expiration.
+func (t *TTLLabels) SetWithTTL(label Label, ttl time.Duration) {
+	t.Labels[label.Key] = label
+	if ttl > 0 {
+		t.expiries[label.Key] = time.Now().Add(ttl)
+	} else {
+		delete(t.expiries, label.Key)
+	}
+}
+
+// ExpireOld removes and returns all labels whose TTL has elapsed.
+func (t *TTLLabels) ExpireOld() (expired Labels) {
+	expired = Labels{}
+	now := time.Now()
+	for key, deadline := range t.expiries {
+		if deadline.After(now) {
+			continue
+		}
+		expired[key] = t.Labels[key]
+		delete(t.Labels, key)
+		delete(t.expiries, key)
+	}
+	return expired
+}
+
+// identityLabels implements IdentityLabelSet, expiring outdated labels before
+// they are considered for identity determination.
+func (t *TTLLabels) identityLabels() Labels {
+	t.ExpireOld()
+	return t.Labels
+}
+
+// identityLabels implements IdentityLabelSet. Plain labels never expire.
+func (l Labels) identityLabels() Labels {
+	return l
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package labels
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/cilium/pkg/time"
+)
+
+func TestTTLLabelsExpireOld(t *testing.T) {
+	permanent := NewLabel("permanent", "a", LabelSourceUnspec)
+	shortLived := NewLabel("short-lived", "b", LabelSourceUnspec)
+
+	tl := NewTTLLabels()
+	tl.SetWithTTL(permanent, 0)
+	tl.SetWithTTL(shortLived, time.Nanosecond)
+	require.Len(t, tl.Labels, 2)
+
+	// The short-lived label expires immediately, the permanent one stays.
+	time.Sleep(time.Millisecond)
+	expired := tl.ExpireOld()
+	require.Equal(t, Labels{shortLived.Key: shortLived}, expired)
+	require.Equal(t, Labels{permanent.Key: permanent}, tl.Labels)
+
+	// Re-adding a label without TTL clears a previous expiration.
+	tl.SetWithTTL(shortLived, time.Nanosecond)
+	tl.SetWithTTL(shortLived, 0)
+	time.Sleep(time.Millisecond)
+	require.Empty(t, tl.ExpireOld())
+	require.Len(t, tl.Labels, 2)
+}
+
+func TestReplaceIdentityLabelsExpiresTTL(t *testing.T) {
+	logger := logrus.NewEntry(logrus.New())
+
+	stale := NewLabel("stale", "a", LabelSourceK8s)
+	fresh := NewLabel("fresh", "b", LabelSourceK8s)
+
+	tl := NewTTLLabels()
+	tl.SetWithTTL(stale, time.Nanosecond)
+	tl.SetWithTTL(fresh, time.Hour)
+	time.Sleep(time.Millisecond)
+
+	o := NewOpLabels()
+	require.True(t, o.ReplaceIdentityLabels(LabelSourceAny, tl, logger))
+	require.Equal(t, Labels{fresh.Key: fresh}, o.OrchestrationIdentity)
+}
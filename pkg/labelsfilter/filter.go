@@ -291,46 +291,40 @@ func (cfg *labelPrefixCfg) filterLabels(lbls labels.Labels) (identityLabels, inf
 	validLabelPrefixesMU.RLock()
 	defer validLabelPrefixesMU.RUnlock()
 
-	identityLabels = labels.Labels{}
-	informationLabels = labels.Labels{}
-	for k, v := range lbls {
-		included, ignored := 0, 0
-
-		for _, p := range cfg.LabelPrefixes {
-			if m, len := p.matches(v); m {
-				if p.Ignore {
-					// save length of shortest matching ignore
-					if ignored == 0 || len < ignored {
-						ignored = len
-					}
-				} else {
-					// save length of longest matching include
-					if len > included {
-						included = len
-					}
+	return labels.PartitionByIdentityRelevance(lbls, cfg.includes)
+}
+
+// includes returns true if the given label is accepted by the configured
+// label prefixes. A label is accepted if:
+//   - No inclusive LabelPrefix (Ignore flag not set) is
+//     configured and label is not ignored.
+//   - An inclusive LabelPrefix matches the label
+//   - If both an inclusive and ignore LabelPrefix match, the
+//     label is accepted if the matching section in the label
+//     is greater than the ignored matching section in label,
+//     e.g. when evaluating the label foo.bar, the prefix rules
+//     {!foo, foo.bar} will cause the label to be accepted
+//     because the inclusive prefix matches over a longer section.
+func (cfg *labelPrefixCfg) includes(lbl labels.Label) bool {
+	included, ignored := 0, 0
+
+	for _, p := range cfg.LabelPrefixes {
+		if m, len := p.matches(lbl); m {
+			if p.Ignore {
+				// save length of shortest matching ignore
+				if ignored == 0 || len < ignored {
+					ignored = len
+				}
+			} else {
+				// save length of longest matching include
+				if len > included {
+					included = len
 				}
 			}
 		}
-
-		// A label is accepted if :
-		// - No inclusive LabelPrefix (Ignore flag not set) is
-		//   configured and label is not ignored.
-		// - An inclusive LabelPrefix matches the label
-		// - If both an inclusive and ignore LabelPrefix match, the
-		//   label is accepted if the matching section in the label
-		//   is greater than the ignored matching section in label,
-		//   e.g. when evaluating the label foo.bar, the prefix rules
-		//   {!foo, foo.bar} will cause the label to be accepted
-		//   because the inclusive prefix matches over a longer section.
-		if (!cfg.whitelist && ignored == 0) || included > ignored {
-			// Just want to make sure we don't have labels deleted in
-			// on side and disappearing in the other side...
-			identityLabels[k] = v
-		} else {
-			informationLabels[k] = v
-		}
 	}
-	return identityLabels, informationLabels
+
+	return (!cfg.whitelist && ignored == 0) || included > ignored
 }
 
 // Filter returns Labels from the given labels that have the same source and the
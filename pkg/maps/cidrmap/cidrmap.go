@@ -162,7 +162,7 @@ func OpenMapElems(pinPath string, prefixlen int, prefixdyn bool, maxelem uint32)
 		return nil, fmt.Errorf("prefixlen must be > 0")
 	}
 	bytes := (prefixlen-1)/8 + 1
-	m, err := bpf.OpenOrCreateMap(&ebpf.MapSpec{
+	m, _, err := bpf.OpenOrCreateMap(&ebpf.MapSpec{
 		Name:       path.Base(pinPath),
 		Type:       mapType,
 		KeySize:    uint32(unsafe.Sizeof(uint32(0)) + uintptr(bytes)),
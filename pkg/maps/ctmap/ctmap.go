@@ -169,6 +169,12 @@ type GCFilter struct {
 	// removed
 	Time uint32
 
+	// MinLastReportTime, when non-zero, protects entries from expiry-based
+	// removal if their last packet was seen at or after this timestamp.
+	// This guards entries created shortly before a GC cycle from being
+	// deleted prematurely.
+	MinLastReportTime uint32
+
 	// MatchIPs is the list of IPs to remove from the conntrack table
 	MatchIPs map[netip.Addr]struct{}
 
@@ -481,7 +487,8 @@ func cleanup(m *Map, filter GCFilter, natMap *nat.Map, stats *gcStats, next func
 }
 
 func (f GCFilter) doFiltering(srcIP, dstIP netip.Addr, srcPort, dstPort uint16, nextHdr, flags uint8, entry *CtEntry) action {
-	if f.RemoveExpired && entry.Lifetime < f.Time {
+	if f.RemoveExpired && entry.Lifetime < f.Time &&
+		(f.MinLastReportTime == 0 || entry.lastReport() < f.MinLastReportTime) {
 		return deleteEntry
 	}
 
@@ -509,8 +516,16 @@ func doGC(m *Map, filter GCFilter, next4, next6 func(GCEvent)) (int, error) {
 // It returns how many items were deleted from m.
 func GC(m *Map, filter GCFilter, next4, next6 func(GCEvent)) (int, error) {
 	if filter.RemoveExpired {
-		t, _ := timestamp.GetCTCurTime(timestamp.GetClockSourceFromOptions())
+		clockSource := timestamp.GetClockSourceFromOptions()
+		t, _ := timestamp.GetCTCurTime(clockSource)
 		filter.Time = uint32(t)
+
+		if minAge := option.Config.BPFGCMinEntryAge; minAge > 0 {
+			age, err := timestamp.SecondsToCTTime(clockSource, uint64(minAge.Seconds()))
+			if err == nil && t > age {
+				filter.MinLastReportTime = uint32(t - age)
+			}
+		}
 	}
 
 	return doGC(m, filter, next4, next6)
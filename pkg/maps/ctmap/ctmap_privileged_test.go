@@ -915,3 +915,58 @@ func benchmarkCtGc(t *testing.B, size int) {
 		doGCForFamily(ctMap, filter, func(g GCEvent) {}, nil, false)
 	}
 }
+
+// TestCtGcMinEntryAge tests that a CT entry created right before a GC cycle
+// is not deleted when a minimum entry age is enforced via the filter.
+func TestCtGcMinEntryAge(t *testing.T) {
+	setupCTMap(t)
+
+	ctMapName := MapNameTCP4Global + "_test"
+	ctMap := newMap(ctMapName, mapTypeIPv4TCPGlobal)
+	err := ctMap.OpenOrCreate()
+	require.NoError(t, err)
+	defer ctMap.Map.Unpin()
+
+	// The entry has already expired, but its last report timestamp is right
+	// before the GC cycle, as for an entry created while GC was pending.
+	now := uint32(39000)
+	ctKey := &CtKey4Global{
+		tuple.TupleKey4Global{
+			TupleKey4: tuple.TupleKey4{
+				SourceAddr: types.IPv4{192, 168, 61, 12},
+				DestAddr:   types.IPv4{192, 168, 61, 11},
+				SourcePort: 0x50,
+				DestPort:   0x3195,
+				NextHeader: u8proto.TCP,
+				Flags:      tuple.TUPLE_F_OUT,
+			},
+		},
+	}
+	ctVal := &CtEntry{
+		Packets:      1,
+		Bytes:        216,
+		Lifetime:     now - 1,
+		LastTxReport: now - 2,
+	}
+	err = ctMap.Map.Update(ctKey, ctVal)
+	require.NoError(t, err)
+
+	// With a 10 second minimum entry age the fresh entry survives the cycle.
+	filter := GCFilter{
+		RemoveExpired:     true,
+		Time:              now,
+		MinLastReportTime: now - 10,
+	}
+	mcast, next, complete := stream.Multicast[GCEvent]()
+	mcast.Observe(context.Background(), NatMapNext4, func(err error) {})
+	stats := doGCForFamily(ctMap, filter, next, nil, false)
+	require.Equal(t, uint32(0), stats.deleted)
+	require.Equal(t, uint32(1), stats.aliveEntries)
+
+	// Without the minimum age the expired entry is collected.
+	filter.MinLastReportTime = 0
+	stats = doGCForFamily(ctMap, filter, next, nil, false)
+	complete(nil)
+	require.Equal(t, uint32(1), stats.deleted)
+	require.Equal(t, uint32(0), stats.aliveEntries)
+}
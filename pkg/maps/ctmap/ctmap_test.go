@@ -4,6 +4,7 @@
 package ctmap
 
 import (
+	"net/netip"
 	"testing"
 	"time"
 
@@ -77,3 +78,20 @@ func TestFilterMapsByProto(t *testing.T) {
 	require.Nil(t, ctMapTCP)
 	require.Nil(t, ctMapAny)
 }
+
+func TestGCFilterMinLastReportTime(t *testing.T) {
+	var src, dst netip.Addr
+	entry := &CtEntry{Lifetime: 100, LastRxReport: 95}
+	filter := GCFilter{RemoveExpired: true, Time: 200}
+
+	require.Equal(t, deleteEntry, filter.doFiltering(src, dst, 0, 0, 0, 0, entry))
+
+	// Entries reported at or after MinLastReportTime are preserved even if
+	// their lifetime has expired.
+	filter.MinLastReportTime = 90
+	require.Equal(t, noAction, filter.doFiltering(src, dst, 0, 0, 0, 0, entry))
+
+	// Entries idle since before the threshold are still removed.
+	filter.MinLastReportTime = 96
+	require.Equal(t, deleteEntry, filter.doFiltering(src, dst, 0, 0, 0, 0, entry))
+}
@@ -5,6 +5,7 @@ package ctmap
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cilium/cilium/pkg/bpf"
 	"github.com/cilium/cilium/pkg/maps/nat"
@@ -14,6 +15,12 @@ import (
 type gcStats struct {
 	*bpf.DumpStats
 
+	// name is the name of the map being garbage collected.
+	name string
+
+	// maxEntries is the capacity of the map being garbage collected.
+	maxEntries int
+
 	// aliveEntries is the number of scanned entries that are still alive.
 	aliveEntries uint32
 
@@ -68,7 +75,9 @@ func (g gcProtocol) String() string {
 
 func statStartGc(m *Map) gcStats {
 	result := gcStats{
-		DumpStats: bpf.NewDumpStats(&m.Map),
+		DumpStats:  bpf.NewDumpStats(&m.Map),
+		name:       m.Name(),
+		maxEntries: int(m.MaxEntries()),
 	}
 	if m.mapType.isIPv6() {
 		result.family = gcFamilyIPv6
@@ -99,6 +108,7 @@ func (s *gcStats) finish() {
 		status = "completed"
 		metrics.ConntrackGCSize.WithLabelValues(family, proto, metricsAlive).Set(float64(s.aliveEntries))
 		metrics.ConntrackGCSize.WithLabelValues(family, proto, metricsDeleted).Set(float64(s.deleted))
+		recordPressureSample(s.name, s.maxEntries, int(s.aliveEntries), int(s.deleted), time.Now())
 	} else {
 		status = "uncompleted"
 		scopedLog := log.WithField("interrupted", s.Interrupted)
@@ -116,6 +126,12 @@ func (s *gcStats) finish() {
 type NatGCStats struct {
 	*bpf.DumpStats
 
+	// name is the name of the map being garbage collected.
+	name string
+
+	// maxEntries is the capacity of the map being garbage collected.
+	maxEntries int
+
 	// family is the address family
 	Family gcFamily
 
@@ -127,8 +143,10 @@ type NatGCStats struct {
 
 func newNatGCStats(m *nat.Map, family gcFamily) NatGCStats {
 	return NatGCStats{
-		DumpStats: m.DumpStats(),
-		Family:    family,
+		DumpStats:  m.DumpStats(),
+		Family:     family,
+		name:       m.Name(),
+		maxEntries: int(m.MaxEntries()),
 	}
 }
 
@@ -138,4 +156,6 @@ func (s *NatGCStats) finish() {
 	metrics.NatGCSize.WithLabelValues(family, metricsIngress, metricsDeleted).Set(float64(s.IngressDeleted))
 	metrics.NatGCSize.WithLabelValues(family, metricsEgress, metricsAlive).Set(float64(s.EgressAlive))
 	metrics.NatGCSize.WithLabelValues(family, metricsEgress, metricsDeleted).Set(float64(s.EgressDeleted))
+	recordPressureSample(s.name, s.maxEntries, int(s.IngressAlive+s.EgressAlive),
+		int(s.IngressDeleted+s.EgressDeleted), time.Now())
 }
@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package ctmap
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cilium/cilium/api/v1/models"
+	"github.com/cilium/cilium/pkg/lock"
+)
+
+const (
+	// pressureSampleWindow bounds how far back garbage collection samples
+	// are considered when estimating the growth trend of a map.
+	pressureSampleWindow = 15 * time.Minute
+
+	// maxPressureSamples caps the number of samples kept per map so that
+	// short GC intervals do not grow the series without bound.
+	maxPressureSamples = 64
+)
+
+// pressureSample records the outcome of a single garbage collection pass over
+// a CT or NAT map.
+type pressureSample struct {
+	when    time.Time
+	alive   int
+	deleted int
+}
+
+// pressureSeries accumulates garbage collection samples for one map.
+type pressureSeries struct {
+	maxEntries int
+	samples    []pressureSample
+}
+
+var (
+	pressureMutex lock.Mutex
+	mapPressure   = make(map[string]*pressureSeries)
+)
+
+// recordPressureSample stores the outcome of a garbage collection pass for
+// later trend estimation, dropping samples that fell out of the sampling
+// window.
+func recordPressureSample(name string, maxEntries, alive, deleted int, now time.Time) {
+	if name == "" || maxEntries == 0 {
+		return
+	}
+
+	pressureMutex.Lock()
+	defer pressureMutex.Unlock()
+
+	series, ok := mapPressure[name]
+	if !ok {
+		series = &pressureSeries{}
+		mapPressure[name] = series
+	}
+	series.maxEntries = maxEntries
+	series.samples = append(series.samples, pressureSample{
+		when:    now,
+		alive:   alive,
+		deleted: deleted,
+	})
+
+	horizon := now.Add(-pressureSampleWindow)
+	series.samples = slices.DeleteFunc(series.samples, func(s pressureSample) bool {
+		return s.when.Before(horizon)
+	})
+	if excess := len(series.samples) - maxPressureSamples; excess > 0 {
+		series.samples = slices.Delete(series.samples, 0, excess)
+	}
+}
+
+// estimateTimeToFull extrapolates the growth observed across the samples to
+// estimate when the map will reach maxEntries. The second return value is
+// false when the map is shrinking or stable and thus not trending to full.
+func estimateTimeToFull(samples []pressureSample, maxEntries int) (time.Duration, bool) {
+	if len(samples) < 2 {
+		return 0, false
+	}
+	first, last := samples[0], samples[len(samples)-1]
+	elapsed := last.when.Sub(first.when)
+	if elapsed <= 0 {
+		return 0, false
+	}
+	growth := last.alive - first.alive
+	if growth <= 0 {
+		return 0, false
+	}
+	remaining := maxEntries - last.alive
+	if remaining <= 0 {
+		return 0, true
+	}
+	rate := float64(growth) / elapsed.Seconds()
+	return time.Duration(float64(remaining) / rate * float64(time.Second)), true
+}
+
+// gcDeletionRate returns the number of entries removed by garbage collection
+// per second, averaged over the sampling window. The deletions of the first
+// sample are excluded as they predate the window spanned by the samples.
+func gcDeletionRate(samples []pressureSample) float64 {
+	if len(samples) < 2 {
+		return 0
+	}
+	elapsed := samples[len(samples)-1].when.Sub(samples[0].when)
+	if elapsed <= 0 {
+		return 0
+	}
+	deleted := 0
+	for _, s := range samples[1:] {
+		deleted += s.deleted
+	}
+	return float64(deleted) / elapsed.Seconds()
+}
+
+// GetMapPressure returns the fill level and growth trend of the CT and NAT
+// maps observed by the garbage collector, sorted by map name.
+func GetMapPressure() []*models.MapPressureEntry {
+	pressureMutex.Lock()
+	defer pressureMutex.Unlock()
+
+	entries := make([]*models.MapPressureEntry, 0, len(mapPressure))
+	for name, series := range mapPressure {
+		if len(series.samples) == 0 {
+			continue
+		}
+		last := series.samples[len(series.samples)-1]
+		entry := &models.MapPressureEntry{
+			Name:         name,
+			FillRatio:    float64(last.alive) / float64(series.maxEntries),
+			DeletionRate: gcDeletionRate(series.samples),
+		}
+		if ttf, ok := estimateTimeToFull(series.samples, series.maxEntries); ok {
+			entry.TrendingToFull = true
+			entry.TimeToFullSeconds = int64(ttf.Seconds())
+		}
+		entries = append(entries, entry)
+	}
+	slices.SortFunc(entries, func(a, b *models.MapPressureEntry) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return entries
+}
+
+// GetAliveEntries returns the number of entries the last garbage collection
+// pass found alive in each CT and NAT map, keyed by map name. Maps that have
+// not been garbage collected yet are absent.
+func GetAliveEntries() map[string]int64 {
+	pressureMutex.Lock()
+	defer pressureMutex.Unlock()
+
+	alive := make(map[string]int64, len(mapPressure))
+	for name, series := range mapPressure {
+		if len(series.samples) == 0 {
+			continue
+		}
+		alive[name] = int64(series.samples[len(series.samples)-1].alive)
+	}
+	return alive
+}
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package ctmap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateTimeToFull(t *testing.T) {
+	t0 := time.Now()
+	samples := func(counts ...int) []pressureSample {
+		s := make([]pressureSample, 0, len(counts))
+		for i, c := range counts {
+			s = append(s, pressureSample{when: t0.Add(time.Duration(i) * time.Minute), alive: c})
+		}
+		return s
+	}
+
+	// Linear growth of 100 entries per minute leaves 700 entries of
+	// headroom, i.e. seven minutes until the map is full.
+	ttf, ok := estimateTimeToFull(samples(100, 200, 300), 1000)
+	require.True(t, ok)
+	require.Equal(t, 7*time.Minute, ttf)
+
+	// A shrinking map is not trending to full.
+	_, ok = estimateTimeToFull(samples(300, 200, 100), 1000)
+	require.False(t, ok)
+
+	// Neither is a stable one.
+	_, ok = estimateTimeToFull(samples(200, 200, 200), 1000)
+	require.False(t, ok)
+
+	// Sub-linear growth extrapolates from the overall rate across the
+	// window, not the last interval.
+	ttf, ok = estimateTimeToFull(samples(100, 300, 400, 400, 460), 1000)
+	require.True(t, ok)
+	require.Equal(t, 6*time.Minute, ttf)
+
+	// A full map trends to full immediately.
+	ttf, ok = estimateTimeToFull(samples(500, 1000), 1000)
+	require.True(t, ok)
+	require.Equal(t, time.Duration(0), ttf)
+
+	// A single sample provides no trend.
+	_, ok = estimateTimeToFull(samples(100), 1000)
+	require.False(t, ok)
+
+	// Samples taken at the same instant provide no trend either.
+	_, ok = estimateTimeToFull([]pressureSample{
+		{when: t0, alive: 100},
+		{when: t0, alive: 200},
+	}, 1000)
+	require.False(t, ok)
+}
+
+func TestGCDeletionRate(t *testing.T) {
+	t0 := time.Now()
+
+	require.Equal(t, float64(0), gcDeletionRate(nil))
+	require.Equal(t, float64(0), gcDeletionRate([]pressureSample{{when: t0, deleted: 10}}))
+
+	// 120 deletions across a two minute window. The deletions of the first
+	// sample predate the window and are excluded.
+	rate := gcDeletionRate([]pressureSample{
+		{when: t0, deleted: 1000},
+		{when: t0.Add(time.Minute), deleted: 60},
+		{when: t0.Add(2 * time.Minute), deleted: 60},
+	})
+	require.Equal(t, float64(1), rate)
+}
+
+func TestGetMapPressure(t *testing.T) {
+	pressureMutex.Lock()
+	saved := mapPressure
+	mapPressure = make(map[string]*pressureSeries)
+	pressureMutex.Unlock()
+	defer func() {
+		pressureMutex.Lock()
+		mapPressure = saved
+		pressureMutex.Unlock()
+	}()
+
+	t0 := time.Now()
+	recordPressureSample("cilium_ct4_global", 1000, 100, 0, t0)
+	recordPressureSample("cilium_ct4_global", 1000, 300, 30, t0.Add(time.Minute))
+	recordPressureSample("cilium_snat_v4_external", 1000, 500, 0, t0)
+	recordPressureSample("cilium_snat_v4_external", 1000, 400, 60, t0.Add(time.Minute))
+
+	entries := GetMapPressure()
+	require.Len(t, entries, 2)
+
+	ct := entries[0]
+	require.Equal(t, "cilium_ct4_global", ct.Name)
+	require.InDelta(t, 0.3, ct.FillRatio, 0.0001)
+	require.InDelta(t, 0.5, ct.DeletionRate, 0.0001)
+	require.True(t, ct.TrendingToFull)
+	require.Equal(t, int64((210 * time.Second).Seconds()), ct.TimeToFullSeconds)
+
+	nat := entries[1]
+	require.Equal(t, "cilium_snat_v4_external", nat.Name)
+	require.InDelta(t, 0.4, nat.FillRatio, 0.0001)
+	require.InDelta(t, 1.0, nat.DeletionRate, 0.0001)
+	require.False(t, nat.TrendingToFull)
+}
+
+func TestGetAliveEntries(t *testing.T) {
+	pressureMutex.Lock()
+	saved := mapPressure
+	mapPressure = make(map[string]*pressureSeries)
+	pressureMutex.Unlock()
+	defer func() {
+		pressureMutex.Lock()
+		mapPressure = saved
+		pressureMutex.Unlock()
+	}()
+
+	require.Empty(t, GetAliveEntries())
+
+	t0 := time.Now()
+	recordPressureSample("cilium_ct4_global", 1000, 100, 0, t0)
+	recordPressureSample("cilium_ct4_global", 1000, 300, 30, t0.Add(time.Minute))
+	recordPressureSample("cilium_snat_v4_external", 1000, 500, 0, t0)
+
+	// The most recent sample of each map wins.
+	alive := GetAliveEntries()
+	require.Len(t, alive, 2)
+	require.Equal(t, int64(300), alive["cilium_ct4_global"])
+	require.Equal(t, int64(500), alive["cilium_snat_v4_external"])
+}
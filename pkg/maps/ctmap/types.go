@@ -562,6 +562,12 @@ func (c *CtEntry) isDsrInternalEntry() bool {
 	return c.Flags&DSRInternal != 0
 }
 
+// lastReport returns the most recent of the rx/tx report timestamps of the
+// entry. For a freshly created entry this is its creation time.
+func (c *CtEntry) lastReport() uint32 {
+	return max(c.LastTxReport, c.LastRxReport)
+}
+
 func (c *CtEntry) flagsString() string {
 	var sb strings.Builder
 
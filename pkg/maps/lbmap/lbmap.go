@@ -170,6 +170,7 @@ func (lbmap *LBBPFMap) UpsertService(p *datapathTypes.UpsertServiceParams) error
 			return err
 		}
 	}
+	recordServiceAlgorithm(uint16(p.ID), p.UseMaglev)
 	return nil
 }
 
@@ -249,6 +250,7 @@ func (*LBBPFMap) DeleteService(svc loadbalancer.L3n4AddrID, backendCount int, us
 			return err
 		}
 	}
+	forgetServiceAlgorithm(uint16(svc.ID))
 	return nil
 }
 
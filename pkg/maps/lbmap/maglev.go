@@ -7,10 +7,12 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"sort"
 
 	"github.com/cilium/cilium/pkg/bpf"
 	"github.com/cilium/cilium/pkg/ebpf"
 	"github.com/cilium/cilium/pkg/loadbalancer"
+	"github.com/cilium/cilium/pkg/lock"
 )
 
 const (
@@ -28,6 +30,134 @@ var (
 	maglevTableSize     uint32
 )
 
+// MaglevTableStatus describes the reconciliation state of a single service's
+// Maglev lookup table.
+type MaglevTableStatus struct {
+	// RevNatID is the reverse NAT ID of the service owning the table.
+	RevNatID uint16
+
+	// IPv6 is true if the table belongs to the IPv6 outer map.
+	IPv6 bool
+
+	// Populated is true if the inner lookup table was successfully created
+	// and inserted into the outer map.
+	Populated bool
+
+	// LastError holds the error of the most recent failed reconciliation
+	// of the table, if any.
+	LastError string
+}
+
+type maglevTableKey struct {
+	revNatID uint16
+	ipv6     bool
+}
+
+// Bookkeeping of the Maglev table reconciliation outcome per service, so that
+// the status API can report table health without dumping BPF maps.
+var (
+	maglevBookkeepingMutex lock.RWMutex
+	maglevTables           = map[maglevTableKey]MaglevTableStatus{}
+	randomServices         = map[uint16]struct{}{}
+)
+
+// recordMaglevTableUpdate updates the bookkeeping of the given service's
+// Maglev lookup table after a reconciliation attempt.
+func recordMaglevTableUpdate(ipv6 bool, revNATID uint16, err error) {
+	maglevBookkeepingMutex.Lock()
+	defer maglevBookkeepingMutex.Unlock()
+
+	key := maglevTableKey{revNatID: revNATID, ipv6: ipv6}
+	status := maglevTables[key]
+	status.RevNatID = revNATID
+	status.IPv6 = ipv6
+	if err != nil {
+		status.LastError = err.Error()
+	} else {
+		status.Populated = true
+		status.LastError = ""
+	}
+	maglevTables[key] = status
+}
+
+// forgetMaglevTable drops the bookkeeping of the given service's Maglev
+// lookup table after it has been removed.
+func forgetMaglevTable(ipv6 bool, revNATID uint16) {
+	maglevBookkeepingMutex.Lock()
+	defer maglevBookkeepingMutex.Unlock()
+
+	delete(maglevTables, maglevTableKey{revNatID: revNATID, ipv6: ipv6})
+}
+
+// recordServiceAlgorithm updates the bookkeeping of which backend selection
+// algorithm the given service uses.
+func recordServiceAlgorithm(revNATID uint16, useMaglev bool) {
+	maglevBookkeepingMutex.Lock()
+	defer maglevBookkeepingMutex.Unlock()
+
+	if useMaglev {
+		delete(randomServices, revNATID)
+	} else {
+		randomServices[revNATID] = struct{}{}
+	}
+}
+
+// forgetServiceAlgorithm drops the backend selection algorithm bookkeeping
+// for the given service.
+func forgetServiceAlgorithm(revNATID uint16) {
+	maglevBookkeepingMutex.Lock()
+	defer maglevBookkeepingMutex.Unlock()
+
+	delete(randomServices, revNATID)
+}
+
+// MaglevTableStatuses returns the reconciliation state of all known Maglev
+// lookup tables, ordered by service ID with IPv4 tables first.
+func MaglevTableStatuses() []MaglevTableStatus {
+	maglevBookkeepingMutex.RLock()
+	defer maglevBookkeepingMutex.RUnlock()
+
+	statuses := make([]MaglevTableStatus, 0, len(maglevTables))
+	for _, status := range maglevTables {
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		if statuses[i].RevNatID != statuses[j].RevNatID {
+			return statuses[i].RevNatID < statuses[j].RevNatID
+		}
+		return !statuses[i].IPv6 && statuses[j].IPv6
+	})
+	return statuses
+}
+
+// MaglevServiceCounts returns the number of services currently using the
+// Maglev backend selection algorithm and the number using random selection.
+func MaglevServiceCounts() (maglev, random int) {
+	maglevBookkeepingMutex.RLock()
+	defer maglevBookkeepingMutex.RUnlock()
+
+	maglevIDs := make(map[uint16]struct{}, len(maglevTables))
+	for key := range maglevTables {
+		maglevIDs[key.revNatID] = struct{}{}
+	}
+	return len(maglevIDs), len(randomServices)
+}
+
+// MaglevInnerMapsAllocated returns the number of inner Maglev maps currently
+// inserted into the outer maps.
+func MaglevInnerMapsAllocated() int {
+	maglevBookkeepingMutex.RLock()
+	defer maglevBookkeepingMutex.RUnlock()
+
+	allocated := 0
+	for _, status := range maglevTables {
+		if status.Populated {
+			allocated++
+		}
+	}
+	return allocated
+}
+
 // InitMaglevMaps inits the ipv4 and/or ipv6 maglev outer and inner maps.
 func InitMaglevMaps(ipv4, ipv6 bool, tableSize uint32) error {
 	// Always try to delete old maps with the wrong M parameter, otherwise
@@ -104,7 +234,9 @@ func deleteMapIfMNotMatch(mapName string, tableSize uint32) (bool, error) {
 
 // updateMaglevTable creates a new inner Maglev map containing the given backend IDs
 // and sets it as the active lookup table for the given service ID.
-func updateMaglevTable(ipv6 bool, revNATID uint16, backendIDs []loadbalancer.BackendID) error {
+func updateMaglevTable(ipv6 bool, revNATID uint16, backendIDs []loadbalancer.BackendID) (err error) {
+	defer func() { recordMaglevTableUpdate(ipv6, revNATID, err) }()
+
 	outer := maglevOuter4Map
 	if ipv6 {
 		outer = maglevOuter6Map
@@ -138,6 +270,10 @@ func deleteMaglevTable(ipv6 bool, revNATID uint16) error {
 		outerMap = maglevOuter6Map
 	}
 
+	// The table is going away, drop its bookkeeping regardless of whether
+	// the removal from the outer map succeeds.
+	forgetMaglevTable(ipv6, revNATID)
+
 	outerKey := MaglevOuterKey{RevNatID: revNATID}
 	if err := outerMap.Delete(outerKey.toNetwork()); err != nil {
 		return err
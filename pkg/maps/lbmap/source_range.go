@@ -141,6 +141,27 @@ var (
 	SourceRange6Map *bpf.Map
 )
 
+// SourceRangeRestrictedServices returns the number of services with at least
+// one source range CIDR programmed into the datapath, across both IP
+// families.
+func SourceRangeRestrictedServices() (int, error) {
+	ids := make(map[uint16]struct{})
+	parser := func(key bpf.MapKey, _ bpf.MapValue) {
+		if k, ok := key.(SourceRangeKey); ok {
+			ids[k.ToHost().GetRevNATID()] = struct{}{}
+		}
+	}
+	for _, m := range []*bpf.Map{SourceRange4Map, SourceRange6Map} {
+		if m == nil {
+			continue
+		}
+		if err := m.DumpWithCallbackIfExists(parser); err != nil {
+			return 0, err
+		}
+	}
+	return len(ids), nil
+}
+
 // initSourceRange creates the BPF maps for storing both IPv4 and IPv6
 // service source ranges.
 func initSourceRange(params InitParams) {
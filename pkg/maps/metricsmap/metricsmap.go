@@ -159,14 +159,63 @@ func (vs Values) Bytes() uint64 {
 	return b
 }
 
+// SocketLBStats aggregates the socket-LB connection counters maintained by
+// the cgroup datapath programs in the metrics map.
+type SocketLBStats struct {
+	// NoBackend is the number of service lookups that found no backend.
+	NoBackend uint64
+
+	// HostnsOnlyRejected is the number of connections rejected because
+	// socket-LB is restricted to the host namespace.
+	HostnsOnlyRejected uint64
+
+	// XlateTCP is the number of TCP connections translated by socket-LB.
+	XlateTCP uint64
+
+	// XlateUDP is the number of UDP connections translated by socket-LB.
+	XlateUDP uint64
+}
+
+// socketLBEvents maps the socket-LB reason codes to the event label of the
+// cilium_socket_lb_events_total metric.
+var socketLBEvents = map[uint8]string{
+	monitorAPI.ReasonLBNoBackend:        "no_backend",
+	monitorAPI.ReasonSockLBRejectHostns: "hostns_only_rejected",
+	monitorAPI.ReasonSockLBXlateTCP:     "xlate_tcp",
+	monitorAPI.ReasonSockLBXlateUDP:     "xlate_udp",
+}
+
+// CollectSocketLBStats sums the socket-LB related counters of the given
+// metrics map.
+func CollectSocketLBStats(m MetricsMap) (*SocketLBStats, error) {
+	stats := &SocketLBStats{}
+	err := m.IterateWithCallback(func(key *Key, values *Values) {
+		switch key.Reason {
+		case monitorAPI.ReasonLBNoBackend:
+			stats.NoBackend += values.Count()
+		case monitorAPI.ReasonSockLBRejectHostns:
+			stats.HostnsOnlyRejected += values.Count()
+		case monitorAPI.ReasonSockLBXlateTCP:
+			stats.XlateTCP += values.Count()
+		case monitorAPI.ReasonSockLBXlateUDP:
+			stats.XlateUDP += values.Count()
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
 // metricsMapCollector implements Prometheus Collector interface
 type metricsmapCollector struct {
 	mutex lock.Mutex
 
-	droppedCountDesc *prometheus.Desc
-	droppedByteDesc  *prometheus.Desc
-	forwardCountDesc *prometheus.Desc
-	forwardByteDesc  *prometheus.Desc
+	droppedCountDesc  *prometheus.Desc
+	droppedByteDesc   *prometheus.Desc
+	forwardCountDesc  *prometheus.Desc
+	forwardByteDesc   *prometheus.Desc
+	socketLBCountDesc *prometheus.Desc
 }
 
 func newMetricsMapCollector() prometheus.Collector {
@@ -191,6 +240,11 @@ func newMetricsMapCollector() prometheus.Collector {
 			"Total forwarded bytes, tagged by ingress/egress direction",
 			[]string{metrics.LabelDirection}, nil,
 		),
+		socketLBCountDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "", "socket_lb_events_total"),
+			"Total socket-LB connection events, tagged by event type",
+			[]string{"event"}, nil,
+		),
 	}
 }
 
@@ -203,6 +257,10 @@ type dropLabels struct {
 	reason    string
 }
 
+type socketLBLabels struct {
+	event string
+}
+
 type metricValues struct {
 	bytes float64
 	count float64
@@ -260,6 +318,7 @@ func (mc *metricsmapCollector) Collect(ch chan<- prometheus.Metric) {
 
 	drop := make(promMetrics[dropLabels])
 	fwd := make(promMetrics[forwardLabels])
+	socketLB := make(promMetrics[socketLBLabels])
 
 	err := Metrics.IterateWithCallback(func(key *Key, values *Values) {
 		if key.IsDrop() {
@@ -272,6 +331,10 @@ func (mc *metricsmapCollector) Collect(ch chan<- prometheus.Metric) {
 			return
 		}
 
+		if event, ok := socketLBEvents[key.Reason]; ok {
+			socketLB.sum(socketLBLabels{event: event}, values)
+		}
+
 		labelSet := forwardLabels{
 			direction: key.Direction(),
 		}
@@ -292,6 +355,10 @@ func (mc *metricsmapCollector) Collect(ch chan<- prometheus.Metric) {
 		mc.updateCounterMetric(mc.droppedCountDesc, ch, value.count, labels.reason, labels.direction)
 		mc.updateCounterMetric(mc.droppedByteDesc, ch, value.bytes, labels.reason, labels.direction)
 	}
+
+	for labels, value := range socketLB {
+		mc.updateCounterMetric(mc.socketLBCountDesc, ch, value.count, labels.event)
+	}
 }
 
 func (mc *metricsmapCollector) updateCounterMetric(desc *prometheus.Desc, metricsChan chan<- prometheus.Metric, value float64, labelValues ...string) {
@@ -307,6 +374,7 @@ func (mc *metricsmapCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- mc.forwardCountDesc
 	ch <- mc.droppedCountDesc
 	ch <- mc.droppedByteDesc
+	ch <- mc.socketLBCountDesc
 }
 
 func RegisterCollector() {
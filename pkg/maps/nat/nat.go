@@ -437,6 +437,27 @@ func RetriesMaps(ipv4, ipv6, nodeport bool) (ipv4RetriesMap, ipv6RetriesMap Retr
 	return
 }
 
+// AllocFailures returns the number of SNAT port allocations that failed after
+// exhausting all retries, summed across CPUs. This is the last bucket of the
+// retries histogram: an allocation that still collides after
+// SnatCollisionRetries attempts is dropped by the datapath. The map must be
+// open.
+func AllocFailures(m RetriesMap) (uint64, error) {
+	var failures uint64
+	callback := func(key bpf.MapKey, values any) {
+		if key.(*RetriesKey).Key != SnatCollisionRetries {
+			return
+		}
+		for _, v := range *values.(*RetriesValues) {
+			failures += uint64(v.Value)
+		}
+	}
+	if err := m.DumpPerCPUWithCallback(callback); err != nil {
+		return 0, err
+	}
+	return failures, nil
+}
+
 func CreateRetriesMaps(ipv4, ipv6 bool) error {
 	if ipv4 {
 		ipv4Map := NewRetriesMap(MapNameSnat4AllocRetries)
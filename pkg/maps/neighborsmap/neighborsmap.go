@@ -98,6 +98,23 @@ func InitMaps(ipv4, ipv6 bool) error {
 	return nil
 }
 
+// Count returns the combined number of entries in the IPv4 and IPv6 neighbor
+// maps. Maps which cannot be opened are counted as empty.
+func Count() int {
+	count := 0
+	neigh4Map, neigh6Map := neighMapsGet()
+	for _, neighMap := range []*bpf.Map{neigh4Map, neigh6Map} {
+		if err := neighMap.Open(); err != nil {
+			continue
+		}
+		neighMap.DumpWithCallback(func(bpf.MapKey, bpf.MapValue) {
+			count++
+		})
+		neighMap.Close()
+	}
+	return count
+}
+
 // NeighRetire retires a cached neigh entry from the LRU cache
 func NeighRetire(ip net.IP) {
 	var neighMap *bpf.Map
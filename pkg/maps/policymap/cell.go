@@ -13,6 +13,7 @@ import (
 
 	"github.com/cilium/cilium/pkg/bpf"
 	"github.com/cilium/cilium/pkg/datapath/linux/config/defines"
+	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/logging/logfields"
 	"github.com/cilium/cilium/pkg/option"
 )
@@ -55,6 +56,17 @@ type Factory interface {
 
 	PolicyMaxEntries() int
 	StatsMaxEntries() int
+
+	// UpdatePressure records the number of entries in use in the policy
+	// map of the given endpoint.
+	UpdatePressure(id uint16, used int)
+	// RecordInsertFailure records a failed policy map insert for the given
+	// endpoint.
+	RecordInsertFailure(id uint16)
+	// PressureReport returns the tracked per-endpoint policy map
+	// utilization, sorted by the number of entries in use in descending
+	// order.
+	PressureReport() []EndpointPressure
 }
 
 type factory struct {
@@ -64,12 +76,20 @@ type factory struct {
 	policyMapEntries int
 
 	stats *StatsMap
+
+	// pressureMutex protects pressure.
+	pressureMutex lock.RWMutex
+
+	// pressure tracks per-endpoint policy map utilization and insert
+	// failures, keyed by endpoint ID.
+	pressure map[uint16]*EndpointPressure
 }
 
 func newFactory(stats *StatsMap, policyMapEntries int) *factory {
 	return &factory{
 		policyMapEntries: policyMapEntries,
 		stats:            stats,
+		pressure:         map[uint16]*EndpointPressure{},
 	}
 }
 
@@ -99,6 +119,10 @@ func (f *factory) CreateEndpoint(id uint16) error {
 
 // CreateEndpoint removes the policy map if the specified endpoint.
 func (f *factory) RemoveEndpoint(id uint16) error {
+	f.pressureMutex.Lock()
+	delete(f.pressure, id)
+	f.pressureMutex.Unlock()
+
 	return os.RemoveAll(bpf.LocalMapPath(MapName, id))
 }
 
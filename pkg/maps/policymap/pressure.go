@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package policymap
+
+import (
+	"sort"
+)
+
+// EndpointPressure describes the utilization of a single endpoint's policy
+// map as tracked by the factory.
+type EndpointPressure struct {
+	// EndpointID is the ID of the endpoint owning the policy map.
+	EndpointID uint16
+
+	// Used is the number of entries in use in the endpoint's policy map.
+	Used int
+
+	// InsertFailures is the number of policy map inserts that have failed
+	// for the endpoint, typically because the map was full.
+	InsertFailures int64
+}
+
+// UpdatePressure records the number of entries in use in the policy map of
+// the given endpoint.
+func (f *factory) UpdatePressure(id uint16, used int) {
+	f.pressureMutex.Lock()
+	defer f.pressureMutex.Unlock()
+
+	p, ok := f.pressure[id]
+	if !ok {
+		p = &EndpointPressure{EndpointID: id}
+		f.pressure[id] = p
+	}
+	p.Used = used
+}
+
+// RecordInsertFailure records a failed policy map insert for the given
+// endpoint.
+func (f *factory) RecordInsertFailure(id uint16) {
+	f.pressureMutex.Lock()
+	defer f.pressureMutex.Unlock()
+
+	p, ok := f.pressure[id]
+	if !ok {
+		p = &EndpointPressure{EndpointID: id}
+		f.pressure[id] = p
+	}
+	p.InsertFailures++
+}
+
+// PressureReport returns the tracked per-endpoint policy map utilization,
+// sorted by the number of entries in use in descending order.
+func (f *factory) PressureReport() []EndpointPressure {
+	f.pressureMutex.RLock()
+	report := make([]EndpointPressure, 0, len(f.pressure))
+	for _, p := range f.pressure {
+		report = append(report, *p)
+	}
+	f.pressureMutex.RUnlock()
+
+	sort.Slice(report, func(i, j int) bool {
+		if report[i].Used != report[j].Used {
+			return report[i].Used > report[j].Used
+		}
+		return report[i].EndpointID < report[j].EndpointID
+	})
+
+	return report
+}
@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package policymap
+
+import (
+	"testing"
+
+	"github.com/cilium/ebpf/rlimit"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/cilium/pkg/bpf"
+	"github.com/cilium/cilium/pkg/identity"
+	"github.com/cilium/cilium/pkg/policy/trafficdirection"
+	"github.com/cilium/cilium/pkg/testutils"
+	"github.com/cilium/cilium/pkg/u8proto"
+)
+
+// TestPolicyMapOverflowPressure fills a policy map shrunken well below the
+// configurable minimum and verifies that the factory tracking reflects both
+// the utilization and the insert failure once the map overflows.
+func TestPolicyMapOverflowPressure(t *testing.T) {
+	testutils.PrivilegedTest(t)
+
+	bpf.CheckOrMountFS("")
+
+	if err := rlimit.RemoveMemlock(); err != nil {
+		t.Fatal(err)
+	}
+
+	const overflowMapSize = 4
+
+	stats, err := createStatsMapForTest(testMapSize)
+	require.NoError(t, err)
+	require.NotNil(t, stats)
+
+	f := newFactory(stats, overflowMapSize)
+
+	testMap, err := newPolicyMap(0, overflowMapSize, stats)
+	require.NoError(t, err)
+	require.NotNil(t, testMap)
+
+	err = testMap.CreateUnpinned()
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, testMap.DeleteAll())
+	})
+
+	for i := range overflowMapSize {
+		key := NewKey(trafficdirection.Ingress, identity.NumericIdentity(i+1), u8proto.TCP, 80, SinglePortPrefixLen)
+		entry := newAllowEntry(key, 0, 0, 0)
+		require.NoError(t, testMap.Update(&key, &entry))
+	}
+	f.UpdatePressure(0, overflowMapSize)
+
+	// One more entry than the map can hold must fail the insert.
+	key := NewKey(trafficdirection.Ingress, overflowMapSize+1, u8proto.TCP, 80, SinglePortPrefixLen)
+	entry := newAllowEntry(key, 0, 0, 0)
+	err = testMap.Update(&key, &entry)
+	require.Error(t, err)
+	f.RecordInsertFailure(0)
+
+	report := f.PressureReport()
+	require.Len(t, report, 1)
+	require.Equal(t, uint16(0), report[0].EndpointID)
+	require.Equal(t, overflowMapSize, report[0].Used)
+	require.Equal(t, int64(1), report[0].InsertFailures)
+
+	// Removing the endpoint drops its tracking state.
+	require.NoError(t, f.RemoveEndpoint(0))
+	require.Empty(t, f.PressureReport())
+}
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package policymap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFactoryPressureReport(t *testing.T) {
+	f := newFactory(nil, 4)
+
+	f.UpdatePressure(1, 2)
+	f.UpdatePressure(2, 4)
+	f.UpdatePressure(3, 1)
+	f.RecordInsertFailure(2)
+	f.RecordInsertFailure(2)
+
+	report := f.PressureReport()
+	require.Len(t, report, 3)
+
+	require.Equal(t, uint16(2), report[0].EndpointID)
+	require.Equal(t, 4, report[0].Used)
+	require.Equal(t, int64(2), report[0].InsertFailures)
+
+	require.Equal(t, uint16(1), report[1].EndpointID)
+	require.Equal(t, 2, report[1].Used)
+	require.Equal(t, int64(0), report[1].InsertFailures)
+
+	require.Equal(t, uint16(3), report[2].EndpointID)
+}
+
+func TestFactoryPressureReportTieBreak(t *testing.T) {
+	f := newFactory(nil, 4)
+
+	f.UpdatePressure(7, 3)
+	f.UpdatePressure(5, 3)
+
+	report := f.PressureReport()
+	require.Len(t, report, 2)
+	require.Equal(t, uint16(5), report[0].EndpointID)
+	require.Equal(t, uint16(7), report[1].EndpointID)
+}
+
+func TestFactoryRecordInsertFailureUntracked(t *testing.T) {
+	f := newFactory(nil, 4)
+
+	// An insert failure for an endpoint without a prior pressure update
+	// still starts tracking it.
+	f.RecordInsertFailure(9)
+
+	report := f.PressureReport()
+	require.Len(t, report, 1)
+	require.Equal(t, uint16(9), report[0].EndpointID)
+	require.Equal(t, 0, report[0].Used)
+	require.Equal(t, int64(1), report[0].InsertFailures)
+}
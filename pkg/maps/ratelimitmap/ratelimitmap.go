@@ -163,6 +163,12 @@ func (v *MetricsValue) String() string {
 	return fmt.Sprintf("%d", v.Dropped)
 }
 
+// Metrics returns the ratelimit metrics map, which tracks the drop counters
+// of the datapath rate limiters.
+func Metrics() RatelimitMetricsMap {
+	return ratelimitMetrics
+}
+
 // DumpWithCallback iterates through all the keys/values of the ratelimit metrics map,
 // passing each key/value pair to the cb callback
 func (rm ratelimitMetricsMap) DumpWithCallback(cb DumpCallback) error {
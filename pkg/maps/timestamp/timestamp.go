@@ -95,6 +95,23 @@ func GetCTCurTime(clockSource *models.ClockSource) (uint64, error) {
 	}
 }
 
+// SecondsToCTTime converts a number of seconds into the units that are used
+// for timestamps in CT and NAT maps (seconds for ClockSourceModeKtime and
+// scaled jiffies for ClockSourceModeJiffies).
+func SecondsToCTTime(clockSource *models.ClockSource, seconds uint64) (uint64, error) {
+	switch clockSource.Mode {
+	case models.ClockSourceModeKtime:
+		return seconds, nil
+	case models.ClockSourceModeJiffies:
+		if clockSource.Hertz == 0 {
+			return 0, fmt.Errorf("invalid clock Hertz value (0)")
+		}
+		return (seconds * uint64(clockSource.Hertz)) >> bpfMonoScaler, nil
+	default:
+		return 0, fmt.Errorf("invalid clocksource: %s", clockSource.Mode)
+	}
+}
+
 type TimestampConverter func(timestamp uint64) uint64
 
 // Returns a function that converts a CT timestamp from clocksource units into
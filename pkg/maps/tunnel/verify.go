@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package tunnel
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cilium/cilium/pkg/bpf"
+)
+
+// Discrepancies describes the differences between the contents of the tunnel
+// map and an expected prefix to tunnel endpoint mapping.
+type Discrepancies struct {
+	// Missing contains the expected entries absent from the tunnel map,
+	// formatted as "prefix -> endpoint".
+	Missing []string
+
+	// Orphaned contains the tunnel map entries without a corresponding
+	// expected prefix, formatted as "prefix -> endpoint".
+	Orphaned []string
+
+	// Mismatched contains the tunnel map entries pointing to an unexpected
+	// tunnel endpoint, formatted as "prefix: have <actual>, want <expected>".
+	Mismatched []string
+}
+
+// Count returns the total number of discrepancies.
+func (d Discrepancies) Count() int {
+	return len(d.Missing) + len(d.Orphaned) + len(d.Mismatched)
+}
+
+// Intersect returns the discrepancies present in both d and other. It is used
+// to require a discrepancy to persist over consecutive comparison runs before
+// reporting it, to tolerate the transient windows during node add/remove.
+func (d Discrepancies) Intersect(other Discrepancies) Discrepancies {
+	return Discrepancies{
+		Missing:    intersect(d.Missing, other.Missing),
+		Orphaned:   intersect(d.Orphaned, other.Orphaned),
+		Mismatched: intersect(d.Mismatched, other.Mismatched),
+	}
+}
+
+func intersect(a, b []string) []string {
+	inB := make(map[string]struct{}, len(b))
+	for _, s := range b {
+		inB[s] = struct{}{}
+	}
+	var out []string
+	for _, s := range a {
+		if _, ok := inB[s]; ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// CompareEntries compares the actual tunnel map contents against the expected
+// mapping. Both maps are keyed by the prefix address in the format produced by
+// TunnelKey.String, with the tunnel endpoint address as value. The returned
+// discrepancy lists are sorted for deterministic output.
+func CompareEntries(expected, actual map[string]string) Discrepancies {
+	var d Discrepancies
+	for prefix, endpoint := range expected {
+		have, found := actual[prefix]
+		switch {
+		case !found:
+			d.Missing = append(d.Missing, fmt.Sprintf("%s -> %s", prefix, endpoint))
+		case have != endpoint:
+			d.Mismatched = append(d.Mismatched, fmt.Sprintf("%s: have %s, want %s", prefix, have, endpoint))
+		}
+	}
+	for prefix, endpoint := range actual {
+		if _, found := expected[prefix]; !found {
+			d.Orphaned = append(d.Orphaned, fmt.Sprintf("%s -> %s", prefix, endpoint))
+		}
+	}
+	sort.Strings(d.Missing)
+	sort.Strings(d.Orphaned)
+	sort.Strings(d.Mismatched)
+	return d
+}
+
+// DumpEndpoints returns the current contents of the tunnel map as a map from
+// prefix address to tunnel endpoint address, without the encryption key
+// suffix included by Dump.
+func (m *Map) DumpEndpoints() (map[string]string, error) {
+	entries := map[string]string{}
+	err := m.DumpWithCallback(func(k bpf.MapKey, v bpf.MapValue) {
+		key, ok := k.(*TunnelKey)
+		if !ok {
+			return
+		}
+		value, ok := v.(*TunnelValue)
+		if !ok {
+			return
+		}
+		if ip := value.toIP(); ip != nil {
+			entries[key.String()] = ip.String()
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
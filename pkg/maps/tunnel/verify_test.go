@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package tunnel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareEntries(t *testing.T) {
+	expected := map[string]string{
+		"10.0.1.0": "192.168.1.2",
+		"10.0.2.0": "192.168.1.3",
+		"10.0.3.0": "192.168.1.4",
+	}
+	actual := map[string]string{
+		"10.0.1.0": "192.168.1.2", // consistent
+		"10.0.2.0": "192.168.1.9", // wrong endpoint
+		"10.0.4.0": "192.168.1.5", // node no longer exists
+	}
+
+	d := CompareEntries(expected, actual)
+	require.Equal(t, []string{"10.0.3.0 -> 192.168.1.4"}, d.Missing)
+	require.Equal(t, []string{"10.0.4.0 -> 192.168.1.5"}, d.Orphaned)
+	require.Equal(t, []string{"10.0.2.0: have 192.168.1.9, want 192.168.1.3"}, d.Mismatched)
+	require.Equal(t, 3, d.Count())
+
+	d = CompareEntries(expected, map[string]string{
+		"10.0.1.0": "192.168.1.2",
+		"10.0.2.0": "192.168.1.3",
+		"10.0.3.0": "192.168.1.4",
+	})
+	require.Equal(t, 0, d.Count())
+}
+
+func TestDiscrepanciesIntersect(t *testing.T) {
+	first := Discrepancies{
+		Missing:  []string{"10.0.1.0 -> 192.168.1.2", "10.0.2.0 -> 192.168.1.3"},
+		Orphaned: []string{"10.0.4.0 -> 192.168.1.5"},
+	}
+	second := Discrepancies{
+		// Only one of the missing entries persisted; the orphaned entry
+		// was cleaned up in the meantime.
+		Missing:    []string{"10.0.2.0 -> 192.168.1.3"},
+		Mismatched: []string{"10.0.5.0: have 192.168.1.9, want 192.168.1.6"},
+	}
+
+	confirmed := second.Intersect(first)
+	require.Equal(t, []string{"10.0.2.0 -> 192.168.1.3"}, confirmed.Missing)
+	require.Empty(t, confirmed.Orphaned)
+	require.Empty(t, confirmed.Mismatched)
+	require.Equal(t, 1, confirmed.Count())
+}
@@ -157,6 +157,9 @@ const (
 
 	LabelSource = "source"
 
+	// LabelProbe is the label for the name of a status probe
+	LabelProbe = "probe"
+
 	// LabelScope is the label used to defined multiples scopes in the same
 	// metric. For example, one counter may measure a metric over the scope of
 	// the entire event (scope=global), or just part of an event
@@ -211,6 +214,18 @@ const (
 	// LabelArch is the label for the platform architecture (e.g. linux/amd64)
 	LabelArch = "arch"
 
+	// LabelAttachMode is the label for the datapath attach mode (tc or tcx)
+	LabelAttachMode = "attach_mode"
+
+	// LabelDatapathMode is the label for the datapath mode (veth or netkit)
+	LabelDatapathMode = "datapath_mode"
+
+	// LabelRoutingMode is the label for the inter-host routing mode
+	LabelRoutingMode = "routing_mode"
+
+	// LabelEncryptionMode is the label for the transparent encryption mode
+	LabelEncryptionMode = "encryption_mode"
+
 	// LabelDirection is the label for traffic direction
 	LabelDirection = "direction"
 
@@ -276,6 +291,10 @@ var (
 	// BootstrapTimes is the durations of cilium-agent bootstrap sequence.
 	BootstrapTimes = NoOpGaugeVec
 
+	// StatusProbeLeaks is the number of status probe invocations abandoned
+	// because their goroutine did not return.
+	StatusProbeLeaks = NoOpCounterVec
+
 	// APIInteractions is the total time taken to process an API call made
 	// to the cilium-agent
 	APIInteractions = NoOpObserverVec
@@ -426,6 +445,14 @@ var (
 	// ConntrackDumpReset marks the count for conntrack dump resets
 	ConntrackDumpResets = NoOpCounterVec
 
+	// TunnelMapDiscrepancies is the number of confirmed discrepancies between
+	// the tunnel map and the set of known remote nodes
+	TunnelMapDiscrepancies = NoOpGauge
+
+	// NeighborTableInconsistencies is the number of known remote nodes with
+	// missing or failed kernel neighbor entries for their next hop
+	NeighborTableInconsistencies = NoOpGauge
+
 	// Signals
 
 	// SignalsHandled is the number of signals received.
@@ -541,6 +568,10 @@ var (
 	// the IPCache subsystem.
 	IPCacheEventsTotal = NoOpCounterVec
 
+	// IPCacheConflictsTotal is the total number of detected conflicts
+	// between IPCache entries and locally owned IP addresses.
+	IPCacheConflictsTotal = NoOpCounter
+
 	// BPFSyscallDuration is the metric for bpf syscalls duration.
 	BPFSyscallDuration = NoOpObserverVec
 
@@ -554,6 +585,9 @@ var (
 	// VersionMetric labelled by Cilium version
 	VersionMetric = NoOpGaugeVec
 
+	// DatapathInfo is a constant gauge labelled by the datapath configuration
+	DatapathInfo = NoOpGaugeVec
+
 	// APILimiterWaitHistoryDuration is a histogram that measures the
 	// individual wait durations of API limiters
 	APILimiterWaitHistoryDuration = NoOpObserverVec
@@ -659,6 +693,7 @@ var (
 
 type LegacyMetrics struct {
 	BootstrapTimes                   metric.Vec[metric.Gauge]
+	StatusProbeLeaks                 metric.Vec[metric.Counter]
 	APIInteractions                  metric.Vec[metric.Observer]
 	NodeConnectivityStatus           metric.DeletableVec[metric.Gauge]
 	NodeConnectivityLatency          metric.DeletableVec[metric.Gauge]
@@ -694,6 +729,8 @@ type LegacyMetrics struct {
 	ConntrackGCDuration              metric.Vec[metric.Observer]
 	ConntrackInterval                metric.Vec[metric.Gauge]
 	ConntrackDumpResets              metric.Vec[metric.Counter]
+	TunnelMapDiscrepancies           metric.Gauge
+	NeighborTableInconsistencies     metric.Gauge
 	SignalsHandled                   metric.Vec[metric.Counter]
 	ServicesEventsCount              metric.Vec[metric.Counter]
 	ServiceImplementationDelay       metric.Vec[metric.Observer]
@@ -721,10 +758,12 @@ type LegacyMetrics struct {
 	FQDNSemaphoreRejectedTotal       metric.Counter
 	IPCacheErrorsTotal               metric.Vec[metric.Counter]
 	IPCacheEventsTotal               metric.Vec[metric.Counter]
+	IPCacheConflictsTotal            metric.Counter
 	BPFSyscallDuration               metric.Vec[metric.Observer]
 	BPFMapOps                        metric.Vec[metric.Counter]
 	BPFMapCapacity                   metric.Vec[metric.Gauge]
 	VersionMetric                    metric.Vec[metric.Gauge]
+	DatapathInfo                     metric.Vec[metric.Gauge]
 	APILimiterWaitHistoryDuration    metric.Vec[metric.Observer]
 	APILimiterWaitDuration           metric.Vec[metric.Gauge]
 	APILimiterProcessingDuration     metric.Vec[metric.Gauge]
@@ -751,6 +790,14 @@ func NewLegacyMetrics() *LegacyMetrics {
 			Help:       "Duration of bootstrap sequence",
 		}, []string{LabelScope, LabelOutcome}),
 
+		StatusProbeLeaks: metric.NewCounterVec(metric.CounterOpts{
+			ConfigName: Namespace + "_" + SubsystemAgent + "_status_probe_leaks_total",
+			Namespace:  Namespace,
+			Subsystem:  SubsystemAgent,
+			Name:       "status_probe_leaks_total",
+			Help:       "Number of status probe invocations abandoned because their goroutine did not return, labeled by probe name",
+		}, []string{LabelProbe}),
+
 		APIInteractions: metric.NewHistogramVec(metric.HistogramOpts{
 			ConfigName: Namespace + "_" + SubsystemAgent + "_api_process_time_seconds",
 
@@ -1002,6 +1049,24 @@ func NewLegacyMetrics() *LegacyMetrics {
 			Help:       "Number of conntrack dump resets. Happens when a BPF entry gets removed while dumping the map is in progress",
 		}, []string{LabelDatapathArea, LabelDatapathName, LabelDatapathFamily}),
 
+		TunnelMapDiscrepancies: metric.NewGauge(metric.GaugeOpts{
+			ConfigName: Namespace + "_" + SubsystemDatapath + "_tunnel_map_discrepancies",
+			Namespace:  Namespace,
+			Subsystem:  SubsystemDatapath,
+			Name:       "tunnel_map_discrepancies",
+			Help: "Number of tunnel map entries inconsistent with the set of known " +
+				"remote nodes, confirmed over two consecutive comparison runs",
+		}),
+
+		NeighborTableInconsistencies: metric.NewGauge(metric.GaugeOpts{
+			ConfigName: Namespace + "_" + SubsystemDatapath + "_neighbor_table_inconsistencies",
+			Namespace:  Namespace,
+			Subsystem:  SubsystemDatapath,
+			Name:       "neighbor_table_inconsistencies",
+			Help: "Number of known remote nodes with missing or failed kernel " +
+				"neighbor entries for their next hop",
+		}),
+
 		SignalsHandled: metric.NewCounterVec(metric.CounterOpts{
 			ConfigName: Namespace + "_" + SubsystemDatapath + "_signals_handled_total",
 
@@ -1161,6 +1226,14 @@ func NewLegacyMetrics() *LegacyMetrics {
 			Help:       "Number of events interacting with the IP to Identity cache",
 		}, []string{LabelType}),
 
+		IPCacheConflictsTotal: metric.NewCounter(metric.CounterOpts{
+			ConfigName: Namespace + "_" + SubsystemIPCache + "_conflicts_total",
+			Namespace:  Namespace,
+			Subsystem:  SubsystemIPCache,
+			Name:       "conflicts_total",
+			Help:       "Number of detected conflicts between the IP to Identity cache and locally owned IP addresses",
+		}),
+
 		FQDNGarbageCollectorCleanedTotal: metric.NewCounter(metric.CounterOpts{
 			ConfigName: Namespace + "_" + SubsystemFQDN + "_gc_deletions_total",
 			Namespace:  Namespace,
@@ -1245,6 +1318,13 @@ func NewLegacyMetrics() *LegacyMetrics {
 			Help:       "Cilium version",
 		}, []string{LabelVersion, LabelVersionRevision, LabelArch}),
 
+		DatapathInfo: metric.NewGaugeVec(metric.GaugeOpts{
+			ConfigName: Namespace + "_datapath_info",
+			Namespace:  Namespace,
+			Name:       "datapath_info",
+			Help:       "Datapath configuration of the agent, constant 1 labelled by attach, datapath, routing and encryption mode",
+		}, []string{LabelAttachMode, LabelDatapathMode, LabelRoutingMode, LabelEncryptionMode}),
+
 		APILimiterWaitHistoryDuration: metric.NewHistogramVec(metric.HistogramOpts{
 			ConfigName: Namespace + "_" + SubsystemAPILimiter + "_wait_history_duration_seconds",
 			Disabled:   true,
@@ -1390,6 +1470,7 @@ func NewLegacyMetrics() *LegacyMetrics {
 	lm.BPFMapCapacity.WithLabelValues("default").Set(DefaultMapCapacity)
 
 	BootstrapTimes = lm.BootstrapTimes
+	StatusProbeLeaks = lm.StatusProbeLeaks
 	APIInteractions = lm.APIInteractions
 	NodeConnectivityStatus = lm.NodeConnectivityStatus
 	NodeConnectivityLatency = lm.NodeConnectivityLatency
@@ -1425,6 +1506,8 @@ func NewLegacyMetrics() *LegacyMetrics {
 	ConntrackGCDuration = lm.ConntrackGCDuration
 	ConntrackInterval = lm.ConntrackInterval
 	ConntrackDumpResets = lm.ConntrackDumpResets
+	TunnelMapDiscrepancies = lm.TunnelMapDiscrepancies
+	NeighborTableInconsistencies = lm.NeighborTableInconsistencies
 	SignalsHandled = lm.SignalsHandled
 	ServicesEventsCount = lm.ServicesEventsCount
 	ServiceImplementationDelay = lm.ServiceImplementationDelay
@@ -1452,10 +1535,12 @@ func NewLegacyMetrics() *LegacyMetrics {
 	FQDNSemaphoreRejectedTotal = lm.FQDNSemaphoreRejectedTotal
 	IPCacheErrorsTotal = lm.IPCacheErrorsTotal
 	IPCacheEventsTotal = lm.IPCacheEventsTotal
+	IPCacheConflictsTotal = lm.IPCacheConflictsTotal
 	BPFSyscallDuration = lm.BPFSyscallDuration
 	BPFMapOps = lm.BPFMapOps
 	BPFMapCapacity = lm.BPFMapCapacity
 	VersionMetric = lm.VersionMetric
+	DatapathInfo = lm.DatapathInfo
 	APILimiterWaitHistoryDuration = lm.APILimiterWaitHistoryDuration
 	APILimiterWaitDuration = lm.APILimiterWaitDuration
 	APILimiterProcessingDuration = lm.APILimiterProcessingDuration
@@ -13,6 +13,29 @@ var DropMin uint8 = 130
 // DropInvalid is the Invalid packet reason.
 var DropInvalid uint8 = 2
 
+// Non-drop reason codes maintained by the socket-LB datapath, shared with
+// bpf/lib/common.h.
+const (
+	// ReasonLBNoBackendSlot is emitted when a service backend slot lookup
+	// failed.
+	ReasonLBNoBackendSlot uint8 = 5
+
+	// ReasonLBNoBackend is emitted when a service lookup found no backend.
+	ReasonLBNoBackend uint8 = 6
+
+	// ReasonSockLBRejectHostns is emitted when a connection was rejected
+	// because socket-LB is restricted to the host namespace.
+	ReasonSockLBRejectHostns uint8 = 14
+
+	// ReasonSockLBXlateTCP is emitted when a TCP connection was translated
+	// by socket-LB.
+	ReasonSockLBXlateTCP uint8 = 15
+
+	// ReasonSockLBXlateUDP is emitted when a UDP connection was translated
+	// by socket-LB.
+	ReasonSockLBXlateUDP uint8 = 16
+)
+
 // These values are shared with bpf/lib/common.h and api/v1/flow/flow.proto.
 var errors = map[uint8]string{
 	0:   "Success",
@@ -28,6 +51,9 @@ var errors = map[uint8]string{
 	11:  "Missed tail call to custom program",
 	12:  "Interface Decrypting",
 	13:  "Interface Encrypting",
+	14:  "LB, sock cgroup: Connection rejected, host namespace only",
+	15:  "LB, sock cgroup: Connection translated (TCP)",
+	16:  "LB, sock cgroup: Connection translated (UDP)",
 	130: "Invalid source mac",      // Unused
 	131: "Invalid destination mac", // Unused
 	132: "Invalid source ip",
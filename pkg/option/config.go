@@ -117,6 +117,9 @@ const (
 	// ConntrackGCMaxInterval is the name of the ConntrackGCMaxInterval option
 	ConntrackGCMaxInterval = "conntrack-gc-max-interval"
 
+	// BPFGCMinEntryAge is the name of the BPFGCMinEntryAge option
+	BPFGCMinEntryAge = "bpf-gc-min-entry-age"
+
 	// DebugArg is the argument enables debugging mode
 	DebugArg = "debug"
 
@@ -397,6 +400,10 @@ const (
 	// StateDir is the directory path to store runtime state
 	StateDir = "state-dir"
 
+	// StatusSnapshotFile is the host path to which the agent writes a
+	// compact JSON summary of its aggregated status
+	StatusSnapshotFile = "status-snapshot-file"
+
 	// TracePayloadlen length of payload to capture when tracing
 	TracePayloadlen = "trace-payloadlen"
 
@@ -844,6 +851,15 @@ const (
 	// HealthCheckICMPFailureThreshold is the name of the HealthCheckICMPFailureThreshold option
 	HealthCheckICMPFailureThreshold = "health-check-icmp-failure-threshold"
 
+	// EnableHealthCheckTunnelPort is the name of the EnableHealthCheckTunnelPort option
+	EnableHealthCheckTunnelPort = "enable-health-check-tunnel-port"
+
+	// HealthCheckTunnelPortSampleSize is the name of the HealthCheckTunnelPortSampleSize option
+	HealthCheckTunnelPortSampleSize = "health-check-tunnel-port-sample-size"
+
+	// HealthCheckTunnelPortInterval is the name of the HealthCheckTunnelPortInterval option
+	HealthCheckTunnelPortInterval = "health-check-tunnel-port-interval"
+
 	// EndpointQueueSize is the size of the EventQueue per-endpoint.
 	EndpointQueueSize = "endpoint-queue-size"
 
@@ -1375,6 +1391,11 @@ type DaemonConfig struct {
 	// StateDir is the directory where runtime state of endpoints is stored
 	StateDir string
 
+	// StatusSnapshotFile is the host path to which a compact JSON summary
+	// of the aggregated status is written for external watchdogs, empty if
+	// disabled
+	StatusSnapshotFile string
+
 	// Options changeable at runtime
 	Opts *IntOptions
 
@@ -1788,6 +1809,20 @@ type DaemonConfig struct {
 	// is marked as healthy.
 	HealthCheckICMPFailureThreshold int
 
+	// EnableHealthCheckTunnelPort enables probing the tunnel port (UDP) of
+	// remote nodes, to detect firewalls blocking tunnel traffic between
+	// nodes.
+	EnableHealthCheckTunnelPort bool
+
+	// HealthCheckTunnelPortSampleSize is the number of remote nodes whose
+	// tunnel port is probed per probe round, bounding the probe's cost on
+	// large clusters.
+	HealthCheckTunnelPortSampleSize int
+
+	// HealthCheckTunnelPortInterval is the interval between tunnel port
+	// probe rounds.
+	HealthCheckTunnelPortInterval time.Duration
+
 	// KVstoreLeaseTTL is the time-to-live for kvstore lease.
 	KVstoreLeaseTTL time.Duration
 
@@ -1832,6 +1867,11 @@ type DaemonConfig struct {
 	// the specified maximum value.
 	ConntrackGCMaxInterval time.Duration
 
+	// BPFGCMinEntryAge if set causes the BPF map garbage collection to skip
+	// CT and NAT entries whose last activity is more recent than this age,
+	// protecting freshly created entries from deletion.
+	BPFGCMinEntryAge time.Duration
+
 	// LoopbackIPv4 is the address to use for service loopback SNAT
 	LoopbackIPv4 string
 
@@ -2263,6 +2303,8 @@ var (
 		EnableHealthCheckLoadBalancerIP: defaults.EnableHealthCheckLoadBalancerIP,
 		EnableHealthCheckNodePort:       defaults.EnableHealthCheckNodePort,
 		HealthCheckICMPFailureThreshold: defaults.HealthCheckICMPFailureThreshold,
+		HealthCheckTunnelPortSampleSize: defaults.HealthCheckTunnelPortSampleSize,
+		HealthCheckTunnelPortInterval:   defaults.HealthCheckTunnelPortInterval,
 		EnableIPv4:                      defaults.EnableIPv4,
 		EnableIPv6:                      defaults.EnableIPv6,
 		EnableIPv6NDP:                   defaults.EnableIPv6NDP,
@@ -2876,6 +2918,9 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 	c.EnableHealthCheckNodePort = vp.GetBool(EnableHealthCheckNodePort)
 	c.EnableHealthCheckLoadBalancerIP = vp.GetBool(EnableHealthCheckLoadBalancerIP)
 	c.HealthCheckICMPFailureThreshold = vp.GetInt(HealthCheckICMPFailureThreshold)
+	c.EnableHealthCheckTunnelPort = vp.GetBool(EnableHealthCheckTunnelPort)
+	c.HealthCheckTunnelPortSampleSize = vp.GetInt(HealthCheckTunnelPortSampleSize)
+	c.HealthCheckTunnelPortInterval = vp.GetDuration(HealthCheckTunnelPortInterval)
 	c.EnableLocalNodeRoute = vp.GetBool(EnableLocalNodeRoute)
 	c.EnablePolicy = strings.ToLower(vp.GetString(EnablePolicy))
 	c.EnableExternalIPs = vp.GetBool(EnableExternalIPs)
@@ -2948,6 +2993,7 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 	c.RunDir = vp.GetString(StateDir)
 	c.ExternalEnvoyProxy = vp.GetBool(ExternalEnvoyProxy)
 	c.SocketPath = vp.GetString(SocketPath)
+	c.StatusSnapshotFile = vp.GetString(StatusSnapshotFile)
 	c.TracePayloadlen = vp.GetInt(TracePayloadlen)
 	c.Version = vp.GetString(Version)
 	c.PolicyTriggerInterval = vp.GetDuration(PolicyTriggerInterval)
@@ -3193,6 +3239,7 @@ func (c *DaemonConfig) Populate(vp *viper.Viper) {
 
 	c.ConntrackGCInterval = vp.GetDuration(ConntrackGCInterval)
 	c.ConntrackGCMaxInterval = vp.GetDuration(ConntrackGCMaxInterval)
+	c.BPFGCMinEntryAge = vp.GetDuration(BPFGCMinEntryAge)
 
 	if m, err := command.GetStringMapStringE(vp, KVStoreOpt); err != nil {
 		log.Fatalf("unable to parse %s: %s", KVStoreOpt, err)
@@ -3989,6 +4036,28 @@ func validateConfigMap(cmd *cobra.Command, m map[string]interface{}) error {
 	return nil
 }
 
+// MergeConfigDir reads the configuration snapshot stored in dirName, replaces
+// deprecated fields, validates each key against the flag set of the given
+// command and merges the values into viper. It is shared between the daemon
+// startup path and offline validation of a configuration snapshot so that the
+// two cannot diverge.
+func MergeConfigDir(cmd *cobra.Command, vp *viper.Viper, dirName string) error {
+	m, err := ReadDirConfig(dirName)
+	if err != nil {
+		return fmt.Errorf("unable to read configuration directory: %w", err)
+	}
+
+	// replace deprecated fields with new fields
+	ReplaceDeprecatedFields(m)
+
+	// validate the config-map
+	if err := validateConfigMap(cmd, m); err != nil {
+		return fmt.Errorf("incorrect config-map flag value: %w", err)
+	}
+
+	return MergeConfig(vp, m)
+}
+
 // InitConfig reads in config file and ENV variables if set.
 func InitConfig(cmd *cobra.Command, programName, configName string, vp *viper.Viper) func() {
 	return func() {
@@ -4010,20 +4079,8 @@ func InitConfig(cmd *cobra.Command, programName, configName string, vp *viper.Vi
 				log.Fatalf("Non-existent configuration directory %s", Config.ConfigDir)
 			}
 
-			if m, err := ReadDirConfig(Config.ConfigDir); err != nil {
-				log.WithError(err).Fatalf("Unable to read configuration directory %s", Config.ConfigDir)
-			} else {
-				// replace deprecated fields with new fields
-				ReplaceDeprecatedFields(m)
-
-				// validate the config-map
-				if err := validateConfigMap(cmd, m); err != nil {
-					log.WithError(err).Fatal("Incorrect config-map flag value")
-				}
-
-				if err := MergeConfig(vp, m); err != nil {
-					log.WithError(err).Fatal("Unable to merge configuration")
-				}
+			if err := MergeConfigDir(cmd, vp, Config.ConfigDir); err != nil {
+				log.WithError(err).Fatalf("Unable to apply configuration directory %s", Config.ConfigDir)
 			}
 		}
 
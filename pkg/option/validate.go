@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package option
+
+import (
+	"fmt"
+	"net"
+)
+
+// Tunnel routing option names and values owned by pkg/datapath/tunnel, which
+// this package cannot import.
+const (
+	tunnelProtocolOption   = "tunnel-protocol"
+	underlayProtocolOption = "underlay-protocol"
+
+	tunnelVXLAN  = "vxlan"
+	tunnelGeneve = "geneve"
+
+	underlayIPv6 = "ipv6"
+)
+
+// ValidationError records a single invalid agent option along with an
+// explanation of why its value was rejected.
+type ValidationError struct {
+	// Option is the name of the offending flag, without leading dashes.
+	Option string
+
+	// Reason explains why the value is invalid.
+	Reason string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("--%s: %s", e.Option, e.Reason)
+}
+
+// ValidateKubeProxyReplacement runs the cross-field checks on the kube-proxy
+// replacement, NodePort and DSR options and returns one ValidationError per
+// violation. encapProtocol and underlayProtocol carry the tunnel routing
+// configuration ("vxlan"/"geneve" and "ipv4"/"ipv6").
+//
+// Both the daemon startup path and the offline `cilium-dbg preflight
+// validate-config` command invoke this function, so any new cross-field check
+// must be added here rather than in the daemon.
+func (c *DaemonConfig) ValidateKubeProxyReplacement(encapProtocol, underlayProtocol string) (errs []ValidationError) {
+	if c.KubeProxyReplacement != KubeProxyReplacementTrue &&
+		c.KubeProxyReplacement != KubeProxyReplacementFalse {
+		errs = append(errs, ValidationError{KubeProxyReplacement,
+			fmt.Sprintf("invalid value %q, valid values = {%q, %q}",
+				c.KubeProxyReplacement, KubeProxyReplacementTrue, KubeProxyReplacementFalse)})
+	}
+
+	// When kube-proxy replacement is enabled, the daemon force-enables
+	// NodePort before validating. Derive the effective value here so that
+	// offline validation of a snapshot sees the same configuration.
+	enableNodePort := c.EnableNodePort || c.KubeProxyReplacement == KubeProxyReplacementTrue
+
+	if enableNodePort {
+		if c.NodePortMode != NodePortModeSNAT &&
+			c.NodePortMode != NodePortModeDSR &&
+			c.NodePortMode != NodePortModeHybrid {
+			errs = append(errs, ValidationError{NodePortMode,
+				fmt.Sprintf("invalid value %q", c.NodePortMode)})
+		}
+
+		if c.LoadBalancerModeAnnotation && c.NodePortMode == NodePortModeHybrid {
+			errs = append(errs, ValidationError{NodePortMode,
+				fmt.Sprintf("value %q is not supported as default under annotation mode", c.NodePortMode)})
+		}
+
+		if c.NodePortMode == NodePortModeDSR &&
+			c.LoadBalancerDSRDispatch != DSRDispatchOption &&
+			c.LoadBalancerDSRDispatch != DSRDispatchIPIP &&
+			c.LoadBalancerDSRDispatch != DSRDispatchGeneve {
+			errs = append(errs, ValidationError{LoadBalancerDSRDispatch,
+				fmt.Sprintf("invalid value %q", c.LoadBalancerDSRDispatch)})
+		}
+
+		if c.NodePortMode == NodePortModeHybrid &&
+			c.LoadBalancerDSRDispatch != DSRDispatchOption &&
+			c.LoadBalancerDSRDispatch != DSRDispatchGeneve {
+			errs = append(errs, ValidationError{LoadBalancerDSRDispatch,
+				fmt.Sprintf("invalid value %q", c.LoadBalancerDSRDispatch)})
+		}
+
+		if c.LoadBalancerModeAnnotation && c.LoadBalancerDSRDispatch != DSRDispatchIPIP {
+			errs = append(errs, ValidationError{LoadBalancerDSRDispatch,
+				fmt.Sprintf("invalid value %q under annotation mode", c.LoadBalancerDSRDispatch)})
+		}
+
+		if c.LoadBalancerRSSv4CIDR != "" {
+			if err := validateRSSCIDR(c.LoadBalancerRSSv4CIDR, false); err != nil {
+				errs = append(errs, ValidationError{LoadBalancerRSSv4CIDR,
+					fmt.Sprintf("invalid value %q: %s", c.LoadBalancerRSSv4CIDR, err)})
+			}
+		}
+
+		if c.LoadBalancerRSSv6CIDR != "" {
+			if err := validateRSSCIDR(c.LoadBalancerRSSv6CIDR, true); err != nil {
+				errs = append(errs, ValidationError{LoadBalancerRSSv6CIDR,
+					fmt.Sprintf("invalid value %q: %s", c.LoadBalancerRSSv6CIDR, err)})
+			}
+		}
+
+		dsrIPIP := c.LoadBalancerUsesDSR() && c.LoadBalancerDSRDispatch == DSRDispatchIPIP
+		if dsrIPIP && c.NodePortAcceleration == NodePortAccelerationDisabled {
+			errs = append(errs, ValidationError{LoadBalancerDSRDispatch,
+				fmt.Sprintf("DSR dispatch mode %s currently only available under XDP acceleration", c.LoadBalancerDSRDispatch)})
+		}
+
+		if (c.LoadBalancerRSSv4CIDR != "" || c.LoadBalancerRSSv6CIDR != "") && !dsrIPIP {
+			errs = append(errs, ValidationError{LoadBalancerRSSv4CIDR,
+				fmt.Sprintf("currently only supported under %s dispatch for DSR", DSRDispatchIPIP)})
+		}
+
+		if c.NodePortAlg != NodePortAlgRandom && c.NodePortAlg != NodePortAlgMaglev {
+			errs = append(errs, ValidationError{NodePortAlg,
+				fmt.Sprintf("invalid value %q", c.NodePortAlg)})
+		}
+
+		if c.TunnelingEnabled() && underlayProtocol == underlayIPv6 {
+			errs = append(errs, ValidationError{underlayProtocolOption,
+				"BPF NodePort cannot be used over an IPv6 underlay"})
+		}
+
+		if c.TunnelingEnabled() && encapProtocol == tunnelVXLAN && c.LoadBalancerUsesDSR() {
+			errs = append(errs, ValidationError{NodePortMode,
+				fmt.Sprintf("Node Port %q mode cannot be used with %s tunneling", c.NodePortMode, tunnelVXLAN)})
+		}
+
+		if c.TunnelingEnabled() && c.LoadBalancerUsesDSR() &&
+			c.LoadBalancerDSRDispatch != DSRDispatchGeneve {
+			errs = append(errs, ValidationError{LoadBalancerDSRDispatch,
+				fmt.Sprintf("tunnel routing with Node Port %q mode requires %s dispatch", c.NodePortMode, DSRDispatchGeneve)})
+		}
+
+		if c.LoadBalancerUsesDSR() && c.LoadBalancerDSRDispatch == DSRDispatchGeneve &&
+			encapProtocol != tunnelGeneve {
+			errs = append(errs, ValidationError{tunnelProtocolOption,
+				fmt.Sprintf("Node Port %q mode with %s dispatch requires %s tunnel protocol", c.NodePortMode, c.LoadBalancerDSRDispatch, tunnelGeneve)})
+		}
+
+		if c.LoadBalancerIPIPSockMark && !dsrIPIP {
+			errs = append(errs, ValidationError{LoadBalancerIPIPSockMark,
+				fmt.Sprintf("Node Port %q mode with IPIP socket mark logic requires %s dispatch", c.NodePortMode, DSRDispatchIPIP)})
+		}
+	}
+
+	if c.InstallNoConntrackIptRules {
+		// InstallNoConntrackIptRules can only be enabled when Cilium is
+		// running in full KPR mode as otherwise conntrack would be
+		// required for NAT operations
+		if !c.KubeProxyReplacementFullyEnabled() {
+			errs = append(errs, ValidationError{InstallNoConntrackIptRules,
+				fmt.Sprintf("requires the agent to run with %s=%s", KubeProxyReplacement, KubeProxyReplacementTrue)})
+		}
+
+		if c.MasqueradingEnabled() && !c.EnableBPFMasquerade {
+			errs = append(errs, ValidationError{InstallNoConntrackIptRules,
+				fmt.Sprintf("requires the agent to run with %s", EnableBPFMasquerade)})
+		}
+	}
+
+	return errs
+}
+
+// validateRSSCIDR checks that the given RSS source CIDR is well-formed and of
+// the expected address family.
+func validateRSSCIDR(cidrStr string, ipv6 bool) error {
+	ip, cidr, err := net.ParseCIDR(cidrStr)
+	if err != nil {
+		return err
+	}
+	if ipv6 {
+		if ip.To4() != nil {
+			return fmt.Errorf("CIDR is not IPv6 based")
+		}
+		if ones, _ := cidr.Mask.Size(); ones == 0 {
+			return fmt.Errorf("CIDR length must be in (0,128]")
+		}
+	} else {
+		if ip.To4() == nil {
+			return fmt.Errorf("CIDR is not IPv4 based")
+		}
+		if ones, _ := cidr.Mask.Size(); ones == 0 {
+			return fmt.Errorf("CIDR length must be in (0,32]")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package option
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateKubeProxyReplacement(t *testing.T) {
+	// validKPRConfig returns a configuration that passes the cross-field
+	// checks, to be broken by the individual test cases.
+	validKPRConfig := func() *DaemonConfig {
+		return &DaemonConfig{
+			KubeProxyReplacement:    KubeProxyReplacementTrue,
+			RoutingMode:             RoutingModeNative,
+			NodePortMode:            NodePortModeSNAT,
+			NodePortAlg:             NodePortAlgRandom,
+			LoadBalancerDSRDispatch: DSRDispatchOption,
+		}
+	}
+
+	tests := []struct {
+		name             string
+		setup            func(*DaemonConfig)
+		encapProtocol    string
+		underlayProtocol string
+		wantOptions      []string
+	}{
+		{
+			name:  "valid configuration",
+			setup: func(c *DaemonConfig) {},
+		},
+		{
+			name: "invalid kube-proxy-replacement value",
+			setup: func(c *DaemonConfig) {
+				c.KubeProxyReplacement = "partial"
+			},
+			wantOptions: []string{KubeProxyReplacement},
+		},
+		{
+			name: "invalid node-port-mode value",
+			setup: func(c *DaemonConfig) {
+				c.NodePortMode = "dsr-ipip"
+			},
+			wantOptions: []string{NodePortMode},
+		},
+		{
+			name: "DSR over vxlan tunneling",
+			setup: func(c *DaemonConfig) {
+				c.RoutingMode = RoutingModeTunnel
+				c.NodePortMode = NodePortModeDSR
+				c.LoadBalancerDSRDispatch = DSRDispatchGeneve
+			},
+			encapProtocol: tunnelVXLAN,
+			// DSR cannot be used with vxlan tunneling and the geneve
+			// dispatch requires the geneve tunnel protocol.
+			wantOptions: []string{NodePortMode, tunnelProtocolOption},
+		},
+		{
+			name: "geneve dispatch without geneve tunnel protocol",
+			setup: func(c *DaemonConfig) {
+				c.NodePortMode = NodePortModeDSR
+				c.LoadBalancerDSRDispatch = DSRDispatchGeneve
+			},
+			encapProtocol: tunnelVXLAN,
+			wantOptions:   []string{tunnelProtocolOption},
+		},
+		{
+			name: "IPIP dispatch without XDP acceleration",
+			setup: func(c *DaemonConfig) {
+				c.NodePortMode = NodePortModeDSR
+				c.LoadBalancerDSRDispatch = DSRDispatchIPIP
+				c.NodePortAcceleration = NodePortAccelerationDisabled
+			},
+			wantOptions: []string{LoadBalancerDSRDispatch},
+		},
+		{
+			name: "RSS CIDR without IPIP dispatch",
+			setup: func(c *DaemonConfig) {
+				c.LoadBalancerRSSv4CIDR = "10.0.0.0/8"
+			},
+			wantOptions: []string{LoadBalancerRSSv4CIDR},
+		},
+		{
+			name: "malformed RSS IPv6 CIDR",
+			setup: func(c *DaemonConfig) {
+				c.NodePortMode = NodePortModeDSR
+				c.LoadBalancerDSRDispatch = DSRDispatchIPIP
+				c.NodePortAcceleration = NodePortAccelerationNative
+				c.LoadBalancerRSSv6CIDR = "10.0.0.0/8"
+			},
+			wantOptions: []string{LoadBalancerRSSv6CIDR},
+		},
+		{
+			name: "invalid node-port-algorithm value",
+			setup: func(c *DaemonConfig) {
+				c.NodePortAlg = "round-robin"
+			},
+			wantOptions: []string{NodePortAlg},
+		},
+		{
+			name: "NodePort over IPv6 underlay",
+			setup: func(c *DaemonConfig) {
+				c.RoutingMode = RoutingModeTunnel
+			},
+			underlayProtocol: underlayIPv6,
+			wantOptions:      []string{underlayProtocolOption},
+		},
+		{
+			name: "no-conntrack iptables rules without full KPR",
+			setup: func(c *DaemonConfig) {
+				c.KubeProxyReplacement = KubeProxyReplacementFalse
+				c.InstallNoConntrackIptRules = true
+			},
+			wantOptions: []string{InstallNoConntrackIptRules},
+		},
+		{
+			name: "kube-proxy-replacement implies NodePort checks",
+			setup: func(c *DaemonConfig) {
+				// NodePort is not explicitly enabled, but full KPR
+				// force-enables it, so its options are still validated.
+				c.EnableNodePort = false
+				c.NodePortMode = "invalid"
+			},
+			wantOptions: []string{NodePortMode},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := validKPRConfig()
+			tt.setup(c)
+			if tt.encapProtocol == "" {
+				tt.encapProtocol = tunnelVXLAN
+			}
+			if tt.underlayProtocol == "" {
+				tt.underlayProtocol = "ipv4"
+			}
+
+			errs := c.ValidateKubeProxyReplacement(tt.encapProtocol, tt.underlayProtocol)
+
+			options := make([]string, 0, len(errs))
+			for _, ve := range errs {
+				require.NotEmpty(t, ve.Reason)
+				options = append(options, ve.Option)
+			}
+			require.ElementsMatch(t, tt.wantOptions, options)
+		})
+	}
+}
@@ -4,14 +4,18 @@
 package proxy
 
 import (
+	"context"
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/cilium/cilium/api/v1/models"
 	"github.com/cilium/cilium/pkg/completion"
 	datapath "github.com/cilium/cilium/pkg/datapath/types"
 	"github.com/cilium/cilium/pkg/envoy"
+	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/policy"
 	"github.com/cilium/cilium/pkg/proxy/endpoint"
 	"github.com/cilium/cilium/pkg/proxy/types"
@@ -30,10 +34,20 @@ func (dr *envoyRedirect) GetRedirect() *Redirect {
 	return &dr.Redirect
 }
 
+// envoyConfigVersionsTTL bounds how often the status probe performs the
+// relatively heavy config_dump query against the Envoy admin endpoint. The
+// cheap server_info liveness check runs on every probe invocation.
+const envoyConfigVersionsTTL = time.Minute
+
 type envoyProxyIntegration struct {
 	adminClient     *envoy.EnvoyAdminClient
 	xdsServer       envoy.XDSServer
 	iptablesManager datapath.IptablesManager
+
+	// configVersionsMutex protects the cached config_dump results below.
+	configVersionsMutex lock.Mutex
+	configVersions      map[string]string
+	configVersionsAt    time.Time
 }
 
 // createRedirect creates a redirect with corresponding proxy configuration. This will launch a proxy instance.
@@ -66,6 +80,57 @@ func (p *envoyProxyIntegration) changeLogLevel(level logrus.Level) error {
 	return p.adminClient.ChangeLogLevel(level)
 }
 
+// getAdminStatusModel queries the Envoy admin endpoint and reports its
+// health, version and accepted xDS configuration versions. An unresponsive
+// admin endpoint is reported as Failure.
+func (p *envoyProxyIntegration) getAdminStatusModel(ctx context.Context) *models.EnvoyAdminStatus {
+	info, err := p.adminClient.GetServerInfo(ctx)
+	if err != nil {
+		return &models.EnvoyAdminStatus{
+			Status: &models.Status{
+				State: models.StatusStateFailure,
+				Msg:   "Envoy admin endpoint is not responding: " + err.Error(),
+			},
+		}
+	}
+
+	s := &models.EnvoyAdminStatus{
+		Status: &models.Status{
+			State: models.StatusStateOk,
+			Msg:   info.State,
+		},
+		Version:           info.Version,
+		XdsConfigVersions: p.getConfigVersions(ctx),
+	}
+	// The uptime is reported in protobuf duration encoding, e.g. "570s".
+	if uptime, err := time.ParseDuration(info.UptimeCurrentEpoch); err == nil {
+		s.UptimeSeconds = int64(uptime.Seconds())
+	}
+	return s
+}
+
+// getConfigVersions returns the per-type xDS configuration versions, backed
+// by a cache so that the heavy config_dump query runs at most once per
+// envoyConfigVersionsTTL rather than on every status probe.
+func (p *envoyProxyIntegration) getConfigVersions(ctx context.Context) map[string]string {
+	p.configVersionsMutex.Lock()
+	defer p.configVersionsMutex.Unlock()
+
+	if time.Since(p.configVersionsAt) < envoyConfigVersionsTTL {
+		return p.configVersions
+	}
+
+	versions, err := p.adminClient.GetXDSConfigVersions(ctx)
+	if err != nil {
+		// Keep reporting the previous versions; the basic liveness check
+		// covers an admin endpoint that stopped responding altogether.
+		return p.configVersions
+	}
+	p.configVersions = versions
+	p.configVersionsAt = time.Now()
+	return versions
+}
+
 func (p *envoyProxyIntegration) UpdateNetworkPolicy(ep endpoint.EndpointUpdater, policy *policy.L4Policy, ingressPolicyEnforced, egressPolicyEnforced bool, wg *completion.WaitGroup) (error, func() error) {
 	return p.xdsServer.UpdateNetworkPolicy(ep, policy, ingressPolicyEnforced, egressPolicyEnforced, wg)
 }
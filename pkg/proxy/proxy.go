@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
@@ -297,6 +298,16 @@ func (p *Proxy) RemoveRedirect(id string) {
 	p.removeRedirect(id)
 }
 
+// HasRedirect returns true if the proxy is tracking a redirect with the
+// given id.
+func (p *Proxy) HasRedirect(id string) bool {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	_, ok := p.redirects[id]
+	return ok
+}
+
 // removeRedirect removes an existing redirect. p.mutex must be held
 func (p *Proxy) removeRedirect(id string) {
 	p.logger.Debug("Removing proxy redirect", fieldProxyRedirectID, id)
@@ -376,6 +387,23 @@ func (p *Proxy) GetStatusModel() *models.ProxyStatus {
 	return result
 }
 
+// envoyAdminProbeTimeout bounds the status probe's queries against the Envoy
+// admin endpoint, so that a wedged Envoy cannot stall status collection.
+const envoyAdminProbeTimeout = 3 * time.Second
+
+// GetEnvoyAdminStatusModel returns the health of the embedded Envoy as
+// reported by its admin endpoint. It returns nil when Envoy is deployed
+// externally, as its admin socket is then not accessible to the agent.
+func (p *Proxy) GetEnvoyAdminStatusModel(ctx context.Context) *models.EnvoyAdminStatus {
+	if option.Config.ExternalEnvoyProxy {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, envoyAdminProbeTimeout)
+	defer cancel()
+	return p.envoyIntegration.getAdminStatusModel(ctx)
+}
+
 // updateRedirectMetrics updates the redirect metrics per application protocol
 // in Prometheus. Lock needs to be held to call this function.
 func (p *Proxy) updateRedirectMetrics() {
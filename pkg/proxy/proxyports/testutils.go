@@ -32,6 +32,10 @@ func (m *MockIPTablesManager) GetProxyPorts() map[string]uint16 {
 	return nil
 }
 
+func (m *MockIPTablesManager) VerifyInstalledRules() ([]string, error) {
+	return nil, nil
+}
+
 func proxyPortsForTest(t *testing.T) (*ProxyPorts, func()) {
 	mockIPTablesManager := &MockIPTablesManager{}
 	config := ProxyPortsConfig{
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -148,6 +149,11 @@ type APILimiter struct {
 	// requestsScheduled is the total number of scheduled requests
 	requestsScheduled int64
 
+	// requestsRejected is the total number of requests that the rate
+	// limiter refused to serve because the wait duration would have
+	// exceeded the configured maximum
+	requestsRejected int64
+
 	// parallelWaitSemaphore is the semaphore used to implement
 	// params.MaxParallel. It is initialized with a capacity of
 	// waitSemaphoreResolution and each API request will acquire
@@ -398,6 +404,64 @@ func (l *APILimiter) Parameters() APILimiterParameters {
 	return l.params
 }
 
+// LimiterStatus is a point-in-time snapshot of the bookkeeping of an
+// APILimiter.
+type LimiterStatus struct {
+	// Name is the name of the API call the limiter applies to
+	Name string
+
+	// Limit is the currently applied rate limit, 0 if no rate limit is
+	// configured
+	Limit rate.Limit
+
+	// Burst is the currently applied rate burst
+	Burst int
+
+	// ParallelRequests is the currently allowed maximum parallel requests
+	ParallelRequests int
+
+	// MeanWaitDuration is the mean wait duration over the last
+	// MeanOver requests
+	MeanWaitDuration time.Duration
+
+	// MaxWaitDuration is the longest wait duration among the last
+	// MeanOver requests
+	MaxWaitDuration time.Duration
+
+	// RequestsProcessed is the total number of processed requests
+	RequestsProcessed int64
+
+	// RequestsScheduled is the total number of scheduled requests
+	RequestsScheduled int64
+
+	// RequestsRejected is the total number of requests rejected because
+	// the wait duration would have exceeded the configured maximum
+	RequestsRejected int64
+}
+
+// Status returns a snapshot of the current bookkeeping of the limiter.
+func (l *APILimiter) Status() LimiterStatus {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	status := LimiterStatus{
+		Name:              l.name,
+		ParallelRequests:  l.parallelRequests,
+		MeanWaitDuration:  time.Duration(l.meanWaitDuration * float64(time.Second)),
+		MaxWaitDuration:   calcMaxDuration(l.waitDurations),
+		RequestsProcessed: l.requestsProcessed,
+		RequestsScheduled: l.requestsScheduled,
+		RequestsRejected:  l.requestsRejected,
+	}
+
+	if l.limiter != nil {
+		status.Limit = l.limiter.Limit()
+		status.Burst = l.limiter.Burst()
+	}
+
+	return status
+}
+
 // SetRateLimit sets the rate limit of the limiter. If limiter is unset, a new
 // Limiter is created using the rate burst set in the parameters.
 func (l *APILimiter) SetRateLimit(limit rate.Limit) {
@@ -508,6 +572,11 @@ func (l *APILimiter) requestFinished(r *limitedRequest, err error, code int) {
 		l.currentRequestsInFlight--
 	}
 
+	switch r.outcome {
+	case outcomeParallelMaxWait, outcomeLimitMaxWait:
+		l.requestsRejected++
+	}
+
 	// Only auto-adjust ratelimiter using metrics from successful API requests
 	if err == nil {
 		l.processingDurations = append(l.processingDurations, processingDuration)
@@ -570,6 +639,17 @@ func calcMeanDuration(durations []time.Duration) float64 {
 	return total / float64(len(durations))
 }
 
+// calcMaxDuration returns the longest of the given durations
+func calcMaxDuration(durations []time.Duration) time.Duration {
+	max := time.Duration(0)
+	for _, t := range durations {
+		if t > max {
+			max = t
+		}
+	}
+	return max
+}
+
 // LimitedRequest represents a request that is being limited. It is returned
 // by Wait() and the caller of Wait() is responsible to call Done() or Error()
 // when the API call has been processed or resulted in an error. It is safe to
@@ -894,6 +974,21 @@ func (s *APILimiterSet) Limiter(name string) *APILimiter {
 	return s.limiters[name]
 }
 
+// Status returns a snapshot of the bookkeeping of all limiters in the set,
+// sorted by name.
+func (s *APILimiterSet) Status() []LimiterStatus {
+	status := make([]LimiterStatus, 0, len(s.limiters))
+	for _, l := range s.limiters {
+		status = append(status, l.Status())
+	}
+
+	sort.Slice(status, func(i, j int) bool {
+		return status[i].Name < status[j].Name
+	})
+
+	return status
+}
+
 type dummyRequest struct{}
 
 func (d dummyRequest) WaitDuration() time.Duration { return 0 }
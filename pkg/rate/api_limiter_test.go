@@ -616,6 +616,53 @@ func TestCalcMeanDuration(t *testing.T) {
 	require.Equal(t, time.Duration(2).Seconds(), calcMeanDuration([]time.Duration{1, 2, 3}))
 }
 
+func TestCalcMaxDuration(t *testing.T) {
+	require.Equal(t, time.Duration(0), calcMaxDuration(nil))
+	require.Equal(t, time.Duration(3), calcMaxDuration([]time.Duration{1, 3, 2}))
+}
+
+func TestLimiterStatus(t *testing.T) {
+	a := NewAPILimiter("foo", APILimiterParameters{
+		RateLimit:       1.0 / 60.0, // 1 request/minute
+		RateBurst:       1,
+		MaxWaitDuration: time.Millisecond,
+		Log:             true,
+	}, nil)
+
+	// The first request is served from the burst allowance.
+	req, err := a.Wait(context.Background())
+	require.NoError(t, err)
+	req.Done()
+
+	// The second request would have to wait for close to a minute and must
+	// be rejected.
+	_, err = a.Wait(context.Background())
+	require.Error(t, err)
+
+	status := a.Status()
+	require.Equal(t, "foo", status.Name)
+	require.Equal(t, rate.Limit(1.0/60.0), status.Limit)
+	require.Equal(t, 1, status.Burst)
+	require.Equal(t, int64(2), status.RequestsScheduled)
+	require.Equal(t, int64(1), status.RequestsProcessed)
+	require.Equal(t, int64(1), status.RequestsRejected)
+}
+
+func TestAPILimiterSetStatus(t *testing.T) {
+	s, err := NewAPILimiterSet(nil, map[string]APILimiterParameters{
+		"foo": {RateLimit: 2.0},
+		"bar": {RateLimit: 1.0},
+	}, nil)
+	require.NoError(t, err)
+
+	status := s.Status()
+	require.Len(t, status, 2)
+	require.Equal(t, "bar", status[0].Name)
+	require.Equal(t, rate.Limit(1.0), status[0].Limit)
+	require.Equal(t, "foo", status[1].Name)
+	require.Equal(t, rate.Limit(2.0), status[1].Limit)
+}
+
 func TestDelayedAdjustment(t *testing.T) {
 	l := APILimiter{
 		adjustmentFactor: 1.5,
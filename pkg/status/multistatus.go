@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package status
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cilium/cilium/api/v1/models"
+)
+
+// SubCheck is the outcome of one named check performed by a probe that
+// verifies several things.
+type SubCheck struct {
+	// Name identifies the check within the probe
+	Name string
+
+	// State is one of models.StatusStateOk, models.StatusStateWarning or
+	// models.StatusStateFailure
+	State string
+
+	// Msg describes the finding in human readable form
+	Msg string
+}
+
+// MultiStatus aggregates the outcomes of the individual checks performed by a
+// probe, so that each finding is reported separately in the status API
+// instead of being flattened into a single error string.
+type MultiStatus struct {
+	checks []SubCheck
+}
+
+// NewMultiStatus returns an empty MultiStatus ready to record check outcomes.
+func NewMultiStatus() *MultiStatus {
+	return &MultiStatus{}
+}
+
+// Add records the outcome of a check with an explicit state.
+func (m *MultiStatus) Add(name, state, msg string) {
+	m.checks = append(m.checks, SubCheck{Name: name, State: state, Msg: msg})
+}
+
+// AddOK records a successful check.
+func (m *MultiStatus) AddOK(name, msg string) {
+	m.Add(name, models.StatusStateOk, msg)
+}
+
+// AddWarning records a check that found a degradation.
+func (m *MultiStatus) AddWarning(name, msg string) {
+	m.Add(name, models.StatusStateWarning, msg)
+}
+
+// AddFailure records a failed check.
+func (m *MultiStatus) AddFailure(name, msg string) {
+	m.Add(name, models.StatusStateFailure, msg)
+}
+
+// Ok returns true when no check reported a warning or failure.
+func (m *MultiStatus) Ok() bool {
+	for _, c := range m.checks {
+		if c.State != models.StatusStateOk {
+			return false
+		}
+	}
+	return true
+}
+
+// StatusModel renders the aggregated outcome as a models.Status with the
+// individual checks nested underneath. The overall state is the worst state
+// among the checks. The message is taken from the degraded check when there
+// is exactly one, so that single-check probes read as before; with several
+// degraded checks it only names them and the detail is left to the nested
+// sub-checks.
+func (m *MultiStatus) StatusModel() *models.Status {
+	s := &models.Status{State: models.StatusStateOk}
+
+	var degraded []SubCheck
+	var okMsgs []string
+	for _, c := range m.checks {
+		s.SubChecks = append(s.SubChecks, &models.SubCheckStatus{
+			Name:  c.Name,
+			State: c.State,
+			Msg:   c.Msg,
+		})
+
+		switch c.State {
+		case models.StatusStateFailure:
+			s.State = models.StatusStateFailure
+			degraded = append(degraded, c)
+		case models.StatusStateWarning:
+			if s.State != models.StatusStateFailure {
+				s.State = models.StatusStateWarning
+			}
+			degraded = append(degraded, c)
+		default:
+			if c.Msg != "" {
+				okMsgs = append(okMsgs, c.Msg)
+			}
+		}
+	}
+
+	switch len(degraded) {
+	case 0:
+		s.Msg = strings.Join(okMsgs, ", ")
+	case 1:
+		s.Msg = degraded[0].Msg
+	default:
+		names := make([]string, 0, len(degraded))
+		for _, c := range degraded {
+			names = append(names, c.Name)
+		}
+		s.Msg = fmt.Sprintf("%d of %d checks degraded: %s",
+			len(degraded), len(m.checks), strings.Join(names, ", "))
+	}
+
+	return s
+}
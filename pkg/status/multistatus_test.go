@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package status
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/cilium/api/v1/models"
+)
+
+func TestMultiStatusAllOK(t *testing.T) {
+	ms := NewMultiStatus()
+	ms.AddOK("connectivity", "etcd: 1/1 connected")
+	ms.AddOK("lease-keepalive", "")
+
+	require.True(t, ms.Ok())
+
+	s := ms.StatusModel()
+	require.Equal(t, models.StatusStateOk, s.State)
+	require.Equal(t, "etcd: 1/1 connected", s.Msg)
+	require.Len(t, s.SubChecks, 2)
+	require.Equal(t, "connectivity", s.SubChecks[0].Name)
+	require.Equal(t, models.StatusStateOk, s.SubChecks[0].State)
+}
+
+func TestMultiStatusSingleDegraded(t *testing.T) {
+	ms := NewMultiStatus()
+	ms.AddOK("connectivity", "etcd: 1/1 connected")
+	ms.AddWarning("lease-keepalive", "latency approaching lease TTL")
+
+	require.False(t, ms.Ok())
+
+	s := ms.StatusModel()
+	require.Equal(t, models.StatusStateWarning, s.State)
+	require.Equal(t, "latency approaching lease TTL", s.Msg)
+	require.Len(t, s.SubChecks, 2)
+}
+
+func TestMultiStatusMultipleDegraded(t *testing.T) {
+	ms := NewMultiStatus()
+	ms.AddWarning("missing", "2 entries")
+	ms.AddFailure("orphaned", "1 entry")
+	ms.AddOK("mismatched", "")
+
+	s := ms.StatusModel()
+	require.Equal(t, models.StatusStateFailure, s.State)
+	require.Equal(t, "2 of 3 checks degraded: missing, orphaned", s.Msg)
+	require.Len(t, s.SubChecks, 3)
+}
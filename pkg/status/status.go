@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"os"
 	"runtime/pprof"
+	"sync"
 	"sync/atomic"
 
 	"github.com/sirupsen/logrus"
@@ -17,6 +18,7 @@ import (
 	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/logging"
 	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/metrics"
 	"github.com/cilium/cilium/pkg/time"
 )
 
@@ -40,12 +42,25 @@ type Status struct {
 
 	// StaleWarning is true once the WarningThreshold has been reached
 	StaleWarning bool
+
+	// Disabled is true for the status update delivered when the probe is
+	// disabled at runtime via SetProbeEnabled()
+	Disabled bool
+
+	// Shutdown is true for the final status update delivered to each probe
+	// when the collector is drained via DrainAndClose()
+	Shutdown bool
 }
 
 // Probe is run by the collector at a particular interval between invocations
 type Probe struct {
 	Name string
 
+	// Probe must be safe to invoke concurrently with itself: probe runs
+	// are normally serialized, but when the collector's watchdog abandons
+	// a wedged invocation, the replacement invocation may run while the
+	// abandoned one is still blocked. Any state shared between
+	// invocations must be synchronized.
 	Probe func(ctx context.Context) (interface{}, error)
 
 	// OnStatusUpdate is called whenever the status of the probe changes
@@ -56,10 +71,117 @@ type Probe struct {
 	// factors such as current cluster size
 	Interval func(failures int) time.Duration
 
+	// StaleTimeout is an optional per-probe override of the collector's
+	// FailureThreshold, after which the probe is marked stale. This
+	// allows heavyweight probes to be given a longer deadline and cheap
+	// probes a tighter one. When zero, the collector's FailureThreshold
+	// applies.
+	StaleTimeout time.Duration
+
 	// consecutiveFailures is the number of consecutive failures in the
 	// probe becoming stale or failing. It is managed by
 	// updateProbeStatus()
 	consecutiveFailures int
+
+	// totalFailures is the total number of failures or timeouts of the
+	// probe since the collector was started. It is managed by
+	// updateProbeStatus()
+	totalFailures int
+
+	// lastDuration is the duration of the most recently completed run of
+	// the probe. It is protected by the collector's mutex.
+	lastDuration time.Duration
+
+	// disabled is true while the probe has been disabled at runtime via
+	// SetProbeEnabled(). It is protected by the collector's mutex.
+	disabled bool
+
+	// leakedInvocations is the number of probe invocations that were
+	// abandoned by the watchdog because their goroutine did not return.
+	// It is protected by the collector's mutex.
+	leakedInvocations int
+}
+
+// StatusCollector relays probe results and staleness information gathered
+// from a set of probes. Consumers should depend on this interface rather
+// than on Collector directly, so that the fake collector provided by the
+// statustest package can be injected in tests.
+type StatusCollector interface {
+	// WaitForFirstRun blocks until all probes have been executed at least
+	// once, or the context gets canceled.
+	WaitForFirstRun(ctx context.Context) error
+
+	// GetStaleProbes returns a map of stale probes which key is a probe
+	// name and value is a time when the last instance of the probe has
+	// been started.
+	GetStaleProbes() map[string]time.Time
+
+	// Close exits all probes and shuts down the collector
+	Close()
+
+	// DrainAndClose stops all probes, waits for in-flight probes to
+	// complete or the context to expire, delivers a final status update
+	// with Shutdown set to each probe, and shuts down the collector.
+	DrainAndClose(ctx context.Context) error
+
+	// Stats returns statistics about the collector's probes.
+	Stats() CollectorStats
+
+	// ProbeStats returns the execution history of each probe, in
+	// registration order.
+	ProbeStats() []ProbeStats
+
+	// SetProbeEnabled disables or re-enables scheduling of the named
+	// probe. Disabled probes are no longer run and cannot become stale.
+	// Returns an error if no probe with the given name is registered.
+	SetProbeEnabled(name string, enabled bool) error
+}
+
+// CollectorStats describes the internal health of a collector, for
+// introspection by monitoring code.
+type CollectorStats struct {
+	// TotalProbes is the number of probes registered with the collector.
+	TotalProbes int
+
+	// RunningProbes is the number of probes currently being executed.
+	RunningProbes int
+
+	// FailedProbes is the number of probes whose most recent execution
+	// failed or timed out.
+	FailedProbes int
+
+	// LastCycleDuration is the duration of the most recently completed
+	// probe execution.
+	LastCycleDuration time.Duration
+
+	// CycleCount is the total number of completed probe executions.
+	CycleCount uint64
+
+	// LeakedProbes is the total number of probe invocations that were
+	// abandoned because their goroutine did not return.
+	LeakedProbes int
+}
+
+// ProbeStats describes the recent execution history of a single probe.
+type ProbeStats struct {
+	// Name is the name of the probe.
+	Name string
+
+	// LastDuration is the duration of the most recently completed run of
+	// the probe.
+	LastDuration time.Duration
+
+	// ConsecutiveFailures is the number of consecutive failures or
+	// timeouts of the probe.
+	ConsecutiveFailures int
+
+	// TotalFailures is the total number of failures or timeouts of the
+	// probe since the collector was started.
+	TotalFailures int
+
+	// Disabled is true if the probe has been disabled at runtime and is
+	// no longer scheduled.
+	Disabled bool
 }
 
 // Collector concurrently runs probes used to check status of various subsystems
@@ -67,9 +189,19 @@ type Collector struct {
 	lock.RWMutex   // protects staleProbes and probeStartTime
 	config         Config
 	stop           chan struct{}
+	stopOnce       sync.Once
+	probes         []Probe
+	probeWg        sync.WaitGroup
 	staleProbes    map[string]struct{}
 	probeStartTime map[string]time.Time
 
+	// runningProbes, lastCycleDuration and cycleCount are also protected
+	// by the embedded mutex and updated after each probe run, for the
+	// statistics returned by Stats().
+	runningProbes     int
+	lastCycleDuration time.Duration
+	cycleCount        uint64
+
 	// lastStackdumpTime is the last time we dumped stack; only do it
 	// every 5 minutes so we don't waste resources.
 	lastStackdumpTime atomic.Int64
@@ -84,6 +216,11 @@ type Config struct {
 	FailureThreshold time.Duration
 	Interval         time.Duration
 	StackdumpPath    string
+
+	// LeakThreshold is the number of consecutive failure threshold
+	// intervals after which a probe goroutine that has not returned is
+	// abandoned and the probe is restarted.
+	LeakThreshold int
 }
 
 var DefaultConfig = Config{
@@ -91,6 +228,7 @@ var DefaultConfig = Config{
 	FailureThreshold: defaults.StatusCollectorFailureThreshold,
 	Interval:         defaults.StatusCollectorInterval,
 	StackdumpPath:    "/run/cilium/state/agent.stack.gz",
+	LeakThreshold:    defaults.StatusCollectorLeakThreshold,
 }
 
 // NewCollector creates a collector and starts the given probes.
@@ -100,6 +238,7 @@ func NewCollector(probes []Probe, config Config) *Collector {
 	c := &Collector{
 		config:         config,
 		stop:           make(chan struct{}),
+		probes:         probes,
 		staleProbes:    make(map[string]struct{}),
 		probeStartTime: make(map[string]time.Time),
 		firstRunSwg:    lock.NewStoppableWaitGroup(),
@@ -117,8 +256,12 @@ func NewCollector(probes []Probe, config Config) *Collector {
 		c.config.WarningThreshold = defaults.StatusCollectorWarningThreshold
 	}
 
-	for i := range probes {
-		c.spawnProbe(&probes[i], c.firstRunSwg.Add())
+	if c.config.LeakThreshold == 0 {
+		c.config.LeakThreshold = defaults.StatusCollectorLeakThreshold
+	}
+
+	for i := range c.probes {
+		c.spawnProbe(&c.probes[i], c.firstRunSwg.Add())
 	}
 	c.firstRunSwg.Stop()
 
@@ -138,7 +281,35 @@ func (c *Collector) WaitForFirstRun(ctx context.Context) error {
 
 // Close exits all probes and shuts down the collector
 func (c *Collector) Close() {
-	close(c.stop)
+	c.stopOnce.Do(func() { close(c.stop) })
+}
+
+// DrainAndClose signals all probe goroutines to stop and waits until
+// in-flight probes have completed, or until ctx expires. Afterwards, every
+// probe receives one final status update with Shutdown set, so that status
+// consumers can distinguish an orderly shutdown from missing data. Returns
+// the context's error if the drain did not complete in time.
+func (c *Collector) DrainAndClose(ctx context.Context) error {
+	c.Close()
+
+	drained := make(chan struct{})
+	go func() {
+		c.probeWg.Wait()
+		close(drained)
+	}()
+
+	var err error
+	select {
+	case <-drained:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	for i := range c.probes {
+		c.probes[i].OnStatusUpdate(Status{Shutdown: true})
+	}
+
+	return err
 }
 
 // GetStaleProbes returns a map of stale probes which key is a probe name and
@@ -158,11 +329,114 @@ func (c *Collector) GetStaleProbes() map[string]time.Time {
 	return probes
 }
 
+// finishProbeRun updates the collector statistics once a probe run has
+// completed.
+func (c *Collector) finishProbeRun(p *Probe) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.runningProbes--
+	c.lastCycleDuration = time.Since(c.probeStartTime[p.Name])
+	c.cycleCount++
+	p.lastDuration = c.lastCycleDuration
+}
+
+// Stats returns statistics about the collector's probes.
+func (c *Collector) Stats() CollectorStats {
+	c.RLock()
+	defer c.RUnlock()
+
+	failed, leaked := 0, 0
+	for i := range c.probes {
+		if c.probes[i].consecutiveFailures > 0 {
+			failed++
+		}
+		leaked += c.probes[i].leakedInvocations
+	}
+
+	return CollectorStats{
+		TotalProbes:       len(c.probes),
+		RunningProbes:     c.runningProbes,
+		FailedProbes:      failed,
+		LastCycleDuration: c.lastCycleDuration,
+		CycleCount:        c.cycleCount,
+		LeakedProbes:      leaked,
+	}
+}
+
+// ProbeStats returns the execution history of each probe, in registration
+// order.
+func (c *Collector) ProbeStats() []ProbeStats {
+	c.RLock()
+	defer c.RUnlock()
+
+	stats := make([]ProbeStats, 0, len(c.probes))
+	for i := range c.probes {
+		p := &c.probes[i]
+		stats = append(stats, ProbeStats{
+			Name:                p.Name,
+			LastDuration:        p.lastDuration,
+			ConsecutiveFailures: p.consecutiveFailures,
+			TotalFailures:       p.totalFailures,
+			Disabled:            p.disabled,
+		})
+	}
+
+	return stats
+}
+
+// SetProbeEnabled disables or re-enables scheduling of the named probe.
+// Disabling a probe removes any staleness it has accumulated and delivers a
+// final status update with Disabled set; the probe is then skipped by its
+// probe loop until it is re-enabled. Returns an error if no probe with the
+// given name is registered.
+func (c *Collector) SetProbeEnabled(name string, enabled bool) error {
+	c.Lock()
+	var p *Probe
+	for i := range c.probes {
+		if c.probes[i].Name == name {
+			p = &c.probes[i]
+			break
+		}
+	}
+	if p == nil {
+		c.Unlock()
+		return fmt.Errorf("probe %s not found", name)
+	}
+
+	notify := !enabled && !p.disabled
+	p.disabled = !enabled
+	if !enabled {
+		// A disabled probe is never scheduled, so it must not linger
+		// in the stale set nor count as failing.
+		delete(c.staleProbes, name)
+		p.consecutiveFailures = 0
+	}
+	c.Unlock()
+
+	if notify {
+		p.OnStatusUpdate(Status{Disabled: true})
+	}
+
+	return nil
+}
+
+// probeDisabled returns whether the given probe is currently disabled.
+func (c *Collector) probeDisabled(p *Probe) bool {
+	c.RLock()
+	defer c.RUnlock()
+	return p.disabled
+}
+
 // spawnProbe starts a goroutine which invokes the probe at the particular interval.
 func (c *Collector) spawnProbe(p *Probe, firstRunCompleted func()) {
+	c.probeWg.Add(1)
 	go func() {
+		defer c.probeWg.Done()
 		for {
-			c.runProbe(p)
+			if !c.probeDisabled(p) {
+				c.runProbe(p)
+			}
 
 			// The first run of the probe has completed.
 			if firstRunCompleted != nil {
@@ -188,18 +462,27 @@ func (c *Collector) spawnProbe(p *Probe, firstRunCompleted func()) {
 // runProbe runs the given probe, and returns either after the probe has returned
 // or after the collector has been closed.
 func (c *Collector) runProbe(p *Probe) {
+	failureThreshold := c.config.FailureThreshold
+	if p.StaleTimeout != 0 {
+		failureThreshold = p.StaleTimeout
+	}
+
 	var (
 		statusData       interface{}
 		err              error
 		warningThreshold = time.After(c.config.WarningThreshold)
 		hardTimeout      = false
 		probeReturned    = make(chan struct{}, 1)
-		ctx, cancel      = context.WithTimeout(context.Background(), c.config.FailureThreshold)
+		ctx, cancel      = context.WithTimeout(context.Background(), failureThreshold)
 		ctxTimeout       = make(chan struct{}, 1)
+		// The watchdog abandons the probe goroutine once it has not
+		// returned for LeakThreshold consecutive failure intervals.
+		abandonAfter = time.After(time.Duration(c.config.LeakThreshold) * failureThreshold)
 	)
 
 	c.Lock()
 	c.probeStartTime[p.Name] = time.Now()
+	c.runningProbes++
 	c.Unlock()
 
 	go func() {
@@ -221,9 +504,11 @@ func (c *Collector) runProbe(p *Probe) {
 	for {
 		select {
 		case <-c.stop:
-			// Collector was closed. The probe will complete in the background
-			// and won't be restarted again.
+			// Collector was closed. Wait for the in-flight probe to
+			// complete; it won't be restarted again.
 			cancel()
+			<-probeReturned
+			c.finishProbeRun(p)
 			return
 
 		case <-warningThreshold:
@@ -245,19 +530,55 @@ func (c *Collector) runProbe(p *Probe) {
 			}
 
 			cancel()
+			c.finishProbeRun(p)
 			return
 
 		case <-ctxTimeout:
 			// We have timed out. Report a status and mark that we timed out so we
 			// do not emit status later.
 			staleErr := fmt.Errorf("no response from %s probe within %v seconds",
-				p.Name, c.config.FailureThreshold.Seconds())
+				p.Name, failureThreshold.Seconds())
 			c.updateProbeStatus(p, nil, true, staleErr)
 			hardTimeout = true
+
+		case <-abandonAfter:
+			// The probe goroutine is most likely wedged: its context
+			// expired long ago and it still has not returned. Give
+			// up on this invocation so that the probe loop starts a
+			// fresh one; the leaked goroutine keeps running until
+			// whatever blocks it resolves, but its result is
+			// discarded. Should it revive, it may run concurrently
+			// with its replacement, which is why Probe functions
+			// must tolerate concurrent invocation.
+			cancel()
+			c.abandonProbe(p)
+			c.finishProbeRun(p)
+			return
 		}
 	}
 }
 
+// abandonProbe records that an invocation of the given probe was abandoned
+// by the watchdog because its goroutine did not return, and dumps the stack
+// of all goroutines, including the abandoned one, to aid in debugging the
+// wedged probe.
+func (c *Collector) abandonProbe(p *Probe) {
+	c.Lock()
+	p.leakedInvocations++
+	leaked := p.leakedInvocations
+	c.Unlock()
+
+	metrics.StatusProbeLeaks.WithLabelValues(p.Name).Inc()
+
+	log.WithFields(logrus.Fields{
+		logfields.Probe: p.Name,
+		"leakedTotal":   leaked,
+	}).Warnf("Probe goroutine did not return within %d failure intervals, abandoning it and restarting the probe",
+		c.config.LeakThreshold)
+
+	go c.maybeDumpStack()
+}
+
 func (c *Collector) updateProbeStatus(p *Probe, data interface{}, stale bool, err error) {
 	// Update stale status of the probe
 	c.Lock()
@@ -265,12 +586,14 @@ func (c *Collector) updateProbeStatus(p *Probe, data interface{}, stale bool, er
 	if stale {
 		c.staleProbes[p.Name] = struct{}{}
 		p.consecutiveFailures++
+		p.totalFailures++
 	} else {
 		delete(c.staleProbes, p.Name)
 		if err == nil {
 			p.consecutiveFailures = 0
 		} else {
 			p.consecutiveFailures++
+			p.totalFailures++
 		}
 	}
 	c.Unlock()
@@ -119,6 +119,90 @@ func TestCollectorFailureTimeout(t *testing.T) {
 	require.Len(t, collector.GetStaleProbes(), 1)
 }
 
+func TestProbeTimeoutOverride(t *testing.T) {
+	s := setUpTest(t)
+
+	// The probe's own timeout is a quarter of the collector's failure
+	// threshold; the probe outlives the former but not the latter, so a
+	// stale warning proves that the override was applied.
+	timeout := s.Config().FailureThreshold / 4
+
+	var ok atomic.Uint64
+
+	p := []Probe{
+		{
+			Name:         "tight",
+			StaleTimeout: timeout,
+			Probe: func(ctx context.Context) (interface{}, error) {
+				time.Sleep(timeout * 2)
+				return nil, nil
+			},
+			OnStatusUpdate: func(status Status) {
+				if status.StaleWarning && status.Data == nil && status.Err != nil {
+					if strings.Contains(status.Err.Error(),
+						fmt.Sprintf("within %v seconds", timeout.Seconds())) {
+
+						ok.Add(1)
+					}
+				}
+			},
+		},
+	}
+
+	collector := NewCollector(p, s.Config())
+	defer collector.Close()
+
+	// wait for the probe's own timeout to kick in
+	require.NoError(t, testutils.WaitUntil(func() bool {
+		return ok.Load() >= 1
+	}, 1*time.Second))
+	require.Contains(t, collector.GetStaleProbes(), "tight")
+}
+
+func TestPerProbeStaleTimeout(t *testing.T) {
+	s := setUpTest(t)
+
+	// Generous global thresholds, so that only a per-probe StaleTimeout
+	// can mark a probe stale within the runtime of this test.
+	config := s.Config()
+	config.Interval = time.Minute
+	config.WarningThreshold = time.Minute
+	config.FailureThreshold = time.Minute
+
+	release := make(chan struct{})
+	blocked := func(ctx context.Context) (interface{}, error) {
+		<-release
+		return nil, nil
+	}
+
+	p := []Probe{
+		{
+			Name:           "tight",
+			StaleTimeout:   time.Second,
+			Probe:          blocked,
+			OnStatusUpdate: func(status Status) {},
+		},
+		{
+			Name:           "patient",
+			StaleTimeout:   60 * time.Second,
+			Probe:          blocked,
+			OnStatusUpdate: func(status Status) {},
+		},
+	}
+
+	collector := NewCollector(p, config)
+	defer collector.Close()
+	defer close(release)
+
+	// The tight probe exceeds its 1 second StaleTimeout while the patient
+	// probe, started at the same time, stays within its 60 second one.
+	require.NoError(t, testutils.WaitUntil(func() bool {
+		return len(collector.GetStaleProbes()) > 0
+	}, 2*time.Second))
+	require.Contains(t, collector.GetStaleProbes(), "tight")
+	require.NotContains(t, collector.GetStaleProbes(), "patient")
+}
+
 func TestCollectorSuccess(t *testing.T) {
 	s := setUpTest(t)
 
@@ -222,3 +306,297 @@ func TestWaitForFirstRun(t *testing.T) {
 	unlock <- struct{}{}
 	require.NoError(t, test())
 }
+
+func TestDrainAndClose(t *testing.T) {
+	s := setUpTest(t)
+
+	var shutdown atomic.Uint64
+
+	p := []Probe{
+		{
+			Probe: func(ctx context.Context) (interface{}, error) {
+				// Block until the collector cancels the probe context
+				// during the drain.
+				<-ctx.Done()
+				return nil, nil
+			},
+			OnStatusUpdate: func(status Status) {
+				if status.Shutdown {
+					shutdown.Add(1)
+				}
+			},
+		},
+		{
+			Probe: func(ctx context.Context) (interface{}, error) {
+				return nil, nil
+			},
+			OnStatusUpdate: func(status Status) {
+				if status.Shutdown {
+					shutdown.Add(1)
+				}
+			},
+		},
+	}
+
+	collector := NewCollector(p, s.Config())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, collector.DrainAndClose(ctx))
+	require.Equal(t, uint64(2), shutdown.Load())
+}
+
+func TestDrainAndCloseTimeout(t *testing.T) {
+	s := setUpTest(t)
+
+	var shutdown atomic.Uint64
+	block := make(chan struct{})
+	defer close(block)
+
+	p := []Probe{
+		{
+			Probe: func(ctx context.Context) (interface{}, error) {
+				// Ignore context cancellation to simulate a stuck probe.
+				<-block
+				return nil, nil
+			},
+			OnStatusUpdate: func(status Status) {
+				if status.Shutdown {
+					shutdown.Add(1)
+				}
+			},
+		},
+	}
+
+	collector := NewCollector(p, s.Config())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	require.Error(t, collector.DrainAndClose(ctx))
+
+	// The Shutdown sentinel is delivered even when the drain times out.
+	require.Equal(t, uint64(1), shutdown.Load())
+}
+
+func TestCollectorStats(t *testing.T) {
+	s := setUpTest(t)
+
+	var ok, errs atomic.Uint64
+
+	p := []Probe{
+		{
+			Name: "success",
+			Probe: func(ctx context.Context) (interface{}, error) {
+				return "testData", nil
+			},
+			OnStatusUpdate: func(status Status) {
+				if status.Err == nil && !status.Shutdown {
+					ok.Add(1)
+				}
+			},
+		},
+		{
+			Name: "failure",
+			Probe: func(ctx context.Context) (interface{}, error) {
+				return nil, fmt.Errorf("error")
+			},
+			OnStatusUpdate: func(status Status) {
+				if status.Err != nil {
+					errs.Add(1)
+				}
+			},
+		},
+	}
+
+	collector := NewCollector(p, s.Config())
+	defer collector.Close()
+
+	// wait until both probes have completed at least two runs
+	require.NoError(t, testutils.WaitUntil(func() bool {
+		return ok.Load() >= 2 && errs.Load() >= 2
+	}, 1*time.Second))
+
+	stats := collector.Stats()
+	require.Equal(t, 2, stats.TotalProbes)
+	require.Equal(t, 1, stats.FailedProbes)
+	require.GreaterOrEqual(t, stats.CycleCount, uint64(4))
+}
+
+func TestProbeStats(t *testing.T) {
+	s := setUpTest(t)
+
+	var ok, errs atomic.Uint64
+
+	p := []Probe{
+		{
+			Name: "success",
+			Probe: func(ctx context.Context) (interface{}, error) {
+				return "testData", nil
+			},
+			OnStatusUpdate: func(status Status) {
+				if status.Err == nil && !status.Shutdown {
+					ok.Add(1)
+				}
+			},
+		},
+		{
+			Name: "failure",
+			Probe: func(ctx context.Context) (interface{}, error) {
+				return nil, fmt.Errorf("error")
+			},
+			OnStatusUpdate: func(status Status) {
+				if status.Err != nil {
+					errs.Add(1)
+				}
+			},
+		},
+	}
+
+	collector := NewCollector(p, s.Config())
+	defer collector.Close()
+
+	// wait until both probes have completed at least two runs
+	require.NoError(t, testutils.WaitUntil(func() bool {
+		return ok.Load() >= 2 && errs.Load() >= 2
+	}, 1*time.Second))
+
+	stats := collector.ProbeStats()
+	require.Len(t, stats, 2)
+
+	require.Equal(t, "success", stats[0].Name)
+	require.Equal(t, 0, stats[0].ConsecutiveFailures)
+	require.Equal(t, 0, stats[0].TotalFailures)
+
+	require.Equal(t, "failure", stats[1].Name)
+	require.GreaterOrEqual(t, stats[1].ConsecutiveFailures, 2)
+	require.GreaterOrEqual(t, stats[1].TotalFailures, stats[1].ConsecutiveFailures)
+}
+
+func TestSetProbeEnabled(t *testing.T) {
+	s := setUpTest(t)
+
+	var runs, disabled atomic.Uint64
+
+	p := []Probe{
+		{
+			Name: "toggled",
+			Probe: func(ctx context.Context) (interface{}, error) {
+				runs.Add(1)
+				return nil, nil
+			},
+			OnStatusUpdate: func(status Status) {
+				if status.Disabled {
+					disabled.Add(1)
+				}
+			},
+		},
+	}
+
+	collector := NewCollector(p, s.Config())
+	defer collector.Close()
+
+	// Unknown probe names are rejected.
+	require.Error(t, collector.SetProbeEnabled("unknown", false))
+
+	// wait for the probe to run at least once, then disable it
+	require.NoError(t, testutils.WaitUntil(func() bool {
+		return runs.Load() >= 1
+	}, 1*time.Second))
+	require.NoError(t, collector.SetProbeEnabled("toggled", false))
+	require.EqualValues(t, 1, disabled.Load())
+	require.True(t, collector.ProbeStats()[0].Disabled)
+
+	// While disabled, at most the run that was already scheduled when the
+	// probe was disabled may still complete.
+	seen := runs.Load()
+	time.Sleep(5 * s.Config().Interval)
+	require.LessOrEqual(t, runs.Load(), seen+1)
+
+	// Disabling an already disabled probe does not deliver another update.
+	require.NoError(t, collector.SetProbeEnabled("toggled", false))
+	require.EqualValues(t, 1, disabled.Load())
+
+	// Re-enabling resumes the schedule.
+	require.NoError(t, collector.SetProbeEnabled("toggled", true))
+	require.False(t, collector.ProbeStats()[0].Disabled)
+	resumed := runs.Load()
+	require.NoError(t, testutils.WaitUntil(func() bool {
+		return runs.Load() > resumed
+	}, 1*time.Second))
+}
+
+func TestSetProbeEnabledClearsStale(t *testing.T) {
+	s := setUpTest(t)
+
+	// A generous leak threshold, so that the watchdog cannot restart the
+	// stuck probe within the runtime of this test.
+	config := s.Config()
+	config.LeakThreshold = 1000
+
+	release := make(chan struct{})
+
+	p := []Probe{
+		{
+			Name:         "stuck",
+			StaleTimeout: 10 * time.Millisecond,
+			Probe: func(ctx context.Context) (interface{}, error) {
+				<-release
+				return nil, nil
+			},
+			OnStatusUpdate: func(status Status) {},
+		},
+	}
+
+	collector := NewCollector(p, config)
+	defer collector.Close()
+	defer close(release)
+
+	require.NoError(t, testutils.WaitUntil(func() bool {
+		return len(collector.GetStaleProbes()) > 0
+	}, 1*time.Second))
+
+	// Disabling the probe removes it from the stale set and resets its
+	// failure streak.
+	require.NoError(t, collector.SetProbeEnabled("stuck", false))
+	require.Empty(t, collector.GetStaleProbes())
+	require.Equal(t, 0, collector.ProbeStats()[0].ConsecutiveFailures)
+}
+
+func TestWatchdogRestartsWedgedProbe(t *testing.T) {
+	s := setUpTest(t)
+	config := s.Config()
+	config.Interval = time.Millisecond
+	config.FailureThreshold = 20 * time.Millisecond
+	config.LeakThreshold = 2
+
+	var runs atomic.Uint64
+	// block simulates a wedge that does not honor context cancellation.
+	block := make(chan struct{})
+
+	p := []Probe{
+		{
+			Name: "wedged",
+			Probe: func(ctx context.Context) (interface{}, error) {
+				if runs.Add(1) == 1 {
+					<-block
+				}
+				return nil, nil
+			},
+			OnStatusUpdate: func(status Status) {},
+		},
+	}
+
+	collector := NewCollector(p, config)
+	defer collector.Close()
+	defer close(block)
+
+	// The watchdog must abandon the wedged invocation and start a fresh
+	// one, which succeeds immediately.
+	require.NoError(t, testutils.WaitUntil(func() bool {
+		return runs.Load() >= 2
+	}, 5*time.Second))
+
+	require.NoError(t, testutils.WaitUntil(func() bool {
+		return collector.Stats().LeakedProbes == 1
+	}, 1*time.Second))
+}
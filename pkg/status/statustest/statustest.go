@@ -0,0 +1,254 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+// Package statustest provides a fake status collector for testing code that
+// consumes probe results without having to run the real probe goroutines.
+package statustest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/status"
+	"github.com/cilium/cilium/pkg/time"
+)
+
+var _ status.StatusCollector = &FakeCollector{}
+
+// FakeCollector implements status.StatusCollector with canned data. Probe
+// results are set explicitly via SetProbeResult and staleness is triggered
+// by advancing the fake clock, so tests remain fully deterministic.
+type FakeCollector struct {
+	config status.Config
+
+	mu             lock.RWMutex
+	probes         map[string]*status.Probe
+	probeOrder     []string
+	probeStartTime map[string]time.Time
+	staleProbes    map[string]struct{}
+	disabledProbes map[string]struct{}
+	updates        map[string][]status.Status
+	now            time.Time
+}
+
+// NewFakeCollector creates a fake collector for the given probes. Unlike the
+// real collector, no probe is invoked; results are injected via
+// SetProbeResult and AdvanceClock.
+func NewFakeCollector(probes []status.Probe, config status.Config) *FakeCollector {
+	f := &FakeCollector{
+		config:         config,
+		probes:         make(map[string]*status.Probe, len(probes)),
+		probeStartTime: make(map[string]time.Time, len(probes)),
+		staleProbes:    make(map[string]struct{}),
+		disabledProbes: make(map[string]struct{}),
+		updates:        make(map[string][]status.Status),
+		now:            time.Now(),
+	}
+
+	if f.config.FailureThreshold == time.Duration(0) {
+		f.config.FailureThreshold = status.DefaultConfig.FailureThreshold
+	}
+
+	for i := range probes {
+		f.probes[probes[i].Name] = &probes[i]
+		f.probeOrder = append(f.probeOrder, probes[i].Name)
+		f.probeStartTime[probes[i].Name] = f.now
+	}
+
+	return f
+}
+
+// WaitForFirstRun returns immediately as the fake collector never runs any
+// probe.
+func (f *FakeCollector) WaitForFirstRun(ctx context.Context) error {
+	return ctx.Err()
+}
+
+// GetStaleProbes returns the probes marked stale by AdvanceClock, keyed by
+// probe name with the time the last probe result was recorded.
+func (f *FakeCollector) GetStaleProbes() map[string]time.Time {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	probes := make(map[string]time.Time, len(f.staleProbes))
+	for p := range f.staleProbes {
+		probes[p] = f.probeStartTime[p]
+	}
+
+	return probes
+}
+
+// Close is a no-op for the fake collector.
+func (f *FakeCollector) Close() {}
+
+// DrainAndClose delivers a final status update with Shutdown set to each
+// probe, as the real collector does on shutdown.
+func (f *FakeCollector) DrainAndClose(ctx context.Context) error {
+	f.mu.Lock()
+	st := status.Status{Shutdown: true}
+	notify := make([]*status.Probe, 0, len(f.probes))
+	for name, p := range f.probes {
+		f.updates[name] = append(f.updates[name], st)
+		notify = append(notify, p)
+	}
+	f.mu.Unlock()
+
+	for _, p := range notify {
+		if p.OnStatusUpdate != nil {
+			p.OnStatusUpdate(st)
+		}
+	}
+
+	return ctx.Err()
+}
+
+// SetProbeResult records a result for the named probe and invokes its
+// OnStatusUpdate callback, as the real collector would after a probe run.
+// Any staleness of the probe is cleared.
+func (f *FakeCollector) SetProbeResult(name string, data interface{}, err error) {
+	f.mu.Lock()
+	p, ok := f.probes[name]
+	if !ok {
+		f.mu.Unlock()
+		panic(fmt.Sprintf("unknown probe %q", name))
+	}
+	delete(f.staleProbes, name)
+	f.probeStartTime[name] = f.now
+	st := status.Status{Data: data, Err: err}
+	f.updates[name] = append(f.updates[name], st)
+	f.mu.Unlock()
+
+	if p.OnStatusUpdate != nil {
+		p.OnStatusUpdate(st)
+	}
+}
+
+// AdvanceClock moves the fake clock forward. Probes without a result for
+// longer than the configured failure threshold are marked stale and receive
+// a StaleWarning status update, mirroring the real collector's timeout
+// handling.
+func (f *FakeCollector) AdvanceClock(d time.Duration) {
+	var notify []*status.Probe
+	var statuses []status.Status
+
+	f.mu.Lock()
+	f.now = f.now.Add(d)
+	for name, p := range f.probes {
+		if _, stale := f.staleProbes[name]; stale {
+			continue
+		}
+		if _, disabled := f.disabledProbes[name]; disabled {
+			continue
+		}
+		if f.now.Sub(f.probeStartTime[name]) < f.config.FailureThreshold {
+			continue
+		}
+		f.staleProbes[name] = struct{}{}
+		st := status.Status{
+			Err: fmt.Errorf("no response from %s probe within %v seconds",
+				name, f.config.FailureThreshold.Seconds()),
+			StaleWarning: true,
+		}
+		f.updates[name] = append(f.updates[name], st)
+		notify = append(notify, p)
+		statuses = append(statuses, st)
+	}
+	f.mu.Unlock()
+
+	for i, p := range notify {
+		if p.OnStatusUpdate != nil {
+			p.OnStatusUpdate(statuses[i])
+		}
+	}
+}
+
+// SetProbeEnabled disables or re-enables the named probe. Disabling clears
+// any staleness and delivers a status update with Disabled set, as the real
+// collector does. Disabled probes are not marked stale by AdvanceClock.
+func (f *FakeCollector) SetProbeEnabled(name string, enabled bool) error {
+	f.mu.Lock()
+	p, ok := f.probes[name]
+	if !ok {
+		f.mu.Unlock()
+		return fmt.Errorf("probe %s not found", name)
+	}
+
+	_, wasDisabled := f.disabledProbes[name]
+	notify := !enabled && !wasDisabled
+	if enabled {
+		delete(f.disabledProbes, name)
+	} else {
+		f.disabledProbes[name] = struct{}{}
+		delete(f.staleProbes, name)
+	}
+	st := status.Status{Disabled: true}
+	if notify {
+		f.updates[name] = append(f.updates[name], st)
+	}
+	f.mu.Unlock()
+
+	if notify && p.OnStatusUpdate != nil {
+		p.OnStatusUpdate(st)
+	}
+
+	return nil
+}
+
+// StatusUpdates returns, in order, the status updates delivered to the named
+// probe's OnStatusUpdate callback.
+func (f *FakeCollector) StatusUpdates(name string) []status.Status {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	updates := make([]status.Status, len(f.updates[name]))
+	copy(updates, f.updates[name])
+	return updates
+}
+
+// Stats returns statistics matching the results injected so far. No probe is
+// ever reported as running, and cycle durations are always zero, as the fake
+// collector completes probe results instantaneously.
+func (f *FakeCollector) Stats() status.CollectorStats {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	stats := status.CollectorStats{
+		TotalProbes: len(f.probes),
+	}
+	for name := range f.probes {
+		updates := f.updates[name]
+		stats.CycleCount += uint64(len(updates))
+		if len(updates) > 0 && updates[len(updates)-1].Err != nil {
+			stats.FailedProbes++
+		}
+	}
+	return stats
+}
+
+// ProbeStats returns the execution history of each probe, derived from the
+// results injected so far. Durations are always zero, as the fake collector
+// completes probe results instantaneously.
+func (f *FakeCollector) ProbeStats() []status.ProbeStats {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	stats := make([]status.ProbeStats, 0, len(f.probeOrder))
+	for _, name := range f.probeOrder {
+		ps := status.ProbeStats{Name: name}
+		if _, disabled := f.disabledProbes[name]; disabled {
+			ps.Disabled = true
+		}
+		updates := f.updates[name]
+		for _, u := range updates {
+			if u.Err != nil {
+				ps.TotalFailures++
+			}
+		}
+		for i := len(updates) - 1; i >= 0 && updates[i].Err != nil; i-- {
+			ps.ConsecutiveFailures++
+		}
+		stats = append(stats, ps)
+	}
+
+	return stats
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package status
+
+import (
+	"github.com/cilium/cilium/api/v1/models"
+)
+
+// SummaryOptions controls which sections SummarizeStatus copies into the
+// summarized response beyond the cluster health.
+type SummaryOptions struct {
+	// IncludeKvstore copies the kvstore section, which readiness checks
+	// need when the agent requires a working datastore connection.
+	IncludeKvstore bool
+
+	// IncludeClusterMesh copies the clustermesh section.
+	IncludeClusterMesh bool
+
+	// FailingControllersCap is the maximum number of failing controllers
+	// copied into the summary. When zero, a single failing controller is
+	// reported, which is enough for a readiness check to turn unhealthy.
+	FailingControllersCap int
+}
+
+// SummarizeStatus produces the minimal variant of the given status response:
+// the cluster health, the failing controllers up to the configured cap, and
+// any sections requested via opts. All copied sections are deep copies, so
+// the result can be used after any lock protecting full has been released.
+// Nil sections of full are skipped.
+func SummarizeStatus(full *models.StatusResponse, opts SummaryOptions) *models.StatusResponse {
+	sr := &models.StatusResponse{
+		Cluster: new(models.ClusterStatus),
+	}
+
+	if full.Cluster != nil && full.Cluster.CiliumHealth != nil {
+		health := *full.Cluster.CiliumHealth
+		sr.Cluster.CiliumHealth = &health
+	}
+
+	limit := opts.FailingControllersCap
+	if limit == 0 {
+		limit = 1
+	}
+	for _, c := range full.Controllers {
+		if c.Status == nil || c.Status.LastFailureMsg == "" {
+			continue
+		}
+		sr.Controllers = append(sr.Controllers, c.DeepCopy())
+		if len(sr.Controllers) >= limit {
+			break
+		}
+	}
+
+	if opts.IncludeKvstore && full.Kvstore != nil {
+		sr.Kvstore = full.Kvstore.DeepCopy()
+	}
+
+	if opts.IncludeClusterMesh && full.ClusterMesh != nil {
+		sr.ClusterMesh = full.ClusterMesh.DeepCopy()
+	}
+
+	return sr
+}
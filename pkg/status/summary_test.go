@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package status
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/cilium/api/v1/models"
+)
+
+func TestSummarizeStatus(t *testing.T) {
+	full := &models.StatusResponse{
+		Cluster: &models.ClusterStatus{
+			Self:         "node-1",
+			CiliumHealth: &models.Status{State: models.StatusStateOk, Msg: "healthy"},
+		},
+		Controllers: models.ControllerStatuses{
+			{Name: "healthy-controller", Status: &models.ControllerStatusStatus{}},
+			{Name: "first-failing", Status: &models.ControllerStatusStatus{LastFailureMsg: "boom"}},
+			{Name: "second-failing", Status: &models.ControllerStatusStatus{LastFailureMsg: "also boom"}},
+			{Name: "no-status"},
+		},
+		Kvstore:     &models.Status{State: models.StatusStateFailure, Msg: "etcd down"},
+		ClusterMesh: &models.ClusterMeshStatus{NumGlobalServices: 3},
+		Ipam:        &models.IPAMStatus{Allocations: map[string]string{}},
+	}
+
+	tests := []struct {
+		name            string
+		opts            SummaryOptions
+		controllerNames []string
+		wantKvstore     bool
+		wantClusterMesh bool
+	}{
+		{
+			name:            "defaults copy the first failing controller only",
+			opts:            SummaryOptions{},
+			controllerNames: []string{"first-failing"},
+		},
+		{
+			name:            "controller cap copies additional failing controllers",
+			opts:            SummaryOptions{FailingControllersCap: 2},
+			controllerNames: []string{"first-failing", "second-failing"},
+		},
+		{
+			name:            "kvstore section is copied on request",
+			opts:            SummaryOptions{IncludeKvstore: true},
+			controllerNames: []string{"first-failing"},
+			wantKvstore:     true,
+		},
+		{
+			name:            "clustermesh section is copied on request",
+			opts:            SummaryOptions{IncludeClusterMesh: true},
+			controllerNames: []string{"first-failing"},
+			wantClusterMesh: true,
+		},
+		{
+			name: "all options combined",
+			opts: SummaryOptions{
+				IncludeKvstore:        true,
+				IncludeClusterMesh:    true,
+				FailingControllersCap: 10,
+			},
+			controllerNames: []string{"first-failing", "second-failing"},
+			wantKvstore:     true,
+			wantClusterMesh: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sr := SummarizeStatus(full, tt.opts)
+
+			// Only the cluster health is copied, never the full
+			// cluster section or unrelated sections.
+			require.Nil(t, sr.Ipam)
+			require.Empty(t, sr.Cluster.Self)
+			require.Equal(t, full.Cluster.CiliumHealth, sr.Cluster.CiliumHealth)
+
+			names := make([]string, 0, len(sr.Controllers))
+			for _, c := range sr.Controllers {
+				names = append(names, c.Name)
+			}
+			require.Equal(t, tt.controllerNames, names)
+
+			if tt.wantKvstore {
+				require.Equal(t, full.Kvstore, sr.Kvstore)
+			} else {
+				require.Nil(t, sr.Kvstore)
+			}
+
+			if tt.wantClusterMesh {
+				require.Equal(t, full.ClusterMesh, sr.ClusterMesh)
+			} else {
+				require.Nil(t, sr.ClusterMesh)
+			}
+		})
+	}
+}
+
+func TestSummarizeStatusNilSections(t *testing.T) {
+	sr := SummarizeStatus(&models.StatusResponse{}, SummaryOptions{
+		IncludeKvstore:     true,
+		IncludeClusterMesh: true,
+	})
+
+	require.NotNil(t, sr.Cluster)
+	require.Nil(t, sr.Cluster.CiliumHealth)
+	require.Empty(t, sr.Controllers)
+	require.Nil(t, sr.Kvstore)
+	require.Nil(t, sr.ClusterMesh)
+}
+
+func TestSummarizeStatusDoesNotAlias(t *testing.T) {
+	full := &models.StatusResponse{
+		Cluster: &models.ClusterStatus{
+			CiliumHealth: &models.Status{State: models.StatusStateOk, Msg: "healthy"},
+		},
+		Controllers: models.ControllerStatuses{
+			{Name: "failing", Status: &models.ControllerStatusStatus{LastFailureMsg: "boom"}},
+		},
+		Kvstore: &models.Status{State: models.StatusStateOk, Msg: "connected"},
+	}
+
+	sr := SummarizeStatus(full, SummaryOptions{IncludeKvstore: true})
+
+	sr.Cluster.CiliumHealth.Msg = "mutated"
+	sr.Controllers[0].Status.LastFailureMsg = "mutated"
+	sr.Kvstore.Msg = "mutated"
+
+	require.Equal(t, "healthy", full.Cluster.CiliumHealth.Msg)
+	require.Equal(t, "boom", full.Controllers[0].Status.LastFailureMsg)
+	require.Equal(t, "connected", full.Kvstore.Msg)
+}